@@ -0,0 +1,79 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// fake-api-server fakeドライバをバックエンドとしたさくらのクラウドAPI互換のHTTPサーバを起動する
+//
+// 起動後、APIルートURL(SAKURACLOUD_API_ROOT_URLなど)に http://<addr>/cloud/zone を指定して利用する
+//
+//	$ go run ./cmd/fake-api-server -addr localhost:8080 -store fake-store.json
+//	$ go run ./cmd/fake-api-server -addr localhost:8080 -fixture fixture.yaml
+//	$ SAKURACLOUD_API_ROOT_URL=http://localhost:8080/cloud/zone terraform apply
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/sacloud/libsacloud/v2/sacloud/fake"
+	"github.com/sacloud/libsacloud/v2/sacloud/fake/server"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:8080", "address to listen on")
+	storePath := flag.String("store", "", "path to the data store file. if empty, in-memory store is used")
+	storeType := flag.String("store-type", "json", "type of the data store file [json/kv]")
+	fixturePath := flag.String("fixture", "", "path to the fixture file(YAML/JSON) to seed the data store")
+	flag.Parse()
+
+	store, err := newStore(*storeType, *storePath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if *fixturePath != "" {
+		if err := applyFixture(store, *fixturePath); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+	}
+
+	log.Printf("fake API server is listening on %s (API root URL: http://%s%s)", *addr, *addr, server.DefaultPathPrefix)
+	s := &server.Server{Addr: *addr, Store: store}
+	log.Fatal(s.ListenAndServe())
+}
+
+func newStore(storeType, path string) (fake.Store, error) {
+	if path == "" {
+		return fake.NewInMemoryStore(), nil
+	}
+	switch strings.ToLower(storeType) {
+	case "json":
+		return fake.NewJSONFileStore(path), nil
+	case "kv":
+		return fake.NewKVFileStore(path), nil
+	default:
+		return nil, fmt.Errorf("unsupported store type: %s", storeType)
+	}
+}
+
+func applyFixture(store fake.Store, path string) error {
+	fixture, err := fake.LoadFixtureFile(path)
+	if err != nil {
+		return err
+	}
+	return fixture.Apply(store)
+}
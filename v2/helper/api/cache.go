@@ -0,0 +1,317 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+)
+
+// DefaultCacheTTL CachingCallerでTTLが定義されていないリソースに対するキャッシュ有効期間
+var DefaultCacheTTL = 5 * time.Second
+
+// DefaultCacheTTLs CachingCallerでのリソースパスごとのキャッシュ有効期間のデフォルト値
+//
+// キーはAPIのパス(例: server, product/server)
+var DefaultCacheTTLs = map[string]time.Duration{
+	"zone":                time.Hour,
+	"region":              time.Hour,
+	"product/server":      time.Hour,
+	"product/disk":        time.Hour,
+	"product/internet":    time.Hour,
+	"product/license":     time.Hour,
+	"product/privatehost": time.Hour,
+	"public/price":        time.Hour,
+	// コピー中のMigratedMBや電源状態などStatePollingWaiterによる待機で参照される項目を持つリソースは短い期間とする
+	"archive": 5 * time.Second,
+	"server":  5 * time.Second,
+	"disk":    5 * time.Second,
+}
+
+// DefaultCacheDependencies CachingCallerでのリソースパスごとの依存関係のデフォルト値
+//
+// キーは更新系リクエストの対象となるリソースパス、値は更新時に合わせてキャッシュを破棄するリソースパス。
+// 例えばディスクの接続/切断(disk/:id/to/server/:id)はサーバのレスポンス(Disks)にも影響する
+var DefaultCacheDependencies = map[string][]string{
+	"server":       {"disk", "interface", "cdrom", "privatehost"},
+	"disk":         {"server", "archive"},
+	"archive":      {"disk"},
+	"cdrom":        {"server"},
+	"interface":    {"server", "switch", "appliance"},
+	"packetfilter": {"interface", "server"},
+	"switch":       {"server", "interface", "internet", "subnet", "bridge", "appliance"},
+	"internet":     {"switch", "subnet", "ipaddress", "ipv6net"},
+	"subnet":       {"internet", "switch"},
+	"bridge":       {"switch"},
+	"appliance":    {"switch", "interface"},
+	"privatehost":  {"server"},
+}
+
+// CacheStats CachingCallerのキャッシュ統計情報
+type CacheStats struct {
+	// Hits キャッシュヒット数
+	Hits uint64
+	// Misses キャッシュミス数
+	Misses uint64
+	// Invalidations 更新系リクエストにより破棄されたキャッシュエントリ数
+	Invalidations uint64
+	// Entries 現在保持しているキャッシュエントリ数(期限切れを含む)
+	Entries int
+}
+
+// CachingCaller GETリクエストのレスポンスをキャッシュするsacloud.APICaller
+//
+// キャッシュはメソッド+URI+リクエストボディ単位で保持され、リソースパスごとのTTLが経過するまで有効となる。
+// PUT/POST/DELETEリクエストが発行された場合は、リクエストの前後で同じゾーン/リソースパスと
+// 依存するリソースパス(Dependencies)のキャッシュを全て破棄する。
+// また、更新系リクエストと並行して実行されたGETリクエストのレスポンスはキャッシュしない
+type CachingCaller struct {
+	// Caller 実際のAPI呼び出しを行うsacloud.APICaller
+	Caller sacloud.APICaller
+	// TTLs リソースパスごとのキャッシュ有効期間 未指定の場合DefaultCacheTTLsが利用される
+	TTLs map[string]time.Duration
+	// DefaultTTL TTLsに定義されていないリソースのキャッシュ有効期間 未指定の場合DefaultCacheTTLが利用される
+	DefaultTTL time.Duration
+	// Dependencies 更新系リクエスト時に合わせてキャッシュを破棄するリソースパス 未指定の場合DefaultCacheDependenciesが利用される
+	Dependencies map[string][]string
+
+	mu          sync.Mutex
+	entries     map[string]*cacheEntry
+	generations map[string]uint64
+	epoch       uint64
+	stats       CacheStats
+}
+
+// cacheGeneration GETリクエスト開始時点のキャッシュの世代
+//
+// レスポンス取得までの間に更新系リクエストやPurgeが実行されたかの判定に利用する
+type cacheGeneration struct {
+	epoch      uint64
+	collection uint64
+}
+
+type cacheEntry struct {
+	collection string
+	data       []byte
+	expiresAt  time.Time
+}
+
+// NewCachingCaller 指定のsacloud.APICallerをラップしたCachingCallerを返す
+func NewCachingCaller(caller sacloud.APICaller, ttls map[string]time.Duration) *CachingCaller {
+	return &CachingCaller{
+		Caller: caller,
+		TTLs:   ttls,
+	}
+}
+
+// Do APIコール実施
+func (c *CachingCaller) Do(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
+	collection, resource := parseResourcePath(uri)
+
+	if method != http.MethodGet {
+		// 更新前の状態を取得した実行中のGETリクエストがあってもキャッシュされないよう、前後で破棄する
+		c.invalidate(collection, resource)
+		data, err := c.Caller.Do(ctx, method, uri, body)
+		c.invalidate(collection, resource)
+		return data, err
+	}
+
+	key, err := cacheKey(method, uri, body)
+	if err != nil {
+		return nil, err
+	}
+	if data, ok := c.get(key); ok {
+		return data, nil
+	}
+
+	generation := c.generation(collection)
+	data, err := c.Caller.Do(ctx, method, uri, body)
+	if err != nil {
+		return nil, err
+	}
+	c.put(key, collection, resource, generation, data)
+	return data, nil
+}
+
+// Stats キャッシュ統計情報を返す
+func (c *CachingCaller) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Entries = len(c.entries)
+	return stats
+}
+
+// Purge 全てのキャッシュエントリを破棄する
+func (c *CachingCaller) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// 実行中のGETリクエストのレスポンスが破棄前の状態でキャッシュされないよう世代を進める
+	c.epoch++
+	c.entries = nil
+}
+
+func (c *CachingCaller) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		if ok {
+			delete(c.entries, key)
+		}
+		c.stats.Misses++
+		return nil, false
+	}
+	c.stats.Hits++
+	return copyBytes(entry.data), true
+}
+
+func (c *CachingCaller) generation(collection string) cacheGeneration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return cacheGeneration{epoch: c.epoch, collection: c.generations[collection]}
+}
+
+func (c *CachingCaller) put(key, collection, resource string, generation cacheGeneration, data []byte) {
+	ttl := c.ttl(resource)
+	if ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// リクエスト中に更新系リクエストやPurgeが実行された場合、レスポンスは古い状態の可能性があるためキャッシュしない
+	if generation.epoch != c.epoch || c.generations[collection] != generation.collection {
+		return
+	}
+	if c.entries == nil {
+		c.entries = make(map[string]*cacheEntry)
+	}
+	c.entries[key] = &cacheEntry{
+		collection: collection,
+		data:       copyBytes(data),
+		expiresAt:  time.Now().Add(ttl),
+	}
+}
+
+func (c *CachingCaller) invalidate(collection, resource string) {
+	collections := map[string]bool{collection: true}
+	zone := strings.TrimSuffix(collection, "/"+resource)
+	if zone != collection {
+		for _, dep := range c.dependencies(resource) {
+			collections[zone+"/"+dep] = true
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generations == nil {
+		c.generations = make(map[string]uint64)
+	}
+	for col := range collections {
+		c.generations[col]++
+	}
+	for key, entry := range c.entries {
+		if collections[entry.collection] {
+			delete(c.entries, key)
+			c.stats.Invalidations++
+		}
+	}
+}
+
+func (c *CachingCaller) dependencies(resource string) []string {
+	deps := c.Dependencies
+	if deps == nil {
+		deps = DefaultCacheDependencies
+	}
+	return deps[resource]
+}
+
+func (c *CachingCaller) ttl(resource string) time.Duration {
+	ttls := c.TTLs
+	if ttls == nil {
+		ttls = DefaultCacheTTLs
+	}
+	if ttl, ok := ttls[resource]; ok {
+		return ttl
+	}
+	if c.DefaultTTL != 0 {
+		return c.DefaultTTL
+	}
+	return DefaultCacheTTL
+}
+
+func cacheKey(method, uri string, body interface{}) (string, error) {
+	key := method + " " + uri
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return "", err
+		}
+		key += " " + string(data)
+	}
+	return key, nil
+}
+
+func copyBytes(data []byte) []byte {
+	if data == nil {
+		return nil
+	}
+	ret := make([]byte, len(data))
+	copy(ret, data)
+	return ret
+}
+
+// parseResourcePath URIからリソースのコレクション(ゾーン+リソースパス)とリソースパスを抽出する
+//
+// 例: https://secure.sakura.ad.jp/cloud/zone/is1a/api/cloud/1.1/server/123456789012/power の場合、
+// コレクションは"is1a/server"、リソースパスは"server"となる。
+// product/serverのように2階層のリソースパスにも対応する
+func parseResourcePath(uri string) (collection string, resource string) {
+	path := uri
+	if u, err := url.Parse(uri); err == nil {
+		path = u.Path
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, s := range segments {
+		// {{.zone}}/api/{service}/{version}/{{.pathName}}
+		if s != "api" || i == 0 || i+3 >= len(segments) {
+			continue
+		}
+		zone := segments[i-1]
+		rest := segments[i+3:]
+
+		resource = rest[0]
+		if (resource == "product" || resource == "public") && len(rest) > 1 {
+			resource += "/" + rest[1]
+		}
+		return zone + "/" + resource, resource
+	}
+	return path, path
+}
@@ -0,0 +1,205 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type dummyCaller struct {
+	calls []string
+	err   error
+	onDo  func(method, uri string)
+}
+
+func (c *dummyCaller) Do(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
+	c.calls = append(c.calls, method+" "+uri)
+	if c.onDo != nil {
+		c.onDo(method, uri)
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	return []byte(`{"method":"` + method + `"}`), nil
+}
+
+const (
+	testServerURL = "https://secure.sakura.ad.jp/cloud/zone/is1a/api/cloud/1.1/server/123456789012"
+	testPlanURL   = "https://secure.sakura.ad.jp/cloud/zone/is1a/api/cloud/1.1/product/server"
+	testDiskURL   = "https://secure.sakura.ad.jp/cloud/zone/is1a/api/cloud/1.1/disk/123456789012"
+)
+
+func TestCachingCaller_Do(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("cache GET responses", func(t *testing.T) {
+		dummy := &dummyCaller{}
+		caller := NewCachingCaller(dummy, nil)
+
+		for i := 0; i < 3; i++ {
+			data, err := caller.Do(ctx, http.MethodGet, testPlanURL, nil)
+			require.NoError(t, err)
+			require.Equal(t, `{"method":"GET"}`, string(data))
+		}
+		require.Len(t, dummy.calls, 1)
+		require.Equal(t, CacheStats{Hits: 2, Misses: 1, Entries: 1}, caller.Stats())
+	})
+
+	t.Run("request body is part of the key", func(t *testing.T) {
+		dummy := &dummyCaller{}
+		caller := NewCachingCaller(dummy, nil)
+
+		_, err := caller.Do(ctx, http.MethodGet, testPlanURL, map[string]interface{}{"From": 0})
+		require.NoError(t, err)
+		_, err = caller.Do(ctx, http.MethodGet, testPlanURL, map[string]interface{}{"From": 10})
+		require.NoError(t, err)
+		require.Len(t, dummy.calls, 2)
+	})
+
+	t.Run("expired entries", func(t *testing.T) {
+		dummy := &dummyCaller{}
+		caller := NewCachingCaller(dummy, map[string]time.Duration{"server": time.Millisecond})
+
+		_, err := caller.Do(ctx, http.MethodGet, testServerURL, nil)
+		require.NoError(t, err)
+		time.Sleep(2 * time.Millisecond)
+		_, err = caller.Do(ctx, http.MethodGet, testServerURL, nil)
+		require.NoError(t, err)
+		require.Len(t, dummy.calls, 2)
+	})
+
+	t.Run("invalidate by mutating requests", func(t *testing.T) {
+		dummy := &dummyCaller{}
+		caller := NewCachingCaller(dummy, nil)
+
+		_, err := caller.Do(ctx, http.MethodGet, testServerURL, nil)
+		require.NoError(t, err)
+		_, err = caller.Do(ctx, http.MethodGet, testPlanURL, nil)
+		require.NoError(t, err)
+
+		_, err = caller.Do(ctx, http.MethodPut, testServerURL+"/power", nil)
+		require.NoError(t, err)
+
+		_, err = caller.Do(ctx, http.MethodGet, testServerURL, nil)
+		require.NoError(t, err)
+		_, err = caller.Do(ctx, http.MethodGet, testPlanURL, nil)
+		require.NoError(t, err)
+
+		require.Equal(t, []string{
+			"GET " + testServerURL,
+			"GET " + testPlanURL,
+			"PUT " + testServerURL + "/power",
+			"GET " + testServerURL,
+		}, dummy.calls)
+		require.EqualValues(t, 1, caller.Stats().Invalidations)
+	})
+
+	t.Run("invalidate dependent resources", func(t *testing.T) {
+		dummy := &dummyCaller{}
+		caller := NewCachingCaller(dummy, nil)
+
+		_, err := caller.Do(ctx, http.MethodGet, testServerURL, nil)
+		require.NoError(t, err)
+		_, err = caller.Do(ctx, http.MethodGet, testPlanURL, nil)
+		require.NoError(t, err)
+
+		// ディスクの接続はサーバのレスポンスにも影響する
+		_, err = caller.Do(ctx, http.MethodPut, testDiskURL+"/to/server/123456789012", nil)
+		require.NoError(t, err)
+
+		_, err = caller.Do(ctx, http.MethodGet, testServerURL, nil)
+		require.NoError(t, err)
+		_, err = caller.Do(ctx, http.MethodGet, testPlanURL, nil)
+		require.NoError(t, err)
+		require.Len(t, dummy.calls, 4)
+	})
+
+	t.Run("in-flight GET responses are not cached after mutating requests", func(t *testing.T) {
+		dummy := &dummyCaller{}
+		caller := NewCachingCaller(dummy, nil)
+
+		// GETリクエストの実行中に更新系リクエストが発行された場合
+		dummy.onDo = func(method, uri string) {
+			if method == http.MethodGet && len(dummy.calls) == 1 {
+				_, err := caller.Do(ctx, http.MethodPut, testServerURL+"/power", nil)
+				require.NoError(t, err)
+			}
+		}
+
+		for i := 0; i < 2; i++ {
+			_, err := caller.Do(ctx, http.MethodGet, testServerURL, nil)
+			require.NoError(t, err)
+		}
+		require.Equal(t, []string{
+			"GET " + testServerURL,
+			"PUT " + testServerURL + "/power",
+			"GET " + testServerURL,
+		}, dummy.calls)
+		require.Equal(t, 1, caller.Stats().Entries)
+	})
+
+	t.Run("in-flight GET responses are not cached after purge", func(t *testing.T) {
+		dummy := &dummyCaller{}
+		caller := NewCachingCaller(dummy, nil)
+
+		// GETリクエストの実行中にPurgeされた場合
+		dummy.onDo = func(method, uri string) {
+			if len(dummy.calls) == 1 {
+				caller.Purge()
+			}
+		}
+
+		for i := 0; i < 2; i++ {
+			_, err := caller.Do(ctx, http.MethodGet, testServerURL, nil)
+			require.NoError(t, err)
+		}
+		require.Len(t, dummy.calls, 2)
+		require.Equal(t, 1, caller.Stats().Entries)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		dummy := &dummyCaller{err: errors.New("dummy")}
+		caller := NewCachingCaller(dummy, nil)
+
+		for i := 0; i < 2; i++ {
+			_, err := caller.Do(ctx, http.MethodGet, testServerURL, nil)
+			require.Error(t, err)
+		}
+		require.Len(t, dummy.calls, 2)
+	})
+}
+
+func TestParseResourcePath(t *testing.T) {
+	cases := []struct {
+		uri        string
+		collection string
+		resource   string
+	}{
+		{uri: testServerURL + "/power", collection: "is1a/server", resource: "server"},
+		{uri: testPlanURL + "?%7B%22Count%22%3A1000%7D", collection: "is1a/product/server", resource: "product/server"},
+		{uri: "https://secure.sakura.ad.jp/cloud/zone/is1a/api/system/1.0/bill/by-contract/1", collection: "is1a/bill", resource: "bill"},
+	}
+	for _, tt := range cases {
+		collection, resource := parseResourcePath(tt.uri)
+		require.Equal(t, tt.collection, collection, tt.uri)
+		require.Equal(t, tt.resource, resource, tt.uri)
+	}
+}
@@ -15,6 +15,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -30,7 +31,12 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
+// defaultRetryJitterFactor CallerOptions.RetryJitterが有効な場合にリトライ間隔に加えるゆらぎの割合
+const defaultRetryJitterFactor = 0.2
+
 // NewCaller 指定のオプションでsacloud.APICallerを構築して返す
+//
+// オプションの検証は行わないため、必要に応じてCallerOptions.Validateを呼ぶかNewCallerWithDefaultsを利用すること
 func NewCaller(opts ...*CallerOptions) sacloud.APICaller {
 	return newCaller(MergeOptions(opts...))
 }
@@ -43,15 +49,26 @@ func NewCallerWithDefaults(opts *CallerOptions) (sacloud.APICaller, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewCaller(defaultOpts, opts), nil
+	merged := MergeOptions(defaultOpts, opts)
+	if err := merged.Validate(); err != nil {
+		return nil, err
+	}
+	return newCaller(merged), nil
 }
 
 func newCaller(opts *CallerOptions) sacloud.APICaller {
+	registry := opts.ClientRegistry
+
 	// build http client
 	httpClient := http.DefaultClient
 	if opts.HTTPClient != nil {
 		httpClient = opts.HTTPClient
 	}
+	if registry != nil {
+		// 他のAPICallerに影響を与えないようにコピーしたものを利用する
+		copied := *httpClient
+		httpClient = &copied
+	}
 	if opts.HTTPRequestTimeout > 0 {
 		httpClient.Timeout = time.Duration(opts.HTTPRequestTimeout) * time.Second
 	}
@@ -64,6 +81,11 @@ func newCaller(opts *CallerOptions) sacloud.APICaller {
 	if opts.HTTPRequestRateLimit == 0 {
 		httpClient.Transport = &sacloudhttp.RateLimitRoundTripper{RateLimitPerSec: 10} // デフォルト値
 	}
+	if opts.OpenTelemetryMetrics {
+		if rateLimited, ok := httpClient.Transport.(*sacloudhttp.RateLimitRoundTripper); ok {
+			httpClient.Transport = otel.NewRateLimitWaitRoundTripper(rateLimited)
+		}
+	}
 
 	retryMax := 0
 	if opts.RetryMax > 0 {
@@ -94,12 +116,41 @@ func newCaller(opts *CallerOptions) sacloud.APICaller {
 		RetryWaitMax:      retryWaitMax,
 		RetryWaitMin:      retryWaitMin,
 		HTTPClient:        httpClient,
+		Middlewares:       opts.Middlewares,
+		Registry:          registry,
+	}
+	retryEventHandler := opts.RetryEventHandler
+	if opts.OpenTelemetryMetrics {
+		retryEventHandler = chainRetryEventHandlers(otel.RetryEventHandler, opts.RetryEventHandler)
+	}
+	if opts.RetryOnStillCreating || opts.RetryOnTooManyRequests || opts.RetryOnConnectionError ||
+		opts.RetryJitter || retryEventHandler != nil {
+		caller.RetryPolicy = &sacloud.RetryPolicy{
+			RetryMax:               retryMax,
+			RetryWaitMin:           retryWaitMin,
+			RetryWaitMax:           retryWaitMax,
+			RetryOnStillCreating:   opts.RetryOnStillCreating,
+			RetryOnTooManyRequests: opts.RetryOnTooManyRequests,
+			RetryOnConnectionError: opts.RetryOnConnectionError,
+			OnRetry:                retryEventHandler,
+		}
+		if opts.RetryJitter {
+			caller.RetryPolicy.JitterFactor = defaultRetryJitterFactor
+		}
+	}
+	if registry != nil {
+		registry.StatePollingTimeout = 72 * time.Hour
+	} else {
+		sacloud.DefaultStatePollingTimeout = 72 * time.Hour
 	}
-	sacloud.DefaultStatePollingTimeout = 72 * time.Hour
 
 	if opts.TraceAPI {
-		// note: exact once
-		trace.AddClientFactoryHooks()
+		if registry != nil {
+			trace.AddClientFactoryHooksToRegistry(registry)
+		} else {
+			// note: exact once
+			trace.AddClientFactoryHooks()
+		}
 	}
 	if opts.TraceHTTP {
 		caller.HTTPClient.Transport = &sacloudhttp.TracingRoundTripper{
@@ -107,22 +158,37 @@ func newCaller(opts *CallerOptions) sacloud.APICaller {
 		}
 	}
 	if opts.OpenTelemetry {
-		otel.Initialize(opts.OpenTelemetryOptions...)
+		if registry != nil {
+			otel.InitializeRegistry(registry, opts.OpenTelemetryOptions...)
+		} else {
+			otel.Initialize(opts.OpenTelemetryOptions...)
+		}
 		transport := caller.HTTPClient.Transport
 		if transport == nil {
 			transport = http.DefaultTransport
 		}
 		caller.HTTPClient.Transport = otelhttp.NewTransport(transport)
 	}
+	if opts.OpenTelemetryMetrics {
+		if registry != nil {
+			otel.InitializeMetricsRegistry(registry, opts.OpenTelemetryOptions...)
+		} else {
+			otel.InitializeMetrics(opts.OpenTelemetryOptions...)
+		}
+	}
 
 	if opts.FakeMode {
-		if opts.FakeStorePath != "" {
-			fake.DataStore = fake.NewJSONFileStore(opts.FakeStorePath)
-		}
-		// note: exact once
-		fake.SwitchFactoryFuncToFake()
+		if registry != nil {
+			setupFakeRegistry(registry, opts.FakeStorePath)
+		} else {
+			if opts.FakeStorePath != "" {
+				fake.DataStore = fake.NewJSONFileStore(opts.FakeStorePath)
+			}
+			// note: exact once
+			fake.SwitchFactoryFuncToFake()
 
-		SetupFakeDefaults()
+			SetupFakeDefaults()
+		}
 	}
 
 	if opts.DefaultZone != "" {
@@ -139,11 +205,59 @@ func newCaller(opts *CallerOptions) sacloud.APICaller {
 	if len(opts.Zones) > 0 {
 		sacloud.SakuraCloudZones = opts.Zones
 	}
-	return caller
+
+	var ret sacloud.APICaller = caller
+	switch opts.CassetteMode {
+	case CassetteModeRecord:
+		ret = NewRecordingCaller(caller, opts.CassettePath, opts.AccessToken, opts.AccessTokenSecret)
+	case CassetteModeReplay, CassetteModeReplayOrdered:
+		ret = &ReplayingCaller{
+			Path:    opts.CassettePath,
+			Ordered: opts.CassetteMode == CassetteModeReplayOrdered,
+		}
+	}
+
+	if opts.DryRun {
+		ret = NewDryRunCaller(ret)
+	}
+	if opts.CacheAPI {
+		ret = NewCachingCaller(ret, opts.CacheTTLs)
+	}
+	if registry != nil && ret != sacloud.APICaller(caller) {
+		ret = sacloud.NewCallerWithRegistry(ret, registry)
+	}
+	return ret
+}
+
+// setupFakeRegistry フェイクモード向けにClientRegistryを設定する
+//
+// storePathが空の場合はインメモリのデータストアが利用される
+func setupFakeRegistry(registry *sacloud.ClientRegistry, storePath string) {
+	var store fake.Store = fake.NewInMemoryStore()
+	if storePath != "" {
+		store = fake.NewJSONFileStore(storePath)
+	}
+	fake.SwitchRegistryFactoryFuncToFake(registry, store)
+
+	registry.StatePollingInterval = fakePollingInterval
+	registry.DBStatusPollingInterval = fakePollingInterval
 }
 
+func chainRetryEventHandlers(handlers ...func(ctx context.Context, event *sacloud.RetryEvent)) func(ctx context.Context, event *sacloud.RetryEvent) {
+	return func(ctx context.Context, event *sacloud.RetryEvent) {
+		for _, h := range handlers {
+			if h != nil {
+				h(ctx, event)
+			}
+		}
+	}
+}
+
+// fakePollingInterval フェイクモードでのデフォルトポーリング間隔
+const fakePollingInterval = 10 * time.Millisecond
+
 func SetupFakeDefaults() {
-	defaultInterval := 10 * time.Millisecond
+	defaultInterval := fakePollingInterval
 
 	// update default polling intervals: libsacloud/sacloud
 	sacloud.DefaultStatePollingInterval = defaultInterval
@@ -15,6 +15,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
@@ -22,7 +23,11 @@ import (
 
 	sacloudhttp "github.com/sacloud/go-http"
 	"github.com/sacloud/libsacloud/v2"
+	"github.com/sacloud/libsacloud/v2/helper/builder"
+	"github.com/sacloud/libsacloud/v2/helper/builder/vpcrouter"
+	"github.com/sacloud/libsacloud/v2/helper/wait"
 	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -96,3 +101,92 @@ func TestNewCaller(t *testing.T) {
 		require.NotEqual(t, caller1, caller2)
 	})
 }
+
+func TestNewCaller_withClientRegistry(t *testing.T) {
+	ctx := context.Background()
+	timeout := http.DefaultClient.Timeout
+
+	newFakeCaller := func() sacloud.APICaller {
+		return newCaller(&CallerOptions{
+			AccessToken:        "token",
+			AccessTokenSecret:  "secret",
+			HTTPRequestTimeout: 1,
+			FakeMode:           true,
+			ClientRegistry:     sacloud.NewClientRegistry(),
+		})
+	}
+	caller1 := newFakeCaller()
+	caller2 := newFakeCaller()
+
+	// グローバルな値は変更されない
+	require.Equal(t, timeout, http.DefaultClient.Timeout)
+
+	switchOp1 := sacloud.NewSwitchOp(caller1)
+	created, err := switchOp1.Create(ctx, "is1a", &sacloud.SwitchCreateRequest{Name: "caller1"})
+	require.NoError(t, err)
+
+	found, err := switchOp1.Find(ctx, "is1a", &sacloud.FindCondition{})
+	require.NoError(t, err)
+	require.Len(t, found.Switches, 2) // 初期データ(スイッチ)+作成したスイッチ
+
+	// 別のレジストリを持つcallerからは参照できない
+	_, err = sacloud.NewSwitchOp(caller2).Read(ctx, "is1a", created.ID)
+	require.True(t, sacloud.IsNotFoundError(err))
+}
+
+func TestNewCaller_registryPollingSettings(t *testing.T) {
+	registry := sacloud.NewClientRegistry()
+	caller := newCaller(&CallerOptions{
+		AccessToken:       "token",
+		AccessTokenSecret: "secret",
+		FakeMode:          true,
+		ClientRegistry:    registry,
+	})
+	require.Equal(t, fakePollingInterval, registry.StatePollingInterval)
+	require.Equal(t, 72*time.Hour, registry.StatePollingTimeout)
+
+	serverOp := sacloud.NewServerOp(caller)
+	r, ok := sacloud.ClientRegistryFromAPI(serverOp)
+	require.True(t, ok)
+	require.Equal(t, registry, r)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	server, err := serverOp.Create(ctx, "is1a", &sacloud.ServerCreateRequest{Name: "registry", CPU: 1, MemoryMB: 1024})
+	require.NoError(t, err)
+	require.NoError(t, serverOp.Boot(ctx, "is1a", server.ID))
+
+	// DefaultStatePollingInterval(5秒)ではなくレジストリのポーリング間隔で待機する
+	started := time.Now()
+	_, err = wait.UntilServerIsUp(ctx, serverOp, "is1a", server.ID)
+	require.NoError(t, err)
+	require.Less(t, int64(time.Since(started)), int64(time.Second))
+}
+
+func TestNewCaller_registryPollingSettingsWithBuilder(t *testing.T) {
+	registry := sacloud.NewClientRegistry()
+	caller := newCaller(&CallerOptions{
+		AccessToken:       "token",
+		AccessTokenSecret: "secret",
+		FakeMode:          true,
+		ClientRegistry:    registry,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// RetryableSetupを利用するビルダーでもレジストリのポーリング設定で待機する
+	started := time.Now()
+	vpcRouter, err := (&vpcrouter.Builder{
+		Name:          "registry",
+		PlanID:        types.VPCRouterPlans.Standard,
+		NICSetting:    &vpcrouter.StandardNICSetting{},
+		RouterSetting: &vpcrouter.RouterSetting{},
+		SetupOptions:  &builder.RetryableSetupParameter{NICUpdateWaitDuration: time.Millisecond},
+		Client:        sacloud.NewVPCRouterOp(caller),
+	}).Build(ctx, "is1a")
+	require.NoError(t, err)
+	require.Equal(t, types.Availabilities.Available, vpcRouter.Availability)
+	require.Less(t, int64(time.Since(started)), int64(time.Second))
+}
@@ -0,0 +1,487 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/sacloud/libsacloud/v2/sacloud"
+)
+
+const (
+	// CassetteModeRecord API呼び出しを記録するモード
+	CassetteModeRecord = "record"
+	// CassetteModeReplay 記録されたAPI呼び出しをリクエスト内容でマッチングして再生するモード
+	CassetteModeReplay = "replay"
+	// CassetteModeReplayOrdered 記録されたAPI呼び出しを記録された順番で再生するモード
+	CassetteModeReplayOrdered = "replay-ordered"
+)
+
+// scrubbedValue 記録時にシークレットを置き換える文字列
+const scrubbedValue = "**scrubbed**"
+
+// DefaultCassetteScrubKeys 記録時に値をマスクするJSONのキー名
+var DefaultCassetteScrubKeys = []string{
+	"AccessToken",
+	"AccessTokenSecret",
+	"Password",
+	"PrivateKey",
+}
+
+// Cassette 記録されたAPI呼び出しの一覧
+type Cassette struct {
+	Interactions []*CassetteInteraction
+}
+
+// CassetteInteraction 記録された1回分のAPI呼び出し
+type CassetteInteraction struct {
+	Method       string
+	URI          string
+	RequestBody  json.RawMessage `json:",omitempty"`
+	ResponseBody json.RawMessage `json:",omitempty"`
+
+	// StatusCode APIエラー時のレスポンスステータスコード
+	StatusCode int `json:",omitempty"`
+	// APIError APIエラー時のレスポンス
+	APIError *sacloud.APIErrorResponse `json:",omitempty"`
+	// Error APIエラー以外のエラーメッセージ
+	Error string `json:",omitempty"`
+}
+
+// LoadCassette 指定のパスからCassetteを読み込む
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cassette := &Cassette{}
+	if err := json.Unmarshal(data, cassette); err != nil {
+		return nil, fmt.Errorf("loading cassette %q failed: %s", path, err)
+	}
+	return cassette, nil
+}
+
+// Save 指定のパスへCassetteを書き込む
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// RecordingCaller API呼び出しをCassetteとしてファイルに記録するsacloud.APICaller
+//
+// 記録はAPI呼び出しのたびにPathへ追記される(ファイル全体の書き直しは行わない)。
+// 追記後のファイルは常にLoadCassetteで読み込める状態となる。記録の終了後はCloseを呼ぶこと。
+// Secretsに指定された文字列、およびScrubKeysに指定されたキーを持つJSONの値は記録前にマスクされる
+//
+// 記録に失敗した場合もAPI呼び出しの結果はそのまま返される(リソース作成などの実APIへの変更は既に行われているため)。
+// 記録時のエラーはOnRecordErrorで通知され、Errで参照できる
+type RecordingCaller struct {
+	// Caller 実際のAPI呼び出しを行うsacloud.APICaller
+	Caller sacloud.APICaller
+	// Path 記録先ファイルのパス
+	Path string
+	// Secrets 記録前にマスクする文字列
+	Secrets []string
+	// ScrubKeys 値をマスクするJSONのキー名 未指定の場合DefaultCassetteScrubKeysが利用される
+	ScrubKeys []string
+	// OnRecordError 記録に失敗した場合に呼ばれるfunc
+	OnRecordError func(err error)
+
+	mu       sync.Mutex
+	cassette Cassette
+	errs     *multierror.Error
+	file     *os.File
+	offset   int64
+	written  int
+	closed   bool
+}
+
+const (
+	// cassetteFileHeader 記録ファイルの先頭
+	cassetteFileHeader = "{\n  \"Interactions\": ["
+	// cassetteFileTrailer 記録ファイルの末尾 追記のたびに上書きされる
+	cassetteFileTrailer = "\n  ]\n}\n"
+)
+
+// NewRecordingCaller 指定のsacloud.APICallerをラップしたRecordingCallerを返す
+func NewRecordingCaller(caller sacloud.APICaller, path string, secrets ...string) *RecordingCaller {
+	return &RecordingCaller{
+		Caller:  caller,
+		Path:    path,
+		Secrets: secrets,
+	}
+}
+
+// Do APIコール実施
+func (c *RecordingCaller) Do(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
+	data, err := c.Caller.Do(ctx, method, uri, body)
+
+	interaction := &CassetteInteraction{
+		Method: method,
+		URI:    c.scrubString(uri),
+	}
+	if body != nil {
+		reqBody, marshalErr := json.Marshal(body)
+		if marshalErr != nil {
+			c.recordError(method, uri, marshalErr)
+			return data, err
+		}
+		interaction.RequestBody = c.scrubJSON(reqBody)
+	}
+	if err != nil {
+		if apiErr, ok := err.(sacloud.APIError); ok {
+			interaction.StatusCode = apiErr.ResponseCode()
+			interaction.APIError = apiErr.OrigErr()
+		} else {
+			interaction.Error = c.scrubString(err.Error())
+		}
+	} else {
+		interaction.ResponseBody = c.scrubJSON(data)
+	}
+
+	if recErr := c.record(interaction); recErr != nil {
+		c.recordError(method, uri, recErr)
+	}
+	return data, err
+}
+
+// Err 記録時に発生したエラーを返す 発生していない場合はnil
+func (c *RecordingCaller) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.errs.ErrorOrNil()
+}
+
+func (c *RecordingCaller) recordError(method, uri string, err error) {
+	err = fmt.Errorf("recording %s %s failed: %s", method, c.scrubString(uri), err)
+
+	c.mu.Lock()
+	c.errs = multierror.Append(c.errs, err)
+	c.mu.Unlock()
+
+	if c.OnRecordError != nil {
+		c.OnRecordError(err)
+	}
+}
+
+// Cassette 記録済みのCassetteのコピーを返す
+func (c *RecordingCaller) Cassette() *Cassette {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	interactions := make([]*CassetteInteraction, len(c.cassette.Interactions))
+	copy(interactions, c.cassette.Interactions)
+	return &Cassette{Interactions: interactions}
+}
+
+func (c *RecordingCaller) record(interaction *CassetteInteraction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return fmt.Errorf("cassette %q is already closed", c.Path)
+	}
+	c.cassette.Interactions = append(c.cassette.Interactions, interaction)
+	if c.Path == "" {
+		return nil
+	}
+	return c.write(interaction)
+}
+
+// write 記録ファイルへinteractionを追記する
+//
+// 末尾の閉じ括弧を上書きする形で書き込むため、1回の記録で書き込まれるのはinteraction1件分のみとなる
+func (c *RecordingCaller) write(interaction *CassetteInteraction) error {
+	if c.file == nil {
+		f, err := os.OpenFile(c.Path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		if _, err := f.WriteString(cassetteFileHeader + cassetteFileTrailer); err != nil {
+			f.Close() // nolint
+			return err
+		}
+		c.file = f
+		c.offset = int64(len(cassetteFileHeader))
+	}
+
+	data, err := json.MarshalIndent(interaction, "    ", "  ")
+	if err != nil {
+		return err
+	}
+	buf := &bytes.Buffer{}
+	if c.written > 0 {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\n    ")
+	buf.Write(data)
+	size := buf.Len()
+	buf.WriteString(cassetteFileTrailer)
+
+	if _, err := c.file.WriteAt(buf.Bytes(), c.offset); err != nil {
+		return err
+	}
+	c.offset += int64(size)
+	c.written++
+	return nil
+}
+
+// Close 記録ファイルを閉じる
+//
+// Close以降のAPI呼び出しは記録されず、記録時のエラーとして扱われる
+func (c *RecordingCaller) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.closed = true
+	if c.file == nil {
+		return nil
+	}
+	err := c.file.Close()
+	c.file = nil
+	return err
+}
+
+func (c *RecordingCaller) scrubString(s string) string {
+	return scrubString(s, c.Secrets)
+}
+
+func (c *RecordingCaller) scrubJSON(data []byte) json.RawMessage {
+	return scrubJSON(data, c.ScrubKeys, c.Secrets)
+}
+
+func scrubString(s string, secrets []string) string {
+	for _, secret := range secrets {
+		if secret != "" {
+			s = strings.ReplaceAll(s, secret, scrubbedValue)
+		}
+	}
+	return s
+}
+
+func scrubJSON(data []byte, keys []string, secrets []string) json.RawMessage {
+	if len(data) == 0 {
+		return nil
+	}
+	if !json.Valid(data) {
+		// 文字列として保持しておく
+		data, _ = json.Marshal(string(data)) // nolint - string always can be marshaled
+	}
+
+	if keys == nil {
+		keys = DefaultCassetteScrubKeys
+	}
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&v); err == nil {
+		if scrubValue(v, keys) {
+			if scrubbed, err := json.Marshal(v); err == nil {
+				data = scrubbed
+			}
+		}
+	}
+	return json.RawMessage(scrubString(string(data), secrets))
+}
+
+func scrubValue(v interface{}, keys []string) bool {
+	scrubbed := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if containsString(keys, key) {
+				if _, ok := value.(string); ok {
+					v[key] = scrubbedValue
+					scrubbed = true
+					continue
+				}
+			}
+			if scrubValue(value, keys) {
+				scrubbed = true
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if scrubValue(value, keys) {
+				scrubbed = true
+			}
+		}
+	}
+	return scrubbed
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplayingCaller 記録されたCassetteからレスポンスを返すsacloud.APICaller
+//
+// Orderedがtrueの場合は記録された順番でレスポンスを返し、リクエストのメソッド/URIが一致しない場合はエラーを返す。
+// falseの場合はメソッド/URI/リクエストボディが一致する未使用の記録を先頭から探して返す。
+// リクエストボディは記録時と同様にマスクしてから比較される
+type ReplayingCaller struct {
+	// Cassette 再生するCassette 未指定の場合は初回のAPI呼び出し時にPathから読み込まれる
+	Cassette *Cassette
+	// Path Cassetteの読み込み元ファイルのパス
+	Path string
+	// Ordered 記録された順番で再生するか
+	Ordered bool
+	// ScrubKeys 値をマスクするJSONのキー名 未指定の場合DefaultCassetteScrubKeysが利用される
+	ScrubKeys []string
+
+	mu   sync.Mutex
+	next int
+	used map[int]bool
+}
+
+// NewReplayingCaller 指定のパスからCassetteを読み込みReplayingCallerを返す
+func NewReplayingCaller(path string, ordered bool) (*ReplayingCaller, error) {
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+	return &ReplayingCaller{
+		Cassette: cassette,
+		Path:     path,
+		Ordered:  ordered,
+	}, nil
+}
+
+// Do APIコール実施
+func (c *ReplayingCaller) Do(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
+	var reqBody []byte
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = scrubJSON(data, c.ScrubKeys, nil)
+	}
+
+	interaction, err := c.find(method, uri, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	return interaction.response()
+}
+
+// Remaining 未使用の記録数を返す
+func (c *ReplayingCaller) Remaining() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Cassette == nil {
+		return 0
+	}
+	if c.Ordered {
+		return len(c.Cassette.Interactions) - c.next
+	}
+	return len(c.Cassette.Interactions) - len(c.used)
+}
+
+func (c *ReplayingCaller) find(method, uri string, reqBody []byte) (*CassetteInteraction, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Cassette == nil {
+		cassette, err := LoadCassette(c.Path)
+		if err != nil {
+			return nil, err
+		}
+		c.Cassette = cassette
+	}
+
+	if c.Ordered {
+		if c.next >= len(c.Cassette.Interactions) {
+			return nil, fmt.Errorf("cassette has no more interactions: %s %s", method, uri)
+		}
+		interaction := c.Cassette.Interactions[c.next]
+		if interaction.Method != method || interaction.URI != uri {
+			return nil, fmt.Errorf("unexpected request: expected %s %s, got %s %s",
+				interaction.Method, interaction.URI, method, uri)
+		}
+		c.next++
+		return interaction, nil
+	}
+
+	if c.used == nil {
+		c.used = make(map[int]bool)
+	}
+	for i, interaction := range c.Cassette.Interactions {
+		if c.used[i] || interaction.Method != method || interaction.URI != uri {
+			continue
+		}
+		if !equalJSON(interaction.RequestBody, reqBody) {
+			continue
+		}
+		c.used[i] = true
+		return interaction, nil
+	}
+	return nil, fmt.Errorf("cassette has no matching interaction: %s %s", method, uri)
+}
+
+func (i *CassetteInteraction) response() ([]byte, error) {
+	if i.APIError != nil || i.StatusCode != 0 {
+		u, err := url.Parse(i.URI)
+		if err != nil {
+			return nil, err
+		}
+		return nil, sacloud.NewAPIError(i.Method, u, i.StatusCode, i.APIError)
+	}
+	if i.Error != "" {
+		return nil, errors.New(i.Error)
+	}
+
+	if len(i.ResponseBody) > 0 && i.ResponseBody[0] == '"' {
+		var s string
+		if err := json.Unmarshal(i.ResponseBody, &s); err == nil {
+			return []byte(s), nil
+		}
+	}
+	return []byte(i.ResponseBody), nil
+}
+
+func equalJSON(v1, v2 []byte) bool {
+	if len(v1) == 0 || len(v2) == 0 {
+		return len(v1) == len(v2)
+	}
+	var o1, o2 interface{}
+	if err := json.Unmarshal(v1, &o1); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(v2, &o2); err != nil {
+		return false
+	}
+	d1, _ := json.Marshal(o1) // nolint
+	d2, _ := json.Marshal(o2) // nolint
+	return bytes.Equal(d1, d2)
+}
@@ -0,0 +1,175 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/stretchr/testify/require"
+)
+
+type cassetteTestCaller struct {
+	calls int
+}
+
+func (c *cassetteTestCaller) Do(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
+	c.calls++
+	if strings.HasSuffix(uri, "/404") {
+		u, _ := url.Parse(uri) // nolint
+		return nil, sacloud.NewAPIError(method, u, http.StatusNotFound, &sacloud.APIErrorResponse{
+			IsFatal:      true,
+			Status:       "404 Not Found",
+			ErrorCode:    "not_found",
+			ErrorMessage: "not found",
+		})
+	}
+	return []byte(`{"Server":{"ID":123456789012,"Name":"example","Password":"p@ssw0rd"},"Token":"secret-token"}`), nil
+}
+
+func TestCassette_RecordAndReplay(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder := NewRecordingCaller(&cassetteTestCaller{}, path, "secret-token")
+	_, err := recorder.Do(ctx, http.MethodPost, testServerURL, map[string]interface{}{
+		"Server": map[string]interface{}{"Name": "example", "Password": "p@ssw0rd"},
+	})
+	require.NoError(t, err)
+	_, err = recorder.Do(ctx, http.MethodGet, testServerURL+"/404", nil)
+	require.True(t, sacloud.IsNotFoundError(err))
+	require.NoError(t, recorder.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "p@ssw0rd")
+	require.NotContains(t, string(data), "secret-token")
+
+	t.Run("replay by request matching", func(t *testing.T) {
+		replayer, err := NewReplayingCaller(path, false)
+		require.NoError(t, err)
+
+		_, err = replayer.Do(ctx, http.MethodGet, testServerURL+"/404", nil)
+		require.True(t, sacloud.IsNotFoundError(err))
+		require.Equal(t, "not_found", err.(sacloud.APIError).Code())
+
+		data, err := replayer.Do(ctx, http.MethodPost, testServerURL, map[string]interface{}{
+			"Server": map[string]interface{}{"Name": "example", "Password": "p@ssw0rd"},
+		})
+		require.NoError(t, err)
+		require.Contains(t, string(data), `"example"`)
+		require.Equal(t, 0, replayer.Remaining())
+
+		_, err = replayer.Do(ctx, http.MethodGet, testServerURL+"/404", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("replay in order", func(t *testing.T) {
+		replayer := &ReplayingCaller{Path: path, Ordered: true}
+
+		_, err = replayer.Do(ctx, http.MethodGet, testServerURL+"/404", nil)
+		require.Error(t, err)
+		require.False(t, sacloud.IsNotFoundError(err))
+
+		_, err := replayer.Do(ctx, http.MethodPost, testServerURL, nil)
+		require.NoError(t, err)
+		_, err = replayer.Do(ctx, http.MethodGet, testServerURL+"/404", nil)
+		require.True(t, sacloud.IsNotFoundError(err))
+	})
+}
+
+func TestRecordingCaller_appendInteractions(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder := NewRecordingCaller(&cassetteTestCaller{}, path, "secret-token")
+	defer recorder.Close() // nolint
+
+	for i := 1; i <= 3; i++ {
+		_, err := recorder.Do(ctx, http.MethodGet, testServerURL, nil)
+		require.NoError(t, err)
+
+		// 追記の途中でも読み込める
+		cassette, err := LoadCassette(path)
+		require.NoError(t, err)
+		require.Len(t, cassette.Interactions, i)
+	}
+
+	// Cassette.Saveと同じ形式で書き込まれる
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	saved := filepath.Join(t.TempDir(), "saved.json")
+	require.NoError(t, recorder.Cassette().Save(saved))
+	expected, err := os.ReadFile(saved)
+	require.NoError(t, err)
+	require.Equal(t, strings.TrimSpace(string(expected)), strings.TrimSpace(string(data)))
+
+	// Close以降は記録されない
+	require.NoError(t, recorder.Close())
+	_, err = recorder.Do(ctx, http.MethodGet, testServerURL, nil)
+	require.NoError(t, err)
+	require.Error(t, recorder.Err())
+	cassette, err := LoadCassette(path)
+	require.NoError(t, err)
+	require.Len(t, cassette.Interactions, 3)
+}
+
+func TestRecordingCaller_recordError(t *testing.T) {
+	ctx := context.Background()
+	// 書き込めないパス
+	path := filepath.Join(t.TempDir(), "not-exists", "cassette.json")
+
+	var notified []error
+	caller := &cassetteTestCaller{}
+	recorder := NewRecordingCaller(caller, path, "secret-token")
+	recorder.OnRecordError = func(err error) {
+		notified = append(notified, err)
+	}
+
+	// 記録に失敗しても実APIのレスポンスは返される
+	data, err := recorder.Do(ctx, http.MethodPost, testServerURL, map[string]interface{}{"Server": map[string]interface{}{"Name": "example"}})
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"example"`)
+	require.Equal(t, 1, caller.calls)
+
+	require.Len(t, notified, 1)
+	require.Error(t, recorder.Err())
+	require.Contains(t, recorder.Err().Error(), "recording POST "+testServerURL+" failed")
+}
+
+func TestCallerOptions_Validate(t *testing.T) {
+	for _, mode := range []string{"", CassetteModeRecord, CassetteModeReplay, CassetteModeReplayOrdered} {
+		require.NoError(t, (&CallerOptions{CassetteMode: mode, CassettePath: "cassette.json"}).Validate(), mode)
+	}
+
+	err := (&CallerOptions{CassetteMode: "replay_ordered", CassettePath: "cassette.json"}).Validate()
+	require.EqualError(t, err, `invalid CassetteMode: "replay_ordered" (valid values: record, replay, replay-ordered)`)
+
+	err = (&CallerOptions{CassetteMode: CassetteModeReplay}).Validate()
+	require.EqualError(t, err, `CassettePath is required when CassetteMode is "replay"`)
+
+	// NewCallerは検証を行わず、不明なCassetteModeは無視される
+	var caller sacloud.APICaller
+	require.NotPanics(t, func() {
+		caller = NewCaller(&CallerOptions{CassetteMode: "replay_ordered", CassettePath: "cassette.json"})
+	})
+	require.IsType(t, &sacloud.Client{}, caller)
+}
@@ -0,0 +1,298 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+)
+
+// DryRunIDBase DryRunCallerがリソース作成時に払い出すIDの開始値
+var DryRunIDBase int64 = 999000000000
+
+// DryRunOperation DryRunCallerが実行をブロックした更新系API呼び出し
+type DryRunOperation struct {
+	// Seq 呼び出し順(1始まり)
+	Seq int
+	// Method リクエストメソッド
+	Method string
+	// URI リクエストURI
+	URI string
+	// Zone 対象ゾーン
+	Zone string
+	// Resource 対象リソースのパス(例: server, product/server)
+	Resource string
+	// ResourceID 対象リソースのID 作成の場合は払い出されたID
+	ResourceID string `json:",omitempty"`
+	// Body リクエストボディ
+	Body json.RawMessage `json:",omitempty"`
+	// CalledAt 呼び出し日時
+	CalledAt time.Time
+}
+
+// String fmt.Stringerの実装
+func (o *DryRunOperation) String() string {
+	return fmt.Sprintf("%d: %s %s", o.Seq, o.Method, o.URI)
+}
+
+// DryRunReport DryRunCallerの実行結果
+type DryRunReport struct {
+	// Operations ブロックした更新系API呼び出しのリスト(呼び出し順)
+	Operations []*DryRunOperation
+}
+
+// DryRunCaller 参照系(GET)のAPI呼び出しのみを実施し、更新系(PUT/POST/DELETE)の呼び出しを記録してブロックするsacloud.APICaller
+//
+// 更新系の呼び出しに対しては成功を示すレスポンスを合成して返す。
+// POSTで作成されたリソースにはDryRunIDBaseから始まるIDが払い出され、以降のGET/PUT/DELETEはAPIを呼ばずに合成したリソースに対して行われる。
+// これによりhelper/builderなどの作成->待機->参照といった一連の処理を実際のリソースを作成せずに実行できる
+//
+// Note: フェイクモードではフェイクドライバがAPICallerを経由せずに処理を行い更新系の呼び出しをブロックできないため、
+// CallerOptionsではDryRunとFakeModeの併用はエラーとなる
+type DryRunCaller struct {
+	// Caller 参照系のAPI呼び出しを行うsacloud.APICaller
+	Caller sacloud.APICaller
+
+	mu         sync.Mutex
+	operations []*DryRunOperation
+	resources  map[string]*dryRunResource
+	nextID     int64
+}
+
+type dryRunResource struct {
+	key   string
+	value map[string]interface{}
+}
+
+// NewDryRunCaller 指定のsacloud.APICallerをラップしたDryRunCallerを返す
+func NewDryRunCaller(caller sacloud.APICaller) *DryRunCaller {
+	return &DryRunCaller{Caller: caller}
+}
+
+// Do APIコール実施
+func (c *DryRunCaller) Do(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
+	path := parseDryRunPath(uri)
+
+	if method == http.MethodGet {
+		if data, ok := c.readSynthesized(path); ok {
+			return data, nil
+		}
+		return c.Caller.Do(ctx, method, uri, body)
+	}
+
+	var reqBody map[string]interface{}
+	var rawBody json.RawMessage
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		rawBody = data
+		json.Unmarshal(data, &reqBody) // nolint - リクエストボディがオブジェクトでない場合は無視する
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	op := &DryRunOperation{
+		Seq:        len(c.operations) + 1,
+		Method:     method,
+		URI:        uri,
+		Zone:       path.zone,
+		Resource:   path.resource,
+		ResourceID: path.id,
+		Body:       rawBody,
+		CalledAt:   time.Now(),
+	}
+	c.operations = append(c.operations, op)
+
+	response := map[string]interface{}{
+		"is_ok":   true,
+		"Success": true,
+	}
+	switch {
+	case method == http.MethodPost && path.id == "":
+		c.nextID++
+		id := strconv.FormatInt(DryRunIDBase+c.nextID, 10)
+		op.ResourceID = id
+		if key, value, ok := resourceValueFromBody(reqBody); ok {
+			value["ID"] = id
+			if _, ok := value["Availability"]; !ok {
+				value["Availability"] = "available"
+			}
+			c.putResource(path.collection+"/"+id, key, value)
+			response[key] = value
+		}
+	case path.id != "" && path.sub == "":
+		resourceKey := path.collection + "/" + path.id
+		switch method {
+		case http.MethodPut:
+			if key, value, ok := resourceValueFromBody(reqBody); ok {
+				if current, ok := c.resources[resourceKey]; ok {
+					for k, v := range value {
+						current.value[k] = v
+					}
+					value = current.value
+				}
+				value["ID"] = path.id
+				response[key] = value
+			}
+		case http.MethodDelete:
+			if current, ok := c.resources[resourceKey]; ok {
+				response[current.key] = current.value
+				delete(c.resources, resourceKey)
+			}
+		}
+	case path.id != "" && path.sub == "power":
+		if current, ok := c.resources[path.collection+"/"+path.id]; ok {
+			status := "up"
+			if method == http.MethodDelete {
+				status = "down"
+			}
+			current.value["Instance"] = map[string]interface{}{"Status": status}
+		}
+	}
+
+	return json.Marshal(response)
+}
+
+// Operations ブロックした更新系API呼び出しのリストを呼び出し順で返す
+func (c *DryRunCaller) Operations() []*DryRunOperation {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ops := make([]*DryRunOperation, len(c.operations))
+	copy(ops, c.operations)
+	return ops
+}
+
+// Report 実行結果を返す
+func (c *DryRunCaller) Report() *DryRunReport {
+	return &DryRunReport{Operations: c.Operations()}
+}
+
+func (c *DryRunCaller) putResource(key, resourceKey string, value map[string]interface{}) {
+	if c.resources == nil {
+		c.resources = make(map[string]*dryRunResource)
+	}
+	c.resources[key] = &dryRunResource{key: resourceKey, value: value}
+}
+
+func (c *DryRunCaller) readSynthesized(path *dryRunPath) ([]byte, bool) {
+	if path.id == "" {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current, ok := c.resources[path.collection+"/"+path.id]
+	if !ok {
+		return nil, false
+	}
+
+	response := map[string]interface{}{
+		"is_ok":   true,
+		"Success": true,
+	}
+	switch path.sub {
+	case "":
+		response[current.key] = current.value
+	case "power":
+		if instance, ok := current.value["Instance"]; ok {
+			response["Instance"] = instance
+		}
+	}
+	data, err := json.Marshal(response)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// resourceValueFromBody {"Server": {...}}のようなリクエストボディからリソースのキーと値を取り出す
+func resourceValueFromBody(body map[string]interface{}) (string, map[string]interface{}, bool) {
+	for key, v := range body {
+		if value, ok := v.(map[string]interface{}); ok {
+			return key, value, true
+		}
+	}
+	return "", nil, false
+}
+
+type dryRunPath struct {
+	zone       string
+	resource   string
+	collection string
+	id         string
+	sub        string
+}
+
+// parseDryRunPath URIからゾーン/リソースパス/ID/サブパスを抽出する
+//
+// 例: https://secure.sakura.ad.jp/cloud/zone/is1a/api/cloud/1.1/server/123456789012/power の場合、
+// ゾーン"is1a"、リソースパス"server"、ID"123456789012"、サブパス"power"となる
+func parseDryRunPath(uri string) *dryRunPath {
+	collection, resource := parseResourcePath(uri)
+	ret := &dryRunPath{
+		resource:   resource,
+		collection: collection,
+	}
+	if i := strings.Index(collection, "/"); i > 0 {
+		ret.zone = collection[:i]
+	}
+
+	path := uri
+	if u, err := url.Parse(uri); err == nil {
+		path = u.Path
+	}
+	i := strings.Index(path, "/api/")
+	if i < 0 {
+		return ret
+	}
+	// api/{service}/{version}/{{.pathName}}/...
+	segments := strings.Split(strings.Trim(path[i:], "/"), "/")
+	skip := 3 + len(strings.Split(resource, "/"))
+	if len(segments) <= skip {
+		return ret
+	}
+	rest := segments[skip:]
+	if isNumeric(rest[0]) {
+		ret.id = rest[0]
+		ret.sub = strings.Join(rest[1:], "/")
+	}
+	return ret
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,100 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRunCaller(t *testing.T) {
+	ctx := context.Background()
+	dummy := &dummyCaller{}
+	caller := NewDryRunCaller(dummy)
+	serverOp := sacloud.NewServerOp(caller)
+
+	// GET is passed through
+	_, err := caller.Do(ctx, http.MethodGet, testPlanURL, nil)
+	require.NoError(t, err)
+
+	server, err := serverOp.Create(ctx, "is1a", &sacloud.ServerCreateRequest{
+		CPU:                  1,
+		MemoryMB:             1024,
+		ServerPlanCommitment: types.Commitments.Standard,
+		ServerPlanGeneration: types.PlanGenerations.Default,
+		Name:                 "dry-run",
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.ID(DryRunIDBase+1), server.ID)
+	require.Equal(t, "dry-run", server.Name)
+
+	require.NoError(t, serverOp.Boot(ctx, "is1a", server.ID))
+
+	read, err := serverOp.Read(ctx, "is1a", server.ID)
+	require.NoError(t, err)
+	require.Equal(t, "dry-run", read.Name)
+	require.True(t, read.InstanceStatus.IsUp())
+
+	_, err = serverOp.Update(ctx, "is1a", server.ID, &sacloud.ServerUpdateRequest{Name: "updated"})
+	require.NoError(t, err)
+	read, err = serverOp.Read(ctx, "is1a", server.ID)
+	require.NoError(t, err)
+	require.Equal(t, "updated", read.Name)
+
+	require.NoError(t, serverOp.Delete(ctx, "is1a", server.ID))
+	_, err = serverOp.Read(ctx, "is1a", server.ID)
+	require.NoError(t, err) // passed through to the dummy caller
+
+	require.Equal(t, []string{"GET " + testPlanURL, "GET " + testServerURLOf(server.ID)}, dummy.calls)
+
+	report := caller.Report()
+	require.Len(t, report.Operations, 4)
+	var methods []string
+	for _, op := range report.Operations {
+		methods = append(methods, op.Method)
+		require.Equal(t, "is1a", op.Zone)
+		require.Equal(t, "server", op.Resource)
+		require.Equal(t, server.ID.String(), op.ResourceID)
+	}
+	require.Equal(t, []string{http.MethodPost, http.MethodPut, http.MethodPut, http.MethodDelete}, methods)
+	require.Contains(t, string(report.Operations[0].Body), `"Name":"dry-run"`)
+}
+
+func testServerURLOf(id types.ID) string {
+	return sacloud.SakuraCloudAPIRoot + "/is1a/api/cloud/1.1/server/" + id.String()
+}
+
+func TestDryRunCaller_fakeMode(t *testing.T) {
+	opts := &CallerOptions{
+		AccessToken:       "token",
+		AccessTokenSecret: "secret",
+		FakeMode:          true,
+		DryRun:            true,
+	}
+	require.EqualError(t, opts.Validate(), "DryRun can not be used with FakeMode")
+
+	// フェイクモードで更新系の呼び出しがフェイクドライバに対して実行されないよう、呼び出し元にエラーを返す
+	t.Setenv("SAKURACLOUD_PROFILE", "")
+	t.Setenv("USACLOUD_PROFILE", "")
+	t.Setenv("HOME", t.TempDir())
+	caller, err := NewCallerWithDefaults(opts)
+	require.EqualError(t, err, "DryRun can not be used with FakeMode")
+	require.Nil(t, caller)
+}
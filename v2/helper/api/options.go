@@ -15,10 +15,13 @@
 package api
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	sacloudhttp "github.com/sacloud/go-http"
 	"github.com/sacloud/libsacloud/v2/sacloud"
@@ -45,15 +48,59 @@ type CallerOptions struct {
 	RetryWaitMax int
 	RetryWaitMin int
 
+	RetryOnStillCreating   bool
+	RetryOnTooManyRequests bool
+	RetryOnConnectionError bool
+	RetryJitter            bool
+	RetryEventHandler      func(ctx context.Context, event *sacloud.RetryEvent)
+
 	UserAgent string
 
+	Middlewares []sacloud.Middleware
+
 	TraceAPI             bool
 	TraceHTTP            bool
 	OpenTelemetry        bool
 	OpenTelemetryOptions []otel.Option
+	OpenTelemetryMetrics bool
 
 	FakeMode      bool
 	FakeStorePath string
+
+	CassetteMode string
+	CassettePath string
+
+	// DryRun 更新系のAPI呼び出しを記録してブロックする(DryRunCaller)
+	//
+	// フェイクドライバはAPICallerを経由しないため、FakeModeとは併用できない
+	DryRun bool
+
+	CacheAPI  bool
+	CacheTTLs map[string]time.Duration
+
+	// ClientRegistry 指定した場合、APIトレースやフェイクモード、ポーリング設定などを
+	// パッケージレベルのグローバル変数ではなくこのレジストリに対して行う
+	//
+	// 1プロセス内で設定の異なる複数のAPICallerを利用する場合に指定する。
+	// Note: APIRootURL/DefaultZone/Zonesは引き続きグローバル変数に対して設定される
+	ClientRegistry *sacloud.ClientRegistry
+}
+
+// Validate 値の組み合わせや列挙値が正しいか検証する
+func (o *CallerOptions) Validate() error {
+	switch o.CassetteMode {
+	case "", CassetteModeRecord, CassetteModeReplay, CassetteModeReplayOrdered:
+	default:
+		return fmt.Errorf("invalid CassetteMode: %q (valid values: %s, %s, %s)",
+			o.CassetteMode, CassetteModeRecord, CassetteModeReplay, CassetteModeReplayOrdered)
+	}
+	if o.CassetteMode != "" && o.CassettePath == "" {
+		return fmt.Errorf("CassettePath is required when CassetteMode is %q", o.CassetteMode)
+	}
+	if o.DryRun && o.FakeMode {
+		return fmt.Errorf("DryRun can not be used with FakeMode")
+	}
+	return nil
 }
 
 // DefaultOption 環境変数、プロファイルからCallerOptionsを組み立てて返す
@@ -134,8 +181,26 @@ func MergeOptions(opts ...*CallerOptions) *CallerOptions {
 		if opt.UserAgent != "" {
 			merged.UserAgent = opt.UserAgent
 		}
+		if len(opt.Middlewares) > 0 {
+			merged.Middlewares = opt.Middlewares
+		}
+		if opt.RetryEventHandler != nil {
+			merged.RetryEventHandler = opt.RetryEventHandler
+		}
 
 		// Note: bool値は一度trueにしたらMergeでfalseになることがない
+		if opt.RetryOnStillCreating {
+			merged.RetryOnStillCreating = true
+		}
+		if opt.RetryOnTooManyRequests {
+			merged.RetryOnTooManyRequests = true
+		}
+		if opt.RetryOnConnectionError {
+			merged.RetryOnConnectionError = true
+		}
+		if opt.RetryJitter {
+			merged.RetryJitter = true
+		}
 		if opt.TraceAPI {
 			merged.TraceAPI = true
 		}
@@ -145,6 +210,9 @@ func MergeOptions(opts ...*CallerOptions) *CallerOptions {
 		if opt.OpenTelemetry {
 			merged.OpenTelemetry = true
 		}
+		if opt.OpenTelemetryMetrics {
+			merged.OpenTelemetryMetrics = true
+		}
 		if len(opt.OpenTelemetryOptions) > 0 {
 			merged.OpenTelemetryOptions = opt.OpenTelemetryOptions
 		}
@@ -154,6 +222,24 @@ func MergeOptions(opts ...*CallerOptions) *CallerOptions {
 		if opt.FakeStorePath != "" {
 			merged.FakeStorePath = opt.FakeStorePath
 		}
+		if opt.CassetteMode != "" {
+			merged.CassetteMode = opt.CassetteMode
+		}
+		if opt.CassettePath != "" {
+			merged.CassettePath = opt.CassettePath
+		}
+		if opt.DryRun {
+			merged.DryRun = true
+		}
+		if opt.CacheAPI {
+			merged.CacheAPI = true
+		}
+		if len(opt.CacheTTLs) > 0 {
+			merged.CacheTTLs = opt.CacheTTLs
+		}
+		if opt.ClientRegistry != nil {
+			merged.ClientRegistry = opt.ClientRegistry
+		}
 	}
 	return merged
 }
@@ -176,11 +262,23 @@ func OptionsFromEnv() *CallerOptions {
 		RetryWaitMax: intFromEnv("SAKURACLOUD_RETRY_WAIT_MAX", 0),
 		RetryWaitMin: intFromEnv("SAKURACLOUD_RETRY_WAIT_MIN", 0),
 
+		RetryOnStillCreating:   os.Getenv("SAKURACLOUD_RETRY_ON_STILL_CREATING") != "",
+		RetryOnTooManyRequests: os.Getenv("SAKURACLOUD_RETRY_ON_TOO_MANY_REQUESTS") != "",
+		RetryOnConnectionError: os.Getenv("SAKURACLOUD_RETRY_ON_CONNECTION_ERROR") != "",
+		RetryJitter:            os.Getenv("SAKURACLOUD_RETRY_JITTER") != "",
+
 		TraceAPI:  profile.EnableAPITrace(stringFromEnv("SAKURACLOUD_TRACE", "")),
 		TraceHTTP: profile.EnableHTTPTrace(stringFromEnv("SAKURACLOUD_TRACE", "")),
 
 		FakeMode:      os.Getenv("SAKURACLOUD_FAKE_MODE") != "",
 		FakeStorePath: stringFromEnv("SAKURACLOUD_FAKE_STORE_PATH", ""),
+
+		CassetteMode: stringFromEnv("SAKURACLOUD_CASSETTE_MODE", ""),
+		CassettePath: stringFromEnv("SAKURACLOUD_CASSETTE_PATH", ""),
+
+		DryRun: os.Getenv("SAKURACLOUD_DRY_RUN") != "",
+
+		CacheAPI: os.Getenv("SAKURACLOUD_API_CACHE") != "",
 	}
 }
 
@@ -201,21 +299,25 @@ func OptionsFromProfile(profileName string) (*CallerOptions, error) {
 	}
 
 	return &CallerOptions{
-		AccessToken:          config.AccessToken,
-		AccessTokenSecret:    config.AccessTokenSecret,
-		APIRootURL:           config.APIRootURL,
-		DefaultZone:          config.DefaultZone,
-		Zones:                config.Zones,
-		AcceptLanguage:       config.AcceptLanguage,
-		HTTPRequestTimeout:   config.HTTPRequestTimeout,
-		HTTPRequestRateLimit: config.HTTPRequestRateLimit,
-		RetryMax:             config.RetryMax,
-		RetryWaitMax:         config.RetryWaitMax,
-		RetryWaitMin:         config.RetryWaitMin,
-		TraceAPI:             config.EnableAPITrace(),
-		TraceHTTP:            config.EnableHTTPTrace(),
-		FakeMode:             config.FakeMode,
-		FakeStorePath:        config.FakeStorePath,
+		AccessToken:            config.AccessToken,
+		AccessTokenSecret:      config.AccessTokenSecret,
+		APIRootURL:             config.APIRootURL,
+		DefaultZone:            config.DefaultZone,
+		Zones:                  config.Zones,
+		AcceptLanguage:         config.AcceptLanguage,
+		HTTPRequestTimeout:     config.HTTPRequestTimeout,
+		HTTPRequestRateLimit:   config.HTTPRequestRateLimit,
+		RetryMax:               config.RetryMax,
+		RetryWaitMax:           config.RetryWaitMax,
+		RetryWaitMin:           config.RetryWaitMin,
+		RetryOnStillCreating:   config.RetryOnStillCreating,
+		RetryOnTooManyRequests: config.RetryOnTooManyRequests,
+		RetryOnConnectionError: config.RetryOnConnectionError,
+		RetryJitter:            config.RetryJitter,
+		TraceAPI:               config.EnableAPITrace(),
+		TraceHTTP:              config.EnableHTTPTrace(),
+		FakeMode:               config.FakeMode,
+		FakeStorePath:          config.FakeStorePath,
 	}, nil
 }
 
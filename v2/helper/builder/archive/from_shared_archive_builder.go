@@ -81,7 +81,7 @@ func (b *FromSharedArchiveBuilder) Build(ctx context.Context, zone string) (*sac
 
 	lastState, err := sacloud.WaiterForReady(func() (interface{}, error) {
 		return b.Client.Archive.Read(ctx, zone, archive.ID)
-	}).WaitForState(ctx)
+	}).WaitForState(sacloud.WithClientRegistryOf(ctx, b.Client.Archive))
 
 	var ret *sacloud.Archive
 	if lastState != nil {
@@ -85,7 +85,7 @@ func (b *TransferArchiveBuilder) Build(ctx context.Context, zone string) (*saclo
 
 	lastState, err := sacloud.WaiterForReady(func() (interface{}, error) {
 		return b.Client.Archive.Read(ctx, zone, archive.ID)
-	}).WaitForState(ctx)
+	}).WaitForState(sacloud.WithClientRegistryOf(ctx, b.Client.Archive))
 
 	var ret *sacloud.Archive
 	if lastState != nil {
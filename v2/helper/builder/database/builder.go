@@ -90,6 +90,7 @@ func (b *Builder) Build(ctx context.Context, zone string) (*sacloud.Database, er
 		return nil, err
 	}
 
+	registry, _ := sacloud.ClientRegistryFromAPI(b.Client.Database)
 	builder := &setup.RetryableSetup{
 		Create: func(ctx context.Context, zone string) (accessor.ID, error) {
 			return b.Client.Database.Create(ctx, zone, &sacloud.DatabaseCreateRequest{
@@ -124,8 +125,9 @@ func (b *Builder) Build(ctx context.Context, zone string) (*sacloud.Database, er
 			waiter := sacloud.WaiterForUp(func() (interface{}, error) {
 				return b.Client.Database.Status(ctx, zone, id)
 			})
-			waiter.SetPollingInterval(sacloud.DefaultDBStatusPollingInterval) // HACK 現状は決め打ち、ユースケースが出たら修正する
-			_, err := waiter.WaitForState(ctx)
+			waitCtx := sacloud.WithClientRegistryOf(ctx, b.Client.Database)
+			waiter.SetPollingInterval(sacloud.DBStatusPollingIntervalFromContext(waitCtx)) // HACK 現状は決め打ち、ユースケースが出たら修正する
+			_, err := waiter.WaitForState(waitCtx)
 			if err != nil {
 				return err
 			}
@@ -142,6 +144,7 @@ func (b *Builder) Build(ctx context.Context, zone string) (*sacloud.Database, er
 		DeleteRetryCount:    b.SetupOptions.DeleteRetryCount,
 		DeleteRetryInterval: b.SetupOptions.DeleteRetryInterval,
 		PollingInterval:     b.SetupOptions.PollingInterval,
+		ClientRegistry:      registry,
 	}
 
 	result, err := builder.Setup(ctx, zone)
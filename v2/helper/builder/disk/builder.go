@@ -642,7 +642,7 @@ func (d *ConnectedDiskBuilder) Build(ctx context.Context, zone string, serverID
 		waiter := sacloud.WaiterForReady(func() (interface{}, error) {
 			return d.Client.Disk.Read(ctx, zone, d.ID)
 		})
-		if _, err := waiter.WaitForState(ctx); err != nil {
+		if _, err := waiter.WaitForState(sacloud.WithClientRegistryOf(ctx, d.Client.Disk)); err != nil {
 			return nil, err
 		}
 	}
@@ -667,7 +667,7 @@ func (d *ConnectedDiskBuilder) Update(ctx context.Context, zone string) (*Update
 		waiter := sacloud.WaiterForReady(func() (interface{}, error) {
 			return d.Client.Disk.Read(ctx, zone, d.ID)
 		})
-		if _, err := waiter.WaitForState(ctx); err != nil {
+		if _, err := waiter.WaitForState(sacloud.WithClientRegistryOf(ctx, d.Client.Disk)); err != nil {
 			return nil, err
 		}
 	}
@@ -754,7 +754,7 @@ func build(ctx context.Context, client *APIClient, zone string, serverID types.I
 	waiter := sacloud.WaiterForReady(func() (interface{}, error) {
 		return client.Disk.Read(ctx, zone, disk.ID)
 	})
-	lastState, err := waiter.WaitForState(ctx)
+	lastState, err := waiter.WaitForState(sacloud.WithClientRegistryOf(ctx, client.Disk))
 	if err != nil {
 		if lastState != nil {
 			return &BuildResult{DiskID: lastState.(*sacloud.Disk).ID}, err
@@ -806,7 +806,7 @@ func update(ctx context.Context, client *APIClient, zone string, builder diskBui
 	waiter := sacloud.WaiterForReady(func() (interface{}, error) {
 		return client.Disk.Read(ctx, zone, disk.ID)
 	})
-	lastState, err := waiter.WaitForState(ctx)
+	lastState, err := waiter.WaitForState(sacloud.WithClientRegistryOf(ctx, client.Disk))
 	if err != nil {
 		return nil, err
 	}
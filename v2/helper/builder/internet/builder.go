@@ -92,7 +92,7 @@ func (b *Builder) Build(ctx context.Context, zone string) (*sacloud.Internet, er
 	waiter := sacloud.WaiterForApplianceUp(func() (interface{}, error) {
 		return b.Client.Internet.Read(ctx, zone, internet.ID)
 	}, b.NotFoundRetry)
-	if _, err := waiter.WaitForState(ctx); err != nil {
+	if _, err := waiter.WaitForState(sacloud.WithClientRegistryOf(ctx, b.Client.Internet)); err != nil {
 		return internet, err
 	}
 
@@ -181,6 +181,7 @@ func (b *Builder) Build(ctx context.Context, zone string) (*sacloud.MobileGatewa
 		return nil, err
 	}
 
+	registry, _ := sacloud.ClientRegistryFromAPI(b.Client.MobileGateway)
 	builder := &setup.RetryableSetup{
 		Create: func(ctx context.Context, zone string) (accessor.ID, error) {
 			return b.Client.MobileGateway.Create(ctx, zone, &sacloud.MobileGatewayCreateRequest{
@@ -295,6 +296,7 @@ func (b *Builder) Build(ctx context.Context, zone string) (*sacloud.MobileGatewa
 		DeleteRetryCount:          b.SetupOptions.DeleteRetryCount,
 		DeleteRetryInterval:       b.SetupOptions.DeleteRetryInterval,
 		PollingInterval:           b.SetupOptions.PollingInterval,
+		ClientRegistry:            registry,
 	}
 
 	result, err := builder.Setup(ctx, zone)
@@ -178,6 +178,7 @@ func (b *Builder) Build(ctx context.Context, zone string) (*sacloud.VPCRouter, e
 		return nil, err
 	}
 
+	registry, _ := sacloud.ClientRegistryFromAPI(b.Client)
 	builder := &setup.RetryableSetup{
 		Create: func(ctx context.Context, zone string) (accessor.ID, error) {
 			return b.Client.Create(ctx, zone, &sacloud.VPCRouterCreateRequest{
@@ -265,6 +266,7 @@ func (b *Builder) Build(ctx context.Context, zone string) (*sacloud.VPCRouter, e
 		DeleteRetryCount:          b.SetupOptions.DeleteRetryCount,
 		DeleteRetryInterval:       b.SetupOptions.DeleteRetryInterval,
 		PollingInterval:           b.SetupOptions.PollingInterval,
+		ClientRegistry:            registry,
 	}
 
 	result, err := builder.Setup(ctx, zone)
@@ -0,0 +1,25 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dnszone DNSアプライアンスのレコードを扱うユーティリティ
+//
+// Parseはゾーンファイル($ORIGIN、$TTL、相対名、複数文字列のTXT、MX/SRV/CAAの各フィールドを含む)を
+// sacloud.DNSRecordsに変換します。
+// DNSアプライアンスで扱えないレコード(SOA、ゾーン頂点のNS、未対応のレコードタイプなど)は破棄せずにParseResult.Skippedとして返します。
+//
+// Writeはsacloud.DNSをゾーンファイルとして出力します。
+//
+// NewPlanは現在のレコードと期待するレコードを比較して変更計画(追加/TTL変更/削除と競合)を作成し、
+// Applyは変更計画の作成後に他から設定が変更されていないことをSettingsHashで確認した上で変更を適用します。
+package dnszone
@@ -0,0 +1,171 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnszone
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// token ゾーンファイル中のトークン
+type token struct {
+	text   string
+	quoted bool
+}
+
+// entry 括弧による継続行をまとめた論理行
+type entry struct {
+	line         int
+	leadingBlank bool
+	tokens       []token
+}
+
+// lex ゾーンファイルを論理行ごとのトークンに分割する
+func lex(src string) ([]*entry, error) {
+	var entries []*entry
+	var current *entry
+	var buf strings.Builder
+	inToken := false
+
+	line := 1
+	depth := 0
+	lineStart := true
+
+	flush := func() {
+		if inToken {
+			current.tokens = append(current.tokens, token{text: buf.String()})
+			buf.Reset()
+			inToken = false
+		}
+	}
+	ensureEntry := func(blank bool) {
+		if current == nil {
+			current = &entry{line: line, leadingBlank: blank}
+		}
+	}
+	endEntry := func() {
+		flush()
+		if current != nil && len(current.tokens) > 0 {
+			entries = append(entries, current)
+		}
+		current = nil
+	}
+
+	runes := []rune(src)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		atLineStart := lineStart
+		lineStart = false
+
+		switch c {
+		case '\n':
+			if depth == 0 {
+				endEntry()
+			} else {
+				flush()
+			}
+			line++
+			lineStart = true
+		case ' ', '\t', '\r':
+			if atLineStart && depth == 0 {
+				ensureEntry(true)
+			}
+			flush()
+		case ';':
+			flush()
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+		case '(':
+			ensureEntry(false)
+			flush()
+			depth++
+		case ')':
+			flush()
+			if depth == 0 {
+				return nil, &ParseError{Line: line, Err: fmt.Errorf("unbalanced parentheses")}
+			}
+			depth--
+		case '"':
+			ensureEntry(false)
+			flush()
+			text, next, err := lexQuoted(runes, i+1)
+			if err != nil {
+				return nil, &ParseError{Line: line, Err: err}
+			}
+			current.tokens = append(current.tokens, token{text: text, quoted: true})
+			i = next
+		default:
+			ensureEntry(false)
+			buf.WriteRune(c)
+			inToken = true
+			if c == '\\' && i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+				buf.WriteRune(runes[i])
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, &ParseError{Line: line, Err: fmt.Errorf("unbalanced parentheses")}
+	}
+	endEntry()
+	return entries, nil
+}
+
+// lexQuoted ダブルクォートで囲まれた文字列を読み取り、エスケープを解除した文字列と閉じクォートの位置を返す
+func lexQuoted(runes []rune, start int) (string, int, error) {
+	var buf strings.Builder
+	for i := start; i < len(runes); i++ {
+		c := runes[i]
+		switch c {
+		case '"':
+			return buf.String(), i, nil
+		case '\n':
+			return "", 0, fmt.Errorf("unterminated quoted string")
+		case '\\':
+			if i+1 >= len(runes) {
+				return "", 0, fmt.Errorf("unterminated quoted string")
+			}
+			// \DDD形式
+			if i+3 < len(runes) && isDigits(string(runes[i+1:i+4])) {
+				v, _ := strconv.Atoi(string(runes[i+1 : i+4]))
+				if v > 255 {
+					return "", 0, fmt.Errorf("invalid escape sequence: \\%s", string(runes[i+1:i+4]))
+				}
+				buf.WriteByte(byte(v))
+				i += 3
+				continue
+			}
+			i++
+			buf.WriteRune(runes[i])
+		default:
+			buf.WriteRune(c)
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated quoted string")
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,433 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnszone
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+// DefaultTTL $TTLとTTLがどちらも指定されていないレコードに用いるTTL
+const DefaultTTL = 3600
+
+// ParseError ゾーンファイルの構文エラー
+type ParseError struct {
+	Line int
+	Err  error
+}
+
+// Error errorインターフェースの実装
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+// Unwrap 元のエラーを返す
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// SkipReason レコードをDNSRecordsに含めなかった理由
+type SkipReason string
+
+const (
+	// SkipReasonUnsupportedType DNSアプライアンスで未対応のレコードタイプ
+	SkipReasonUnsupportedType SkipReason = "unsupported record type"
+	// SkipReasonUnsupportedClass IN以外のクラス
+	SkipReasonUnsupportedClass SkipReason = "unsupported class"
+	// SkipReasonManagedByService SOAやゾーン頂点のNSなどサービス側で管理されるレコード
+	SkipReasonManagedByService SkipReason = "managed by the service"
+	// SkipReasonOutOfZone ゾーン外の名前を持つレコード
+	SkipReasonOutOfZone SkipReason = "out of zone"
+)
+
+// SkippedRecord DNSRecordsに含めなかったレコード
+type SkippedRecord struct {
+	// Line ゾーンファイル上の行番号
+	Line int
+	// Name 所有者名(FQDN)
+	Name string
+	// Type レコードタイプ
+	Type string
+	// RData レコードデータ(ゾーンファイル上の表記)
+	RData string
+	// Reason 理由
+	Reason SkipReason
+}
+
+// String SkippedRecordの文字列表現
+func (r *SkippedRecord) String() string {
+	return fmt.Sprintf("line %d: %s %s %s: %s", r.Line, r.Name, r.Type, r.RData, r.Reason)
+}
+
+// ParseResult Parseの結果
+type ParseResult struct {
+	// Records DNSアプライアンスに登録可能なレコード
+	Records sacloud.DNSRecords
+	// Skipped DNSアプライアンスに登録できないため除外したレコード
+	Skipped []*SkippedRecord
+}
+
+// Parse ゾーンファイルを読み込みzoneのレコードとして返す
+//
+// zoneはDNSアプライアンスのゾーン名(example.comなど)で、$ORIGINの初期値となる。
+// レコード名はゾーンからの相対名(ゾーン頂点の場合は@)に、
+// CNAME/NS/MXなどのホスト名はFQDN(末尾に.付き)に変換される。
+// 構文エラーの場合は*ParseErrorを返す
+func Parse(r io.Reader, zone string) (*ParseResult, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := lex(string(src))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{
+		zone:   fqdn(zone),
+		origin: fqdn(zone),
+		result: &ParseResult{},
+	}
+	for _, e := range entries {
+		if err := p.parseEntry(e); err != nil {
+			return nil, &ParseError{Line: e.line, Err: err}
+		}
+	}
+	return p.result, nil
+}
+
+type parser struct {
+	zone      string
+	origin    string
+	lastOwner string
+	result    *ParseResult
+
+	defaultTTL    int
+	hasDefaultTTL bool
+	lastTTL       int
+	hasLastTTL    bool
+}
+
+func (p *parser) parseEntry(e *entry) error {
+	tokens := e.tokens
+	if !tokens[0].quoted && strings.HasPrefix(tokens[0].text, "$") && !e.leadingBlank {
+		return p.parseDirective(tokens)
+	}
+
+	var owner string
+	if e.leadingBlank {
+		if p.lastOwner == "" {
+			return fmt.Errorf("owner name is not specified")
+		}
+		owner = p.lastOwner
+	} else {
+		owner = p.absoluteName(tokens[0].text)
+		tokens = tokens[1:]
+	}
+	p.lastOwner = owner
+
+	ttl, hasTTL := 0, false
+	class := ""
+	for len(tokens) > 0 && !tokens[0].quoted {
+		text := tokens[0].text
+		if !hasTTL {
+			if v, err := parseTTL(text); err == nil {
+				ttl, hasTTL = v, true
+				tokens = tokens[1:]
+				continue
+			}
+		}
+		if class == "" && isClass(text) {
+			class = strings.ToUpper(text)
+			tokens = tokens[1:]
+			continue
+		}
+		break
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("record type is not specified")
+	}
+	rrType := strings.ToUpper(tokens[0].text)
+	rdata := tokens[1:]
+
+	switch {
+	case hasTTL:
+		p.lastTTL, p.hasLastTTL = ttl, true
+	case p.hasDefaultTTL:
+		ttl = p.defaultTTL
+	case p.hasLastTTL:
+		ttl = p.lastTTL
+	default:
+		ttl = DefaultTTL
+	}
+
+	skip := func(reason SkipReason) error {
+		p.result.Skipped = append(p.result.Skipped, &SkippedRecord{
+			Line:   e.line,
+			Name:   owner,
+			Type:   rrType,
+			RData:  joinTokens(rdata),
+			Reason: reason,
+		})
+		return nil
+	}
+
+	if class != "" && class != "IN" {
+		return skip(SkipReasonUnsupportedClass)
+	}
+	if rrType == "SOA" {
+		return skip(SkipReasonManagedByService)
+	}
+	if !isSupportedType(rrType) {
+		return skip(SkipReasonUnsupportedType)
+	}
+	name, ok := p.relativeName(owner)
+	if !ok {
+		return skip(SkipReasonOutOfZone)
+	}
+	recordType := types.EDNSRecordType(rrType)
+	if recordType == types.DNSRecordTypes.NS && name == "@" {
+		return skip(SkipReasonManagedByService)
+	}
+
+	value, err := p.parseRData(recordType, rdata)
+	if err != nil {
+		return fmt.Errorf("%s %s: %s", owner, rrType, err)
+	}
+	p.result.Records.Add(&sacloud.DNSRecord{
+		Name:  name,
+		Type:  recordType,
+		RData: value,
+		TTL:   ttl,
+	})
+	return nil
+}
+
+func (p *parser) parseDirective(tokens []token) error {
+	directive := strings.ToUpper(tokens[0].text)
+	switch directive {
+	case "$ORIGIN":
+		if len(tokens) != 2 {
+			return fmt.Errorf("$ORIGIN requires a domain name")
+		}
+		p.origin = p.absoluteName(tokens[1].text)
+	case "$TTL":
+		if len(tokens) != 2 {
+			return fmt.Errorf("$TTL requires a TTL value")
+		}
+		ttl, err := parseTTL(tokens[1].text)
+		if err != nil {
+			return err
+		}
+		p.defaultTTL, p.hasDefaultTTL = ttl, true
+	default:
+		return fmt.Errorf("unsupported directive: %s", tokens[0].text)
+	}
+	return nil
+}
+
+func (p *parser) parseRData(t types.EDNSRecordType, tokens []token) (string, error) {
+	switch t {
+	case types.DNSRecordTypes.A, types.DNSRecordTypes.AAAA:
+		if err := expectTokens(tokens, 1); err != nil {
+			return "", err
+		}
+		ip := net.ParseIP(tokens[0].text)
+		isIPv4 := ip != nil && !strings.Contains(tokens[0].text, ":")
+		if ip == nil || isIPv4 != (t == types.DNSRecordTypes.A) {
+			return "", fmt.Errorf("invalid address: %s", tokens[0].text)
+		}
+		return tokens[0].text, nil
+	case types.DNSRecordTypes.CNAME, types.DNSRecordTypes.NS, types.DNSRecordTypes.PTR, types.DNSRecordTypes.ALIAS:
+		if err := expectTokens(tokens, 1); err != nil {
+			return "", err
+		}
+		return p.absoluteName(tokens[0].text), nil
+	case types.DNSRecordTypes.MX:
+		if err := expectTokens(tokens, 2); err != nil {
+			return "", err
+		}
+		pref, err := parseUint16("preference", tokens[0].text)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d %s", pref, p.absoluteName(tokens[1].text)), nil
+	case types.DNSRecordTypes.SRV:
+		if err := expectTokens(tokens, 4); err != nil {
+			return "", err
+		}
+		var values []int
+		for i, field := range []string{"priority", "weight", "port"} {
+			v, err := parseUint16(field, tokens[i].text)
+			if err != nil {
+				return "", err
+			}
+			values = append(values, v)
+		}
+		return fmt.Sprintf("%d %d %d %s", values[0], values[1], values[2], p.absoluteName(tokens[3].text)), nil
+	case types.DNSRecordTypes.TXT:
+		if len(tokens) == 0 {
+			return "", fmt.Errorf("TXT requires at least one string")
+		}
+		var buf strings.Builder
+		for _, t := range tokens {
+			buf.WriteString(t.text)
+		}
+		return buf.String(), nil
+	case types.DNSRecordTypes.CAA:
+		if err := expectTokens(tokens, 3); err != nil {
+			return "", err
+		}
+		flags, err := strconv.Atoi(tokens[0].text)
+		if err != nil || flags < 0 || flags > 255 {
+			return "", fmt.Errorf("invalid flags: %s", tokens[0].text)
+		}
+		tag := strings.ToLower(tokens[1].text)
+		if tag == "" || !isAlnum(tag) {
+			return "", fmt.Errorf("invalid tag: %s", tokens[1].text)
+		}
+		return fmt.Sprintf("%d %s %s", flags, tag, quoteTXT(tokens[2].text)), nil
+	}
+	return "", fmt.Errorf("unsupported record type")
+}
+
+// absoluteName 現在の$ORIGINを元にFQDNを返す
+func (p *parser) absoluteName(name string) string {
+	switch {
+	case name == "@":
+		return p.origin
+	case strings.HasSuffix(name, "."):
+		return name
+	default:
+		return name + "." + p.origin
+	}
+}
+
+// relativeName FQDNをゾーンからの相対名に変換する ゾーン外の場合はfalseを返す
+func (p *parser) relativeName(name string) (string, bool) {
+	lower := strings.ToLower(name)
+	if lower == p.zone {
+		return "@", true
+	}
+	if strings.HasSuffix(lower, "."+p.zone) {
+		return name[:len(name)-len(p.zone)-1], true
+	}
+	return "", false
+}
+
+func fqdn(name string) string {
+	name = strings.ToLower(name)
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+	return name
+}
+
+func isClass(s string) bool {
+	switch strings.ToUpper(s) {
+	case "IN", "CH", "CS", "HS":
+		return true
+	}
+	return false
+}
+
+func isSupportedType(s string) bool {
+	for _, t := range types.DNSRecordTypeStrings {
+		if s == t {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTTL 秒数またはBIND形式(1h30mなど)のTTLを秒数に変換する
+func parseTTL(s string) (int, error) {
+	if isDigits(s) {
+		return strconv.Atoi(s)
+	}
+
+	total, num := 0, ""
+	for _, c := range strings.ToLower(s) {
+		if c >= '0' && c <= '9' {
+			num += string(c)
+			continue
+		}
+		if num == "" {
+			return 0, fmt.Errorf("invalid TTL: %s", s)
+		}
+		v, _ := strconv.Atoi(num)
+		switch c {
+		case 's':
+		case 'm':
+			v *= 60
+		case 'h':
+			v *= 60 * 60
+		case 'd':
+			v *= 60 * 60 * 24
+		case 'w':
+			v *= 60 * 60 * 24 * 7
+		default:
+			return 0, fmt.Errorf("invalid TTL: %s", s)
+		}
+		total += v
+		num = ""
+	}
+	if num != "" {
+		return 0, fmt.Errorf("invalid TTL: %s", s)
+	}
+	return total, nil
+}
+
+func parseUint16(field, s string) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil || v < 0 || v > 65535 {
+		return 0, fmt.Errorf("invalid %s: %s", field, s)
+	}
+	return v, nil
+}
+
+func expectTokens(tokens []token, n int) error {
+	if len(tokens) != n {
+		return fmt.Errorf("expected %d fields, got %d", n, len(tokens))
+	}
+	return nil
+}
+
+func isAlnum(s string) bool {
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func joinTokens(tokens []token) string {
+	var s []string
+	for _, t := range tokens {
+		if t.quoted {
+			s = append(s, strconv.Quote(t.text))
+			continue
+		}
+		s = append(s, t.text)
+	}
+	return strings.Join(s, " ")
+}
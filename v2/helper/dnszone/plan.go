@@ -0,0 +1,286 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnszone
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+// ChangeAction レコードに対する変更の種類
+type ChangeAction string
+
+const (
+	// ChangeActionCreate レコードの追加
+	ChangeActionCreate ChangeAction = "create"
+	// ChangeActionUpdateTTL TTLの変更
+	ChangeActionUpdateTTL ChangeAction = "update-ttl"
+	// ChangeActionDelete レコードの削除
+	ChangeActionDelete ChangeAction = "delete"
+)
+
+// Change レコードに対する変更
+type Change struct {
+	// Action 変更の種類
+	Action ChangeAction
+	// Record 対象レコード Deleteの場合は現在のレコード、それ以外は変更後のレコード
+	Record *sacloud.DNSRecord
+	// PreviousTTL 変更前のTTL ActionがUpdateTTLの場合のみ設定される
+	PreviousTTL int
+}
+
+// String Changeの文字列表現
+func (c *Change) String() string {
+	s := fmt.Sprintf("%s %s %s %s", c.Action, c.Record.Name, c.Record.Type, c.Record.RData)
+	if c.Action == ChangeActionUpdateTTL {
+		s += fmt.Sprintf(" (TTL %d => %d)", c.PreviousTTL, c.Record.TTL)
+	}
+	return s
+}
+
+// Conflict 同時に登録できないレコードの組み合わせ
+type Conflict struct {
+	// Name レコード名
+	Name string
+	// Types 競合しているレコードタイプ
+	Types []types.EDNSRecordType
+	// Reason 理由
+	Reason string
+}
+
+// String Conflictの文字列表現
+func (c *Conflict) String() string {
+	var ts []string
+	for _, t := range c.Types {
+		ts = append(ts, t.String())
+	}
+	return fmt.Sprintf("%s [%s]: %s", c.Name, strings.Join(ts, ","), c.Reason)
+}
+
+// Diff 現在のレコードと期待するレコードを比較し変更内容を返す
+//
+// レコードは名前/タイプ/値で同一性を判定し、同一のレコードでTTLのみが異なる場合はTTLの変更として扱う。
+// 変更は期待するレコードの順に追加/TTL変更を、その後に現在のレコードの順に削除を返す
+func Diff(current, desired sacloud.DNSRecords) []*Change {
+	var changes []*Change
+	for _, d := range desired {
+		c := current.Find(d.Name, d.Type, d.RData)
+		switch {
+		case c == nil:
+			changes = append(changes, &Change{Action: ChangeActionCreate, Record: d})
+		case c.TTL != d.TTL:
+			changes = append(changes, &Change{Action: ChangeActionUpdateTTL, Record: d, PreviousTTL: c.TTL})
+		}
+	}
+	for _, c := range current {
+		if !desired.Exist(c) {
+			changes = append(changes, &Change{Action: ChangeActionDelete, Record: c})
+		}
+	}
+	return changes
+}
+
+// CheckConflicts 同時に登録できないレコードの組み合わせを返す
+//
+// 以下を競合として扱う
+//   - CNAMEと他のタイプのレコードが同じ名前に存在する
+//   - 同じ名前に複数のCNAMEが存在する
+//   - ゾーン頂点(@)にCNAMEが存在する
+//   - 名前/タイプが同じレコード間でTTLが異なる
+func CheckConflicts(records sacloud.DNSRecords) []*Conflict {
+	var names []string
+	byName := make(map[string]sacloud.DNSRecords)
+	for _, r := range records {
+		name := r.Name
+		if name == "" {
+			name = "@"
+		}
+		if _, ok := byName[name]; !ok {
+			names = append(names, name)
+		}
+		byName[name] = append(byName[name], r)
+	}
+
+	var conflicts []*Conflict
+	for _, name := range names {
+		rs := byName[name]
+
+		var rrTypes []types.EDNSRecordType
+		ttls := make(map[types.EDNSRecordType]int)
+		cnames := 0
+		for _, r := range rs {
+			if r.Type == types.DNSRecordTypes.CNAME {
+				cnames++
+			}
+			ttl, ok := ttls[r.Type]
+			if !ok {
+				rrTypes = append(rrTypes, r.Type)
+				ttls[r.Type] = r.TTL
+				continue
+			}
+			if ttl != r.TTL {
+				conflicts = append(conflicts, &Conflict{
+					Name:   name,
+					Types:  []types.EDNSRecordType{r.Type},
+					Reason: fmt.Sprintf("records have different TTLs (%d and %d)", ttl, r.TTL),
+				})
+				ttls[r.Type] = r.TTL
+			}
+		}
+
+		if cnames == 0 {
+			continue
+		}
+		switch {
+		case name == "@":
+			conflicts = append(conflicts, &Conflict{
+				Name:   name,
+				Types:  []types.EDNSRecordType{types.DNSRecordTypes.CNAME},
+				Reason: "CNAME cannot be placed at the zone apex",
+			})
+		case len(rrTypes) > 1:
+			conflicts = append(conflicts, &Conflict{
+				Name:   name,
+				Types:  rrTypes,
+				Reason: "CNAME cannot coexist with other records",
+			})
+		case cnames > 1:
+			conflicts = append(conflicts, &Conflict{
+				Name:   name,
+				Types:  rrTypes,
+				Reason: "multiple CNAME records",
+			})
+		}
+	}
+	return conflicts
+}
+
+// Plan DNSアプライアンスのレコードに対する変更計画
+type Plan struct {
+	// ID DNSアプライアンスのID
+	ID types.ID
+	// SettingsHash 変更計画作成時のSettingsHash
+	SettingsHash string
+	// Changes 変更内容
+	Changes []*Change
+	// Conflicts 変更後のレコードの競合
+	Conflicts []*Conflict
+}
+
+// NewPlan 現在のDNSアプライアンスの状態と期待するレコードから変更計画を作成する
+func NewPlan(dns *sacloud.DNS, desired sacloud.DNSRecords) *Plan {
+	return &Plan{
+		ID:           dns.ID,
+		SettingsHash: dns.SettingsHash,
+		Changes:      Diff(dns.Records, desired),
+		Conflicts:    CheckConflicts(desired),
+	}
+}
+
+// HasChanges 変更があるか
+func (p *Plan) HasChanges() bool {
+	return len(p.Changes) > 0
+}
+
+// Records currentに変更内容を適用したレコードを返す
+//
+// currentのレコードの順序は維持され、追加するレコードは末尾に追加される
+func (p *Plan) Records(current sacloud.DNSRecords) sacloud.DNSRecords {
+	var records sacloud.DNSRecords
+	for _, r := range current {
+		record := *r
+		records = append(records, &record)
+	}
+	for _, c := range p.Changes {
+		switch c.Action {
+		case ChangeActionCreate:
+			record := *c.Record
+			records.Add(&record)
+		case ChangeActionUpdateTTL:
+			if r := records.Find(c.Record.Name, c.Record.Type, c.Record.RData); r != nil {
+				r.TTL = c.Record.TTL
+			}
+		case ChangeActionDelete:
+			records.Delete(c.Record)
+		}
+	}
+	return records
+}
+
+// ConflictError 変更後のレコードに競合がある場合のエラー
+type ConflictError struct {
+	Conflicts []*Conflict
+}
+
+// Error errorインターフェース実装
+func (e *ConflictError) Error() string {
+	var s []string
+	for _, c := range e.Conflicts {
+		s = append(s, c.String())
+	}
+	return fmt.Sprintf("conflicting records: %s", strings.Join(s, ", "))
+}
+
+// SettingsChangedError 変更計画の作成後にDNSアプライアンスの設定が変更されていた場合のエラー
+type SettingsChangedError struct {
+	// ID DNSアプライアンスのID
+	ID types.ID
+	// Expected 変更計画作成時のSettingsHash
+	Expected string
+	// Actual 現在のSettingsHash
+	Actual string
+}
+
+// Error errorインターフェース実装
+func (e *SettingsChangedError) Error() string {
+	return fmt.Sprintf("DNS[%s] settings have been changed since the plan was made: SettingsHash %q => %q", e.ID, e.Expected, e.Actual)
+}
+
+// SettingsUpdater Applyで利用するDNSアプライアンスのAPI
+type SettingsUpdater interface {
+	Read(ctx context.Context, id types.ID) (*sacloud.DNS, error)
+	UpdateSettings(ctx context.Context, id types.ID, param *sacloud.DNSUpdateSettingsRequest) (*sacloud.DNS, error)
+}
+
+// Apply 変更計画をDNSアプライアンスに適用する
+//
+// 競合がある場合は*ConflictErrorを返す。
+// 変更計画の作成後に他から設定が変更されていた(SettingsHashが異なる)場合は*SettingsChangedErrorを返す。
+// UpdateSettingsにもSettingsHashを指定するため、ReadからUpdateSettingsまでの間の変更はAPI側で検出される
+func Apply(ctx context.Context, api SettingsUpdater, plan *Plan) (*sacloud.DNS, error) {
+	if len(plan.Conflicts) > 0 {
+		return nil, &ConflictError{Conflicts: plan.Conflicts}
+	}
+
+	current, err := api.Read(ctx, plan.ID)
+	if err != nil {
+		return nil, fmt.Errorf("reading DNS[%s] failed: %s", plan.ID, err)
+	}
+	if current.SettingsHash != plan.SettingsHash {
+		return nil, &SettingsChangedError{ID: plan.ID, Expected: plan.SettingsHash, Actual: current.SettingsHash}
+	}
+	if !plan.HasChanges() {
+		return current, nil
+	}
+
+	return api.UpdateSettings(ctx, plan.ID, &sacloud.DNSUpdateSettingsRequest{
+		Records:      plan.Records(current.Records),
+		SettingsHash: current.SettingsHash,
+	})
+}
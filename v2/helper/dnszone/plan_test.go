@@ -0,0 +1,152 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnszone
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/mock"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	current := sacloud.DNSRecords{
+		{Name: "www", Type: types.DNSRecordTypes.A, RData: "192.0.2.1", TTL: 300},
+		{Name: "www", Type: types.DNSRecordTypes.A, RData: "192.0.2.2", TTL: 300},
+		{Name: "mail", Type: types.DNSRecordTypes.A, RData: "192.0.2.3", TTL: 3600},
+	}
+	desired := sacloud.DNSRecords{
+		{Name: "www", Type: types.DNSRecordTypes.A, RData: "192.0.2.1", TTL: 300},
+		{Name: "mail", Type: types.DNSRecordTypes.A, RData: "192.0.2.3", TTL: 600},
+		{Name: "@", Type: types.DNSRecordTypes.MX, RData: "10 mail.example.com.", TTL: 3600},
+	}
+
+	changes := Diff(current, desired)
+	require.Equal(t, []*Change{
+		{Action: ChangeActionUpdateTTL, Record: desired[1], PreviousTTL: 3600},
+		{Action: ChangeActionCreate, Record: desired[2]},
+		{Action: ChangeActionDelete, Record: current[1]},
+	}, changes)
+	require.Equal(t, "update-ttl mail A 192.0.2.3 (TTL 3600 => 600)", changes[0].String())
+
+	require.Empty(t, Diff(current, current))
+}
+
+func TestCheckConflicts(t *testing.T) {
+	records := sacloud.DNSRecords{
+		{Name: "www", Type: types.DNSRecordTypes.CNAME, RData: "web.example.com.", TTL: 300},
+		{Name: "www", Type: types.DNSRecordTypes.A, RData: "192.0.2.1", TTL: 300},
+		{Name: "ftp", Type: types.DNSRecordTypes.CNAME, RData: "a.example.com.", TTL: 300},
+		{Name: "ftp", Type: types.DNSRecordTypes.CNAME, RData: "b.example.com.", TTL: 300},
+		{Name: "@", Type: types.DNSRecordTypes.CNAME, RData: "example.net.", TTL: 300},
+		{Name: "api", Type: types.DNSRecordTypes.A, RData: "192.0.2.2", TTL: 300},
+		{Name: "api", Type: types.DNSRecordTypes.A, RData: "192.0.2.3", TTL: 600},
+		{Name: "api", Type: types.DNSRecordTypes.AAAA, RData: "2001:db8::1", TTL: 600},
+	}
+
+	conflicts := CheckConflicts(records)
+	require.Len(t, conflicts, 4)
+	require.Equal(t, "www [CNAME,A]: CNAME cannot coexist with other records", conflicts[0].String())
+	require.Equal(t, "ftp [CNAME]: multiple CNAME records", conflicts[1].String())
+	require.Equal(t, "@ [CNAME]: CNAME cannot be placed at the zone apex", conflicts[2].String())
+	require.Equal(t, "api [A]: records have different TTLs (300 and 600)", conflicts[3].String())
+
+	require.Empty(t, CheckConflicts(records[5:6]))
+}
+
+func TestApply(t *testing.T) {
+	ctx := context.Background()
+	current := &sacloud.DNS{
+		ID:           1,
+		SettingsHash: "hash1",
+		Records: sacloud.DNSRecords{
+			{Name: "www", Type: types.DNSRecordTypes.A, RData: "192.0.2.1", TTL: 300},
+			{Name: "old", Type: types.DNSRecordTypes.A, RData: "192.0.2.9", TTL: 300},
+			{Name: "mail", Type: types.DNSRecordTypes.A, RData: "192.0.2.3", TTL: 3600},
+		},
+	}
+	desired := sacloud.DNSRecords{
+		{Name: "@", Type: types.DNSRecordTypes.MX, RData: "10 mail.example.com.", TTL: 3600},
+		{Name: "mail", Type: types.DNSRecordTypes.A, RData: "192.0.2.3", TTL: 600},
+		{Name: "www", Type: types.DNSRecordTypes.A, RData: "192.0.2.1", TTL: 300},
+	}
+
+	t.Run("apply", func(t *testing.T) {
+		ctrl := mock.NewController(t)
+		api := mock.NewDNSMock(ctrl)
+
+		plan := NewPlan(current, desired)
+		require.True(t, plan.HasChanges())
+
+		mock.InOrder(
+			api.EXPECT().Read(mock.Any(), types.ID(1)).Return(current, nil),
+			api.EXPECT().UpdateSettings(mock.Any(), types.ID(1), &sacloud.DNSUpdateSettingsRequest{
+				Records: sacloud.DNSRecords{
+					{Name: "www", Type: types.DNSRecordTypes.A, RData: "192.0.2.1", TTL: 300},
+					{Name: "mail", Type: types.DNSRecordTypes.A, RData: "192.0.2.3", TTL: 600},
+					{Name: "@", Type: types.DNSRecordTypes.MX, RData: "10 mail.example.com.", TTL: 3600},
+				},
+				SettingsHash: "hash1",
+			}).Return(&sacloud.DNS{ID: 1}, nil),
+		)
+
+		updated, err := Apply(ctx, api, plan)
+		require.NoError(t, err)
+		require.Equal(t, types.ID(1), updated.ID)
+
+		// 元のレコードは変更されない
+		require.Equal(t, 3600, current.Records[2].TTL)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		ctrl := mock.NewController(t)
+		api := mock.NewDNSMock(ctrl)
+		api.EXPECT().Read(mock.Any(), types.ID(1)).Return(current, nil)
+
+		updated, err := Apply(ctx, api, NewPlan(current, current.Records))
+		require.NoError(t, err)
+		require.Equal(t, current, updated)
+	})
+
+	t.Run("settings changed", func(t *testing.T) {
+		ctrl := mock.NewController(t)
+		api := mock.NewDNSMock(ctrl)
+
+		plan := NewPlan(current, desired)
+		changed := *current
+		changed.SettingsHash = "hash2"
+		api.EXPECT().Read(mock.Any(), types.ID(1)).Return(&changed, nil)
+
+		_, err := Apply(ctx, api, plan)
+		var settingsErr *SettingsChangedError
+		require.True(t, errors.As(err, &settingsErr))
+		require.Equal(t, "hash2", settingsErr.Actual)
+	})
+
+	t.Run("conflicts", func(t *testing.T) {
+		ctrl := mock.NewController(t)
+		api := mock.NewDNSMock(ctrl)
+
+		plan := NewPlan(current, append(desired, &sacloud.DNSRecord{
+			Name: "www", Type: types.DNSRecordTypes.CNAME, RData: "web.example.com.", TTL: 300,
+		}))
+		_, err := Apply(ctx, api, plan)
+		require.EqualError(t, err, "conflicting records: www [A,CNAME]: CNAME cannot coexist with other records")
+	})
+}
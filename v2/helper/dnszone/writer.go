@@ -0,0 +1,100 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnszone
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+// maxTXTStringLength TXTレコードの1文字列あたりの最大長
+const maxTXTStringLength = 255
+
+// Write DNSアプライアンスのレコードをゾーンファイルとして出力する
+//
+// ゾーン頂点のNSレコードはDNSNameServersから出力する。
+// 出力したゾーンファイルをParseした場合、これらのNSレコードはSkippedとして扱われる
+func Write(w io.Writer, dns *sacloud.DNS) error {
+	zone := dns.DNSZone
+	if zone == "" {
+		zone = dns.Name
+	}
+	if zone == "" {
+		return fmt.Errorf("zone name is empty")
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 1, '\t', 0)
+	fmt.Fprintf(tw, "$ORIGIN %s\n", fqdn(zone))
+	for _, ns := range dns.DNSNameServers {
+		fmt.Fprintf(tw, "@\t%d\tIN\t%s\t%s\n", DefaultTTL, types.DNSRecordTypes.NS, fqdn(ns))
+	}
+	for _, record := range dns.Records {
+		if !isSupportedType(record.Type.String()) {
+			return fmt.Errorf("unsupported record type: %s %s", record.Name, record.Type)
+		}
+		name := record.Name
+		if name == "" {
+			name = "@"
+		}
+		fmt.Fprintf(tw, "%s\t%d\tIN\t%s\t%s\n", name, record.TTL, record.Type, formatRData(record))
+	}
+	return tw.Flush()
+}
+
+func formatRData(record *sacloud.DNSRecord) string {
+	if record.Type != types.DNSRecordTypes.TXT {
+		return record.RData
+	}
+
+	// 255文字を超える場合は複数の文字列に分割する
+	var strs []string
+	value := record.RData
+	for {
+		chunk := value
+		if len(chunk) > maxTXTStringLength {
+			chunk = chunk[:maxTXTStringLength]
+		}
+		strs = append(strs, quoteTXT(chunk))
+		value = value[len(chunk):]
+		if value == "" {
+			break
+		}
+	}
+	return strings.Join(strs, " ")
+}
+
+func quoteTXT(s string) string {
+	var buf strings.Builder
+	buf.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\\':
+			buf.WriteByte('\\')
+			buf.WriteByte(c)
+		case c < 0x20 || c >= 0x7f:
+			buf.WriteString(fmt.Sprintf("\\%03d", c))
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	buf.WriteByte('"')
+	return buf.String()
+}
@@ -0,0 +1,173 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnszone
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testZoneFile = `
+$ORIGIN example.com.
+$TTL 1h
+@       IN  SOA ns1.example.com. hostmaster.example.com. (
+                2022010101 ; serial
+                7200       ; refresh
+                3600       ; retry
+                1209600    ; expire
+                3600 )     ; minimum
+        IN  NS  ns1.gslb.sakura.ne.jp.
+        IN  MX  10 mail
+www     300 IN A 192.0.2.1
+        IN  AAAA 2001:db8::1
+ftp         CNAME www
+_sip._tcp   SRV 10 60 5060 sip.example.net.
+@           CAA 0 issue "letsencrypt.org"
+txt         TXT ( "v=spf1 include:_spf.example.com"
+                  " ~all" )
+escaped     TXT "say \"hello\"\059"
+sub         NS  ns.sub
+sshfp       SSHFP 1 1 123456789abcdef67890123456789abcdef67890
+other.example.net. A 192.0.2.2
+
+$ORIGIN dev.example.com.
+api     A   192.0.2.3
+`
+
+func TestParse(t *testing.T) {
+	result, err := Parse(strings.NewReader(testZoneFile), "example.com")
+	require.NoError(t, err)
+
+	require.Equal(t, sacloud.DNSRecords{
+		{Name: "@", Type: types.DNSRecordTypes.MX, RData: "10 mail.example.com.", TTL: 3600},
+		{Name: "www", Type: types.DNSRecordTypes.A, RData: "192.0.2.1", TTL: 300},
+		{Name: "www", Type: types.DNSRecordTypes.AAAA, RData: "2001:db8::1", TTL: 3600},
+		{Name: "ftp", Type: types.DNSRecordTypes.CNAME, RData: "www.example.com.", TTL: 3600},
+		{Name: "_sip._tcp", Type: types.DNSRecordTypes.SRV, RData: "10 60 5060 sip.example.net.", TTL: 3600},
+		{Name: "@", Type: types.DNSRecordTypes.CAA, RData: `0 issue "letsencrypt.org"`, TTL: 3600},
+		{Name: "txt", Type: types.DNSRecordTypes.TXT, RData: "v=spf1 include:_spf.example.com ~all", TTL: 3600},
+		{Name: "escaped", Type: types.DNSRecordTypes.TXT, RData: `say "hello";`, TTL: 3600},
+		{Name: "sub", Type: types.DNSRecordTypes.NS, RData: "ns.sub.example.com.", TTL: 3600},
+		{Name: "api.dev", Type: types.DNSRecordTypes.A, RData: "192.0.2.3", TTL: 3600},
+	}, result.Records)
+
+	require.Len(t, result.Skipped, 4)
+	require.Equal(t, &SkippedRecord{
+		Line:   4,
+		Name:   "example.com.",
+		Type:   "SOA",
+		RData:  "ns1.example.com. hostmaster.example.com. 2022010101 7200 3600 1209600 3600",
+		Reason: SkipReasonManagedByService,
+	}, result.Skipped[0])
+	require.Equal(t, SkipReasonManagedByService, result.Skipped[1].Reason)
+	require.Equal(t, "NS", result.Skipped[1].Type)
+	require.Equal(t, SkipReasonUnsupportedType, result.Skipped[2].Reason)
+	require.Equal(t, "sshfp.example.com.", result.Skipped[2].Name)
+	require.Equal(t, SkipReasonOutOfZone, result.Skipped[3].Reason)
+	require.Equal(t, 22, result.Skipped[3].Line)
+}
+
+func TestParse_ttl(t *testing.T) {
+	src := `
+a 60 A 192.0.2.1
+b    A 192.0.2.2
+$TTL 1d2h
+c    A 192.0.2.3
+d IN 0 A 192.0.2.4
+`
+	result, err := Parse(strings.NewReader(src), "example.com.")
+	require.NoError(t, err)
+	var ttls []int
+	for _, r := range result.Records {
+		ttls = append(ttls, r.TTL)
+	}
+	require.Equal(t, []int{60, 60, 93600, 0}, ttls)
+
+	result, err = Parse(strings.NewReader("a A 192.0.2.1"), "example.com")
+	require.NoError(t, err)
+	require.Equal(t, DefaultTTL, result.Records[0].TTL)
+}
+
+func TestParse_errors(t *testing.T) {
+	cases := []struct {
+		src  string
+		line int
+		msg  string
+	}{
+		{src: "www A 2001:db8::1", line: 1, msg: "invalid address"},
+		{src: "\nwww AAAA 192.0.2.1", line: 2, msg: "invalid address"},
+		{src: "@ MX mail", line: 1, msg: "expected 2 fields, got 1"},
+		{src: "_sip._tcp SRV 10 60 70000 sip", line: 1, msg: "invalid port: 70000"},
+		{src: "@ CAA 256 issue \"ca\"", line: 1, msg: "invalid flags: 256"},
+		{src: "txt TXT \"unterminated", line: 1, msg: "unterminated quoted string"},
+		{src: "@ SOA ns. host. ( 1 2 3 4 5", line: 1, msg: "unbalanced parentheses"},
+		{src: "$INCLUDE other.zone", line: 1, msg: "unsupported directive"},
+		{src: "  A 192.0.2.1", line: 1, msg: "owner name is not specified"},
+		{src: "www 300", line: 1, msg: "record type is not specified"},
+	}
+	for _, tc := range cases {
+		_, err := Parse(strings.NewReader(tc.src), "example.com")
+		require.Error(t, err, tc.src)
+		require.Contains(t, err.Error(), tc.msg, tc.src)
+
+		var parseErr *ParseError
+		require.True(t, errors.As(err, &parseErr), tc.src)
+		require.Equal(t, tc.line, parseErr.Line, tc.src)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	dns := &sacloud.DNS{
+		Name:           "example.com",
+		DNSZone:        "example.com",
+		DNSNameServers: []string{"ns1.gslb1.sakura.ne.jp", "ns2.gslb1.sakura.ne.jp"},
+		Records: sacloud.DNSRecords{
+			{Name: "www", Type: types.DNSRecordTypes.A, RData: "192.0.2.1", TTL: 300},
+			{Name: "@", Type: types.DNSRecordTypes.MX, RData: "10 mail.example.com.", TTL: 3600},
+			{Name: "@", Type: types.DNSRecordTypes.CAA, RData: `0 issue "letsencrypt.org"`, TTL: 3600},
+			{Name: "txt", Type: types.DNSRecordTypes.TXT, RData: `say "hello"` + strings.Repeat("x", 300), TTL: 3600},
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, Write(buf, dns))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 7)
+	require.Equal(t, "$ORIGIN example.com.", lines[0])
+	require.Equal(t, []string{"@", "3600", "IN", "NS", "ns1.gslb1.sakura.ne.jp."}, strings.Fields(lines[1]))
+	require.Equal(t, []string{"www", "300", "IN", "A", "192.0.2.1"}, strings.Fields(lines[3]))
+	require.Contains(t, lines[6], `"say \"hello\"`)
+
+	// 出力したゾーンファイルを読み込むと元のレコードに戻る
+	result, err := Parse(buf, "example.com")
+	require.NoError(t, err)
+	require.Equal(t, dns.Records, result.Records)
+	require.Len(t, result.Skipped, 2)
+
+	t.Run("unsupported type", func(t *testing.T) {
+		err := Write(&bytes.Buffer{}, &sacloud.DNS{
+			Name:    "example.com",
+			Records: sacloud.DNSRecords{{Name: "www", Type: "SSHFP", RData: "1 1 00"}},
+		})
+		require.EqualError(t, err, "unsupported record type: www SSHFP")
+	})
+}
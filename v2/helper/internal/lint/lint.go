@@ -0,0 +1,51 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lint パケットフィルタやエンハンスドロードバランサのルール検査で共通利用する検出結果の定義
+package lint
+
+import "fmt"
+
+// IssueKind 検査で検出した問題の種類
+type IssueKind string
+
+// Severity 問題の重要度
+type Severity string
+
+const (
+	// SeverityError APIで受け付けられない、または意図通りに動作しない問題
+	SeverityError Severity = "error"
+	// SeverityWarning 意図通りか確認が必要な問題
+	SeverityWarning Severity = "warning"
+)
+
+// Issue 検査で検出した問題
+type Issue struct {
+	// Kind 種類
+	Kind IssueKind
+	// Severity 重要度
+	Severity Severity
+	// Index 対象ルールのインデックス 特定のルールに対する問題でない場合は-1
+	Index int
+	// Message 詳細
+	Message string
+}
+
+// String Issueの文字列表現
+func (i *Issue) String() string {
+	if i.Index < 0 {
+		return fmt.Sprintf("%s: %s: %s", i.Severity, i.Kind, i.Message)
+	}
+	return fmt.Sprintf("%s: rule[%d]: %s: %s", i.Severity, i.Index, i.Kind, i.Message)
+}
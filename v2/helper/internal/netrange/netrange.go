@@ -0,0 +1,88 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package netrange パケットフィルタやVPCルータのルール評価で利用するアドレス/ポート範囲
+package netrange
+
+import (
+	"encoding/binary"
+	"net"
+
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+// MaxPort ポート番号の最大値
+const MaxPort = 65535
+
+// AddressRange IPv4アドレスの範囲(両端を含む)
+type AddressRange struct {
+	From, To uint32
+}
+
+// NewAddressRange 開始/終了のIPアドレスからAddressRangeを作成する
+func NewAddressRange(from, to net.IP) AddressRange {
+	return AddressRange{From: IPToUint32(from), To: IPToUint32(to)}
+}
+
+// Contains ipが範囲に含まれるか
+func (r AddressRange) Contains(ip uint32) bool {
+	return r.From <= ip && ip <= r.To
+}
+
+// Covers r2の全てのアドレスが範囲に含まれるか
+func (r AddressRange) Covers(r2 AddressRange) bool {
+	return r.From <= r2.From && r2.To <= r.To
+}
+
+// Size 範囲に含まれるアドレス数
+func (r AddressRange) Size() uint64 {
+	return uint64(r.To) - uint64(r.From) + 1
+}
+
+// PortRange ポート番号の範囲(両端を含む)
+type PortRange struct {
+	From, To int
+}
+
+// Contains portが範囲に含まれるか
+func (r PortRange) Contains(port int) bool {
+	return r.From <= port && port <= r.To
+}
+
+// Covers r2の全てのポートが範囲に含まれるか
+func (r PortRange) Covers(r2 PortRange) bool {
+	return r.From <= r2.From && r2.To <= r.To
+}
+
+// IsAny 全てのポート(0〜65535)を表すか
+func (r PortRange) IsAny() bool {
+	return r.From == 0 && r.To == MaxPort
+}
+
+// HasPorts ポート番号を持つプロトコル(tcp/udp)か
+func HasPorts(protocol types.Protocol) bool {
+	return protocol == types.Protocols.TCP || protocol == types.Protocols.UDP
+}
+
+// IPToUint32 IPv4アドレスを数値に変換する
+func IPToUint32(ip net.IP) uint32 {
+	return binary.BigEndian.Uint32(ip.To4())
+}
+
+// Uint32ToIP 数値をIPv4アドレスに変換する
+func Uint32ToIP(v uint32) net.IP {
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, v)
+	return ip
+}
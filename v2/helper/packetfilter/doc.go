@@ -0,0 +1,22 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package packetfilter パケットフィルタのルールをオフラインで評価/検査するユーティリティ
+//
+// パケットフィルタのルール(sacloud.PacketFilterExpression)は先頭から順に評価され、最初に一致したルールのアクションが適用されます。
+// いずれのルールにも一致しないパケットは許可されます。
+//
+// Evaluateは指定のパケットに一致するルールとその結果を返します。
+// Lintは他のルールに隠れて評価されないルールや末尾の拒否ルールの有無、許可範囲の広すぎるルール、ルール数の上限などを検査します。
+package packetfilter
@@ -0,0 +1,232 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetfilter
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/sacloud/libsacloud/v2/helper/internal/netrange"
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+// Packet 評価対象のパケット
+type Packet struct {
+	// Protocol プロトコル tcp/udp/icmp/fragmentのいずれか それ以外のIPパケットの場合はip
+	Protocol types.Protocol
+	// SourceAddress 送信元IPアドレス(IPv4)
+	SourceAddress string
+	// SourcePort 送信元ポート(tcp/udpのみ)
+	SourcePort int
+	// DestinationPort 宛先ポート(tcp/udpのみ)
+	DestinationPort int
+}
+
+// String Packetの文字列表現
+func (p *Packet) String() string {
+	if netrange.HasPorts(p.Protocol) {
+		return fmt.Sprintf("%s %s:%d => :%d", p.Protocol, p.SourceAddress, p.SourcePort, p.DestinationPort)
+	}
+	return fmt.Sprintf("%s %s", p.Protocol, p.SourceAddress)
+}
+
+// Result 評価結果
+type Result struct {
+	// Action 適用されるアクション
+	Action types.Action
+	// Index 一致したルールのインデックス 一致するルールがない場合は-1
+	Index int
+	// Expression 一致したルール 一致するルールがない場合はnil
+	Expression *sacloud.PacketFilterExpression
+}
+
+// Matched いずれかのルールに一致したか
+func (r *Result) Matched() bool {
+	return r.Expression != nil
+}
+
+// Evaluator パケットフィルタのルールの評価器
+type Evaluator struct {
+	rules []*rule
+}
+
+// NewEvaluator ルールを解析してEvaluatorを作成する
+//
+// 不正なルールが含まれる場合はエラーを返す
+func NewEvaluator(expressions []*sacloud.PacketFilterExpression) (*Evaluator, error) {
+	e := &Evaluator{}
+	for i, exp := range expressions {
+		r, err := compile(i, exp)
+		if err != nil {
+			return nil, err
+		}
+		e.rules = append(e.rules, r)
+	}
+	return e, nil
+}
+
+// Evaluate ルールを先頭から評価し、最初に一致したルールとアクションを返す
+//
+// 一致するルールがない場合は許可となる
+func (e *Evaluator) Evaluate(packet *Packet) (*Result, error) {
+	p, err := parsePacket(packet)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range e.rules {
+		if r.matches(p) {
+			return &Result{Action: r.expression.Action, Index: r.index, Expression: r.expression}, nil
+		}
+	}
+	return &Result{Action: types.Actions.Allow, Index: -1}, nil
+}
+
+// Evaluate expressionsを先頭から評価し、最初に一致したルールとアクションを返す
+func Evaluate(expressions []*sacloud.PacketFilterExpression, packet *Packet) (*Result, error) {
+	e, err := NewEvaluator(expressions)
+	if err != nil {
+		return nil, err
+	}
+	return e.Evaluate(packet)
+}
+
+// RuleError 不正なルールを示すエラー
+type RuleError struct {
+	// Index ルールのインデックス
+	Index int
+	// Err エラー詳細
+	Err error
+}
+
+// Error errorインターフェース実装
+func (e *RuleError) Error() string {
+	return fmt.Sprintf("rule[%d]: %s", e.Index, e.Err)
+}
+
+// rule 解析済みのルール
+type rule struct {
+	index      int
+	expression *sacloud.PacketFilterExpression
+
+	protocol  types.Protocol
+	source    netrange.AddressRange
+	srcPort   netrange.PortRange
+	dstPort   netrange.PortRange
+	withPorts bool
+}
+
+type packet struct {
+	protocol types.Protocol
+	source   uint32
+	srcPort  int
+	dstPort  int
+}
+
+func compile(index int, exp *sacloud.PacketFilterExpression) (*rule, error) {
+	ruleErr := func(format string, args ...interface{}) error {
+		return &RuleError{Index: index, Err: fmt.Errorf(format, args...)}
+	}
+
+	switch exp.Protocol {
+	case types.Protocols.TCP, types.Protocols.UDP, types.Protocols.ICMP, types.Protocols.Fragment, types.Protocols.IP:
+	default:
+		return nil, ruleErr("unsupported protocol: %q", exp.Protocol)
+	}
+	if !exp.Action.IsAllow() && !exp.Action.IsDeny() {
+		return nil, ruleErr("invalid action: %q", exp.Action)
+	}
+
+	from, to, err := exp.SourceNetwork.Range()
+	if err != nil {
+		return nil, ruleErr("%s", err)
+	}
+	r := &rule{
+		index:      index,
+		expression: exp,
+		protocol:   exp.Protocol,
+		source:     netrange.NewAddressRange(from, to),
+		srcPort:    netrange.PortRange{From: 0, To: netrange.MaxPort},
+		dstPort:    netrange.PortRange{From: 0, To: netrange.MaxPort},
+		withPorts:  netrange.HasPorts(exp.Protocol),
+	}
+	if r.withPorts {
+		from, to, err := exp.SourcePort.Range()
+		if err != nil {
+			return nil, ruleErr("source %s", err)
+		}
+		r.srcPort = netrange.PortRange{From: from, To: to}
+
+		from, to, err = exp.DestinationPort.Range()
+		if err != nil {
+			return nil, ruleErr("destination %s", err)
+		}
+		r.dstPort = netrange.PortRange{From: from, To: to}
+	}
+	return r, nil
+}
+
+func (r *rule) matches(p *packet) bool {
+	if r.protocol != types.Protocols.IP && r.protocol != p.protocol {
+		return false
+	}
+	if !r.source.Contains(p.source) {
+		return false
+	}
+	if r.withPorts {
+		return r.srcPort.Contains(p.srcPort) && r.dstPort.Contains(p.dstPort)
+	}
+	return true
+}
+
+// covers rに一致する全てのパケットにr2が一致するか
+func (r *rule) covers(r2 *rule) bool {
+	if r.protocol != types.Protocols.IP && r.protocol != r2.protocol {
+		return false
+	}
+	if !r.source.Covers(r2.source) {
+		return false
+	}
+	if r.withPorts {
+		return r.srcPort.Covers(r2.srcPort) && r.dstPort.Covers(r2.dstPort)
+	}
+	return true
+}
+
+func parsePacket(p *Packet) (*packet, error) {
+	switch p.Protocol {
+	case types.Protocols.TCP, types.Protocols.UDP, types.Protocols.ICMP, types.Protocols.Fragment, types.Protocols.IP:
+	default:
+		return nil, fmt.Errorf("unsupported protocol: %q", p.Protocol)
+	}
+	ip := net.ParseIP(p.SourceAddress).To4()
+	if ip == nil {
+		return nil, fmt.Errorf("invalid source address: %q", p.SourceAddress)
+	}
+	if netrange.HasPorts(p.Protocol) {
+		if p.SourcePort < 0 || p.SourcePort > 65535 {
+			return nil, fmt.Errorf("invalid source port: %d", p.SourcePort)
+		}
+		if p.DestinationPort < 0 || p.DestinationPort > 65535 {
+			return nil, fmt.Errorf("invalid destination port: %d", p.DestinationPort)
+		}
+	}
+	return &packet{
+		protocol: p.Protocol,
+		source:   netrange.IPToUint32(ip),
+		srcPort:  p.SourcePort,
+		dstPort:  p.DestinationPort,
+	}, nil
+}
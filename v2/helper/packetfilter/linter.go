@@ -0,0 +1,165 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetfilter
+
+import (
+	"fmt"
+
+	"github.com/sacloud/libsacloud/v2/helper/internal/lint"
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+// MaxExpressions 1つのパケットフィルタに登録可能なルール数の上限
+const MaxExpressions = 30
+
+// broadNetworkSize 許可範囲が広すぎるとみなす送信元アドレス数(/8以上)
+const broadNetworkSize = 1 << 24
+
+// IssueKind 検査で検出した問題の種類
+type IssueKind = lint.IssueKind
+
+const (
+	// IssueInvalidRule 不正なルール
+	IssueInvalidRule IssueKind = "invalid-rule"
+	// IssueShadowedRule 前方のルールに隠れて評価されないルール
+	IssueShadowedRule IssueKind = "shadowed-rule"
+	// IssueMissingTrailingDeny 末尾に全てを拒否するルールがない
+	IssueMissingTrailingDeny IssueKind = "missing-trailing-deny"
+	// IssueOverlyBroadAllow 許可範囲が広すぎるルール
+	IssueOverlyBroadAllow IssueKind = "overly-broad-allow"
+	// IssueTooManyRules ルール数が上限を超えている
+	IssueTooManyRules IssueKind = "too-many-rules"
+)
+
+// Severity 問題の重要度
+type Severity = lint.Severity
+
+const (
+	// SeverityError APIで受け付けられない、または意図通りに動作しない問題
+	SeverityError = lint.SeverityError
+	// SeverityWarning 意図通りか確認が必要な問題
+	SeverityWarning = lint.SeverityWarning
+)
+
+// Issue 検査で検出した問題
+//
+// パケットフィルタ全体に対する問題の場合、Indexは-1となる
+type Issue = lint.Issue
+
+// Lint パケットフィルタのルールを検査し、検出した問題を返す
+//
+// 以下を検査する
+//   - 不正なルール(未対応のプロトコル、解析できない送信元ネットワークやポートなど)
+//   - 前方のルールが全て一致するため評価されないルール
+//   - 末尾に全てを拒否するルール(プロトコルip、送信元ネットワーク指定なしのdeny)がない
+//   - 送信元を問わず、全てのプロトコルまたはtcp/udpの全ての宛先ポートを許可するルール
+//   - ルール数がMaxExpressionsを超えている
+func Lint(expressions []*sacloud.PacketFilterExpression) []*Issue {
+	var issues []*Issue
+
+	if len(expressions) > MaxExpressions {
+		issues = append(issues, &Issue{
+			Kind:     IssueTooManyRules,
+			Severity: SeverityError,
+			Index:    -1,
+			Message:  fmt.Sprintf("%d rules exceed the limit of %d", len(expressions), MaxExpressions),
+		})
+	}
+
+	var rules []*rule
+	for i, exp := range expressions {
+		r, err := compile(i, exp)
+		if err != nil {
+			issues = append(issues, &Issue{
+				Kind:     IssueInvalidRule,
+				Severity: SeverityError,
+				Index:    i,
+				Message:  err.(*RuleError).Err.Error(),
+			})
+			continue
+		}
+
+		for _, prev := range rules {
+			if !prev.covers(r) {
+				continue
+			}
+			msg := fmt.Sprintf("never matches because rule[%d] matches all of its packets", prev.index)
+			if prev.expression.Action != r.expression.Action {
+				msg += fmt.Sprintf(" with the opposite action (%s)", prev.expression.Action)
+			}
+			issues = append(issues, &Issue{
+				Kind:     IssueShadowedRule,
+				Severity: SeverityWarning,
+				Index:    i,
+				Message:  msg,
+			})
+			break
+		}
+
+		if isOverlyBroadAllow(r) {
+			issues = append(issues, &Issue{
+				Kind:     IssueOverlyBroadAllow,
+				Severity: SeverityWarning,
+				Index:    i,
+				Message:  fmt.Sprintf("allows %s from %s", describeTarget(r), describeSource(r)),
+			})
+		}
+		rules = append(rules, r)
+	}
+
+	if len(rules) == 0 || !isDenyAll(rules[len(rules)-1]) || rules[len(rules)-1].index != len(expressions)-1 {
+		issues = append(issues, &Issue{
+			Kind:     IssueMissingTrailingDeny,
+			Severity: SeverityWarning,
+			Index:    -1,
+			Message:  "packets that match no rule are allowed; add a rule denying all packets (protocol ip) at the end",
+		})
+	}
+	return issues
+}
+
+func isDenyAll(r *rule) bool {
+	return r.expression.Action.IsDeny() &&
+		r.protocol == types.Protocols.IP &&
+		r.source.Size() == 1<<32
+}
+
+func isOverlyBroadAllow(r *rule) bool {
+	if !r.expression.Action.IsAllow() || r.source.Size() < broadNetworkSize {
+		return false
+	}
+	switch {
+	case r.protocol == types.Protocols.IP:
+		return true
+	case r.withPorts:
+		return r.dstPort.IsAny()
+	}
+	return false
+}
+
+func describeTarget(r *rule) string {
+	if r.protocol == types.Protocols.IP {
+		return "all protocols"
+	}
+	return fmt.Sprintf("all %s destination ports", r.protocol)
+}
+
+func describeSource(r *rule) string {
+	if r.source.Size() == 1<<32 {
+		return "any source"
+	}
+	return fmt.Sprintf("source network %s", r.expression.SourceNetwork)
+}
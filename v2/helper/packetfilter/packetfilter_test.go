@@ -0,0 +1,151 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetfilter
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+var testExpressions = []*sacloud.PacketFilterExpression{
+	{Protocol: types.Protocols.TCP, SourceNetwork: "192.0.2.0/24", DestinationPort: "22", Action: types.Actions.Allow},
+	{Protocol: types.Protocols.TCP, DestinationPort: "80", Action: types.Actions.Allow},
+	{Protocol: types.Protocols.TCP, DestinationPort: "443", Action: types.Actions.Allow},
+	{Protocol: types.Protocols.TCP, DestinationPort: "32768-61000", Action: types.Actions.Allow},
+	{Protocol: types.Protocols.ICMP, Action: types.Actions.Allow},
+	{Protocol: types.Protocols.Fragment, Action: types.Actions.Allow},
+	{Protocol: types.Protocols.UDP, SourceNetwork: "198.51.100.10/198.51.100.20", SourcePort: "53", Action: types.Actions.Allow},
+	{Protocol: types.Protocols.IP, Action: types.Actions.Deny, Description: "deny all"},
+}
+
+func TestEvaluate(t *testing.T) {
+	evaluator, err := NewEvaluator(testExpressions)
+	require.NoError(t, err)
+
+	cases := []struct {
+		packet *Packet
+		index  int
+		action types.Action
+	}{
+		{
+			packet: &Packet{Protocol: types.Protocols.TCP, SourceAddress: "192.0.2.10", SourcePort: 50000, DestinationPort: 22},
+			index:  0,
+			action: types.Actions.Allow,
+		},
+		{
+			packet: &Packet{Protocol: types.Protocols.TCP, SourceAddress: "203.0.113.1", SourcePort: 50000, DestinationPort: 22},
+			index:  7,
+			action: types.Actions.Deny,
+		},
+		{
+			packet: &Packet{Protocol: types.Protocols.TCP, SourceAddress: "203.0.113.1", SourcePort: 50000, DestinationPort: 443},
+			index:  2,
+			action: types.Actions.Allow,
+		},
+		{
+			packet: &Packet{Protocol: types.Protocols.ICMP, SourceAddress: "203.0.113.1"},
+			index:  4,
+			action: types.Actions.Allow,
+		},
+		{
+			packet: &Packet{Protocol: types.Protocols.UDP, SourceAddress: "198.51.100.15", SourcePort: 53, DestinationPort: 40000},
+			index:  6,
+			action: types.Actions.Allow,
+		},
+		{
+			packet: &Packet{Protocol: types.Protocols.UDP, SourceAddress: "198.51.100.21", SourcePort: 53, DestinationPort: 40000},
+			index:  7,
+			action: types.Actions.Deny,
+		},
+		{
+			packet: &Packet{Protocol: types.Protocols.IP, SourceAddress: "203.0.113.1"},
+			index:  7,
+			action: types.Actions.Deny,
+		},
+	}
+	for _, tc := range cases {
+		result, err := evaluator.Evaluate(tc.packet)
+		require.NoError(t, err, tc.packet.String())
+		require.True(t, result.Matched(), tc.packet.String())
+		require.Equal(t, tc.index, result.Index, tc.packet.String())
+		require.Equal(t, tc.action, result.Action, tc.packet.String())
+		require.Equal(t, testExpressions[tc.index], result.Expression, tc.packet.String())
+	}
+
+	t.Run("no rules matched", func(t *testing.T) {
+		result, err := Evaluate(testExpressions[:2], &Packet{Protocol: types.Protocols.UDP, SourceAddress: "203.0.113.1", DestinationPort: 53})
+		require.NoError(t, err)
+		require.False(t, result.Matched())
+		require.Equal(t, -1, result.Index)
+		require.Equal(t, types.Actions.Allow, result.Action)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := Evaluate([]*sacloud.PacketFilterExpression{
+			{Protocol: types.Protocols.TCP, DestinationPort: "80-", Action: types.Actions.Allow},
+		}, &Packet{Protocol: types.Protocols.TCP, SourceAddress: "192.0.2.1"})
+		require.EqualError(t, err, `rule[0]: destination invalid port: "80-"`)
+
+		_, err = evaluator.Evaluate(&Packet{Protocol: types.Protocols.TCP, SourceAddress: "2001:db8::1"})
+		require.EqualError(t, err, `invalid source address: "2001:db8::1"`)
+	})
+}
+
+func TestLint(t *testing.T) {
+	require.Empty(t, Lint(testExpressions))
+
+	expressions := []*sacloud.PacketFilterExpression{
+		{Protocol: types.Protocols.TCP, SourceNetwork: "192.0.2.0/24", Action: types.Actions.Allow},
+		{Protocol: types.Protocols.TCP, SourceNetwork: "192.0.2.1", DestinationPort: "22", Action: types.Actions.Deny},
+		{Protocol: types.Protocols.HTTP, Action: types.Actions.Allow},
+		{Protocol: types.Protocols.UDP, SourceNetwork: "10.0.0.0/8", Action: types.Actions.Allow},
+		{Protocol: types.Protocols.IP, Action: types.Actions.Allow},
+		{Protocol: types.Protocols.ICMP, Action: types.Actions.Allow},
+	}
+	issues := Lint(expressions)
+	var messages []string
+	for _, issue := range issues {
+		messages = append(messages, issue.String())
+	}
+	require.Equal(t, []string{
+		"warning: rule[1]: shadowed-rule: never matches because rule[0] matches all of its packets with the opposite action (allow)",
+		`error: rule[2]: invalid-rule: unsupported protocol: "http"`,
+		"warning: rule[3]: overly-broad-allow: allows all udp destination ports from source network 10.0.0.0/8",
+		"warning: rule[4]: overly-broad-allow: allows all protocols from any source",
+		"warning: rule[5]: shadowed-rule: never matches because rule[4] matches all of its packets",
+		"warning: missing-trailing-deny: packets that match no rule are allowed; add a rule denying all packets (protocol ip) at the end",
+	}, messages)
+
+	t.Run("too many rules", func(t *testing.T) {
+		var expressions []*sacloud.PacketFilterExpression
+		for i := 0; i < MaxExpressions; i++ {
+			expressions = append(expressions, &sacloud.PacketFilterExpression{
+				Protocol:        types.Protocols.TCP,
+				DestinationPort: types.PacketFilterPort(fmt.Sprintf("%d", 1000+i)),
+				Action:          types.Actions.Allow,
+			})
+		}
+		expressions = append(expressions, &sacloud.PacketFilterExpression{Protocol: types.Protocols.IP, Action: types.Actions.Deny})
+
+		issues := Lint(expressions)
+		require.Len(t, issues, 1)
+		require.Equal(t, IssueTooManyRules, issues[0].Kind)
+		require.Equal(t, SeverityError, issues[0].Severity)
+	})
+}
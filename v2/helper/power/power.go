@@ -49,6 +49,7 @@ var (
 // variablesが指定された場合、PUT /server/:id/powerのCloudInit用のパラメータとして渡される
 // variablesが複数指定された場合は改行で結合される
 func BootServer(ctx context.Context, client ServerAPI, zone string, id types.ID, variables ...string) error {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	return boot(ctx, &serverHandler{
 		ctx:       ctx,
 		client:    client,
@@ -60,6 +61,7 @@ func BootServer(ctx context.Context, client ServerAPI, zone string, id types.ID,
 
 // ShutdownServer シャットダウン
 func ShutdownServer(ctx context.Context, client ServerAPI, zone string, id types.ID, force bool) error {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	return shutdown(ctx, &serverHandler{
 		ctx:    ctx,
 		client: client,
@@ -74,6 +76,7 @@ func ShutdownServer(ctx context.Context, client ServerAPI, zone string, id types
 
 // BootLoadBalancer 起動
 func BootLoadBalancer(ctx context.Context, client LoadBalancerAPI, zone string, id types.ID) error {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	return boot(ctx, &loadBalancerHandler{
 		ctx:    ctx,
 		client: client,
@@ -84,6 +87,7 @@ func BootLoadBalancer(ctx context.Context, client LoadBalancerAPI, zone string,
 
 // ShutdownLoadBalancer シャットダウン
 func ShutdownLoadBalancer(ctx context.Context, client LoadBalancerAPI, zone string, id types.ID, force bool) error {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	return shutdown(ctx, &loadBalancerHandler{
 		ctx:    ctx,
 		client: client,
@@ -98,6 +102,7 @@ func ShutdownLoadBalancer(ctx context.Context, client LoadBalancerAPI, zone stri
 
 // BootDatabase 起動
 func BootDatabase(ctx context.Context, client DatabaseAPI, zone string, id types.ID) error {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	return boot(ctx, &databaseHandler{
 		ctx:    ctx,
 		client: client,
@@ -108,6 +113,7 @@ func BootDatabase(ctx context.Context, client DatabaseAPI, zone string, id types
 
 // ShutdownDatabase シャットダウン
 func ShutdownDatabase(ctx context.Context, client DatabaseAPI, zone string, id types.ID, force bool) error {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	return shutdown(ctx, &databaseHandler{
 		ctx:    ctx,
 		client: client,
@@ -122,6 +128,7 @@ func ShutdownDatabase(ctx context.Context, client DatabaseAPI, zone string, id t
 
 // BootVPCRouter 起動
 func BootVPCRouter(ctx context.Context, client VPCRouterAPI, zone string, id types.ID) error {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	return boot(ctx, &vpcRouterHandler{
 		ctx:    ctx,
 		client: client,
@@ -132,6 +139,7 @@ func BootVPCRouter(ctx context.Context, client VPCRouterAPI, zone string, id typ
 
 // ShutdownVPCRouter シャットダウン
 func ShutdownVPCRouter(ctx context.Context, client VPCRouterAPI, zone string, id types.ID, force bool) error {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	return shutdown(ctx, &vpcRouterHandler{
 		ctx:    ctx,
 		client: client,
@@ -146,6 +154,7 @@ func ShutdownVPCRouter(ctx context.Context, client VPCRouterAPI, zone string, id
 
 // BootNFS 起動
 func BootNFS(ctx context.Context, client NFSAPI, zone string, id types.ID) error {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	return boot(ctx, &nfsHandler{
 		ctx:    ctx,
 		client: client,
@@ -156,6 +165,7 @@ func BootNFS(ctx context.Context, client NFSAPI, zone string, id types.ID) error
 
 // ShutdownNFS シャットダウン
 func ShutdownNFS(ctx context.Context, client NFSAPI, zone string, id types.ID, force bool) error {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	return shutdown(ctx, &nfsHandler{
 		ctx:    ctx,
 		client: client,
@@ -170,6 +180,7 @@ func ShutdownNFS(ctx context.Context, client NFSAPI, zone string, id types.ID, f
 
 // BootMobileGateway 起動
 func BootMobileGateway(ctx context.Context, client MobileGatewayAPI, zone string, id types.ID) error {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	return boot(ctx, &mobileGatewayHandler{
 		ctx:    ctx,
 		client: client,
@@ -180,6 +191,7 @@ func BootMobileGateway(ctx context.Context, client MobileGatewayAPI, zone string
 
 // ShutdownMobileGateway シャットダウン
 func ShutdownMobileGateway(ctx context.Context, client MobileGatewayAPI, zone string, id types.ID, force bool) error {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	return shutdown(ctx, &mobileGatewayHandler{
 		ctx:    ctx,
 		client: client,
@@ -0,0 +1,22 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package proxylb エンハンスドロードバランサの振り分けルールをオフラインで評価/検査するユーティリティ
+//
+// 振り分けルール(sacloud.ProxyLBRule)は先頭から順に評価され、最初に一致したルールのアクションが適用されます。
+// いずれのルールにも一致しないリクエストは実サーバグループが指定されていない実サーバへ転送されます。
+//
+// Evaluateは指定のリクエストに一致するルールと、アクションまたは転送先の実サーバを返します。
+// Validateはルールの設定項目の整合性や、参照している実サーバグループに有効な実サーバが存在するかなどを検査します。
+package proxylb
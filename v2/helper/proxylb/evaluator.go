@@ -0,0 +1,212 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxylb
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+// Request 評価対象のHTTPリクエスト
+type Request struct {
+	// Host Hostヘッダの値 ポート番号が含まれる場合は無視される
+	Host string
+	// Path リクエストパス クエリ文字列が含まれる場合は無視される
+	Path string
+	// Header リクエストヘッダ
+	Header http.Header
+}
+
+// NewRequest *http.RequestからRequestを作成する
+func NewRequest(r *http.Request) *Request {
+	return &Request{
+		Host:   r.Host,
+		Path:   r.URL.Path,
+		Header: r.Header,
+	}
+}
+
+// String Requestの文字列表現
+func (r *Request) String() string {
+	return r.host() + r.path()
+}
+
+func (r *Request) host() string {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.ToLower(host)
+}
+
+func (r *Request) path() string {
+	path := r.Path
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	if path == "" {
+		path = "/"
+	}
+	return path
+}
+
+// Result 評価結果
+type Result struct {
+	// Index 一致したルールのインデックス 一致するルールがない場合は-1
+	Index int
+	// Rule 一致したルール 一致するルールがない場合はnil
+	Rule *sacloud.ProxyLBRule
+	// Action 適用されるアクション 一致するルールがない場合はforward
+	Action types.EProxyLBRuleAction
+	// ServerGroup 転送先の実サーバグループ(forwardの場合のみ) 空の場合は実サーバグループが指定されていない実サーバが対象
+	ServerGroup string
+	// Servers 転送先の有効な実サーバ(forwardの場合のみ)
+	Servers []*sacloud.ProxyLBServer
+}
+
+// Matched いずれかのルールに一致したか
+func (r *Result) Matched() bool {
+	return r.Rule != nil
+}
+
+// String Resultの文字列表現
+func (r *Result) String() string {
+	var s string
+	if r.Matched() {
+		s = fmt.Sprintf("rule[%d] %s", r.Index, r.Action)
+	} else {
+		s = fmt.Sprintf("no rule matched %s", r.Action)
+	}
+	switch r.Action {
+	case types.ProxyLBRuleActions.Redirect:
+		s += fmt.Sprintf(" %s %s", r.Rule.RedirectStatusCode, r.Rule.RedirectLocation)
+	case types.ProxyLBRuleActions.Fixed:
+		s += fmt.Sprintf(" %s %s", r.Rule.FixedStatusCode, r.Rule.FixedContentType)
+	default:
+		var servers []string
+		for _, server := range r.Servers {
+			servers = append(servers, fmt.Sprintf("%s:%d", server.IPAddress, server.Port))
+		}
+		if r.ServerGroup != "" {
+			s += fmt.Sprintf(" group %q", r.ServerGroup)
+		}
+		s += fmt.Sprintf(" to [%s]", strings.Join(servers, " "))
+	}
+	return s
+}
+
+// Evaluate 振り分けルールを先頭から評価し、最初に一致したルールと適用されるアクションを返す
+//
+// Host/Pathはワイルドカード(*: 任意の文字列, ?: 任意の1文字)を含むパターンとして全体一致で評価する。Hostは大文字小文字を区別しない。
+// リクエストヘッダの値はRequestHeaderValueIgnoreCaseが指定された場合に大文字小文字を区別せず、
+// RequestHeaderValueNotMatchが指定された場合は一致しない(ヘッダが存在しない場合を含む)ことを条件とする。
+// アクションが空のルールはforwardとして扱う
+func Evaluate(proxyLB *sacloud.ProxyLB, req *Request) *Result {
+	for i, rule := range proxyLB.Rules {
+		if !matchRule(rule, req) {
+			continue
+		}
+		result := &Result{Index: i, Rule: rule, Action: ruleAction(rule)}
+		if result.Action == types.ProxyLBRuleActions.Forward {
+			result.ServerGroup = rule.ServerGroup
+			result.Servers = enabledServers(proxyLB, rule.ServerGroup)
+		}
+		return result
+	}
+	return &Result{
+		Index:   -1,
+		Action:  types.ProxyLBRuleActions.Forward,
+		Servers: enabledServers(proxyLB, ""),
+	}
+}
+
+func ruleAction(rule *sacloud.ProxyLBRule) types.EProxyLBRuleAction {
+	if rule.Action == "" {
+		return types.ProxyLBRuleActions.Forward
+	}
+	return rule.Action
+}
+
+func enabledServers(proxyLB *sacloud.ProxyLB, group string) []*sacloud.ProxyLBServer {
+	var servers []*sacloud.ProxyLBServer
+	for _, server := range proxyLB.Servers {
+		if server.Enabled && server.ServerGroup == group {
+			servers = append(servers, server)
+		}
+	}
+	return servers
+}
+
+func matchRule(rule *sacloud.ProxyLBRule, req *Request) bool {
+	if rule.Host != "" && !matchWildcard(strings.ToLower(rule.Host), req.host()) {
+		return false
+	}
+	if rule.Path != "" && !matchWildcard(rule.Path, req.path()) {
+		return false
+	}
+	if rule.RequestHeaderName != "" {
+		return matchHeader(rule, req.Header)
+	}
+	return true
+}
+
+func matchHeader(rule *sacloud.ProxyLBRule, header http.Header) bool {
+	matched := false
+	pattern := rule.RequestHeaderValue
+	if rule.RequestHeaderValueIgnoreCase {
+		pattern = strings.ToLower(pattern)
+	}
+	for _, value := range header.Values(rule.RequestHeaderName) {
+		if rule.RequestHeaderValueIgnoreCase {
+			value = strings.ToLower(value)
+		}
+		if matchWildcard(pattern, value) {
+			matched = true
+			break
+		}
+	}
+	return matched != rule.RequestHeaderValueNotMatch
+}
+
+// matchWildcard *(任意の文字列)と?(任意の1文字)を含むパターンでsの全体が一致するか
+func matchWildcard(patternStr, str string) bool {
+	pattern, s := []rune(patternStr), []rune(str)
+	p, i := 0, 0
+	star, mark := -1, 0
+	for i < len(s) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == s[i]):
+			p++
+			i++
+		case p < len(pattern) && pattern[p] == '*':
+			star, mark = p, i
+			p++
+		case star >= 0:
+			mark++
+			p, i = star+1, mark
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
@@ -0,0 +1,211 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxylb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testProxyLB() *sacloud.ProxyLB {
+	return &sacloud.ProxyLB{
+		Servers: []*sacloud.ProxyLBServer{
+			{IPAddress: "192.0.2.1", Port: 80, Enabled: true},
+			{IPAddress: "192.0.2.2", Port: 80, Enabled: false},
+			{IPAddress: "192.0.2.11", Port: 8080, ServerGroup: "api", Enabled: true},
+			{IPAddress: "192.0.2.12", Port: 8080, ServerGroup: "api", Enabled: true},
+			{IPAddress: "192.0.2.21", Port: 80, ServerGroup: "beta", Enabled: true},
+		},
+		Rules: []*sacloud.ProxyLBRule{
+			{
+				Path:             "/maintenance/*",
+				Action:           types.ProxyLBRuleActions.Fixed,
+				FixedStatusCode:  types.ProxyLBFixedStatusCodes.ServiceUnavailable,
+				FixedContentType: types.ProxyLBFixedContentTypes.Plain,
+				FixedMessageBody: "under maintenance",
+			},
+			{
+				Host:               "old.example.com",
+				Action:             types.ProxyLBRuleActions.Redirect,
+				RedirectLocation:   "https://www.example.com/",
+				RedirectStatusCode: types.ProxyLBRedirectStatusCodes.MovedPermanently,
+			},
+			{
+				Host:                         "*.example.com",
+				RequestHeaderName:            "x-channel",
+				RequestHeaderValue:           "BETA*",
+				RequestHeaderValueIgnoreCase: true,
+				ServerGroup:                  "beta",
+			},
+			{
+				Host:        "api.example.com",
+				Path:        "/v?/*",
+				Action:      types.ProxyLBRuleActions.Forward,
+				ServerGroup: "api",
+			},
+		},
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	proxyLB := testProxyLB()
+
+	cases := []struct {
+		name     string
+		request  *Request
+		expected string
+	}{
+		{
+			name:     "fixed",
+			request:  &Request{Host: "www.example.com", Path: "/maintenance/index.html"},
+			expected: "rule[0] fixed 503 text/plain",
+		},
+		{
+			name:     "redirect with port",
+			request:  &Request{Host: "OLD.example.com:443", Path: "/"},
+			expected: "rule[1] redirect 301 https://www.example.com/",
+		},
+		{
+			name: "request header ignore case",
+			request: &Request{Host: "api.example.com", Path: "/v1/users", Header: http.Header{
+				"X-Channel": []string{"beta-2"},
+			}},
+			expected: `rule[2] forward group "beta" to [192.0.2.21:80]`,
+		},
+		{
+			name:     "wildcard path",
+			request:  &Request{Host: "api.example.com", Path: "/v1/users?id=1"},
+			expected: `rule[3] forward group "api" to [192.0.2.11:8080 192.0.2.12:8080]`,
+		},
+		{
+			name:     "no rule matched",
+			request:  &Request{Host: "api.example.com", Path: "/v10/users"},
+			expected: "no rule matched forward to [192.0.2.1:80]",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, Evaluate(proxyLB, tc.request).String())
+		})
+	}
+
+	t.Run("header not match", func(t *testing.T) {
+		proxyLB := testProxyLB()
+		proxyLB.Rules[2].RequestHeaderValueNotMatch = true
+
+		req := NewRequest(httptest.NewRequest(http.MethodGet, "http://www.example.com/", nil))
+		result := Evaluate(proxyLB, req)
+		require.True(t, result.Matched())
+		require.Equal(t, 2, result.Index)
+
+		req.Header.Set("X-Channel", "beta")
+		result = Evaluate(proxyLB, req)
+		require.False(t, result.Matched())
+		require.Equal(t, -1, result.Index)
+	})
+}
+
+func TestMatchWildcard(t *testing.T) {
+	require.True(t, matchWildcard("*", ""))
+	require.True(t, matchWildcard("/a*b*c", "/a-b-b-c"))
+	require.True(t, matchWildcard("?.example.com", "あ.example.com"))
+	require.False(t, matchWildcard("/a*b", "/a-b-c"))
+	require.False(t, matchWildcard("/a", "/a/b"))
+}
+
+func TestCoversWildcard(t *testing.T) {
+	cases := []struct {
+		prev, pattern string
+		expect        bool
+	}{
+		{prev: "/a*", pattern: "/a/b", expect: true},
+		{prev: "/a*", pattern: "/a*c", expect: true},
+		{prev: "/a*c", pattern: "/a?c", expect: true},
+		{prev: "/a?c", pattern: "/a?c", expect: true},
+		{prev: "*.example.com", pattern: "www?.example.com", expect: true},
+		// "/a?c"は"/a-b-c"に一致しない
+		{prev: "/a?c", pattern: "/a*c", expect: false},
+		{prev: "/a/b", pattern: "/a*", expect: false},
+		{prev: "/a*", pattern: "/b*", expect: false},
+	}
+	for _, tc := range cases {
+		require.Equal(t, tc.expect, coversWildcard(tc.prev, tc.pattern), "%s covers %s", tc.prev, tc.pattern)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	require.Empty(t, Validate(testProxyLB()))
+
+	proxyLB := testProxyLB()
+	proxyLB.Servers[0].Enabled = false
+	proxyLB.Servers[4].Enabled = false
+	proxyLB.Rules = append(proxyLB.Rules,
+		&sacloud.ProxyLBRule{
+			Path:             "/maintenance/index.html",
+			Action:           types.ProxyLBRuleActions.Redirect,
+			FixedMessageBody: "moved",
+		},
+		&sacloud.ProxyLBRule{
+			Path:             "/closed",
+			Action:           types.ProxyLBRuleActions.Fixed,
+			FixedStatusCode:  types.EProxyLBFixedStatusCode(404),
+			FixedContentType: types.EProxyLBFixedContentType("text/xml"),
+			ServerGroup:      "api",
+		},
+		&sacloud.ProxyLBRule{
+			Path:                       "/legacy",
+			ServerGroup:                "legacy",
+			RedirectLocation:           "https://www.example.com/",
+			RequestHeaderValueNotMatch: true,
+		},
+		&sacloud.ProxyLBRule{
+			Action: types.EProxyLBRuleAction("drop"),
+		},
+	)
+
+	var issues []string
+	for _, issue := range Validate(proxyLB) {
+		issues = append(issues, issue.String())
+	}
+	require.Equal(t, []string{
+		`error: rule[2]: empty-server-group: server group "beta": no enabled servers`,
+		"error: rule[4]: inconsistent-rule: redirect location is required",
+		"error: rule[4]: inconsistent-rule: invalid redirect status code: 0",
+		"error: rule[4]: inconsistent-rule: fixed response fields are set on a redirect rule",
+		"warning: rule[4]: shadowed-rule: never matches because rule[0] matches all of its requests",
+		"error: rule[5]: inconsistent-rule: invalid fixed status code: 404",
+		`error: rule[5]: inconsistent-rule: invalid fixed content type: "text/xml"`,
+		"error: rule[5]: inconsistent-rule: server group is set on a fixed rule",
+		"error: rule[6]: inconsistent-rule: redirect location/status code is set on a forward rule",
+		"error: rule[6]: inconsistent-rule: request header value/conditions are set without request header name",
+		`error: rule[6]: empty-server-group: server group "legacy": no servers`,
+		`error: rule[7]: invalid-action: invalid action "drop"`,
+	}, issues)
+
+	proxyLB.Rules = proxyLB.Rules[:4]
+	issues = nil
+	for _, issue := range Validate(proxyLB) {
+		issues = append(issues, issue.String())
+	}
+	require.Equal(t, []string{
+		`error: rule[2]: empty-server-group: server group "beta": no enabled servers`,
+		"warning: empty-server-group: requests that match no rule: servers without server group: no enabled servers",
+	}, issues)
+}
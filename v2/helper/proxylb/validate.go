@@ -0,0 +1,248 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxylb
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/sacloud/libsacloud/v2/helper/internal/lint"
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+// IssueKind 検査で検出した問題の種類
+type IssueKind = lint.IssueKind
+
+const (
+	// IssueInvalidAction 不正なアクション
+	IssueInvalidAction IssueKind = "invalid-action"
+	// IssueInconsistentRule アクションと設定項目の組み合わせが不正なルール
+	IssueInconsistentRule IssueKind = "inconsistent-rule"
+	// IssueEmptyServerGroup 有効な実サーバが存在しない実サーバグループへ転送するルール
+	IssueEmptyServerGroup IssueKind = "empty-server-group"
+	// IssueShadowedRule 前方のルールに隠れて評価されないルール
+	IssueShadowedRule IssueKind = "shadowed-rule"
+)
+
+// Severity 問題の重要度
+type Severity = lint.Severity
+
+const (
+	// SeverityError APIで受け付けられない、または意図通りに動作しない問題
+	SeverityError = lint.SeverityError
+	// SeverityWarning 意図通りか確認が必要な問題
+	SeverityWarning = lint.SeverityWarning
+)
+
+// Issue 検査で検出した問題
+//
+// ルールに一致しないリクエストに対する問題の場合、Indexは-1となる
+type Issue = lint.Issue
+
+// Validate 振り分けルールを検査し、検出した問題を返す
+//
+// 以下を検査する
+//   - アクションがforward/redirect/fixed以外
+//   - forwardのルールにリダイレクト/固定レスポンスの設定項目が指定されている
+//   - redirectのルールにリダイレクト先やステータスコード(301/302)が指定されていない、または実サーバグループ/固定レスポンスの設定項目が指定されている
+//   - fixedのルールのステータスコード(200/403/503)やContent-Typeが不正、または実サーバグループ/リダイレクトの設定項目が指定されている
+//   - リクエストヘッダ名が指定されていないのにリクエストヘッダの値や一致条件が指定されている
+//   - forwardのルールが参照する実サーバグループに有効な実サーバが存在しない
+//   - 前方のルールの条件が全て一致するため評価されないルール
+//   - 条件を持たないルールで終わっておらず、ルールに一致しないリクエストの転送先(実サーバグループなし)に有効な実サーバが存在しない
+func Validate(proxyLB *sacloud.ProxyLB) []*Issue {
+	var issues []*Issue
+	add := func(kind IssueKind, severity Severity, index int, format string, args ...interface{}) {
+		issues = append(issues, &Issue{Kind: kind, Severity: severity, Index: index, Message: fmt.Sprintf(format, args...)})
+	}
+
+	catchAll := false
+	for i, rule := range proxyLB.Rules {
+		for _, msg := range inconsistencies(rule) {
+			add(IssueInconsistentRule, SeverityError, i, "%s", msg)
+		}
+
+		switch ruleAction(rule) {
+		case types.ProxyLBRuleActions.Forward:
+			if msg := checkServerGroup(proxyLB, rule.ServerGroup); msg != "" {
+				add(IssueEmptyServerGroup, SeverityError, i, "%s", msg)
+			}
+		case types.ProxyLBRuleActions.Redirect, types.ProxyLBRuleActions.Fixed:
+		default:
+			add(IssueInvalidAction, SeverityError, i, "invalid action %q", rule.Action)
+		}
+
+		for j, prev := range proxyLB.Rules[:i] {
+			if covers(prev, rule) {
+				add(IssueShadowedRule, SeverityWarning, i, "never matches because rule[%d] matches all of its requests", j)
+				break
+			}
+		}
+		if isCatchAll(rule) {
+			catchAll = true
+		}
+	}
+
+	if !catchAll {
+		if msg := checkServerGroup(proxyLB, ""); msg != "" {
+			add(IssueEmptyServerGroup, SeverityWarning, -1, "requests that match no rule: %s", msg)
+		}
+	}
+	return issues
+}
+
+func inconsistencies(rule *sacloud.ProxyLBRule) []string {
+	var msgs []string
+	hasRedirect := rule.RedirectLocation != "" || rule.RedirectStatusCode != 0
+	hasFixed := rule.FixedStatusCode != 0 || rule.FixedContentType != "" || rule.FixedMessageBody != ""
+
+	switch ruleAction(rule) {
+	case types.ProxyLBRuleActions.Forward:
+		if hasRedirect {
+			msgs = append(msgs, "redirect location/status code is set on a forward rule")
+		}
+		if hasFixed {
+			msgs = append(msgs, "fixed response fields are set on a forward rule")
+		}
+	case types.ProxyLBRuleActions.Redirect:
+		if rule.RedirectLocation == "" {
+			msgs = append(msgs, "redirect location is required")
+		}
+		switch rule.RedirectStatusCode {
+		case types.ProxyLBRedirectStatusCodes.MovedPermanently, types.ProxyLBRedirectStatusCodes.Found:
+		default:
+			msgs = append(msgs, fmt.Sprintf("invalid redirect status code: %d", rule.RedirectStatusCode.Int()))
+		}
+		if rule.ServerGroup != "" {
+			msgs = append(msgs, "server group is set on a redirect rule")
+		}
+		if hasFixed {
+			msgs = append(msgs, "fixed response fields are set on a redirect rule")
+		}
+	case types.ProxyLBRuleActions.Fixed:
+		switch rule.FixedStatusCode {
+		case types.ProxyLBFixedStatusCodes.OK, types.ProxyLBFixedStatusCodes.Forbidden, types.ProxyLBFixedStatusCodes.ServiceUnavailable:
+		default:
+			msgs = append(msgs, fmt.Sprintf("invalid fixed status code: %d", rule.FixedStatusCode.Int()))
+		}
+		if !isValidContentType(rule.FixedContentType) {
+			msgs = append(msgs, fmt.Sprintf("invalid fixed content type: %q", rule.FixedContentType))
+		}
+		if rule.ServerGroup != "" {
+			msgs = append(msgs, "server group is set on a fixed rule")
+		}
+		if hasRedirect {
+			msgs = append(msgs, "redirect location/status code is set on a fixed rule")
+		}
+	}
+
+	if rule.RequestHeaderName == "" &&
+		(rule.RequestHeaderValue != "" || rule.RequestHeaderValueIgnoreCase || rule.RequestHeaderValueNotMatch) {
+		msgs = append(msgs, "request header value/conditions are set without request header name")
+	}
+	return msgs
+}
+
+func isValidContentType(t types.EProxyLBFixedContentType) bool {
+	for _, v := range types.ProxyLBFixedContentTypeStrings() {
+		if t.String() == v {
+			return true
+		}
+	}
+	return false
+}
+
+func checkServerGroup(proxyLB *sacloud.ProxyLB, group string) string {
+	name := fmt.Sprintf("server group %q", group)
+	if group == "" {
+		name = "servers without server group"
+	}
+
+	exists := false
+	for _, server := range proxyLB.Servers {
+		if server.ServerGroup == group {
+			exists = true
+			if server.Enabled {
+				return ""
+			}
+		}
+	}
+	if !exists {
+		return fmt.Sprintf("%s: no servers", name)
+	}
+	return fmt.Sprintf("%s: no enabled servers", name)
+}
+
+// isCatchAll 全てのリクエストに一致するルールか
+func isCatchAll(rule *sacloud.ProxyLBRule) bool {
+	return isAnyPattern(rule.Host) && isAnyPattern(rule.Path) && rule.RequestHeaderName == ""
+}
+
+func isAnyPattern(pattern string) bool {
+	for _, c := range pattern {
+		if c != '*' {
+			return false
+		}
+	}
+	return true
+}
+
+// covers ruleが一致する全てのリクエストにprevが一致するか
+func covers(prev, rule *sacloud.ProxyLBRule) bool {
+	if !isAnyPattern(prev.Host) && (rule.Host == "" || !coversWildcard(strings.ToLower(prev.Host), strings.ToLower(rule.Host))) {
+		return false
+	}
+	if !isAnyPattern(prev.Path) && (rule.Path == "" || !coversWildcard(prev.Path, rule.Path)) {
+		return false
+	}
+	if prev.RequestHeaderName == "" {
+		return true
+	}
+	return http.CanonicalHeaderKey(prev.RequestHeaderName) == http.CanonicalHeaderKey(rule.RequestHeaderName) &&
+		prev.RequestHeaderValue == rule.RequestHeaderValue &&
+		prev.RequestHeaderValueIgnoreCase == rule.RequestHeaderValueIgnoreCase &&
+		prev.RequestHeaderValueNotMatch == rule.RequestHeaderValueNotMatch
+}
+
+// coversWildcard patternに一致する全ての文字列がprevPatternにも一致するか
+//
+// patternのワイルドカードは、*はprevPatternの*のみ、?はprevPatternの*または?のみに一致するものとして照合する。
+// このため結果がtrueの場合は必ず包含関係にあるが、包含関係にあっても検出できない場合がある(例: "/a?*"と"/a*?")
+func coversWildcard(prevPattern, pattern string) bool {
+	prev, s := []rune(prevPattern), []rune(pattern)
+	p, i := 0, 0
+	star, mark := -1, 0
+	for i < len(s) {
+		switch {
+		case p < len(prev) && prev[p] == '*':
+			star, mark = p, i
+			p++
+		case p < len(prev) && (prev[p] == s[i] || (prev[p] == '?' && s[i] != '*')):
+			p++
+			i++
+		case star >= 0:
+			mark++
+			p, i = star+1, mark
+		default:
+			return false
+		}
+	}
+	for p < len(prev) && prev[p] == '*' {
+		p++
+	}
+	return p == len(prev)
+}
@@ -400,12 +400,15 @@ func (b *Builder) addServer(ctx context.Context, id types.ID, sc *ServerCert) er
 }
 
 func (b *Builder) wait(ctx context.Context, readStateFunc wait.ReadStateFunc) error {
+	// ClientRegistryにポーリング設定がある場合はそちらをデフォルト値とする
+	registry, _ := sacloud.ClientRegistryFromAPI(b.Client)
+
 	timeout := b.PollingTimeout
-	if timeout == time.Duration(0) {
+	if timeout == time.Duration(0) && (registry == nil || registry.StatePollingTimeout == 0) {
 		timeout = time.Minute // デフォルト: 5分
 	}
 	interval := b.PollingInterval
-	if interval == time.Duration(0) {
+	if interval == time.Duration(0) && (registry == nil || registry.StatePollingInterval == 0) {
 		interval = 5 * time.Second
 	}
 
@@ -413,6 +416,7 @@ func (b *Builder) wait(ctx context.Context, readStateFunc wait.ReadStateFunc) er
 		ReadStateFunc:   readStateFunc,
 		Timeout:         timeout,
 		PollingInterval: interval,
+		ClientRegistry:  registry,
 	}
 
 	_, err := waiter.WaitForState(ctx)
@@ -75,6 +75,10 @@ type RetryableSetup struct {
 	DeleteRetryInterval time.Duration
 	// sacloud.StateWaiterによるステート待ちの間隔
 	PollingInterval time.Duration
+	// ClientRegistry sacloud.StateWaiterによるステート待ちでポーリング設定(タイムアウト/間隔)のデフォルト値として参照するClientRegistry
+	//
+	// 省略した場合はcontextに設定されたClientRegistryが参照される
+	ClientRegistry *sacloud.ClientRegistry
 }
 
 // Setup リソースのビルドを行う。必要に応じてリトライ(リソースの削除&再作成)を行う。
@@ -83,6 +87,9 @@ func (r *RetryableSetup) Setup(ctx context.Context, zone string) (interface{}, e
 		return nil, errors.New("failed: Read is required when IsWaitForCopy or IsWaitForUp is true")
 	}
 
+	if r.ClientRegistry == nil {
+		r.ClientRegistry, _ = sacloud.ClientRegistryFromContext(ctx)
+	}
 	r.init()
 
 	var created interface{}
@@ -147,7 +154,8 @@ func (r *RetryableSetup) init() {
 	if r.ProvisioningRetryInterval <= 0 {
 		r.ProvisioningRetryInterval = defaults.DefaultProvisioningWaitInterval
 	}
-	if r.PollingInterval <= 0 {
+	// ClientRegistryにポーリング間隔が設定されている場合はそちらを優先する
+	if r.PollingInterval <= 0 && (r.ClientRegistry == nil || r.ClientRegistry.StatePollingInterval <= 0) {
 		r.PollingInterval = defaults.DefaultPollingInterval
 	}
 }
@@ -176,6 +184,7 @@ func (r *RetryableSetup) waitForCopyWithCleanup(ctx context.Context, zone string
 			types.Availabilities.Discontinued,
 		},
 		PollingInterval: r.PollingInterval,
+		ClientRegistry:  r.ClientRegistry,
 	}
 
 	//wait
@@ -262,6 +271,7 @@ func (r *RetryableSetup) waitForUp(ctx context.Context, zone string, id types.ID
 				types.ServerInstanceStatuses.Down,
 			},
 			PollingInterval: r.PollingInterval,
+			ClientRegistry:  r.ClientRegistry,
 		}
 		_, err := waiter.WaitForState(ctx)
 		return err
@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/sacloud/libsacloud/v2/sacloud"
 	"github.com/sacloud/libsacloud/v2/sacloud/accessor"
 	"github.com/sacloud/libsacloud/v2/sacloud/types"
 	"github.com/stretchr/testify/require"
@@ -157,3 +158,46 @@ func withErrorReadFunc(readFunc ReadFunc, errCount int) ReadFunc {
 		return &dummyAvailabilityAccessor{available: types.Availabilities.Failed}, nil
 	}
 }
+
+type dummyInstanceStatusAccessor struct {
+	dummyAvailabilityAccessor
+	status types.EServerInstanceStatus
+}
+
+func (d *dummyInstanceStatusAccessor) GetInstanceStatus() types.EServerInstanceStatus {
+	return d.status
+}
+
+func (d *dummyInstanceStatusAccessor) SetInstanceStatus(v types.EServerInstanceStatus) {
+	d.status = v
+}
+
+func TestRetryableSetup_clientRegistry(t *testing.T) {
+	registry := sacloud.NewClientRegistry()
+	registry.StatePollingInterval = time.Millisecond
+	registry.StatePollingTimeout = 50 * time.Millisecond
+
+	retryable := &RetryableSetup{
+		Create: func(context.Context, string) (accessor.ID, error) {
+			return &dummyIDAccessor{id: 1}, nil
+		},
+		Read: func(context.Context, string, types.ID) (interface{}, error) {
+			return &dummyInstanceStatusAccessor{
+				dummyAvailabilityAccessor: dummyAvailabilityAccessor{available: types.Availabilities.Available},
+				status:                    types.ServerInstanceStatuses.Down,
+			}, nil
+		},
+		IsWaitForUp:    true,
+		ClientRegistry: registry,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// 起動しないリソースに対しレジストリのタイムアウトで待機を終える
+	started := time.Now()
+	_, err := retryable.Setup(ctx, "tk1v")
+	require.Error(t, err)
+	require.Less(t, int64(time.Since(started)), int64(time.Second))
+	require.Equal(t, time.Duration(0), retryable.PollingInterval)
+}
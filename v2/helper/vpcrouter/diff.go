@@ -0,0 +1,257 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpcrouter
+
+import (
+	"fmt"
+
+	"github.com/sacloud/libsacloud/v2/helper/internal/netrange"
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+const (
+	// anyAddress CandidateFlowsで任意のアドレスを表すアドレス
+	anyAddress = "0.0.0.0"
+	// anySourcePort CandidateFlowsで任意の送信元ポートを表すポート
+	anySourcePort = 1024
+	// anyDestinationPort CandidateFlowsで任意の宛先ポートを表すポート
+	anyDestinationPort = 1
+)
+
+// FlowChange 2つの設定間で結果が変わるフロー
+type FlowChange struct {
+	// Flow 対象のフロー
+	Flow *Flow
+	// Before 変更前の設定での結果
+	Before *Result
+	// After 変更後の設定での結果
+	After *Result
+}
+
+// String FlowChangeの文字列表現
+func (c *FlowChange) String() string {
+	return fmt.Sprintf("%s: %s => %s", c.Flow, c.Before, c.After)
+}
+
+// Diff 2つの設定で結果(アクションまたはNAT適用後のフロー)が変わるフローを返す
+//
+// flowsを省略した場合はCandidateFlowsで両方の設定から導出したフローを対象とする
+func Diff(before, after *sacloud.VPCRouterSetting, flows ...*Flow) ([]*FlowChange, error) {
+	beforeSimulator, err := NewSimulator(before)
+	if err != nil {
+		return nil, fmt.Errorf("before: %s", err)
+	}
+	afterSimulator, err := NewSimulator(after)
+	if err != nil {
+		return nil, fmt.Errorf("after: %s", err)
+	}
+	if len(flows) == 0 {
+		flows = CandidateFlows(before, after)
+	}
+
+	var changes []*FlowChange
+	for _, flow := range flows {
+		b, err := beforeSimulator.Simulate(flow)
+		if err != nil {
+			return nil, err
+		}
+		a, err := afterSimulator.Simulate(flow)
+		if err != nil {
+			return nil, err
+		}
+		if b.Action != a.Action || *b.Translated != *a.Translated {
+			changes = append(changes, &FlowChange{Flow: flow, Before: b, After: a})
+		}
+	}
+	return changes, nil
+}
+
+// CandidateFlows 設定に含まれるファイアウォールルール、ポートフォワーディング、スタティックNATから代表的なフローを導出する
+//
+// 各ルールに一致するフローに加え、範囲を狭める/広げる変更を検出できるよう、
+// 送信元/宛先のアドレスとポートそれぞれについて範囲の両端とその外側の値に置き換えたフローを生成する。
+// ルールで任意とされているアドレスは0.0.0.0、送信元ポートは1024、宛先ポートは1で表す
+func CandidateFlows(settings ...*sacloud.VPCRouterSetting) []*Flow {
+	var flows []*Flow
+	seen := make(map[string]bool)
+	add := func(f *Flow) {
+		key := f.String()
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		flows = append(flows, f)
+	}
+
+	for _, setting := range settings {
+		for _, fw := range setting.Firewall {
+			if fw == nil {
+				continue
+			}
+			for _, direction := range []Direction{DirectionReceive, DirectionSend} {
+				for _, r := range firewallRules(fw, direction) {
+					base := &Flow{
+						Interface:          fw.Index,
+						Direction:          direction,
+						Protocol:           r.Protocol,
+						SourceAddress:      sampleAddress(r.SourceNetwork),
+						SourcePort:         samplePort(r.Protocol, r.SourcePort, anySourcePort),
+						DestinationAddress: sampleAddress(r.DestinationNetwork),
+						DestinationPort:    samplePort(r.Protocol, r.DestinationPort, anyDestinationPort),
+					}
+					add(base)
+
+					for _, address := range boundaryAddresses(r.SourceNetwork) {
+						f := *base
+						f.SourceAddress = address
+						add(&f)
+					}
+					for _, address := range boundaryAddresses(r.DestinationNetwork) {
+						f := *base
+						f.DestinationAddress = address
+						add(&f)
+					}
+					if !netrange.HasPorts(r.Protocol) {
+						continue
+					}
+					for _, port := range boundaryPorts(r.SourcePort) {
+						f := *base
+						f.SourcePort = port
+						add(&f)
+					}
+					for _, port := range boundaryPorts(r.DestinationPort) {
+						f := *base
+						f.DestinationPort = port
+						add(&f)
+					}
+				}
+			}
+		}
+
+		globalAddress := anyAddress
+		if s, err := NewSimulator(setting); err == nil && s.globalAddress != "" {
+			globalAddress = s.globalAddress
+		}
+		for _, pf := range setting.PortForwarding {
+			add(&Flow{
+				Interface:          0,
+				Direction:          DirectionReceive,
+				Protocol:           types.Protocol(pf.Protocol),
+				SourceAddress:      anyAddress,
+				SourcePort:         anySourcePort,
+				DestinationAddress: globalAddress,
+				DestinationPort:    pf.GlobalPort.Int(),
+			})
+		}
+		for _, nat := range setting.StaticNAT {
+			add(&Flow{
+				Interface:          0,
+				Direction:          DirectionReceive,
+				Protocol:           types.Protocols.IP,
+				SourceAddress:      anyAddress,
+				DestinationAddress: nat.GlobalAddress,
+			})
+			add(&Flow{
+				Interface:          0,
+				Direction:          DirectionSend,
+				Protocol:           types.Protocols.IP,
+				SourceAddress:      nat.PrivateAddress,
+				DestinationAddress: anyAddress,
+			})
+		}
+	}
+	return flows
+}
+
+// sampleAddress ネットワークに含まれるアドレスを1つ返す
+func sampleAddress(network types.VPCFirewallNetwork) string {
+	if network.String() == "" {
+		return anyAddress
+	}
+	from, to, err := network.Range()
+	if err != nil {
+		return anyAddress
+	}
+	// ネットワークアドレスを避けホストアドレスを返す
+	r := netrange.NewAddressRange(from, to)
+	if r.Size() > 2 {
+		return netrange.Uint32ToIP(r.From + 1).String()
+	}
+	return from.String()
+}
+
+// boundaryAddresses ネットワークの両端のアドレスとその外側のアドレスを返す 任意の場合はnilを返す
+func boundaryAddresses(network types.VPCFirewallNetwork) []string {
+	if network.String() == "" {
+		return nil
+	}
+	from, to, err := network.Range()
+	if err != nil {
+		return nil
+	}
+	r := netrange.NewAddressRange(from, to)
+	values := []uint32{r.From, r.To}
+	if r.From > 0 {
+		values = append(values, r.From-1)
+	}
+	if r.To < 1<<32-1 {
+		values = append(values, r.To+1)
+	}
+
+	var addresses []string
+	for _, v := range values {
+		addresses = append(addresses, netrange.Uint32ToIP(v).String())
+	}
+	return addresses
+}
+
+// samplePort ポート指定に含まれるポートを1つ返す
+func samplePort(protocol types.Protocol, port types.VPCFirewallPort, defaultPort int) int {
+	if !netrange.HasPorts(protocol) {
+		return 0
+	}
+	if port.String() == "" {
+		return defaultPort
+	}
+	ranges, err := port.Ranges()
+	if err != nil {
+		return defaultPort
+	}
+	return ranges[0][0]
+}
+
+// boundaryPorts ポート指定に含まれる各範囲の両端のポートとその外側のポートを返す 任意の場合はnilを返す
+func boundaryPorts(port types.VPCFirewallPort) []int {
+	if port.String() == "" {
+		return nil
+	}
+	ranges, err := port.Ranges()
+	if err != nil {
+		return nil
+	}
+
+	var ports []int
+	for _, r := range ranges {
+		ports = append(ports, r[0], r[1])
+		if r[0] > 1 {
+			ports = append(ports, r[0]-1)
+		}
+		if r[1] < netrange.MaxPort {
+			ports = append(ports, r[1]+1)
+		}
+	}
+	return ports
+}
@@ -0,0 +1,29 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vpcrouter VPCルータのファイアウォール/NAT設定をオフラインでシミュレートするユーティリティ
+//
+// Simulatorはsacloud.VPCRouterSettingのファイアウォール(Firewall)、ポートフォワーディング(PortForwarding)、
+// スタティックNAT(StaticNAT)を元に、指定のフロー(インターフェース、方向、プロトコル、送信元/宛先のアドレスとポート)に対して
+// 適用されるNATと一致したファイアウォールルール、最終的な結果(許可/拒否)を返します。
+//
+// シミュレーションは以下の順序で行われます。
+//   - eth0(インデックス0)の受信方向: ポートフォワーディング/スタティックNATによる宛先の変換後にファイアウォールを評価
+//   - eth0(インデックス0)の送信方向: 変換前のアドレスでファイアウォールを評価した後、スタティックNATまたはIPマスカレードで送信元を変換
+//   - その他のインターフェース: ファイアウォールのみ評価
+//
+// ファイアウォールのルールは先頭から評価され、いずれのルールにも一致しないフローは許可されます。
+//
+// Diffは2つの設定で結果が変わるフローとその内容を返します。
+package vpcrouter
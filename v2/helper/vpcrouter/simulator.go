@@ -0,0 +1,480 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpcrouter
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/sacloud/libsacloud/v2/helper/internal/netrange"
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+// Direction ファイアウォールの方向
+type Direction string
+
+const (
+	// DirectionReceive 受信方向(インターフェースからVPCルータに入るフロー)
+	DirectionReceive Direction = "receive"
+	// DirectionSend 送信方向(VPCルータからインターフェースへ出るフロー)
+	DirectionSend Direction = "send"
+)
+
+// Flow シミュレーション対象のフロー
+type Flow struct {
+	// Interface インターフェースのインデックス(0〜7)
+	Interface int
+	// Direction 方向
+	Direction Direction
+	// Protocol プロトコル tcp/udp/icmpのいずれか それ以外のIPパケットの場合はip
+	Protocol types.Protocol
+	// SourceAddress 送信元IPアドレス(IPv4)
+	SourceAddress string
+	// SourcePort 送信元ポート(tcp/udpのみ)
+	SourcePort int
+	// DestinationAddress 宛先IPアドレス(IPv4)
+	DestinationAddress string
+	// DestinationPort 宛先ポート(tcp/udpのみ)
+	DestinationPort int
+}
+
+// String Flowの文字列表現
+func (f *Flow) String() string {
+	return fmt.Sprintf("eth%d %s %s %s => %s", f.Interface, f.Direction, f.Protocol,
+		formatEndpoint(f.Protocol, f.SourceAddress, f.SourcePort),
+		formatEndpoint(f.Protocol, f.DestinationAddress, f.DestinationPort),
+	)
+}
+
+func formatEndpoint(protocol types.Protocol, address string, port int) string {
+	if netrange.HasPorts(protocol) {
+		return fmt.Sprintf("%s:%d", address, port)
+	}
+	return address
+}
+
+// TranslationKind NATの種類
+type TranslationKind string
+
+const (
+	// TranslationPortForwarding ポートフォワーディング
+	TranslationPortForwarding TranslationKind = "port-forwarding"
+	// TranslationStaticNAT スタティックNAT
+	TranslationStaticNAT TranslationKind = "static-nat"
+	// TranslationMasquerade IPマスカレード
+	TranslationMasquerade TranslationKind = "masquerade"
+)
+
+// Translation フローに適用されたNAT
+type Translation struct {
+	// Kind 種類
+	Kind TranslationKind
+	// Index PortForwardingまたはStaticNATのインデックス IPマスカレードの場合は-1
+	Index int
+	// From 変換前のアドレス(tcp/udpの場合はアドレス:ポート)
+	From string
+	// To 変換後のアドレス(tcp/udpの場合はアドレス:ポート)
+	To string
+}
+
+// String Translationの文字列表現
+func (t *Translation) String() string {
+	if t.Index < 0 {
+		return fmt.Sprintf("%s %s => %s", t.Kind, t.From, t.To)
+	}
+	return fmt.Sprintf("%s[%d] %s => %s", t.Kind, t.Index, t.From, t.To)
+}
+
+// Result シミュレーション結果
+type Result struct {
+	// Flow 元のフロー
+	Flow *Flow
+	// Translated NAT適用後のフロー NATが適用されなかった場合はFlowと同じ値
+	Translated *Flow
+	// Translations 適用されたNAT
+	Translations []*Translation
+	// RuleIndex 一致したファイアウォールルールのインデックス 一致するルールがない場合は-1
+	RuleIndex int
+	// Rule 一致したファイアウォールルール 一致するルールがない場合はnil
+	Rule *sacloud.VPCRouterFirewallRule
+	// Action 最終的な結果
+	Action types.Action
+}
+
+// String Resultの文字列表現
+func (r *Result) String() string {
+	var s []string
+	for _, t := range r.Translations {
+		s = append(s, t.String())
+	}
+	if r.Rule != nil {
+		s = append(s, fmt.Sprintf("firewall rule[%d] %s", r.RuleIndex, r.Action))
+	} else {
+		s = append(s, fmt.Sprintf("no firewall rule matched %s", r.Action))
+	}
+	return strings.Join(s, ", ")
+}
+
+// Simulator VPCルータの設定を元にフローをシミュレートする
+type Simulator struct {
+	setting       *sacloud.VPCRouterSetting
+	globalAddress string
+	privateNets   []*net.IPNet
+	firewalls     map[int]map[Direction][]*firewallRule
+}
+
+// NewSimulator 設定を解析してSimulatorを作成する
+//
+// 不正なファイアウォールルールやNAT設定が含まれる場合はエラーを返す
+func NewSimulator(setting *sacloud.VPCRouterSetting) (*Simulator, error) {
+	s := &Simulator{
+		setting:   setting,
+		firewalls: make(map[int]map[Direction][]*firewallRule),
+	}
+
+	for _, nic := range setting.Interfaces {
+		if nic == nil {
+			continue
+		}
+		if nic.Index == 0 {
+			switch {
+			case nic.VirtualIPAddress != "":
+				s.globalAddress = nic.VirtualIPAddress
+			case len(nic.IPAddress) > 0:
+				s.globalAddress = nic.IPAddress[0]
+			}
+			continue
+		}
+		for _, ip := range append([]string{nic.VirtualIPAddress}, nic.IPAddress...) {
+			if ip == "" {
+				continue
+			}
+			if _, ipNet, err := net.ParseCIDR(fmt.Sprintf("%s/%d", ip, nic.NetworkMaskLen)); err == nil {
+				s.privateNets = append(s.privateNets, ipNet)
+				break
+			}
+		}
+	}
+
+	for i, pf := range setting.PortForwarding {
+		if !isValidPort(pf.GlobalPort) {
+			return nil, fmt.Errorf("port forwarding[%d]: invalid global port: %d", i, pf.GlobalPort.Int())
+		}
+		if !isValidAddress(pf.PrivateAddress) {
+			return nil, fmt.Errorf("port forwarding[%d]: invalid private address: %q", i, pf.PrivateAddress)
+		}
+		if !isValidPort(pf.PrivatePort) {
+			return nil, fmt.Errorf("port forwarding[%d]: invalid private port: %d", i, pf.PrivatePort.Int())
+		}
+	}
+
+	for i, nat := range setting.StaticNAT {
+		if !isValidAddress(nat.GlobalAddress) {
+			return nil, fmt.Errorf("static NAT[%d]: invalid global address: %q", i, nat.GlobalAddress)
+		}
+		if !isValidAddress(nat.PrivateAddress) {
+			return nil, fmt.Errorf("static NAT[%d]: invalid private address: %q", i, nat.PrivateAddress)
+		}
+	}
+
+	for _, fw := range setting.Firewall {
+		if fw == nil {
+			continue
+		}
+		rules := map[Direction][]*firewallRule{}
+		for _, direction := range []Direction{DirectionReceive, DirectionSend} {
+			for i, r := range firewallRules(fw, direction) {
+				compiled, err := compileFirewallRule(i, r)
+				if err != nil {
+					return nil, fmt.Errorf("firewall eth%d %s rule[%d]: %s", fw.Index, direction, i, err)
+				}
+				rules[direction] = append(rules[direction], compiled)
+			}
+		}
+		s.firewalls[fw.Index] = rules
+	}
+	return s, nil
+}
+
+// Simulate フローに対するNATとファイアウォールの評価結果を返す
+func (s *Simulator) Simulate(flow *Flow) (*Result, error) {
+	if err := validateFlow(flow); err != nil {
+		return nil, err
+	}
+
+	translated := *flow
+	result := &Result{Flow: flow, Translated: &translated, RuleIndex: -1}
+
+	if flow.Interface == 0 && flow.Direction == DirectionReceive {
+		s.translateDestination(result)
+	}
+
+	rules := s.firewalls[flow.Interface][flow.Direction]
+	target := result.Translated
+	if flow.Direction == DirectionSend {
+		// 送信方向は送信元の変換前に評価する
+		target = flow
+	}
+	f, err := parseFlow(target)
+	if err != nil {
+		return nil, err
+	}
+	result.Action = types.Actions.Allow
+	for _, r := range rules {
+		if r.matches(f) {
+			result.RuleIndex = r.index
+			result.Rule = r.rule
+			result.Action = r.rule.Action
+			break
+		}
+	}
+
+	if flow.Interface == 0 && flow.Direction == DirectionSend && result.Action.IsAllow() {
+		s.translateSource(result)
+	}
+	return result, nil
+}
+
+func (s *Simulator) translateDestination(result *Result) {
+	flow := result.Translated
+
+	for i, nat := range s.setting.StaticNAT {
+		if nat.GlobalAddress == flow.DestinationAddress {
+			result.Translations = append(result.Translations, &Translation{
+				Kind:  TranslationStaticNAT,
+				Index: i,
+				From:  nat.GlobalAddress,
+				To:    nat.PrivateAddress,
+			})
+			flow.DestinationAddress = nat.PrivateAddress
+			return
+		}
+	}
+
+	if s.globalAddress != "" && s.globalAddress != flow.DestinationAddress {
+		return
+	}
+	for i, pf := range s.setting.PortForwarding {
+		if string(pf.Protocol) != flow.Protocol.String() || pf.GlobalPort.Int() != flow.DestinationPort {
+			continue
+		}
+		from := formatEndpoint(flow.Protocol, flow.DestinationAddress, flow.DestinationPort)
+		flow.DestinationAddress = pf.PrivateAddress
+		flow.DestinationPort = pf.PrivatePort.Int()
+		result.Translations = append(result.Translations, &Translation{
+			Kind:  TranslationPortForwarding,
+			Index: i,
+			From:  from,
+			To:    formatEndpoint(flow.Protocol, flow.DestinationAddress, flow.DestinationPort),
+		})
+		return
+	}
+}
+
+func (s *Simulator) translateSource(result *Result) {
+	flow := result.Translated
+
+	for i, nat := range s.setting.StaticNAT {
+		if nat.PrivateAddress == flow.SourceAddress {
+			result.Translations = append(result.Translations, &Translation{
+				Kind:  TranslationStaticNAT,
+				Index: i,
+				From:  nat.PrivateAddress,
+				To:    nat.GlobalAddress,
+			})
+			flow.SourceAddress = nat.GlobalAddress
+			return
+		}
+	}
+
+	if s.globalAddress == "" || !s.isPrivateAddress(flow.SourceAddress) {
+		return
+	}
+	result.Translations = append(result.Translations, &Translation{
+		Kind:  TranslationMasquerade,
+		Index: -1,
+		From:  flow.SourceAddress,
+		To:    s.globalAddress,
+	})
+	flow.SourceAddress = s.globalAddress
+}
+
+func (s *Simulator) isPrivateAddress(address string) bool {
+	ip := net.ParseIP(address)
+	for _, n := range s.privateNets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Simulate settingを元にフローをシミュレートする
+func Simulate(setting *sacloud.VPCRouterSetting, flow *Flow) (*Result, error) {
+	s, err := NewSimulator(setting)
+	if err != nil {
+		return nil, err
+	}
+	return s.Simulate(flow)
+}
+
+func firewallRules(fw *sacloud.VPCRouterFirewall, direction Direction) []*sacloud.VPCRouterFirewallRule {
+	if direction == DirectionReceive {
+		return fw.Receive
+	}
+	return fw.Send
+}
+
+type firewallRule struct {
+	index int
+	rule  *sacloud.VPCRouterFirewallRule
+
+	source      netrange.AddressRange
+	destination netrange.AddressRange
+	srcPorts    []netrange.PortRange
+	dstPorts    []netrange.PortRange
+}
+
+func compileFirewallRule(index int, r *sacloud.VPCRouterFirewallRule) (*firewallRule, error) {
+	switch r.Protocol {
+	case types.Protocols.TCP, types.Protocols.UDP, types.Protocols.ICMP, types.Protocols.IP:
+	default:
+		return nil, fmt.Errorf("unsupported protocol: %q", r.Protocol)
+	}
+	if !r.Action.IsAllow() && !r.Action.IsDeny() {
+		return nil, fmt.Errorf("invalid action: %q", r.Action)
+	}
+
+	compiled := &firewallRule{index: index, rule: r}
+	var err error
+	if compiled.source, err = parseNetwork(r.SourceNetwork); err != nil {
+		return nil, fmt.Errorf("source %s", err)
+	}
+	if compiled.destination, err = parseNetwork(r.DestinationNetwork); err != nil {
+		return nil, fmt.Errorf("destination %s", err)
+	}
+	if netrange.HasPorts(r.Protocol) {
+		if compiled.srcPorts, err = parsePorts(r.SourcePort); err != nil {
+			return nil, fmt.Errorf("source %s", err)
+		}
+		if compiled.dstPorts, err = parsePorts(r.DestinationPort); err != nil {
+			return nil, fmt.Errorf("destination %s", err)
+		}
+	}
+	return compiled, nil
+}
+
+func (r *firewallRule) matches(f *flow) bool {
+	if r.rule.Protocol != types.Protocols.IP && r.rule.Protocol != f.protocol {
+		return false
+	}
+	if !r.source.Contains(f.source) || !r.destination.Contains(f.destination) {
+		return false
+	}
+	if netrange.HasPorts(r.rule.Protocol) {
+		return portsContain(r.srcPorts, f.srcPort) && portsContain(r.dstPorts, f.dstPort)
+	}
+	return true
+}
+
+type flow struct {
+	protocol    types.Protocol
+	source      uint32
+	destination uint32
+	srcPort     int
+	dstPort     int
+}
+
+func validateFlow(f *Flow) error {
+	if f.Interface < 0 || f.Interface > 7 {
+		return fmt.Errorf("invalid interface index: %d", f.Interface)
+	}
+	if f.Direction != DirectionReceive && f.Direction != DirectionSend {
+		return fmt.Errorf("invalid direction: %q", f.Direction)
+	}
+	_, err := parseFlow(f)
+	return err
+}
+
+func parseFlow(f *Flow) (*flow, error) {
+	switch f.Protocol {
+	case types.Protocols.TCP, types.Protocols.UDP, types.Protocols.ICMP, types.Protocols.IP:
+	default:
+		return nil, fmt.Errorf("unsupported protocol: %q", f.Protocol)
+	}
+	src := net.ParseIP(f.SourceAddress).To4()
+	if src == nil {
+		return nil, fmt.Errorf("invalid source address: %q", f.SourceAddress)
+	}
+	dst := net.ParseIP(f.DestinationAddress).To4()
+	if dst == nil {
+		return nil, fmt.Errorf("invalid destination address: %q", f.DestinationAddress)
+	}
+	if netrange.HasPorts(f.Protocol) {
+		if f.SourcePort < 1 || f.SourcePort > 65535 {
+			return nil, fmt.Errorf("invalid source port: %d", f.SourcePort)
+		}
+		if f.DestinationPort < 1 || f.DestinationPort > 65535 {
+			return nil, fmt.Errorf("invalid destination port: %d", f.DestinationPort)
+		}
+	}
+	return &flow{
+		protocol:    f.Protocol,
+		source:      netrange.IPToUint32(src),
+		destination: netrange.IPToUint32(dst),
+		srcPort:     f.SourcePort,
+		dstPort:     f.DestinationPort,
+	}, nil
+}
+
+func parseNetwork(network types.VPCFirewallNetwork) (netrange.AddressRange, error) {
+	from, to, err := network.Range()
+	if err != nil {
+		return netrange.AddressRange{}, err
+	}
+	return netrange.NewAddressRange(from, to), nil
+}
+
+func parsePorts(port types.VPCFirewallPort) ([]netrange.PortRange, error) {
+	ranges, err := port.Ranges()
+	if err != nil {
+		return nil, err
+	}
+	var results []netrange.PortRange
+	for _, r := range ranges {
+		results = append(results, netrange.PortRange{From: r[0], To: r[1]})
+	}
+	return results, nil
+}
+
+func portsContain(ranges []netrange.PortRange, port int) bool {
+	for _, r := range ranges {
+		if r.Contains(port) {
+			return true
+		}
+	}
+	return false
+}
+
+func isValidAddress(address string) bool {
+	return net.ParseIP(address).To4() != nil
+}
+
+func isValidPort(port types.StringNumber) bool {
+	v := port.Int()
+	return types.StringNumber(v) == port && v >= 1 && v <= netrange.MaxPort
+}
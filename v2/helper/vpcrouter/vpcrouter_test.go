@@ -0,0 +1,195 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpcrouter
+
+import (
+	"testing"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testSetting() *sacloud.VPCRouterSetting {
+	return &sacloud.VPCRouterSetting{
+		Interfaces: []*sacloud.VPCRouterInterfaceSetting{
+			{Index: 0, VirtualIPAddress: "203.0.113.10", IPAddress: []string{"203.0.113.11", "203.0.113.12"}, IPAliases: []string{"203.0.113.20"}, NetworkMaskLen: 28},
+			{Index: 1, VirtualIPAddress: "192.168.0.1", IPAddress: []string{"192.168.0.2", "192.168.0.3"}, NetworkMaskLen: 24},
+		},
+		StaticNAT: []*sacloud.VPCRouterStaticNAT{
+			{GlobalAddress: "203.0.113.20", PrivateAddress: "192.168.0.20"},
+		},
+		PortForwarding: []*sacloud.VPCRouterPortForwarding{
+			{Protocol: types.VPCRouterPortForwardingProtocols.TCP, GlobalPort: 2222, PrivateAddress: "192.168.0.11", PrivatePort: 22},
+		},
+		Firewall: []*sacloud.VPCRouterFirewall{
+			{
+				Index: 0,
+				Receive: []*sacloud.VPCRouterFirewallRule{
+					{Protocol: types.Protocols.TCP, SourceNetwork: "198.51.100.0/24", DestinationNetwork: "192.168.0.11", DestinationPort: "22", Action: types.Actions.Allow},
+					{Protocol: types.Protocols.TCP, DestinationNetwork: "192.168.0.20", DestinationPort: "80,443", Action: types.Actions.Allow},
+					{Protocol: types.Protocols.IP, Action: types.Actions.Deny},
+				},
+				Send: []*sacloud.VPCRouterFirewallRule{
+					{Protocol: types.Protocols.UDP, DestinationPort: "1-1023", SourceNetwork: "192.168.0.0/24", Action: types.Actions.Deny},
+				},
+			},
+		},
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	simulator, err := NewSimulator(testSetting())
+	require.NoError(t, err)
+
+	t.Run("port forwarding", func(t *testing.T) {
+		result, err := simulator.Simulate(&Flow{
+			Interface: 0, Direction: DirectionReceive, Protocol: types.Protocols.TCP,
+			SourceAddress: "198.51.100.5", SourcePort: 50000,
+			DestinationAddress: "203.0.113.10", DestinationPort: 2222,
+		})
+		require.NoError(t, err)
+		require.Equal(t, types.Actions.Allow, result.Action)
+		require.Equal(t, 0, result.RuleIndex)
+		require.Equal(t, "port-forwarding[0] 203.0.113.10:2222 => 192.168.0.11:22, firewall rule[0] allow", result.String())
+		require.Equal(t, "192.168.0.11", result.Translated.DestinationAddress)
+		require.Equal(t, 22, result.Translated.DestinationPort)
+
+		// 許可されていない送信元
+		result, err = simulator.Simulate(&Flow{
+			Interface: 0, Direction: DirectionReceive, Protocol: types.Protocols.TCP,
+			SourceAddress: "192.0.2.1", SourcePort: 50000,
+			DestinationAddress: "203.0.113.10", DestinationPort: 2222,
+		})
+		require.NoError(t, err)
+		require.Equal(t, types.Actions.Deny, result.Action)
+		require.Equal(t, 2, result.RuleIndex)
+	})
+
+	t.Run("static NAT", func(t *testing.T) {
+		result, err := simulator.Simulate(&Flow{
+			Interface: 0, Direction: DirectionReceive, Protocol: types.Protocols.TCP,
+			SourceAddress: "192.0.2.1", SourcePort: 50000,
+			DestinationAddress: "203.0.113.20", DestinationPort: 443,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "static-nat[0] 203.0.113.20 => 192.168.0.20, firewall rule[1] allow", result.String())
+
+		result, err = simulator.Simulate(&Flow{
+			Interface: 0, Direction: DirectionSend, Protocol: types.Protocols.TCP,
+			SourceAddress: "192.168.0.20", SourcePort: 50000,
+			DestinationAddress: "192.0.2.1", DestinationPort: 443,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "static-nat[0] 192.168.0.20 => 203.0.113.20, no firewall rule matched allow", result.String())
+		require.Equal(t, "203.0.113.20", result.Translated.SourceAddress)
+	})
+
+	t.Run("masquerade", func(t *testing.T) {
+		result, err := simulator.Simulate(&Flow{
+			Interface: 0, Direction: DirectionSend, Protocol: types.Protocols.TCP,
+			SourceAddress: "192.168.0.50", SourcePort: 50000,
+			DestinationAddress: "192.0.2.1", DestinationPort: 443,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "masquerade 192.168.0.50 => 203.0.113.10, no firewall rule matched allow", result.String())
+
+		// 拒否された場合は変換されない
+		result, err = simulator.Simulate(&Flow{
+			Interface: 0, Direction: DirectionSend, Protocol: types.Protocols.UDP,
+			SourceAddress: "192.168.0.50", SourcePort: 50000,
+			DestinationAddress: "192.0.2.1", DestinationPort: 53,
+		})
+		require.NoError(t, err)
+		require.Equal(t, types.Actions.Deny, result.Action)
+		require.Empty(t, result.Translations)
+	})
+
+	t.Run("interface without firewall", func(t *testing.T) {
+		result, err := simulator.Simulate(&Flow{
+			Interface: 1, Direction: DirectionReceive, Protocol: types.Protocols.ICMP,
+			SourceAddress: "192.168.0.50", DestinationAddress: "192.168.0.1",
+		})
+		require.NoError(t, err)
+		require.Equal(t, types.Actions.Allow, result.Action)
+		require.Nil(t, result.Rule)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := simulator.Simulate(&Flow{Interface: 8, Direction: DirectionReceive})
+		require.EqualError(t, err, "invalid interface index: 8")
+
+		setting := testSetting()
+		setting.Firewall[0].Send[0].DestinationPort = "1-1023,http"
+		_, err = NewSimulator(setting)
+		require.EqualError(t, err, `firewall eth0 send rule[0]: destination invalid port: "1-1023,http"`)
+
+		setting = testSetting()
+		setting.StaticNAT[0].PrivateAddress = "192.168.0.300"
+		_, err = Simulate(setting, &Flow{
+			Interface: 0, Direction: DirectionReceive, Protocol: types.Protocols.TCP,
+			SourceAddress: "192.0.2.1", SourcePort: 50000,
+			DestinationAddress: "203.0.113.20", DestinationPort: 443,
+		})
+		require.EqualError(t, err, `static NAT[0]: invalid private address: "192.168.0.300"`)
+
+		setting = testSetting()
+		setting.PortForwarding[0].PrivatePort = 0
+		_, err = NewSimulator(setting)
+		require.EqualError(t, err, "port forwarding[0]: invalid private port: 0")
+	})
+}
+
+func TestDiff(t *testing.T) {
+	before := testSetting()
+	after := testSetting()
+	after.PortForwarding[0].PrivateAddress = "192.168.0.12"
+	after.Firewall[0].Receive[1].DestinationPort = "443"
+
+	changes, err := Diff(before, after)
+	require.NoError(t, err)
+
+	var messages []string
+	for _, c := range changes {
+		messages = append(messages, c.String())
+	}
+	require.Equal(t, []string{
+		"eth0 receive tcp 0.0.0.0:1024 => 192.168.0.20:80: firewall rule[1] allow => firewall rule[2] deny",
+		"eth0 receive tcp 0.0.0.0:1024 => 203.0.113.10:2222: port-forwarding[0] 203.0.113.10:2222 => 192.168.0.11:22, firewall rule[2] deny => port-forwarding[0] 203.0.113.10:2222 => 192.168.0.12:22, firewall rule[2] deny",
+	}, messages)
+
+	changes, err = Diff(before, before)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	t.Run("narrowing", func(t *testing.T) {
+		after := testSetting()
+		after.Firewall[0].Receive[0].SourceNetwork = "198.51.100.0/25"
+		after.Firewall[0].Receive[1].DestinationPort = "80"
+
+		changes, err := Diff(before, after)
+		require.NoError(t, err)
+
+		var flows []string
+		for _, c := range changes {
+			flows = append(flows, c.Flow.String())
+		}
+		require.Equal(t, []string{
+			"eth0 receive tcp 198.51.100.255:1024 => 192.168.0.11:22",
+			"eth0 receive tcp 0.0.0.0:1024 => 192.168.0.20:443",
+			"eth0 receive tcp 198.51.100.128:1024 => 192.168.0.11:22",
+		}, flows)
+	})
+}
@@ -42,6 +42,9 @@ type SimpleStateWaiter struct {
 
 	// PollingInterval ポーリング間隔
 	PollingInterval time.Duration
+
+	// ClientRegistry TimeoutとPollingIntervalのデフォルト値を参照するClientRegistry
+	ClientRegistry *sacloud.ClientRegistry
 }
 
 func (s *SimpleStateWaiter) waiter() sacloud.StateWaiter {
@@ -62,6 +65,7 @@ func (s *SimpleStateWaiter) waiter() sacloud.StateWaiter {
 
 		PollingInterval: s.PollingInterval,
 		Timeout:         s.Timeout,
+		ClientRegistry:  s.ClientRegistry,
 	}
 }
 
@@ -77,12 +81,12 @@ func (s *SimpleStateWaiter) AsyncWaitForState(ctx context.Context) (compCh <-cha
 
 // SetPollingTimeout sacloud.StateWaiterの実装
 func (s *SimpleStateWaiter) SetPollingTimeout(d time.Duration) {
-	s.waiter().SetPollingTimeout(d)
+	s.Timeout = d
 }
 
 // SetPollingInterval sacloud.StateWaiterの実装
 func (s *SimpleStateWaiter) SetPollingInterval(d time.Duration) {
-	s.waiter().SetPollingInterval(d)
+	s.PollingInterval = d
 }
 
 type fakeState struct {
@@ -30,6 +30,7 @@ var (
 
 // UntilArchiveIsReady コピー完了まで待機
 func UntilArchiveIsReady(ctx context.Context, client sacloud.ArchiveAPI, zone string, id types.ID) (*sacloud.Archive, error) {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	lastState, err := sacloud.WaiterForReady(func() (interface{}, error) {
 		return client.Read(ctx, zone, id)
 	}).WaitForState(ctx)
@@ -41,6 +42,7 @@ func UntilArchiveIsReady(ctx context.Context, client sacloud.ArchiveAPI, zone st
 
 // UntilDatabaseIsUp 起動まで待機
 func UntilDatabaseIsUp(ctx context.Context, client sacloud.DatabaseAPI, zone string, id types.ID) (*sacloud.Database, error) {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	var database *sacloud.Database
 	lastState, err := sacloud.WaiterForApplianceUp(func() (interface{}, error) {
 		return client.Read(ctx, zone, id)
@@ -55,7 +57,7 @@ func UntilDatabaseIsUp(ctx context.Context, client sacloud.DatabaseAPI, zone str
 	waiter := sacloud.WaiterForUp(func() (interface{}, error) {
 		return client.Status(ctx, zone, id)
 	})
-	waiter.SetPollingInterval(sacloud.DefaultDBStatusPollingInterval) // HACK 現状は決め打ち、ユースケースが出たら修正する
+	waiter.SetPollingInterval(sacloud.DBStatusPollingIntervalFromContext(ctx)) // HACK 現状は決め打ち、ユースケースが出たら修正する
 	_, err = waiter.WaitForState(ctx)
 
 	return database, err
@@ -63,6 +65,7 @@ func UntilDatabaseIsUp(ctx context.Context, client sacloud.DatabaseAPI, zone str
 
 // UntilDatabaseIsDown シャットダウンまで待機
 func UntilDatabaseIsDown(ctx context.Context, client sacloud.DatabaseAPI, zone string, id types.ID) (*sacloud.Database, error) {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	lastState, err := sacloud.WaiterForDown(func() (interface{}, error) {
 		return client.Read(ctx, zone, id)
 	}).WaitForState(ctx)
@@ -74,6 +77,7 @@ func UntilDatabaseIsDown(ctx context.Context, client sacloud.DatabaseAPI, zone s
 
 // UntilDiskIsReady コピー完了/ディスク修正完了まで待機
 func UntilDiskIsReady(ctx context.Context, client sacloud.DiskAPI, zone string, id types.ID) (*sacloud.Disk, error) {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	lastState, err := sacloud.WaiterForReady(func() (interface{}, error) {
 		return client.Read(ctx, zone, id)
 	}).WaitForState(ctx)
@@ -85,6 +89,7 @@ func UntilDiskIsReady(ctx context.Context, client sacloud.DiskAPI, zone string,
 
 // UntilInternetIsReady 準備完了まで待機
 func UntilInternetIsReady(ctx context.Context, client sacloud.InternetAPI, zone string, id types.ID) (*sacloud.Internet, error) {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	lastState, err := sacloud.WaiterForApplianceUp(func() (interface{}, error) {
 		return client.Read(ctx, zone, id)
 	}, InternetNotFoundRetryCount).WaitForState(ctx)
@@ -96,6 +101,7 @@ func UntilInternetIsReady(ctx context.Context, client sacloud.InternetAPI, zone
 
 // UntilLoadBalancerIsUp 起動完了まで待機
 func UntilLoadBalancerIsUp(ctx context.Context, client sacloud.LoadBalancerAPI, zone string, id types.ID) (*sacloud.LoadBalancer, error) {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	lastState, err := sacloud.WaiterForApplianceUp(func() (interface{}, error) {
 		return client.Read(ctx, zone, id)
 	}, ApplianceNotFoundRetryCount).WaitForState(ctx)
@@ -107,6 +113,7 @@ func UntilLoadBalancerIsUp(ctx context.Context, client sacloud.LoadBalancerAPI,
 
 // UntilLoadBalancerIsDown シャットダウンまで待機
 func UntilLoadBalancerIsDown(ctx context.Context, client sacloud.LoadBalancerAPI, zone string, id types.ID) (*sacloud.LoadBalancer, error) {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	lastState, err := sacloud.WaiterForDown(func() (interface{}, error) {
 		return client.Read(ctx, zone, id)
 	}).WaitForState(ctx)
@@ -118,6 +125,7 @@ func UntilLoadBalancerIsDown(ctx context.Context, client sacloud.LoadBalancerAPI
 
 // UntilMobileGatewayIsReady コピー完了まで待機
 func UntilMobileGatewayIsReady(ctx context.Context, client sacloud.MobileGatewayAPI, zone string, id types.ID) (*sacloud.MobileGateway, error) {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	lastState, err := sacloud.WaiterForReady(func() (interface{}, error) {
 		return client.Read(ctx, zone, id)
 	}).WaitForState(ctx)
@@ -129,6 +137,7 @@ func UntilMobileGatewayIsReady(ctx context.Context, client sacloud.MobileGateway
 
 // UntilMobileGatewayIsUp 起動まで待機
 func UntilMobileGatewayIsUp(ctx context.Context, client sacloud.MobileGatewayAPI, zone string, id types.ID) (*sacloud.MobileGateway, error) {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	lastState, err := sacloud.WaiterForApplianceUp(func() (interface{}, error) {
 		return client.Read(ctx, zone, id)
 	}, ApplianceNotFoundRetryCount).WaitForState(ctx)
@@ -140,6 +149,7 @@ func UntilMobileGatewayIsUp(ctx context.Context, client sacloud.MobileGatewayAPI
 
 // UntilMobileGatewayIsDown シャットダウンまで待機
 func UntilMobileGatewayIsDown(ctx context.Context, client sacloud.MobileGatewayAPI, zone string, id types.ID) (*sacloud.MobileGateway, error) {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	lastState, err := sacloud.WaiterForDown(func() (interface{}, error) {
 		return client.Read(ctx, zone, id)
 	}).WaitForState(ctx)
@@ -151,6 +161,7 @@ func UntilMobileGatewayIsDown(ctx context.Context, client sacloud.MobileGatewayA
 
 // UntilNFSIsUp 起動まで待機
 func UntilNFSIsUp(ctx context.Context, client sacloud.NFSAPI, zone string, id types.ID) (*sacloud.NFS, error) {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	lastState, err := sacloud.WaiterForApplianceUp(func() (interface{}, error) {
 		return client.Read(ctx, zone, id)
 	}, ApplianceNotFoundRetryCount).WaitForState(ctx)
@@ -162,6 +173,7 @@ func UntilNFSIsUp(ctx context.Context, client sacloud.NFSAPI, zone string, id ty
 
 // UntilNFSIsDown シャットダウンまで待機
 func UntilNFSIsDown(ctx context.Context, client sacloud.NFSAPI, zone string, id types.ID) (*sacloud.NFS, error) {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	lastState, err := sacloud.WaiterForDown(func() (interface{}, error) {
 		return client.Read(ctx, zone, id)
 	}).WaitForState(ctx)
@@ -173,6 +185,7 @@ func UntilNFSIsDown(ctx context.Context, client sacloud.NFSAPI, zone string, id
 
 // UntilServerIsUp 起動まで待機
 func UntilServerIsUp(ctx context.Context, client sacloud.ServerAPI, zone string, id types.ID) (*sacloud.Server, error) {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	lastState, err := sacloud.WaiterForUp(func() (interface{}, error) {
 		return client.Read(ctx, zone, id)
 	}).WaitForState(ctx)
@@ -184,6 +197,7 @@ func UntilServerIsUp(ctx context.Context, client sacloud.ServerAPI, zone string,
 
 // UntilServerIsDown シャットダウンまで待機
 func UntilServerIsDown(ctx context.Context, client sacloud.ServerAPI, zone string, id types.ID) (*sacloud.Server, error) {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	lastState, err := sacloud.WaiterForDown(func() (interface{}, error) {
 		return client.Read(ctx, zone, id)
 	}).WaitForState(ctx)
@@ -195,6 +209,7 @@ func UntilServerIsDown(ctx context.Context, client sacloud.ServerAPI, zone strin
 
 // UntilVPCRouterIsReady コピー完了まで待機
 func UntilVPCRouterIsReady(ctx context.Context, client sacloud.VPCRouterAPI, zone string, id types.ID) (*sacloud.VPCRouter, error) {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	lastState, err := sacloud.WaiterForReady(func() (interface{}, error) {
 		return client.Read(ctx, zone, id)
 	}).WaitForState(ctx)
@@ -206,6 +221,7 @@ func UntilVPCRouterIsReady(ctx context.Context, client sacloud.VPCRouterAPI, zon
 
 // UntilVPCRouterIsUp 起動まで待機
 func UntilVPCRouterIsUp(ctx context.Context, client sacloud.VPCRouterAPI, zone string, id types.ID) (*sacloud.VPCRouter, error) {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	lastState, err := sacloud.WaiterForApplianceUp(func() (interface{}, error) {
 		return client.Read(ctx, zone, id)
 	}, ApplianceNotFoundRetryCount).WaitForState(ctx)
@@ -217,6 +233,7 @@ func UntilVPCRouterIsUp(ctx context.Context, client sacloud.VPCRouterAPI, zone s
 
 // UntilVPCRouterIsDown シャットダウンまで待機
 func UntilVPCRouterIsDown(ctx context.Context, client sacloud.VPCRouterAPI, zone string, id types.ID) (*sacloud.VPCRouter, error) {
+	ctx = sacloud.WithClientRegistryOf(ctx, client)
 	lastState, err := sacloud.WaiterForDown(func() (interface{}, error) {
 		return client.Read(ctx, zone, id)
 	}).WaitForState(ctx)
@@ -19,12 +19,19 @@
 //go:generate go run ../tools/gen-api-envelope/
 //go:generate go run ../tools/gen-api-transformer/
 //go:generate go run ../tools/gen-api-op/
+//go:generate go run ../tools/gen-api-handler/
 //go:generate go run ../tools/gen-api-tracer/
 //go:generate go run ../tools/gen-otel-tracer/
+//go:generate go run ../tools/gen-otel-metrics/
 //go:generate go run ../tools/gen-api-stub/
 //go:generate go run ../tools/gen-api-meta/
 //go:generate go run ../tools/gen-api-fake-store/
 //go:generate go run ../tools/gen-api-fake-op/
+//go:generate go run ../tools/gen-api-fake-fault/
+//go:generate go run ../tools/gen-api-fake-journal/
+//go:generate go run ../tools/gen-api-filter/
+//go:generate go run ../tools/gen-api-find-all/
+//go:generate go run ../tools/gen-api-mock/
 package define
 
 import "github.com/sacloud/libsacloud/v2/internal/dsl"
@@ -16,6 +16,7 @@ package dsl
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/sacloud/libsacloud/v2/internal/dsl/meta"
 )
@@ -80,6 +81,11 @@ func (a *Argument) MapConvTagSrc() string {
 	return fmt.Sprintf("`mapconv:\"%s\"`", a.MapConvTag)
 }
 
+// IsPassthrough リクエストエンベロープへsquashしてマッピングされる引数か
+func (a *Argument) IsPassthrough() bool {
+	return strings.Contains(a.MapConvTag, "squash")
+}
+
 // PathFormatName リクエストパス組み立て時に利用するパラメータ名の
 func (a *Argument) PathFormatName() string {
 	if a.PathFormatAlias != "" {
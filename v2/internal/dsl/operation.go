@@ -65,6 +65,31 @@ func (o *Operation) GetPathFormat() string {
 	return DefaultPathFormat
 }
 
+// IsPathArgument 指定の引数がリクエストパスの組み立てに利用されるか
+func (o *Operation) IsPathArgument(arg *Argument) bool {
+	return strings.Contains(o.GetPathFormat(), fmt.Sprintf("{{.%s}}", arg.PathFormatName()))
+}
+
+// HasNonPathArguments リクエストパス以外に利用される引数を持つか
+func (o *Operation) HasNonPathArguments() bool {
+	for _, arg := range o.Arguments {
+		if !o.IsPathArgument(arg) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasMappableArguments リクエストエンベロープへマッピングされる引数(パス以外、かつsquash指定以外)を持つか
+func (o *Operation) HasMappableArguments() bool {
+	for _, arg := range o.Arguments {
+		if !o.IsPathArgument(arg) && !arg.IsPassthrough() {
+			return true
+		}
+	}
+	return false
+}
+
 // ImportStatements コード生成時に利用するimport文を生成する
 func (o *Operation) ImportStatements(additionalImports ...string) []string {
 	ss := wrapByDoubleQuote(additionalImports...)
@@ -0,0 +1,96 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"log"
+	"path/filepath"
+
+	"github.com/sacloud/libsacloud/v2/internal/define"
+	"github.com/sacloud/libsacloud/v2/internal/dsl"
+	"github.com/sacloud/libsacloud/v2/internal/tools"
+)
+
+const destination = "sacloud/fake/zz_fault_injectors.go"
+
+func init() {
+	log.SetFlags(0)
+	log.SetPrefix("gen-api-fake-fault: ")
+}
+
+func main() {
+	dsl.IsOutOfSacloudPackage = true
+
+	tools.WriteFileWithTemplate(&tools.TemplateConfig{
+		OutputPath: filepath.Join(tools.ProjectRootPath(), destination),
+		Template:   tmpl,
+		Parameter:  define.APIs,
+	})
+	log.Printf("generated: %s\n", filepath.Join(destination))
+}
+
+const tmpl = `// generated by 'github.com/sacloud/libsacloud/internal/tools/gen-api-fake-fault'; DO NOT EDIT
+
+package fake
+
+import (
+{{- range .ImportStatements "context"}}{{ if ne . "\"time\"" }}
+	{{ . }}
+{{- end }}{{- end }}
+)
+
+func addFaultInjectorHooks(add func(resourceName string, hookFunc func(interface{}) interface{}), injector *FaultInjector) {
+{{ range . -}}
+	add(Resource{{.TypeName}}, func(in interface{}) interface{} {
+		return &{{.TypeName}}FaultInjector{Internal: in.(sacloud.{{.TypeName}}API), injector: injector}
+	})
+{{ end -}}
+}
+
+{{ range . }} {{$typeName := .TypeName}} {{ $resource := . }}{{ $structName := printf "%sFaultInjector" .TypeName }}
+/*************************************************
+* {{ $structName }}
+*************************************************/
+
+// {{ $structName }} is for fault injection to {{ $typeName }}Op operations
+type {{ $structName }} struct {
+	Internal sacloud.{{$typeName}}API
+	injector *FaultInjector
+}
+
+{{ range .Operations }}{{$returnErrStatement := .ReturnErrorStatement}}
+// {{ .MethodName }} is API call with fault injection
+func (f *{{ $structName }}) {{ .MethodName }}(ctx context.Context{{if not $resource.IsGlobal}}, zone string{{end}}{{ range .Arguments }}, {{ .ArgName }} {{ .TypeName }}{{ end }}) {{.ResultsStatement}} {
+	ctx, err := f.injector.inject(ctx, &FaultCall{
+		ResourceKey: Resource{{ $typeName }},
+		Operation:   "{{ .MethodName }}",
+{{- if $resource.IsGlobal }}
+		Zone:        sacloud.APIDefaultZone,
+{{- else }}
+		Zone:        zone,
+{{- end }}
+{{- range .Arguments }}{{ if and (eq .ArgName "id") (eq .TypeName "types.ID") }}
+		ID:          id,
+{{- end }}{{ end }}
+	})
+	if err != nil {
+		return {{ $returnErrStatement }}
+	}
+	return f.Internal.{{ .MethodName }}(ctx{{if not $resource.IsGlobal}}, zone{{end}}{{ range .Arguments }}, {{ .ArgName }}{{ end }})
+}
+{{- end -}}
+
+{{ end }}
+`
@@ -0,0 +1,111 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"log"
+	"path/filepath"
+
+	"github.com/sacloud/libsacloud/v2/internal/define"
+	"github.com/sacloud/libsacloud/v2/internal/dsl"
+	"github.com/sacloud/libsacloud/v2/internal/tools"
+)
+
+const destination = "sacloud/fake/zz_journal_recorders.go"
+
+func init() {
+	log.SetFlags(0)
+	log.SetPrefix("gen-api-fake-journal: ")
+}
+
+func main() {
+	dsl.IsOutOfSacloudPackage = true
+
+	tools.WriteFileWithTemplate(&tools.TemplateConfig{
+		OutputPath: filepath.Join(tools.ProjectRootPath(), destination),
+		Template:   tmpl,
+		Parameter:  define.APIs,
+	})
+	log.Printf("generated: %s\n", filepath.Join(destination))
+}
+
+const tmpl = `// generated by 'github.com/sacloud/libsacloud/internal/tools/gen-api-fake-journal'; DO NOT EDIT
+
+package fake
+
+import (
+{{- range .ImportStatements "context" "time"}}
+	{{ . }}
+{{- end }}
+)
+
+func addJournalRecorderHooks(add func(resourceName string, hookFunc func(interface{}) interface{}), journal *Journal) {
+{{ range . -}}
+	add(Resource{{.TypeName}}, func(in interface{}) interface{} {
+		return &{{.TypeName}}JournalRecorder{Internal: in.(sacloud.{{.TypeName}}API), journal: journal}
+	})
+{{ end -}}
+}
+
+{{ range . }} {{$typeName := .TypeName}} {{ $resource := . }}{{ $structName := printf "%sJournalRecorder" .TypeName }}
+/*************************************************
+* {{ $structName }}
+*************************************************/
+
+// {{ $structName }} is for recording {{ $typeName }}Op operations to the journal
+type {{ $structName }} struct {
+	Internal sacloud.{{$typeName}}API
+	journal  *Journal
+}
+
+{{ range .Operations }}
+// {{ .MethodName }} is API call with recording to the journal
+func (r *{{ $structName }}) {{ .MethodName }}(ctx context.Context{{if not $resource.IsGlobal}}, zone string{{end}}{{ range .Arguments }}, {{ .ArgName }} {{ .TypeName }}{{ end }}) {{.ResultsStatement}} {
+	calledAt := time.Now()
+	{{range .ResultsTypeInfo}}{{.VarName}}, {{end}}err := r.Internal.{{ .MethodName }}(ctx{{if not $resource.IsGlobal}}, zone{{end}}{{ range .Arguments }}, {{ .ArgName }}{{ end }})
+	r.journal.record(&JournalEntry{
+		ResourceKey: Resource{{ $typeName }},
+		Operation:   "{{ .MethodName }}",
+{{- if $resource.IsGlobal }}
+		Zone:        sacloud.APIDefaultZone,
+{{- else }}
+		Zone:        zone,
+{{- end }}
+{{- range .Arguments }}{{ if and (eq .ArgName "id") (eq .TypeName "types.ID") }}
+		ID:          id,
+{{- end }}{{ end }}
+{{- if .Arguments }}
+		Arguments: map[string]interface{}{
+{{- range .Arguments }}
+			"{{ .ArgName }}": {{ .ArgName }},
+{{- end }}
+		},
+{{- end }}
+{{- if .ResultsTypeInfo }}
+		Results: []interface{}{
+{{- range .ResultsTypeInfo }}
+			{{ .VarName }},
+{{- end }}
+		},
+{{- end }}
+		Err:       err,
+		Timestamp: calledAt,
+	})
+	return {{range .ResultsTypeInfo}}{{.VarName}}, {{end}}err
+}
+{{- end -}}
+
+{{ end }}
+`
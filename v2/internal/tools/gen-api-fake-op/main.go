@@ -97,6 +97,19 @@ func switchFactoryFuncToFake() {
 {{ end -}}
 }
 
+// SwitchRegistryFactoryFuncToFake switches factory methods in the registry to use fake client with the store
+//
+// If store is nil, fake.DataStore is used
+func SwitchRegistryFactoryFuncToFake(registry *sacloud.ClientRegistry, store Store) {
+{{ range . -}}
+	registry.SetClientFactoryFunc(Resource{{.TypeName}}, func(caller sacloud.APICaller) interface{} {
+		op := new{{ .TypeName }}Op(store)
+		op.registry = registry
+		return op
+	})
+{{ end -}}
+}
+
 
 {{ range . }}{{ $typeName := .TypeName}} 
 
@@ -106,12 +119,18 @@ func switchFactoryFuncToFake() {
 
 // {{ .TypeName }}Op is fake implementation of {{ .TypeName }}API interface
 type {{ .TypeName }}Op struct{
+	storeAccessor
 	key string
 }
 
 // New{{ $typeName}}Op creates new {{ $typeName}}Op instance
 func New{{ $typeName}}Op() sacloud.{{ $typeName}}API {
+	return new{{ $typeName}}Op(nil)
+}
+
+func new{{ $typeName}}Op(store Store) *{{ $typeName}}Op {
 	return &{{$typeName}}Op {
+		storeAccessor: storeAccessor{store: store},
 		key: Resource{{$typeName}},
 	}
 }
@@ -130,44 +149,47 @@ import (
 // {{ .MethodName }} is fake implementation
 func (o *{{ $.TypeName }}Op) {{ .MethodName }}(ctx context.Context{{if not $.IsGlobal}}, zone string{{end}}{{ range .Arguments }}, {{ .ArgName }} {{ .TypeName }}{{ end }}) {{.ResultsStatement}} {
 {{ if eq .MethodName "Find" -}}
-	results, _ := find(o.key, {{if $.IsGlobal}}sacloud.APIDefaultZone{{else}}zone{{end}}, conditions)
+	results, err := find(o.ds(), o.key, {{if $.IsGlobal}}sacloud.APIDefaultZone{{else}}zone{{end}}, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.{{$.TypeName}}
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.{{$.TypeName}}{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.{{.ResultTypeName}}{
-		Total:    len(results),
-		Count:    len(results),
-		From:     0,
+		Total:    results.Total,
+		Count:    results.Count,
+		From:     results.From,
 		{{$.TypeName}}: values,
 	}, nil
 {{ else if eq .MethodName "List" -}}
-	results, _ := find(o.key, {{if $.IsGlobal}}sacloud.APIDefaultZone{{else}}zone{{end}}, nil)
+	results, _ := find(o.ds(), o.key, {{if $.IsGlobal}}sacloud.APIDefaultZone{{else}}zone{{end}}, nil)
 	var values []*sacloud.{{$.TypeName}}
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.{{$.TypeName}}{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.{{.ResultTypeName}}{
-		Total:    len(results),
-		Count:    len(results),
-		From:     0,
+		Total:    results.Total,
+		Count:    results.Count,
+		From:     results.From,
 		{{$.TypeName}}: values,
 	}, nil
 {{ else if eq .MethodName "Create" -}}
 	result := &sacloud.{{$.TypeName}}{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
 	// TODO core logic is not implemented
 
-	s.set{{$.TypeName}}({{if $.IsGlobal}}sacloud.APIDefaultZone{{else}}zone{{end}}, result)
+	put{{$.TypeName}}(o.ds(), {{if $.IsGlobal}}sacloud.APIDefaultZone{{else}}zone{{end}}, result)
 	return result, nil
 {{ else if eq .MethodName "Read" -}}
-	value := s.get{{$.TypeName}}ByID({{if $.IsGlobal}}sacloud.APIDefaultZone{{else}}zone{{end}}, id)
+	value := get{{$.TypeName}}ByID(o.ds(), {{if $.IsGlobal}}sacloud.APIDefaultZone{{else}}zone{{end}}, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -192,7 +214,7 @@ func (o *{{ $.TypeName }}Op) {{ .MethodName }}(ctx context.Context{{if not $.IsG
 
 	// TODO core logic is not implemented
 
-	s.delete(o.key, {{if $.IsGlobal}}sacloud.APIDefaultZone{{else}}zone{{end}}, id)
+	o.ds().Delete(o.key, {{if $.IsGlobal}}sacloud.APIDefaultZone{{else}}zone{{end}}, id)
 	return nil
 {{ else -}}
 	// TODO not implemented
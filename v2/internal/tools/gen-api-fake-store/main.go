@@ -52,8 +52,8 @@ import (
 )
 
 {{ range . }} 
-func get{{.TypeName}}(zone string) []*sacloud.{{.TypeName}} {
-	values := ds().List(Resource{{.TypeName}}, zone)
+func get{{.TypeName}}(s Store, zone string) []*sacloud.{{.TypeName}} {
+	values := s.List(Resource{{.TypeName}}, zone)
 	var ret []*sacloud.{{.TypeName}}
 	for _ , v := range values {
 		if v, ok := v.(*sacloud.{{.TypeName}}); ok {
@@ -63,21 +63,21 @@ func get{{.TypeName}}(zone string) []*sacloud.{{.TypeName}} {
 	return ret
 }
 
-func get{{.TypeName}}ByID(zone string, id types.ID) *sacloud.{{.TypeName}} {
-	v := ds().Get(Resource{{.TypeName}}, zone, id)
+func get{{.TypeName}}ByID(s Store, zone string, id types.ID) *sacloud.{{.TypeName}} {
+	v := s.Get(Resource{{.TypeName}}, zone, id)
 	if v, ok := v.(*sacloud.{{.TypeName}}); ok {
 		return v
 	}
 	return nil
 }
 
-func put{{.TypeName}}(zone string, value *sacloud.{{.TypeName}}) {
+func put{{.TypeName}}(s Store, zone string, value *sacloud.{{.TypeName}}) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(Resource{{.TypeName}}, zone, id.GetID(), value)
+		s.Put(Resource{{.TypeName}}, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(Resource{{.TypeName}}, zone, 0, value)
+	s.Put(Resource{{.TypeName}}, zone, 0, value)
 }
 {{ end }}
 `
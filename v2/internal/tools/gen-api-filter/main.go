@@ -0,0 +1,467 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"log"
+	"path/filepath"
+	"strings"
+
+	"github.com/sacloud/libsacloud/v2/internal/define"
+	"github.com/sacloud/libsacloud/v2/internal/dsl"
+	"github.com/sacloud/libsacloud/v2/internal/tools"
+)
+
+const destination = "sacloud/zz_filter_builders.go"
+
+func init() {
+	log.SetFlags(0)
+	log.SetPrefix("gen-api-filter: ")
+}
+
+func main() {
+	tools.WriteFileWithTemplate(&tools.TemplateConfig{
+		OutputPath: filepath.Join(tools.ProjectRootPath(), destination),
+		Template:   tmpl,
+		Parameter:  filterBuilders(define.APIs),
+	})
+	log.Printf("generated: %s\n", filepath.Join(destination))
+}
+
+// filterKind フィールドの型に応じた検索条件の種別
+type filterKind string
+
+const (
+	filterKindID     filterKind = "id"
+	filterKindString filterKind = "string"
+	filterKindEnum   filterKind = "enum"
+	filterKindNumber filterKind = "number"
+	filterKindBool   filterKind = "bool"
+	filterKindTime   filterKind = "time"
+	filterKindTags   filterKind = "tags"
+)
+
+type filterBuilder struct {
+	TypeName string
+	Fields   []*filterField
+}
+
+type filterField struct {
+	Name       string
+	Key        string
+	Kind       filterKind
+	TypeName   string
+	Comparable bool
+}
+
+// filterKeySpec 検索条件として利用可能なキーの定義
+type filterKeySpec struct {
+	// Comparable 大小比較(GreaterThan/LessThanやAfter/Before)が利用可能か
+	//
+	// さくらのクラウド側でBigint(文字列)として扱われる数値項目ではOpEqual以外の演算子を利用できないためfalseとする
+	Comparable bool
+}
+
+// commonFilterKeys 全リソース共通で検索条件として利用可能なキー
+//
+// モデルに存在しないキーは無視される
+var commonFilterKeys = map[string]filterKeySpec{
+	"ID":           {},
+	"Name":         {},
+	"Description":  {},
+	"Tags.Name":    {},
+	"Availability": {},
+	"Scope":        {},
+	"Class":        {},
+	"Icon.ID":      {},
+	"CreatedAt":    {Comparable: true},
+	"ModifiedAt":   {Comparable: true},
+}
+
+// resourceFilterKeys リソースごとに検索条件として利用可能なキー
+//
+// ここに定義したキーがモデルに存在しない場合は生成時にエラーとする
+var resourceFilterKeys = map[string]map[string]filterKeySpec{
+	"Archive": {
+		"SizeMB":             {Comparable: true},
+		"Plan.ID":            {},
+		"SourceArchive.ID":   {},
+		"SourceDisk.ID":      {},
+		"OriginalArchive.ID": {},
+		"DisplayOrder":       {},
+	},
+	"CDROM": {
+		"SizeMB":       {Comparable: true},
+		"DisplayOrder": {},
+	},
+	"Database": {
+		"Instance.Status": {},
+	},
+	"Disk": {
+		"SizeMB":           {Comparable: true},
+		"Connection":       {},
+		"Plan.ID":          {},
+		"Server.ID":        {},
+		"SourceArchive.ID": {},
+		"SourceDisk.ID":    {},
+	},
+	"DiskPlan": {
+		"StorageClass": {},
+	},
+	"Interface": {
+		"Server.ID":       {},
+		"Switch.ID":       {},
+		"PacketFilter.ID": {},
+		"IPAddress":       {},
+		"UserIPAddress":   {},
+		"MACAddress":      {},
+	},
+	"Internet": {
+		"BandWidthMbps":  {Comparable: true},
+		"NetworkMaskLen": {Comparable: true},
+	},
+	"InternetPlan": {
+		"BandWidthMbps": {Comparable: true},
+	},
+	"License": {
+		"LicenseInfo.ID": {},
+	},
+	"LoadBalancer": {
+		"Instance.Status": {},
+	},
+	"MobileGateway": {
+		"Instance.Status": {},
+	},
+	"NFS": {
+		"Instance.Status": {},
+	},
+	"PrivateHost": {
+		"Plan.ID":   {},
+		"Host.Name": {},
+	},
+	"PrivateHostPlan": {
+		"CPU":      {Comparable: true},
+		"MemoryMB": {Comparable: true},
+	},
+	"Server": {
+		"ServerPlan.ID":         {},
+		"ServerPlan.CPU":        {Comparable: true},
+		"ServerPlan.MemoryMB":   {Comparable: true},
+		"ServerPlan.GPU":        {Comparable: true},
+		"ServerPlan.Commitment": {},
+		"ServerPlan.Generation": {},
+		"PrivateHost.ID":        {},
+		"Instance.Status":       {},
+		"InterfaceDriver":       {},
+	},
+	"ServerPlan": {
+		"CPU":        {Comparable: true},
+		"MemoryMB":   {Comparable: true},
+		"GPU":        {Comparable: true},
+		"Commitment": {},
+		"Generation": {},
+	},
+	"ServiceClass": {
+		"ServiceClassName": {},
+		"ServiceClassPath": {},
+		"IsPublic":         {},
+	},
+	"SSHKey": {
+		"Fingerprint": {},
+	},
+	"Subnet": {
+		"Switch.ID":      {},
+		"NetworkAddress": {},
+		"NetworkMaskLen": {Comparable: true},
+	},
+	"Switch": {
+		"Bridge.ID": {},
+	},
+	"VPCRouter": {
+		"Instance.Status": {},
+	},
+	"Zone": {
+		"IsDummy":      {},
+		"DisplayOrder": {},
+	},
+}
+
+func filterBuilders(resources dsl.Resources) []*filterBuilder {
+	var builders []*filterBuilder
+	for _, resource := range resources {
+		op := findOperation(resource)
+		if op == nil || len(op.Results) == 0 {
+			continue
+		}
+
+		typeName := resource.TypeName()
+		builder := &filterBuilder{TypeName: typeName}
+		keys := make(map[string]bool)
+		for _, field := range op.Results[0].Model.Fields {
+			f := toFilterField(field)
+			if f == nil || keys[f.Key] {
+				continue
+			}
+			spec, ok := resourceFilterKeys[typeName][f.Key]
+			if !ok {
+				spec, ok = commonFilterKeys[f.Key]
+			}
+			if !ok {
+				continue
+			}
+			f.Comparable = spec.Comparable
+			keys[f.Key] = true
+			builder.Fields = append(builder.Fields, f)
+		}
+
+		for key := range resourceFilterKeys[typeName] {
+			if !keys[key] {
+				log.Fatalf("%s: filter key %q is not found in the model", typeName, key)
+			}
+		}
+		builders = append(builders, builder)
+	}
+	return builders
+}
+
+// findOperation FindConditionを引数に取るFindオペレーションを返す
+func findOperation(resource *dsl.Resource) *dsl.Operation {
+	for _, op := range resource.Operations {
+		if op.Name != "Find" {
+			continue
+		}
+		for _, arg := range op.Arguments {
+			if arg.Type.GoType() == "FindCondition" {
+				return op
+			}
+		}
+	}
+	return nil
+}
+
+func toFilterField(field *dsl.FieldDesc) *filterField {
+	key := field.Name
+	if field.Tags != nil && field.Tags.MapConv != "" {
+		mapconv := strings.Split(field.Tags.MapConv, ",")
+		if len(mapconv) > 1 && mapconv[1] == "recursive" {
+			return nil
+		}
+		if mapconv[0] != "" {
+			key = mapconv[0]
+		}
+	}
+	if strings.Contains(key, "[]") {
+		return nil
+	}
+
+	typeName := field.Type.GoTypeSourceCode()
+	var kind filterKind
+	switch {
+	case typeName == "types.ID":
+		kind = filterKindID
+	case typeName == "types.Tags":
+		kind, key = filterKindTags, "Tags.Name"
+	case typeName == "string":
+		kind = filterKindString
+	case strings.HasPrefix(typeName, "types.E"):
+		kind = filterKindEnum
+	case typeName == "int" || typeName == "int64" || typeName == "float64":
+		kind = filterKindNumber
+	case typeName == "bool":
+		kind = filterKindBool
+	case typeName == "time.Time":
+		kind = filterKindTime
+	default:
+		return nil
+	}
+
+	return &filterField{
+		Name:     field.Name,
+		Key:      key,
+		Kind:     kind,
+		TypeName: typeName,
+	}
+}
+
+const tmpl = `// generated by 'github.com/sacloud/libsacloud/internal/tools/gen-api-filter'; DO NOT EDIT
+
+package sacloud
+
+import (
+	"time"
+
+	"github.com/sacloud/libsacloud/v2/sacloud/search"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+{{ range . }} {{ $typeName := .TypeName }}
+/*************************************************
+* {{ $typeName }}FilterBuilder
+*************************************************/
+
+// {{ $typeName }}FilterKey {{ $typeName }}の検索/ソートに利用できるキー
+type {{ $typeName }}FilterKey string
+
+const (
+{{- range .Fields }}
+	// {{ $typeName }}FilterKey{{ .Name }} {{ .Key }}
+	{{ $typeName }}FilterKey{{ .Name }} = {{ $typeName }}FilterKey("{{ .Key }}")
+{{- end }}
+)
+
+// {{ $typeName }}FilterBuilder {{ $typeName }}の検索条件を組み立てる
+type {{ $typeName }}FilterBuilder struct {
+	filterBuilder
+}
+
+// {{ $typeName }}Filter {{ $typeName }}FilterBuilderを作成する
+func {{ $typeName }}Filter() *{{ $typeName }}FilterBuilder {
+	return &{{ $typeName }}FilterBuilder{}
+}
+
+// SortBy ソート順を追加する
+func (b *{{ $typeName }}FilterBuilder) SortBy(key {{ $typeName }}FilterKey, desc bool) *{{ $typeName }}FilterBuilder {
+	b.sortBy(string(key), desc)
+	return b
+}
+
+// From 取得開始位置を設定する
+func (b *{{ $typeName }}FilterBuilder) From(from int) *{{ $typeName }}FilterBuilder {
+	b.setFrom(from)
+	return b
+}
+
+// Count 取得件数を設定する
+func (b *{{ $typeName }}FilterBuilder) Count(count int) *{{ $typeName }}FilterBuilder {
+	b.setCount(count)
+	return b
+}
+
+// Include 取得するフィールドを設定する
+func (b *{{ $typeName }}FilterBuilder) Include(keys ...{{ $typeName }}FilterKey) *{{ $typeName }}FilterBuilder {
+	for _, key := range keys {
+		b.include = append(b.include, string(key))
+	}
+	return b
+}
+
+// Exclude 取得しないフィールドを設定する
+func (b *{{ $typeName }}FilterBuilder) Exclude(keys ...{{ $typeName }}FilterKey) *{{ $typeName }}FilterBuilder {
+	for _, key := range keys {
+		b.exclude = append(b.exclude, string(key))
+	}
+	return b
+}
+{{ range .Fields }}
+{{- if eq .Kind "id" }}
+// {{ .Name }}Equals {{ .Key }}がいずれかの値と一致する
+func (b *{{ $typeName }}FilterBuilder) {{ .Name }}Equals(values ...types.ID) *{{ $typeName }}FilterBuilder {
+	var conditions []interface{}
+	for _, v := range values {
+		conditions = append(conditions, v)
+	}
+	b.setOrEqual("{{ .Key }}", conditions)
+	return b
+}
+{{- else if eq .Kind "string" }}
+// {{ .Name }}Contains {{ .Key }}が全ての値を含む(部分一致)
+func (b *{{ $typeName }}FilterBuilder) {{ .Name }}Contains(values ...string) *{{ $typeName }}FilterBuilder {
+	b.setAndEqual("{{ .Key }}", values)
+	return b
+}
+
+// {{ .Name }}Equals {{ .Key }}がいずれかの値と一致する(完全一致)
+func (b *{{ $typeName }}FilterBuilder) {{ .Name }}Equals(values ...string) *{{ $typeName }}FilterBuilder {
+	var conditions []interface{}
+	for _, v := range values {
+		conditions = append(conditions, v)
+	}
+	b.setOrEqual("{{ .Key }}", conditions)
+	return b
+}
+{{- else if eq .Kind "enum" }}
+// {{ .Name }}Equals {{ .Key }}がいずれかの値と一致する
+func (b *{{ $typeName }}FilterBuilder) {{ .Name }}Equals(values ...{{ .TypeName }}) *{{ $typeName }}FilterBuilder {
+	var conditions []interface{}
+	for _, v := range values {
+		conditions = append(conditions, v)
+	}
+	b.setOrEqual("{{ .Key }}", conditions)
+	return b
+}
+{{- else if eq .Kind "bool" }}
+// {{ .Name }}Equals {{ .Key }}が値と一致する
+func (b *{{ $typeName }}FilterBuilder) {{ .Name }}Equals(value bool) *{{ $typeName }}FilterBuilder {
+	b.setOrEqual("{{ .Key }}", []interface{}{value})
+	return b
+}
+{{- else if eq .Kind "tags" }}
+// HasTags 全てのタグを持つ
+func (b *{{ $typeName }}FilterBuilder) HasTags(tags ...string) *{{ $typeName }}FilterBuilder {
+	b.setTags("{{ .Key }}", tags)
+	return b
+}
+{{- else if eq .Kind "number" }}
+// {{ .Name }}Equals {{ .Key }}がいずれかの値と一致する
+func (b *{{ $typeName }}FilterBuilder) {{ .Name }}Equals(values ...{{ .TypeName }}) *{{ $typeName }}FilterBuilder {
+	var conditions []interface{}
+	for _, v := range values {
+		conditions = append(conditions, v)
+	}
+	b.setOrEqual("{{ .Key }}", conditions)
+	return b
+}
+{{- if .Comparable }}
+
+// {{ .Name }}GreaterThan {{ .Key }}が値より大きい
+func (b *{{ $typeName }}FilterBuilder) {{ .Name }}GreaterThan(value {{ .TypeName }}) *{{ $typeName }}FilterBuilder {
+	b.setWithOp("{{ .Key }}", search.OpGreaterThan, value)
+	return b
+}
+
+// {{ .Name }}GreaterEqual {{ .Key }}が値以上
+func (b *{{ $typeName }}FilterBuilder) {{ .Name }}GreaterEqual(value {{ .TypeName }}) *{{ $typeName }}FilterBuilder {
+	b.setWithOp("{{ .Key }}", search.OpGreaterEqual, value)
+	return b
+}
+
+// {{ .Name }}LessThan {{ .Key }}が値より小さい
+func (b *{{ $typeName }}FilterBuilder) {{ .Name }}LessThan(value {{ .TypeName }}) *{{ $typeName }}FilterBuilder {
+	b.setWithOp("{{ .Key }}", search.OpLessThan, value)
+	return b
+}
+
+// {{ .Name }}LessEqual {{ .Key }}が値以下
+func (b *{{ $typeName }}FilterBuilder) {{ .Name }}LessEqual(value {{ .TypeName }}) *{{ $typeName }}FilterBuilder {
+	b.setWithOp("{{ .Key }}", search.OpLessEqual, value)
+	return b
+}
+{{- end }}
+{{- else if and (eq .Kind "time") .Comparable }}
+// {{ .Name }}After {{ .Key }}が指定日時より後
+func (b *{{ $typeName }}FilterBuilder) {{ .Name }}After(value time.Time) *{{ $typeName }}FilterBuilder {
+	b.setTime("{{ .Key }}", search.OpGreaterThan, value)
+	return b
+}
+
+// {{ .Name }}Before {{ .Key }}が指定日時より前
+func (b *{{ $typeName }}FilterBuilder) {{ .Name }}Before(value time.Time) *{{ $typeName }}FilterBuilder {
+	b.setTime("{{ .Key }}", search.OpLessThan, value)
+	return b
+}
+{{- end }}
+{{ end }}
+{{ end }}
+`
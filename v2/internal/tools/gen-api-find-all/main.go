@@ -0,0 +1,165 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"log"
+	"path/filepath"
+
+	"github.com/sacloud/libsacloud/v2/internal/define"
+	"github.com/sacloud/libsacloud/v2/internal/dsl"
+	"github.com/sacloud/libsacloud/v2/internal/tools"
+)
+
+const destination = "sacloud/zz_find_all.go"
+
+func init() {
+	log.SetFlags(0)
+	log.SetPrefix("gen-api-find-all: ")
+}
+
+func main() {
+	tools.WriteFileWithTemplate(&tools.TemplateConfig{
+		OutputPath: filepath.Join(tools.ProjectRootPath(), destination),
+		Template:   tmpl,
+		Parameter:  finders(define.APIs),
+	})
+	log.Printf("generated: %s\n", filepath.Join(destination))
+}
+
+type finder struct {
+	TypeName    string
+	IsGlobal    bool
+	ModelName   string
+	ResultField string
+}
+
+func finders(resources dsl.Resources) []*finder {
+	var results []*finder
+	for _, resource := range resources {
+		for _, op := range resource.Operations {
+			if op.Name != "Find" || len(op.Results) == 0 || !hasFindCondition(op) {
+				continue
+			}
+			results = append(results, &finder{
+				TypeName:    resource.TypeName(),
+				IsGlobal:    resource.IsGlobal,
+				ModelName:   op.Results[0].Model.Name,
+				ResultField: op.Results[0].DestField,
+			})
+		}
+	}
+	return results
+}
+
+func hasFindCondition(op *dsl.Operation) bool {
+	for _, arg := range op.Arguments {
+		if arg.Type.GoType() == "FindCondition" {
+			return true
+		}
+	}
+	return false
+}
+
+const tmpl = `// generated by 'github.com/sacloud/libsacloud/internal/tools/gen-api-find-all'; DO NOT EDIT
+
+package sacloud
+
+import (
+	"context"
+)
+
+{{ range . }} {{ $typeName := .TypeName }}
+/*************************************************
+* {{ $typeName }}Iterator
+*************************************************/
+
+// FindAll{{ .ResultField }} {{ $typeName }}APIのFindを繰り返し呼び出し、条件に一致する全ての{{ .ModelName }}を返す
+//
+// conditions.Fromを開始位置、conditions.Countを取得する最大件数(0の場合は全件)として扱う
+func FindAll{{ .ResultField }}(ctx context.Context, api {{ $typeName }}API{{ if not .IsGlobal }}, zone string{{ end }}, conditions *FindCondition, opts ...*FindAllOptions) ([]*{{ .ModelName }}, error) {
+	iter := New{{ $typeName }}Iterator(ctx, api{{ if not .IsGlobal }}, zone{{ end }}, conditions, opts...)
+	var values []*{{ .ModelName }}
+	for iter.Next() {
+		values = append(values, iter.Value())
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// {{ $typeName }}Iterator {{ $typeName }}APIのFindの結果をページをまたいで1件ずつ返すイテレーター
+type {{ $typeName }}Iterator struct {
+	*findIterator
+}
+
+// New{{ $typeName }}Iterator {{ $typeName }}Iteratorを作成する
+//
+// Findは最初のNextの呼び出し時から必要に応じて呼び出される
+func New{{ $typeName }}Iterator(ctx context.Context, api {{ $typeName }}API{{ if not .IsGlobal }}, zone string{{ end }}, conditions *FindCondition, opts ...*FindAllOptions) *{{ $typeName }}Iterator {
+	find := func(ctx context.Context, conditions *FindCondition) ([]interface{}, int, error) {
+		result, err := api.Find(ctx{{ if not .IsGlobal }}, zone{{ end }}, conditions)
+		if err != nil {
+			return nil, 0, err
+		}
+		var values []interface{}
+		for _, v := range result.{{ .ResultField }} {
+			values = append(values, v)
+		}
+		return values, result.Total, nil
+	}
+	return &{{ $typeName }}Iterator{findIterator: newFindIterator(ctx, conditions, opts, find)}
+}
+
+// Value 現在の値を返す
+func (it *{{ $typeName }}Iterator) Value() *{{ .ModelName }} {
+	v, _ := it.value().(*{{ .ModelName }})
+	return v
+}
+{{ if not .IsGlobal }}
+// Zoned{{ .ModelName }} ゾーン名を付与した{{ .ModelName }}
+type Zoned{{ .ModelName }} struct {
+	Zone  string
+	Value *{{ .ModelName }}
+}
+
+// Find{{ .ResultField }}InZones 複数のゾーンで並列に{{ $typeName }}APIのFindAll{{ .ResultField }}を実行し、結果をゾーンの指定順にまとめて返す
+//
+// zonesが空の場合はSakuraCloudZonesが対象となる。
+// 一部のゾーンでエラーが発生した場合も他のゾーンの検索は継続し、
+// 成功したゾーンの結果とゾーンごとのエラー(*ZoneError)をまとめた*multierror.Errorを返す
+func Find{{ .ResultField }}InZones(ctx context.Context, api {{ $typeName }}API, zones []string, conditions *FindCondition, opts ...*FindInZonesOptions) ([]*Zoned{{ .ModelName }}, error) {
+	find := func(ctx context.Context, zone string, opts *FindAllOptions) ([]interface{}, error) {
+		iter := New{{ $typeName }}Iterator(ctx, api, zone, conditions, opts)
+		var values []interface{}
+		for iter.Next() {
+			values = append(values, iter.Value())
+		}
+		return values, iter.Err()
+	}
+
+	found, err := findInZones(ctx, zones, opts, find)
+	var results []*Zoned{{ .ModelName }}
+	for _, zoned := range found {
+		for _, v := range zoned.values {
+			results = append(results, &Zoned{{ .ModelName }}{Zone: zoned.zone, Value: v.(*{{ .ModelName }})})
+		}
+	}
+	return results, err
+}
+{{ end }}
+{{ end }}
+`
@@ -0,0 +1,198 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"log"
+	"path/filepath"
+
+	"github.com/sacloud/libsacloud/v2/internal/define"
+	"github.com/sacloud/libsacloud/v2/internal/dsl"
+	"github.com/sacloud/libsacloud/v2/internal/tools"
+)
+
+const destination = "sacloud/fake/server/zz_api_handlers.go"
+
+func init() {
+	log.SetFlags(0)
+	log.SetPrefix("gen-api-handler: ")
+}
+
+func main() {
+	dsl.IsOutOfSacloudPackage = true
+
+	outputPath := destination
+	tools.WriteFileWithTemplate(&tools.TemplateConfig{
+		OutputPath: filepath.Join(tools.ProjectRootPath(), outputPath),
+		Template:   tmpl,
+		Parameter:  define.APIs,
+	})
+	log.Printf("generated: %s\n", outputPath)
+}
+
+const tmpl = `// generated by 'github.com/sacloud/libsacloud/internal/tools/gen-api-handler'; DO NOT EDIT
+
+package server
+
+import (
+	"context"
+
+	"github.com/sacloud/libsacloud/v2/pkg/mapconv"
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+func init() {
+{{- range . }}{{ $typeName := .TypeName }}{{ $resource := . }}
+{{- range .Operations }}{{ $op := . }}
+	registerAPIHandlerRoute(&apiHandlerRoute{
+		resource:   "{{ $typeName }}",
+		operation:  "{{ .MethodName }}",
+		method:     "{{ .Method }}",
+		pathFormat: "{{ .GetPathFormat }}",
+		pathSuffix: "{{ $resource.GetPathSuffix }}",
+		pathName:   "{{ $resource.GetPathName }}",
+		params: map[string]string{
+			{{- range .Arguments }}{{ if $op.IsPathArgument . }}
+			"{{ .PathFormatName }}": "{{ .TypeName }}",
+			{{- end }}{{ end }}
+		},
+		{{- if .HasRequestEnvelope }}
+		envelope: func(req *apiHandlerRequest) (interface{}, error) {
+			{{ range .Arguments }}{{ if not ($op.IsPathArgument .) }}{{ .ArgName }}, {{ end }}{{ end }}err := parse{{ $typeName }}{{ .MethodName }}Request(req)
+			if err != nil {
+				return nil, err
+			}
+			return sacloud.TransformAPIRequestArgs("{{ $typeName }}", "{{ .MethodName }}"{{ range .Arguments }}, {{ if $op.IsPathArgument . }}{{ .ZeroValueOnSource }}{{ else }}{{ .ArgName }}{{ end }}{{ end }})
+		},
+		{{- end }}
+		handle: handle{{ $typeName }}{{ .MethodName }},
+	})
+{{- end }}
+{{- range .Operations }}{{ if and (eq .MethodName "Read") (eq (len .Arguments) 1) }}{{ if eq (index .Arguments 0).TypeName "types.ID" }}
+	registerAPIHandlerReader("{{ $typeName }}", func(ctx context.Context, caller sacloud.APICaller, zone string, id types.ID) error {
+		_, err := sacloud.New{{ $typeName }}Op(caller).Read(ctx{{ if not $resource.IsGlobal }}, zone{{ end }}, id)
+		return err
+	})
+{{- end }}{{ end }}{{ end }}
+{{- end }}
+}
+
+{{ range . }}{{ $typeName := .TypeName }}{{ $resource := . }}
+{{ range .Operations }}{{ $op := . }}
+func handle{{ $typeName }}{{ .MethodName }}(ctx context.Context, caller sacloud.APICaller, req *apiHandlerRequest) (interface{}, error) {
+	{{- range .Arguments }}{{ if $op.IsPathArgument . }}
+	{{- if eq .TypeName "types.ID" }}
+	{{ .ArgName }}, err := req.idParam("{{ .PathFormatName }}")
+	{{- else if eq .TypeName "int" }}
+	{{ .ArgName }}, err := req.intParam("{{ .PathFormatName }}")
+	{{- else }}
+	{{ .ArgName }}, err := req.stringParam("{{ .PathFormatName }}")
+	{{- end }}
+	if err != nil {
+		return nil, err
+	}
+	{{- end }}{{ end }}
+	{{- if .HasRequestEnvelope }}
+	{{- if .HasNonPathArguments }}
+	{{ range .Arguments }}{{ if not ($op.IsPathArgument .) }}{{ .ArgName }}, {{ end }}{{ end }}err := parse{{ $typeName }}{{ .MethodName }}Request(req)
+	if err != nil {
+		return nil, err
+	}
+	{{- else }}
+	if err := parse{{ $typeName }}{{ .MethodName }}Request(req); err != nil {
+		return nil, err
+	}
+	{{- end }}
+	{{- end }}
+
+	{{ if .HasResults -}}
+	{{ range .ResultsTypeInfo }}{{ .VarName }}, {{ end }}err := sacloud.New{{ $typeName }}Op(caller).{{ .MethodName }}(ctx{{ if not $resource.IsGlobal }}, req.zone{{ end }}{{ range .Arguments }}, {{ .ArgName }}{{ end }})
+	if err != nil {
+		return nil, err
+	}
+	{{- else -}}
+	if err := sacloud.New{{ $typeName }}Op(caller).{{ .MethodName }}(ctx{{ if not $resource.IsGlobal }}, req.zone{{ end }}{{ range .Arguments }}, {{ .ArgName }}{{ end }}); err != nil {
+		return nil, err
+	}
+	{{- end }}
+	{{- if .HasResponseEnvelope }}
+
+	{{ if .UseWrappedResult -}}
+	results := result
+	{{- else -}}
+	results := &struct {
+		IsOk bool
+		{{- range .Results }}
+		{{ .DestField }} {{ .GoTypeSourceCode }} {{ .TagString }}
+		{{- end }}
+	}{
+		IsOk: true,
+		{{- range .ResultsTypeInfo }}
+		{{ .FieldName }}: {{ .VarName }},
+		{{- end }}
+	}
+	{{- end }}
+	v, err := sacloud.NewAPIResponseEnvelope("{{ $typeName }}", "{{ .MethodName }}")
+	if err != nil {
+		return nil, err
+	}
+	if err := mapconv.ConvertTo(results, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+	{{- else }}
+	return nil, nil
+	{{- end }}
+}
+{{ if .HasRequestEnvelope }}
+func parse{{ $typeName }}{{ .MethodName }}Request(req *apiHandlerRequest) ({{ range .Arguments }}{{ if not ($op.IsPathArgument .) }}{{ .ArgName }} {{ .TypeName }}, {{ end }}{{ end }}err error) {
+	envelope, err := sacloud.NewAPIRequestEnvelope("{{ $typeName }}", "{{ .MethodName }}")
+	if err != nil {
+		return {{ range .Arguments }}{{ if not ($op.IsPathArgument .) }}{{ .ZeroValueOnSource }}, {{ end }}{{ end }}err
+	}
+	if err := req.decode(envelope); err != nil {
+		return {{ range .Arguments }}{{ if not ($op.IsPathArgument .) }}{{ .ZeroValueOnSource }}, {{ end }}{{ end }}err
+	}
+	{{- range .Arguments }}{{ if .IsPassthrough }}
+
+	{{ .ArgName }} = {{ .ZeroInitializer }}
+	if err := mapconv.ConvertFrom(envelope, {{ .ArgName }}); err != nil {
+		return {{ range $op.Arguments }}{{ if not ($op.IsPathArgument .) }}{{ .ZeroValueOnSource }}, {{ end }}{{ end }}err
+	}
+	{{- end }}{{ end }}
+	{{- if .HasMappableArguments }}
+
+	args := &struct {
+		{{- range $i, $v := .Arguments }}{{ if and (not ($op.IsPathArgument .)) (not .IsPassthrough) }}
+		Arg{{ $i }} {{ .TypeName }} {{ .MapConvTagSrc }}
+		{{- end }}{{ end }}
+	}{}
+	if err := mapconv.ConvertFrom(envelope, args); err != nil {
+		return {{ range .Arguments }}{{ if not ($op.IsPathArgument .) }}{{ .ZeroValueOnSource }}, {{ end }}{{ end }}err
+	}
+	{{- range $i, $v := .Arguments }}{{ if and (not ($op.IsPathArgument .)) (not .IsPassthrough) }}
+	{{ .ArgName }} = args.Arg{{ $i }}
+	if {{ .ArgName }} == {{ .ZeroValueOnSource }} {
+		{{ .ArgName }} = {{ .ZeroInitializer }}
+	}
+	{{- end }}{{ end }}
+	{{- end }}
+	return {{ range .Arguments }}{{ if not ($op.IsPathArgument .) }}{{ .ArgName }}, {{ end }}{{ end }}nil
+}
+{{ end -}}
+{{ end -}}
+{{ end -}}
+`
@@ -0,0 +1,103 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"log"
+	"path/filepath"
+
+	"github.com/sacloud/libsacloud/v2/internal/define"
+	"github.com/sacloud/libsacloud/v2/internal/dsl"
+	"github.com/sacloud/libsacloud/v2/internal/tools"
+)
+
+const destination = "sacloud/mock/zz_api_mocks.go"
+
+func init() {
+	log.SetFlags(0)
+	log.SetPrefix("gen-api-mock: ")
+}
+
+func main() {
+	dsl.IsOutOfSacloudPackage = true
+
+	tools.WriteFileWithTemplate(&tools.TemplateConfig{
+		OutputPath: filepath.Join(tools.ProjectRootPath(), destination),
+		Template:   tmpl,
+		Parameter:  define.APIs,
+	})
+	log.Printf("generated: %s\n", filepath.Join(destination))
+}
+
+const tmpl = `// generated by 'github.com/sacloud/libsacloud/internal/tools/gen-api-mock'; DO NOT EDIT
+
+package mock
+
+import (
+{{- range .ImportStatements "context" }}
+	{{ . }}
+{{- end }}
+)
+
+{{ range . }} {{ $typeName := .TypeName }}{{ $resource := . }}
+/*************************************************
+* {{ $typeName }}Mock
+*************************************************/
+
+// {{ $typeName }}Mock is a mock of sacloud.{{ $typeName }}API
+type {{ $typeName }}Mock struct {
+	ctrl     *Controller
+	recorder *{{ $typeName }}MockRecorder
+}
+
+var _ sacloud.{{ $typeName }}API = (*{{ $typeName }}Mock)(nil)
+
+// {{ $typeName }}MockRecorder records expected calls of {{ $typeName }}Mock
+type {{ $typeName }}MockRecorder struct {
+	mock *{{ $typeName }}Mock
+}
+
+// New{{ $typeName }}Mock creates new {{ $typeName }}Mock instance
+func New{{ $typeName }}Mock(ctrl *Controller) *{{ $typeName }}Mock {
+	mock := &{{ $typeName }}Mock{ctrl: ctrl}
+	mock.recorder = &{{ $typeName }}MockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns the recorder to define expected calls
+func (m *{{ $typeName }}Mock) EXPECT() *{{ $typeName }}MockRecorder {
+	return m.recorder
+}
+
+{{ range .Operations }}
+// {{ .MethodName }} is a mock of sacloud.{{ $typeName }}API.{{ .MethodName }}
+func (m *{{ $typeName }}Mock) {{ .MethodName }}(ctx context.Context{{if not $resource.IsGlobal}}, zone string{{end}}{{ range .Arguments }}, {{ .ArgName }} {{ .TypeName }}{{ end }}) {{.ResultsStatement}} {
+	m.ctrl.t.Helper()
+	rets := m.ctrl.Call(m, "{{ .MethodName }}", ctx{{if not $resource.IsGlobal}}, zone{{end}}{{ range .Arguments }}, {{ .ArgName }}{{ end }})
+	{{ range $i, $r := .ResultsTypeInfo -}}
+	{{ .VarName }}, _ := rets[{{ $i }}].({{ .Type.GoTypeSourceCode }})
+	{{ end -}}
+	err, _ := rets[{{ len .ResultsTypeInfo }}].(error)
+	return {{ range .ResultsTypeInfo }}{{ .VarName }}, {{ end }}err
+}
+
+// {{ .MethodName }} records an expected call of {{ .MethodName }}
+func (r *{{ $typeName }}MockRecorder) {{ .MethodName }}(ctx{{if not $resource.IsGlobal}}, zone{{end}}{{ range .Arguments }}, {{ .ArgName }}{{ end }} interface{}) *Call {
+	r.mock.ctrl.t.Helper()
+	return r.mock.ctrl.RecordCall(r.mock, "{{ .MethodName }}", ctx{{if not $resource.IsGlobal}}, zone{{end}}{{ range .Arguments }}, {{ .ArgName }}{{ end }})
+}
+{{ end }}
+{{ end }}
+`
@@ -53,7 +53,7 @@ var apiLocker = mutexkv.NewMutexKV()
 
 func init() {
 {{ range . }}
-	SetClientFactoryFunc("{{.TypeName}}", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("{{.TypeName}}", func(caller APICaller) interface{} {
 		return &{{ .TypeName }}Op {
 			Client: caller,
 			PathSuffix: "{{.GetPathSuffix}}",
@@ -81,7 +81,13 @@ type {{ .TypeName }}Op struct{
 
 // New{{ $typeName}}Op creates new {{ $typeName}}Op instance
 func New{{ $typeName}}Op(caller APICaller) {{ $typeName}}API {
-	return GetClientFactoryFunc("{{$typeName}}")(caller).({{$typeName}}API)
+	return getClientFactoryFuncFor(caller, "{{$typeName}}")(caller).({{$typeName}}API)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *{{ $typeName }}Op) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 {{ range .Operations }}{{$returnErrStatement := .ReturnErrorStatement}}{{ $operationName := .MethodName }}
@@ -56,13 +56,18 @@ var initOnce sync.Once
 // AddClientFactoryHooks add client factory hooks
 func AddClientFactoryHooks() {
 	initOnce.Do(func(){
-		addClientFactoryHooks()
+		addClientFactoryHooks(sacloud.AddClientFacotyHookFunc)
 	})
 }
 
-func addClientFactoryHooks() {
+// AddClientFactoryHooksToRegistry add client factory hooks to the registry
+func AddClientFactoryHooksToRegistry(registry *sacloud.ClientRegistry) {
+	addClientFactoryHooks(registry.AddClientFactoryHookFunc)
+}
+
+func addClientFactoryHooks(add func(resourceName string, hookFunc func(interface{}) interface{})) {
 {{ range . -}} 
-	sacloud.AddClientFacotyHookFunc("{{.TypeName}}", func(in interface{}) interface{} {
+	add("{{.TypeName}}", func(in interface{}) interface{} {
 		return New{{.TypeName}}Tracer(in.(sacloud.{{.TypeName}}API))
 	})
 {{ end -}}
@@ -85,6 +90,12 @@ func New{{ $typeName}}Tracer(in sacloud.{{$typeName}}API) sacloud.{{$typeName}}A
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *{{ $typeName }}Tracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 {{ range .Operations }}{{$returnErrStatement := .ReturnErrorStatement}}{{ $operationName := .MethodName }}
 // {{ .MethodName }} is API call with trace log
 func (t *{{ $typeName }}Tracer) {{ .MethodName }}(ctx context.Context{{if not $resource.IsGlobal}}, zone string{{end}}{{ range .Arguments }}, {{ .ArgName }} {{ .TypeName }}{{ end }}) {{.ResultsStatement}} {
@@ -96,4 +96,25 @@ func (o *{{ $typeName }}Op) transform{{.MethodName}}Results(data []byte) (*{{.Re
 {{ end -}}
 {{ end -}}
 {{ end -}}
+
+var apiEnvelopeTransformers = map[string]*apiEnvelopeTransformer{
+{{- range . }}{{ $typeName := .TypeName }}
+{{- range .Operations }}{{ if or .HasRequestEnvelope .HasResponseEnvelope }}
+	"{{ $typeName }}.{{ .MethodName }}": {
+		{{- if .HasRequestEnvelope }}
+		newRequest: func() interface{} { return &{{ .RequestEnvelopeStructName }}{} },
+		numArgs:    {{ len .Arguments }},
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			{{- range $i, $v := .Arguments }}
+			arg{{ $i }}, _ := args[{{ $i }}].({{ .TypeName }})
+			{{- end }}
+			return (&{{ $typeName }}Op{}).transform{{ .MethodName }}Args({{ range $i, $v := .Arguments }}arg{{ $i }}, {{ end }})
+		},
+		{{- end }}
+		{{- if .HasResponseEnvelope }}
+		newResponse: func() interface{} { return &{{ .ResponseEnvelopeStructName }}{} },
+		{{- end }}
+	},
+{{- end }}{{ end }}{{ end }}
+}
 `
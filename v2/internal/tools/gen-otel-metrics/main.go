@@ -0,0 +1,101 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"log"
+	"path/filepath"
+
+	"github.com/sacloud/libsacloud/v2/internal/define"
+	"github.com/sacloud/libsacloud/v2/internal/dsl"
+	"github.com/sacloud/libsacloud/v2/internal/tools"
+)
+
+const destination = "sacloud/trace/otel/zz_api_metrics.go"
+
+func init() {
+	log.SetFlags(0)
+	log.SetPrefix("gen-otel-metrics: ")
+}
+
+func main() {
+	dsl.IsOutOfSacloudPackage = true
+
+	tools.WriteFileWithTemplate(&tools.TemplateConfig{
+		OutputPath: filepath.Join(tools.ProjectRootPath(), destination),
+		Template:   tmpl,
+		Parameter:  define.APIs,
+	})
+	log.Printf("generated: %s\n", filepath.Join(destination))
+}
+
+const tmpl = `// generated by 'github.com/sacloud/libsacloud/internal/tools/gen-otel-metrics'; DO NOT EDIT
+
+package otel
+
+import (
+{{- range .ImportStatements "context" "time" }}
+	{{ . }}
+{{- end }}
+)
+
+func addMetricsClientFactoryHooks(add func(resourceName string, hookFunc func(interface{}) interface{}), m *metrics) {
+{{ range . -}}
+	add("{{.TypeName}}", func(in interface{}) interface{} {
+		return new{{.TypeName}}MetricsRecorder(in.(sacloud.{{.TypeName}}API), m)
+	})
+{{ end -}}
+}
+
+{{ range . }} {{$typeName := .TypeName}} {{ $resource := . }}
+/*************************************************
+* {{ $typeName }}MetricsRecorder
+*************************************************/
+
+// {{ $typeName }}MetricsRecorder is for record metrics of {{ $typeName }}Op operations
+type {{ $typeName }}MetricsRecorder struct {
+	Internal sacloud.{{$typeName}}API
+	metrics *metrics
+}
+
+// new{{ $typeName}}MetricsRecorder creates new {{ $typeName}}MetricsRecorder instance
+func new{{ $typeName}}MetricsRecorder(in sacloud.{{$typeName}}API, m *metrics) sacloud.{{$typeName}}API {
+	return &{{ $typeName}}MetricsRecorder {
+		Internal: in,
+		metrics: m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *{{ $typeName }}MetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+{{ range .Operations }}{{ $operationName := .MethodName }}
+// {{ .MethodName }} is API call with metrics
+func (t *{{ $typeName }}MetricsRecorder) {{ .MethodName }}(ctx context.Context{{if not $resource.IsGlobal}}, zone string{{end}}{{ range .Arguments }}, {{ .ArgName }} {{ .TypeName }}{{ end }}) {{.ResultsStatement}} {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "{{ $typeName }}API", "{{ .MethodName }}", {{if $resource.IsGlobal}}""{{else}}zone{{end}})
+
+	{{range .ResultsTypeInfo}}{{.VarName}}, {{end}}err := t.Internal.{{ .MethodName }}(ctx{{if not $resource.IsGlobal}}, zone{{end}}{{ range .Arguments }}, {{ .ArgName }}{{ end }})
+
+	t.metrics.record(ctx, startedAt, err)
+	return {{range .ResultsTypeInfo}}{{.VarName}}, {{end}}err
+}
+{{- end -}}
+
+{{ end }}
+`
@@ -55,9 +55,9 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
-func addClientFactoryHooks(cnf *config) {
+func addClientFactoryHooks(add func(resourceName string, hookFunc func(interface{}) interface{}), cnf *config) {
 {{ range . -}} 
-	sacloud.AddClientFacotyHookFunc("{{.TypeName}}", func(in interface{}) interface{} {
+	add("{{.TypeName}}", func(in interface{}) interface{} {
 		return new{{.TypeName}}Tracer(in.(sacloud.{{.TypeName}}API), cnf)
 	})
 {{ end -}}
@@ -82,6 +82,12 @@ func new{{ $typeName}}Tracer(in sacloud.{{$typeName}}API, cnf *config) sacloud.{
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *{{ $typeName }}Tracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 {{ range .Operations }}{{$returnErrStatement := .ReturnErrorStatement}}{{ $operationName := .MethodName }}
 // {{ .MethodName }} is API call with trace log
 func (t *{{ $typeName }}Tracer) {{ .MethodName }}(ctx context.Context{{if not $resource.IsGlobal}}, zone string{{end}}{{ range .Arguments }}, {{ .ArgName }} {{ .TypeName }}{{ end }}) {{.ResultsStatement}} {
@@ -170,6 +170,7 @@ func (d *Decoder) ConvertTo(source interface{}, dest interface{}) error {
 		WeaklyTypedInput: true,
 		Result:           dest,
 		ZeroFields:       true,
+		DecodeHook:       structToMapHookFunc,
 	}
 	decoder, err := mapstructure.NewDecoder(config)
 	if err != nil {
@@ -273,6 +274,23 @@ func (d *Decoder) ConvertFrom(source interface{}, dest interface{}) error {
 	return decoder.Decode(destMap.Map())
 }
 
+// structToMapHookFunc 異なる型のstruct間で変換する際に、time.Timeなどの公開フィールドを持たない値を保ったままmapへ変換する
+//
+// mapstructureではstruct間の変換時にネストしたstructもmapへ変換するため、time.Timeの値が失われる。
+// このためConvertFromと同じくstructs.Mapを用いてmapに変換する
+func structToMapHookFunc(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+	if from.Kind() == reflect.Ptr {
+		from = from.Elem()
+	}
+	if from.Kind() != reflect.Struct || to.Kind() != reflect.Struct || from == to {
+		return data, nil
+	}
+	if reflect.ValueOf(data).Kind() == reflect.Ptr && reflect.ValueOf(data).IsNil() {
+		return data, nil
+	}
+	return structs.Map(data), nil
+}
+
 // ConvertTo converts struct which input by mapconv to plain models
 func ConvertTo(source interface{}, dest interface{}) error {
 	decoder := &Decoder{Config: &DecoderConfig{TagName: DefaultMapConvTag}}
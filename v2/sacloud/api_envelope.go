@@ -0,0 +1,61 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sacloud
+
+import "fmt"
+
+// apiEnvelopeTransformer 操作ごとのリクエスト/レスポンスエンベロープの生成/変換処理
+type apiEnvelopeTransformer struct {
+	newRequest    func() interface{}
+	newResponse   func() interface{}
+	numArgs       int
+	transformArgs func(args []interface{}) (interface{}, error)
+}
+
+// NewAPIRequestEnvelope 指定のリソース/操作のリクエストエンベロープ(APIリクエストのボディを表すnaked型)の空の値を返す
+//
+// APIリクエストを受け付けるサーバ側(fake/serverなど)でのリクエストボディのデコードに利用する
+func NewAPIRequestEnvelope(resourceName, operationName string) (interface{}, error) {
+	t, ok := apiEnvelopeTransformers[resourceName+"."+operationName]
+	if !ok || t.newRequest == nil {
+		return nil, fmt.Errorf("request envelope for %s.%s is not found", resourceName, operationName)
+	}
+	return t.newRequest(), nil
+}
+
+// NewAPIResponseEnvelope 指定のリソース/操作のレスポンスエンベロープ(APIレスポンスのボディを表すnaked型)の空の値を返す
+//
+// APIリクエストを受け付けるサーバ側(fake/serverなど)でのレスポンスボディのエンコードに利用する
+func NewAPIResponseEnvelope(resourceName, operationName string) (interface{}, error) {
+	t, ok := apiEnvelopeTransformers[resourceName+"."+operationName]
+	if !ok || t.newResponse == nil {
+		return nil, fmt.Errorf("response envelope for %s.%s is not found", resourceName, operationName)
+	}
+	return t.newResponse(), nil
+}
+
+// TransformAPIRequestArgs 指定のリソース/操作の引数(zoneを除く)をリクエストエンベロープに変換する
+//
+// 引数のデフォルト値の設定を含め、XxxOpがAPIリクエスト時に行うものと同じ変換を行う
+func TransformAPIRequestArgs(resourceName, operationName string, args ...interface{}) (interface{}, error) {
+	t, ok := apiEnvelopeTransformers[resourceName+"."+operationName]
+	if !ok || t.transformArgs == nil {
+		return nil, fmt.Errorf("request envelope for %s.%s is not found", resourceName, operationName)
+	}
+	if len(args) != t.numArgs {
+		return nil, fmt.Errorf("%s.%s requires %d arguments, but got %d", resourceName, operationName, t.numArgs, len(args))
+	}
+	return t.transformArgs(args)
+}
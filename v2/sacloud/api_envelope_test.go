@@ -0,0 +1,51 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sacloud
+
+import (
+	"testing"
+
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIEnvelope(t *testing.T) {
+	t.Run("request and response envelopes", func(t *testing.T) {
+		req, err := NewAPIRequestEnvelope("Server", "Create")
+		require.NoError(t, err)
+		require.IsType(t, &serverCreateRequestEnvelope{}, req)
+
+		res, err := NewAPIResponseEnvelope("Server", "Create")
+		require.NoError(t, err)
+		require.IsType(t, &serverCreateResponseEnvelope{}, res)
+
+		_, err = NewAPIRequestEnvelope("Server", "Read")
+		require.EqualError(t, err, "request envelope for Server.Read is not found")
+
+		_, err = NewAPIResponseEnvelope("Server", "Unknown")
+		require.EqualError(t, err, "response envelope for Server.Unknown is not found")
+	})
+
+	t.Run("transform args", func(t *testing.T) {
+		v, err := TransformAPIRequestArgs("Server", "Update", types.ID(1), &ServerUpdateRequest{Name: "example"})
+		require.NoError(t, err)
+		envelope, ok := v.(*serverUpdateRequestEnvelope)
+		require.True(t, ok)
+		require.Equal(t, "example", envelope.Server.Name)
+
+		_, err = TransformAPIRequestArgs("Server", "Update", &ServerUpdateRequest{})
+		require.EqualError(t, err, "Server.Update requires 2 arguments, but got 1")
+	})
+}
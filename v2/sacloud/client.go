@@ -69,6 +69,8 @@ type APICaller interface {
 // リトライ間隔はRetryMinからRetryMaxまで指数的に増加する(Exponential Backoff)
 //
 // リトライ時にcontext.Canceled、またはcontext.DeadlineExceededの場合はリトライしない
+//
+// RetryPolicyを指定した場合、またはWithRetryPolicyでcontextにRetryPolicyを設定した場合はそちらに従ってリトライする
 type Client struct {
 	// AccessToken アクセストークン
 	AccessToken string `validate:"required"`
@@ -88,6 +90,19 @@ type Client struct {
 	RetryWaitMax time.Duration
 	// APIコール時に利用される*http.Client 未指定の場合http.DefaultClientが利用される
 	HTTPClient *http.Client
+	// リトライポリシー 指定した場合はRetryMax/RetryWaitMin/RetryWaitMaxの代わりに利用される
+	RetryPolicy *RetryPolicy
+	// Doの前後に処理を挟むためのMiddleware 先頭の要素から順に呼ばれる
+	Middlewares []Middleware
+	// NewXxxOp(caller)でのクライアントファクトリーやポーリング設定の解決に利用されるClientRegistry
+	//
+	// 未指定の場合はパッケージレベルの設定が利用される
+	Registry *ClientRegistry
+}
+
+// ClientRegistry ClientRegistryHolderの実装
+func (c *Client) ClientRegistry() *ClientRegistry {
+	return c.Registry
 }
 
 // NewClient APIクライアント作成
@@ -140,7 +155,16 @@ func (c *Client) newRequest(ctx context.Context, method, uri string, body interf
 			bodyReader = bytes.NewReader(bodyJSON)
 		}
 	}
-	return http.NewRequestWithContext(ctx, method, url, bodyReader)
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range RequestHeaderFromContext(ctx) {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+	return req, nil
 }
 
 func (c *Client) apiClient() *sacloudhttp.Client {
@@ -161,31 +185,91 @@ func (c *Client) apiClient() *sacloudhttp.Client {
 
 // Do APIコール実施
 func (c *Client) Do(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
+	if len(c.Middlewares) > 0 {
+		return chainMiddlewares(c.doWithRetry, c.Middlewares...)(ctx, method, uri, body)
+	}
+	return c.doWithRetry(ctx, method, uri, body)
+}
+
+func (c *Client) doWithRetry(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
+	policy := c.RetryPolicy
+	if p, ok := RetryPolicyFromContext(ctx); ok {
+		policy = p
+	}
+	if policy == nil {
+		data, _, err := c.do(ctx, c.apiClient(), method, uri, body)
+		return data, err
+	}
+	return c.doWithRetryPolicy(ctx, policy, method, uri, body)
+}
+
+func (c *Client) doWithRetryPolicy(ctx context.Context, policy *RetryPolicy, method, uri string, body interface{}) ([]byte, error) {
+	// リトライはこちらで行うためgo-http側ではリトライしない
+	client := c.apiClient()
+	client.CheckRetryFunc = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		return false, ctx.Err()
+	}
+
+	for attempt := 0; ; attempt++ {
+		data, header, err := c.do(ctx, client, method, uri, body)
+		if err == nil {
+			return data, nil
+		}
+		if attempt >= policy.retryMax() || !policy.shouldRetry(ctx, method, err) {
+			return nil, err
+		}
+
+		wait := policy.wait(attempt, header)
+		if policy.OnRetry != nil {
+			event := &RetryEvent{
+				Method:  method,
+				URL:     uri,
+				Attempt: attempt + 1,
+				Wait:    wait,
+				Err:     err,
+			}
+			if apiErr, ok := AsAPIError(err); ok {
+				event.StatusCode = apiErr.ResponseCode()
+			}
+			policy.OnRetry(ctx, event)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (c *Client) do(ctx context.Context, client *sacloudhttp.Client, method, uri string, body interface{}) ([]byte, http.Header, error) {
 	req, err := c.newRequest(ctx, method, uri, body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// API call
-	resp, err := c.apiClient().Do(req)
+	resp, err := client.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer resp.Body.Close() // nolint - ignore error
 
 	data, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return nil, resp.Header, err
 	}
 
 	if !c.isOkStatus(resp.StatusCode) {
 		errResponse := &APIErrorResponse{}
 		err := json.Unmarshal(data, errResponse)
 		if err != nil {
-			return nil, fmt.Errorf("error in response: %s", string(data))
+			return nil, resp.Header, fmt.Errorf("error in response: %s", string(data))
 		}
-		return nil, NewAPIError(req.Method, req.URL, resp.StatusCode, errResponse)
+		return nil, resp.Header, NewAPIError(req.Method, req.URL, resp.StatusCode, errResponse)
 	}
 
-	return data, nil
+	return data, resp.Header, nil
 }
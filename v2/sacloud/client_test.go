@@ -124,3 +124,142 @@ func TestClient_RetryByStatusCode(t *testing.T) {
 			"got unexpected retry status with status[%d]: expected:%t got:%t", tt.responseCode, tt.shouldRetry, h.isRetried())
 	}
 }
+
+type retryTestContextKey struct{}
+
+func TestClient_Do_WithRetryPolicy(t *testing.T) {
+	t.Run("retry on 409 still_creating and 429 with events", func(t *testing.T) {
+		called := 0
+		dummyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called++
+			switch called {
+			case 1:
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte(`{"is_fatal":false,"error_code":"still_creating"}`)) // nolint
+			case 2:
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write([]byte(`{}`)) // nolint
+			default:
+				w.Write([]byte(`{}`)) // nolint
+			}
+		}))
+		defer dummyServer.Close()
+
+		var events []*RetryEvent
+		client := &Client{
+			RetryPolicy: &RetryPolicy{
+				RetryMax:               2,
+				RetryWaitMin:           time.Millisecond,
+				RetryWaitMax:           time.Millisecond,
+				JitterFactor:           0.5,
+				RetryOnStillCreating:   true,
+				RetryOnTooManyRequests: true,
+				OnRetry: func(ctx context.Context, event *RetryEvent) {
+					events = append(events, event)
+				},
+			},
+		}
+		_, err := client.Do(context.Background(), http.MethodGet, dummyServer.URL, nil)
+		require.NoError(t, err)
+		require.Equal(t, 3, called)
+		require.Len(t, events, 2)
+		require.Equal(t, http.StatusConflict, events[0].StatusCode)
+		require.True(t, IsStillCreatingError(events[0].Err))
+		require.Equal(t, http.StatusTooManyRequests, events[1].StatusCode)
+		require.Equal(t, time.Duration(0), events[1].Wait)
+	})
+
+	t.Run("per-call override with context", func(t *testing.T) {
+		h := &dummyHandler{responseCode: http.StatusServiceUnavailable}
+		dummyServer := httptest.NewServer(h)
+		defer dummyServer.Close()
+
+		client := &Client{RetryPolicy: &RetryPolicy{RetryMax: 3, RetryWaitMin: time.Millisecond, RetryWaitMax: time.Millisecond}}
+		ctx := WithRetryPolicy(context.Background(), &RetryPolicy{RetryMax: -1})
+
+		_, err := client.Do(ctx, http.MethodGet, dummyServer.URL, nil)
+		require.Error(t, err)
+		require.False(t, h.isRetried())
+	})
+
+	t.Run("connection error on GET", func(t *testing.T) {
+		dummyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		url := dummyServer.URL
+		dummyServer.Close()
+
+		retried := 0
+		policy := &RetryPolicy{
+			RetryMax:               1,
+			RetryWaitMin:           time.Millisecond,
+			RetryWaitMax:           time.Millisecond,
+			RetryOnConnectionError: true,
+			OnRetry: func(ctx context.Context, event *RetryEvent) {
+				retried++
+				require.Equal(t, "value", ctx.Value(retryTestContextKey{}))
+			},
+		}
+		client := &Client{RetryPolicy: policy}
+		ctx := context.WithValue(context.Background(), retryTestContextKey{}, "value")
+
+		_, err := client.Do(ctx, http.MethodGet, url, nil)
+		require.Error(t, err)
+		require.Equal(t, 1, retried)
+
+		_, err = client.Do(ctx, http.MethodPost, url, nil)
+		require.Error(t, err)
+		require.Equal(t, 1, retried, "don't retry non-idempotent requests")
+	})
+}
+
+func TestClient_Do_Middlewares(t *testing.T) {
+	var requestIDs []string
+	dummyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestIDs = append(requestIDs, r.Header.Get("X-Request-ID"))
+		w.Write([]byte(`{"Success":true}`)) // nolint
+	}))
+	defer dummyServer.Close()
+
+	var logs []string
+	logger := func(name string) Middleware {
+		return func(next DoFunc) DoFunc {
+			return func(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
+				logs = append(logs, name+":before:"+method)
+				data, err := next(ctx, method, uri, body)
+				logs = append(logs, name+":after:"+string(data))
+				return data, err
+			}
+		}
+	}
+	requestID := func(next DoFunc) DoFunc {
+		return func(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
+			return next(WithRequestHeader(ctx, "X-Request-ID", "id-1"), method, uri, body)
+		}
+	}
+	blockMutation := func(next DoFunc) DoFunc {
+		return func(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
+			if method != http.MethodGet {
+				return []byte(`{"DryRun":true}`), nil
+			}
+			return next(ctx, method, uri, body)
+		}
+	}
+
+	client := &Client{Middlewares: []Middleware{logger("outer"), requestID, blockMutation, logger("inner")}}
+
+	_, err := client.Do(context.Background(), http.MethodGet, dummyServer.URL, nil)
+	require.NoError(t, err)
+	data, err := client.Do(context.Background(), http.MethodDelete, dummyServer.URL, nil)
+	require.NoError(t, err)
+	require.Equal(t, `{"DryRun":true}`, string(data))
+
+	require.Equal(t, []string{"id-1"}, requestIDs)
+	require.Equal(t, []string{
+		"outer:before:GET",
+		"inner:before:GET",
+		`inner:after:{"Success":true}`,
+		`outer:after:{"Success":true}`,
+		"outer:before:DELETE",
+		`outer:after:{"DryRun":true}`,
+	}, logs)
+}
@@ -19,19 +19,12 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // IsNotFoundError 指定のerrorがAPI呼び出し時の404エラーであるか判定
 func IsNotFoundError(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	if apiError, ok := err.(APIError); ok {
-		return apiError.ResponseCode() == http.StatusNotFound
-	}
-
-	return false
+	return errors.Is(err, ErrNotFound)
 }
 
 // IsNoResultsError 指定のerrorがNoResultErrorであるか判定
@@ -40,21 +33,126 @@ func IsNoResultsError(err error) bool {
 		return false
 	}
 
-	_, ok := err.(*NoResultsError)
-	return ok
+	var noResultsErr *NoResultsError
+	return errors.As(err, &noResultsErr)
 }
 
 // IsStillCreatingError 指定のerrorがAPI呼び出し時の409エラー、かつエラーコードがstill_creatingであるか判定
 func IsStillCreatingError(err error) bool {
-	if err == nil {
-		return false
-	}
+	return errors.Is(err, ErrStillCreating)
+}
+
+// IsRetryableError 指定のerrorがAPI呼び出し時のエラーのうち、時間をおいて再実行することで成功する可能性があるものか判定
+//
+// APIエラーレスポンスのis_fatalがtrueの場合はfalseを返す
+func IsRetryableError(err error) bool {
+	return errors.Is(err, ErrRetryable)
+}
 
-	if apiError, ok := err.(APIError); ok {
-		return apiError.ResponseCode() == http.StatusConflict && apiError.Code() == "still_creating"
+// IsFatalError 指定のerrorがAPI呼び出し時のエラーのうち、APIエラーレスポンスのis_fatalがtrueであるものか判定
+func IsFatalError(err error) bool {
+	return errors.Is(err, ErrFatal)
+}
+
+// AsAPIError 指定のerror、またはそれがラップしているerrorからAPIErrorを取り出す
+func AsAPIError(err error) (APIError, bool) {
+	var apiErr APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
 	}
+	return nil, false
+}
 
-	return false
+// APIErrorKind APIエラーの分類
+//
+// errors.Isでの判定に利用する。
+//
+//	if errors.Is(err, sacloud.ErrLocked) {
+//	    // リトライ処理など
+//	}
+//
+// 1つのAPIエラーが複数の分類に該当することもある(例: ErrStillCreatingはErrConflictとErrRetryableにも該当する)
+type APIErrorKind struct {
+	name string
+}
+
+// Error errorインターフェース
+func (k *APIErrorKind) Error() string {
+	return k.name
+}
+
+var (
+	// ErrBadRequest リクエスト内容が不正(400)
+	ErrBadRequest = &APIErrorKind{name: "bad request"}
+	// ErrValidation リクエストパラメータの検証エラー(エラーコードがbad_request/unknown_paramなど)
+	ErrValidation = &APIErrorKind{name: "validation"}
+	// ErrUnauthorized 認証エラー(401)
+	ErrUnauthorized = &APIErrorKind{name: "unauthorized"}
+	// ErrForbidden 権限エラー(403)
+	ErrForbidden = &APIErrorKind{name: "forbidden"}
+	// ErrNotFound 対象リソースが存在しない(404)
+	ErrNotFound = &APIErrorKind{name: "not found"}
+	// ErrMethodNotAllowed 許可されていないメソッド(405)
+	ErrMethodNotAllowed = &APIErrorKind{name: "method not allowed"}
+	// ErrConflict リソースの状態が競合している(409)
+	ErrConflict = &APIErrorKind{name: "conflict"}
+	// ErrStillCreating リソースが作成中(409エラー、かつエラーコードがstill_creating)
+	ErrStillCreating = &APIErrorKind{name: "still creating"}
+	// ErrLocked リソースがロックされている(423、またはエラーコードがbusy/lockなど)
+	ErrLocked = &APIErrorKind{name: "locked"}
+	// ErrTooManyRequests リクエスト過多(429)
+	ErrTooManyRequests = &APIErrorKind{name: "too many requests"}
+	// ErrLimitExceeded リソース数などの上限超過(エラーコードがlimit_count_in_zone_exceededなど)
+	ErrLimitExceeded = &APIErrorKind{name: "limit exceeded"}
+	// ErrServerError APIサーバ側エラー(5xx)
+	ErrServerError = &APIErrorKind{name: "server error"}
+	// ErrMaintenance メンテナンス中などによりサービスが利用できない(503、またはエラーコードがservice_temporarily_unavailableなど)
+	ErrMaintenance = &APIErrorKind{name: "maintenance"}
+	// ErrFatal APIエラーレスポンスのis_fatalがtrue
+	ErrFatal = &APIErrorKind{name: "fatal"}
+	// ErrRetryable 時間をおいて再実行することで成功する可能性がある(is_fatalがfalse、かつErrLocked/ErrTooManyRequests/ErrStillCreating/ErrMaintenanceのいずれか)
+	ErrRetryable = &APIErrorKind{name: "retryable"}
+)
+
+// APIErrorKinds 全てのAPIErrorKindのリスト
+var APIErrorKinds = []*APIErrorKind{
+	ErrBadRequest,
+	ErrValidation,
+	ErrUnauthorized,
+	ErrForbidden,
+	ErrNotFound,
+	ErrMethodNotAllowed,
+	ErrConflict,
+	ErrStillCreating,
+	ErrLocked,
+	ErrTooManyRequests,
+	ErrLimitExceeded,
+	ErrServerError,
+	ErrMaintenance,
+	ErrFatal,
+	ErrRetryable,
+}
+
+// apiErrorCodeKinds エラーコードごとの分類
+//
+// さくらのクラウドAPIのドキュメントに記載されているエラーコードのうち、レスポンスコードだけでは分類できないもの
+// (still_creatingは409の場合のみErrStillCreatingとなるためKinds()で個別に判定する)
+var apiErrorCodeKinds = map[string][]*APIErrorKind{
+	"bad_request":                      {ErrValidation},
+	"invalid_format":                   {ErrValidation},
+	"invalid_param_comb":               {ErrValidation},
+	"unknown_param":                    {ErrValidation},
+	"busy":                             {ErrLocked},
+	"lock":                             {ErrLocked},
+	"lock_by_other":                    {ErrLocked},
+	"limit_count_in_account_exceeded":  {ErrLimitExceeded},
+	"limit_count_in_zone_exceeded":     {ErrLimitExceeded},
+	"limit_count_in_network_exceeded":  {ErrLimitExceeded},
+	"limit_count_in_member_exceeded":   {ErrLimitExceeded},
+	"limit_memory_in_account_exceeded": {ErrLimitExceeded},
+	"limit_size_in_account_exceeded":   {ErrLimitExceeded},
+	"service_temporarily_unavailable":  {ErrMaintenance},
+	"maintenance":                      {ErrMaintenance},
 }
 
 // NoResultsError APIが返した応答に処理すべきデータが含まれていない場合を示すエラー型
@@ -116,7 +214,107 @@ type apiError struct {
 
 // Error errorインターフェース
 func (e *apiError) Error() string {
-	return fmt.Sprintf("Error in response: %#v", e.origErr)
+	var buf strings.Builder
+	buf.WriteString("API Error")
+	if e.method != "" || e.url != nil {
+		buf.WriteString(" in")
+		if e.method != "" {
+			buf.WriteString(" " + e.method)
+		}
+		if e.url != nil {
+			buf.WriteString(" " + e.url.String())
+		}
+	}
+
+	status := fmt.Sprintf("%d", e.responseCode)
+	if text := http.StatusText(e.responseCode); text != "" {
+		status += " " + text
+	}
+	buf.WriteString(": " + status)
+
+	if code := e.Code(); code != "" {
+		buf.WriteString(" (" + code + ")")
+	}
+	if msg := e.Message(); msg != "" {
+		buf.WriteString(": " + msg)
+	}
+	if serial := e.Serial(); serial != "" {
+		buf.WriteString(" [serial: " + serial + "]")
+	}
+	return buf.String()
+}
+
+// Is errors.Isでの判定に利用される
+//
+// targetがAPIErrorKindの場合、このエラーがその分類に該当するか判定する
+func (e *apiError) Is(target error) bool {
+	kind, ok := target.(*APIErrorKind)
+	if !ok {
+		return false
+	}
+	for _, k := range e.Kinds() {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Kinds 該当するAPIErrorKindのリスト
+func (e *apiError) Kinds() []*APIErrorKind {
+	var kinds []*APIErrorKind
+	add := func(kind *APIErrorKind) {
+		for _, k := range kinds {
+			if k == kind {
+				return
+			}
+		}
+		kinds = append(kinds, kind)
+	}
+
+	switch e.responseCode {
+	case http.StatusBadRequest:
+		add(ErrBadRequest)
+	case http.StatusUnauthorized:
+		add(ErrUnauthorized)
+	case http.StatusForbidden:
+		add(ErrForbidden)
+	case http.StatusNotFound:
+		add(ErrNotFound)
+	case http.StatusMethodNotAllowed:
+		add(ErrMethodNotAllowed)
+	case http.StatusConflict:
+		add(ErrConflict)
+		if strings.ToLower(e.Code()) == "still_creating" {
+			add(ErrStillCreating)
+		}
+	case http.StatusLocked:
+		add(ErrLocked)
+	case http.StatusTooManyRequests:
+		add(ErrTooManyRequests)
+	}
+	if e.responseCode >= http.StatusInternalServerError {
+		add(ErrServerError)
+	}
+	if e.responseCode == http.StatusServiceUnavailable {
+		add(ErrMaintenance)
+	}
+	for _, kind := range apiErrorCodeKinds[strings.ToLower(e.Code())] {
+		add(kind)
+	}
+
+	if e.origErr != nil && e.origErr.IsFatal {
+		add(ErrFatal)
+		return kinds
+	}
+	for _, kind := range kinds {
+		switch kind {
+		case ErrLocked, ErrTooManyRequests, ErrStillCreating, ErrMaintenance:
+			add(ErrRetryable)
+			return kinds
+		}
+	}
+	return kinds
 }
 
 // ResponseCode エラー発生時のレスポンスコード
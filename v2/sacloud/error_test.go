@@ -16,7 +16,12 @@ package sacloud
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestNoResultsError(t *testing.T) {
@@ -45,3 +50,172 @@ func TestNoResultsError(t *testing.T) {
 		}
 	}
 }
+
+func TestAPIError_Kinds(t *testing.T) {
+	u, _ := url.Parse("https://secure.sakura.ad.jp/cloud/zone/is1a/api/cloud/1.1/server/123456789012") // nolint
+
+	cases := []struct {
+		name         string
+		responseCode int
+		response     *APIErrorResponse
+		expect       []*APIErrorKind
+	}{
+		{
+			name:         "not found",
+			responseCode: http.StatusNotFound,
+			response:     &APIErrorResponse{IsFatal: true, ErrorCode: "not_found"},
+			expect:       []*APIErrorKind{ErrNotFound, ErrFatal},
+		},
+		{
+			name:         "still creating",
+			responseCode: http.StatusConflict,
+			response:     &APIErrorResponse{ErrorCode: "still_creating"},
+			expect:       []*APIErrorKind{ErrConflict, ErrStillCreating, ErrRetryable},
+		},
+		{
+			name:         "still creating without 409",
+			responseCode: http.StatusBadRequest,
+			response:     &APIErrorResponse{ErrorCode: "still_creating"},
+			expect:       []*APIErrorKind{ErrBadRequest},
+		},
+		{
+			name:         "locked",
+			responseCode: http.StatusLocked,
+			response:     &APIErrorResponse{ErrorCode: "busy"},
+			expect:       []*APIErrorKind{ErrLocked, ErrRetryable},
+		},
+		{
+			name:         "locked but fatal",
+			responseCode: http.StatusLocked,
+			response:     &APIErrorResponse{IsFatal: true},
+			expect:       []*APIErrorKind{ErrLocked, ErrFatal},
+		},
+		{
+			name:         "limit exceeded",
+			responseCode: http.StatusConflict,
+			response:     &APIErrorResponse{IsFatal: true, ErrorCode: "limit_count_in_zone_exceeded"},
+			expect:       []*APIErrorKind{ErrConflict, ErrLimitExceeded, ErrFatal},
+		},
+		{
+			name:         "unauthorized",
+			responseCode: http.StatusUnauthorized,
+			response:     &APIErrorResponse{IsFatal: true, ErrorCode: "unauthorized"},
+			expect:       []*APIErrorKind{ErrUnauthorized, ErrFatal},
+		},
+		{
+			name:         "maintenance",
+			responseCode: http.StatusServiceUnavailable,
+			response:     nil,
+			expect:       []*APIErrorKind{ErrServerError, ErrMaintenance, ErrRetryable},
+		},
+		{
+			name:         "validation",
+			responseCode: http.StatusBadRequest,
+			response:     &APIErrorResponse{IsFatal: true, ErrorCode: "unknown_param"},
+			expect:       []*APIErrorKind{ErrBadRequest, ErrValidation, ErrFatal},
+		},
+		{
+			name:         "busy",
+			responseCode: http.StatusConflict,
+			response:     &APIErrorResponse{ErrorCode: "busy"},
+			expect:       []*APIErrorKind{ErrConflict, ErrLocked, ErrRetryable},
+		},
+		{
+			name:         "undocumented error code",
+			responseCode: http.StatusConflict,
+			response:     &APIErrorResponse{IsFatal: true, ErrorCode: "unlimited_plan_exceeded"},
+			expect:       []*APIErrorKind{ErrConflict, ErrFatal},
+		},
+		{
+			name:         "too many requests",
+			responseCode: http.StatusTooManyRequests,
+			response:     &APIErrorResponse{},
+			expect:       []*APIErrorKind{ErrTooManyRequests, ErrRetryable},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := fmt.Errorf("wrapped: %w", NewAPIError(http.MethodGet, u, tc.responseCode, tc.response))
+			for _, kind := range APIErrorKinds {
+				expect := false
+				for _, k := range tc.expect {
+					if k == kind {
+						expect = true
+					}
+				}
+				require.Equal(t, expect, errors.Is(err, kind), "kind: %s", kind)
+			}
+
+			apiErr, ok := AsAPIError(err)
+			require.True(t, ok)
+			require.Equal(t, tc.responseCode, apiErr.ResponseCode())
+		})
+	}
+}
+
+func TestAPIError_errorCodeKinds(t *testing.T) {
+	u, _ := url.Parse("https://secure.sakura.ad.jp/cloud/zone/is1a/api/cloud/1.1/server/123456789012") // nolint
+
+	cases := []struct {
+		code   string
+		expect *APIErrorKind
+	}{
+		{code: "bad_request", expect: ErrValidation},
+		{code: "invalid_format", expect: ErrValidation},
+		{code: "invalid_param_comb", expect: ErrValidation},
+		{code: "unknown_param", expect: ErrValidation},
+		{code: "busy", expect: ErrLocked},
+		{code: "lock", expect: ErrLocked},
+		{code: "lock_by_other", expect: ErrLocked},
+		{code: "limit_count_in_account_exceeded", expect: ErrLimitExceeded},
+		{code: "limit_count_in_zone_exceeded", expect: ErrLimitExceeded},
+		{code: "limit_count_in_network_exceeded", expect: ErrLimitExceeded},
+		{code: "limit_count_in_member_exceeded", expect: ErrLimitExceeded},
+		{code: "limit_memory_in_account_exceeded", expect: ErrLimitExceeded},
+		{code: "limit_size_in_account_exceeded", expect: ErrLimitExceeded},
+		{code: "service_temporarily_unavailable", expect: ErrMaintenance},
+		{code: "maintenance", expect: ErrMaintenance},
+		// エラーコードの大文字/小文字は区別しない
+		{code: "Unknown_Param", expect: ErrValidation},
+	}
+	require.Len(t, apiErrorCodeKinds, len(cases)-1)
+
+	for _, tc := range cases {
+		// レスポンスコードからは分類できないようにする
+		err := NewAPIError(http.MethodGet, u, http.StatusTeapot, &APIErrorResponse{IsFatal: true, ErrorCode: tc.code})
+		for _, kind := range APIErrorKinds {
+			expect := kind == tc.expect || kind == ErrFatal
+			require.Equal(t, expect, errors.Is(err, kind), "code: %s, kind: %s", tc.code, kind)
+		}
+	}
+}
+
+func TestIsStillCreatingError(t *testing.T) {
+	u, _ := url.Parse("https://secure.sakura.ad.jp/cloud/zone/is1a/api/cloud/1.1/server/123456789012") // nolint
+
+	response := &APIErrorResponse{ErrorCode: "still_creating"}
+	require.True(t, IsStillCreatingError(NewAPIError(http.MethodGet, u, http.StatusConflict, response)))
+	// 409以外のレスポンスコードの場合は該当しない
+	require.False(t, IsStillCreatingError(NewAPIError(http.MethodGet, u, http.StatusBadRequest, response)))
+	require.False(t, IsRetryableError(NewAPIError(http.MethodGet, u, http.StatusBadRequest, response)))
+}
+
+func TestAPIError_Error(t *testing.T) {
+	u, _ := url.Parse("https://secure.sakura.ad.jp/cloud/zone/is1a/api/cloud/1.1/server/123456789012") // nolint
+
+	err := NewAPIError(http.MethodGet, u, http.StatusNotFound, &APIErrorResponse{
+		IsFatal:      true,
+		Serial:       "xxx",
+		Status:       "404 Not Found",
+		ErrorCode:    "not_found",
+		ErrorMessage: "対象が見つかりません",
+	})
+	require.Equal(t,
+		"API Error in GET https://secure.sakura.ad.jp/cloud/zone/is1a/api/cloud/1.1/server/123456789012: 404 Not Found (not_found): 対象が見つかりません [serial: xxx]",
+		err.Error(),
+	)
+
+	err = NewAPIError("", nil, http.StatusServiceUnavailable, nil)
+	require.Equal(t, "API Error: 503 Service Unavailable", err.Error())
+}
@@ -16,11 +16,22 @@ package sacloud
 
 var clientFactory = make(map[string]func(APICaller) interface{})
 
+// builtinClientFactory libsacloud組み込みのクライアントファクトリー
+//
+// ClientRegistryにファクトリーが登録されていない場合に利用される
+var builtinClientFactory = make(map[string]func(APICaller) interface{})
+
 // SetClientFactoryFunc リソースごとのクライアントファクトリーを登録する
 func SetClientFactoryFunc(resourceName string, factoryFunc func(caller APICaller) interface{}) {
 	clientFactory[resourceName] = factoryFunc
 }
 
+// registerBuiltinClientFactoryFunc libsacloud組み込みのクライアントファクトリーを登録する
+func registerBuiltinClientFactoryFunc(resourceName string, factoryFunc func(caller APICaller) interface{}) {
+	builtinClientFactory[resourceName] = factoryFunc
+	SetClientFactoryFunc(resourceName, factoryFunc)
+}
+
 var clientFactoryHooks = make(map[string][]func(interface{}) interface{})
 
 // AddClientFacotyHookFunc クライアントファクトリーのフックを登録する
@@ -36,14 +47,28 @@ func GetClientFactoryFunc(resourceName string) func(APICaller) interface{} {
 	if !ok {
 		panic(resourceName + " is not found in clientFactory")
 	}
-	if hooks, ok := clientFactoryHooks[resourceName]; ok {
-		return func(caller APICaller) interface{} {
-			ret := f(caller)
-			for _, hook := range hooks {
-				ret = hook(ret)
-			}
-			return ret
+	return applyClientFactoryHooks(f, clientFactoryHooks[resourceName])
+}
+
+// getClientFactoryFuncFor callerに応じたクライアントファクトリーを取得する
+//
+// callerがClientRegistryを保持している場合はClientRegistryから、そうでない場合はパッケージレベルの登録内容から取得する
+func getClientFactoryFuncFor(caller APICaller, resourceName string) func(APICaller) interface{} {
+	if registry, ok := ClientRegistryFromCaller(caller); ok {
+		return registry.GetClientFactoryFunc(resourceName)
+	}
+	return GetClientFactoryFunc(resourceName)
+}
+
+func applyClientFactoryHooks(f func(APICaller) interface{}, hooks []func(interface{}) interface{}) func(APICaller) interface{} {
+	if len(hooks) == 0 {
+		return f
+	}
+	return func(caller APICaller) interface{} {
+		ret := f(caller)
+		for _, hook := range hooks {
+			ret = hook(ret)
 		}
+		return ret
 	}
-	return f
 }
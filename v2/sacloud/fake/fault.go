@@ -0,0 +1,219 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+// FaultInjector フェイクドライバでのAPI呼び出しに対しエラーや遅延などの障害を注入する
+//
+// AddClientFactoryHooksまたはAddClientFactoryHooksToRegistryでクライアントファクトリーのフックとして登録し、
+// AddRuleで障害の注入ルールを追加する。ルールは実行中に追加/リセットできる。
+//
+//	injector := fake.NewFaultInjector()
+//	injector.AddClientFactoryHooksToRegistry(registry)
+//	injector.AddRule(&fake.FaultRule{
+//	    ResourceKey: fake.ResourceServer,
+//	    Operation:   "Boot",
+//	    Err:         fake.NewLockedError(),
+//	    Times:       2, // 2回目までは423を返し、3回目以降は成功する
+//	})
+type FaultInjector struct {
+	mu    sync.Mutex
+	rules []*FaultRule
+}
+
+// FaultRule 障害の注入ルール
+//
+// ResourceKey/Operation/Zone/IDのうち空(ゼロ値)でないものが全て一致するAPI呼び出しに適用される
+type FaultRule struct {
+	// ResourceKey 対象リソースのキー(fake.ResourceServerなど)
+	ResourceKey string
+	// Operation 対象オペレーション名(Boot、Createなど)
+	Operation string
+	// Zone 対象ゾーン グローバルリソースの場合はsacloud.APIDefaultZone
+	Zone string
+	// ID 対象リソースのID IDを引数に取るオペレーションでのみ一致する
+	ID types.ID
+	// Match 追加の一致条件 nilの場合は常に一致とみなす
+	Match func(call *FaultCall) bool
+
+	// Err API呼び出し時に返すエラー
+	Err error
+	// Latency API呼び出し前に待機する時間
+	Latency time.Duration
+	// DiskCopyFailure ディスク/アーカイブのコピーを有効状態がfailedとなるように終了させる
+	DiskCopyFailure bool
+	// NeverBoot 起動処理でインスタンスのステータスがupにならないようにする
+	NeverBoot bool
+
+	// Times ルールを適用する回数 0の場合は無制限
+	Times int
+
+	applied int
+}
+
+// FaultCall 障害注入の対象となるAPI呼び出しの情報
+type FaultCall struct {
+	ResourceKey string
+	Operation   string
+	Zone        string
+	ID          types.ID
+}
+
+func (r *FaultRule) match(call *FaultCall) bool {
+	if r.Times > 0 && r.applied >= r.Times {
+		return false
+	}
+	if r.ResourceKey != "" && r.ResourceKey != call.ResourceKey {
+		return false
+	}
+	if r.Operation != "" && r.Operation != call.Operation {
+		return false
+	}
+	if r.Zone != "" && r.Zone != call.Zone {
+		return false
+	}
+	if !r.ID.IsEmpty() && r.ID != call.ID {
+		return false
+	}
+	return r.Match == nil || r.Match(call)
+}
+
+// NewFaultInjector FaultInjectorを作成する
+func NewFaultInjector(rules ...*FaultRule) *FaultInjector {
+	return &FaultInjector{rules: rules}
+}
+
+// AddClientFactoryHooks グローバルなクライアントファクトリーに障害注入用のフックを登録する
+func (f *FaultInjector) AddClientFactoryHooks() {
+	addFaultInjectorHooks(sacloud.AddClientFacotyHookFunc, f)
+}
+
+// AddClientFactoryHooksToRegistry レジストリに障害注入用のフックを登録する
+func (f *FaultInjector) AddClientFactoryHooksToRegistry(registry *sacloud.ClientRegistry) {
+	addFaultInjectorHooks(registry.AddClientFactoryHookFunc, f)
+}
+
+// AddRule ルールを追加する
+func (f *FaultInjector) AddRule(rules ...*FaultRule) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rules = append(f.rules, rules...)
+}
+
+// Reset 全てのルールを削除する
+func (f *FaultInjector) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rules = nil
+}
+
+// inject API呼び出しに一致するルールを適用する
+//
+// 一致した全てのルールの遅延を合計して待機し、最初に一致したErrを返す。
+// DiskCopyFailure/NeverBootは戻り値のcontextを通じてフェイクドライバの非同期処理に伝えられる
+func (f *FaultInjector) inject(ctx context.Context, call *FaultCall) (context.Context, error) {
+	if f == nil {
+		return ctx, nil
+	}
+
+	var latency time.Duration
+	var err error
+	effects := faultEffectsFromContext(ctx)
+
+	f.mu.Lock()
+	for _, rule := range f.rules {
+		if !rule.match(call) {
+			continue
+		}
+		rule.applied++
+
+		latency += rule.Latency
+		if err == nil && rule.Err != nil {
+			err = rule.Err
+		}
+		effects.diskCopyFailure = effects.diskCopyFailure || rule.DiskCopyFailure
+		effects.neverBoot = effects.neverBoot || rule.NeverBoot
+	}
+	f.mu.Unlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx, ctx.Err()
+		case <-timer.C:
+		}
+	}
+	if err != nil {
+		return ctx, err
+	}
+	return context.WithValue(ctx, faultEffectsContextKey{}, effects), nil
+}
+
+type faultEffectsContextKey struct{}
+
+// faultEffects フェイクドライバの非同期処理に対する障害
+type faultEffects struct {
+	diskCopyFailure bool
+	neverBoot       bool
+}
+
+func faultEffectsFromContext(ctx context.Context) faultEffects {
+	if ctx == nil {
+		return faultEffects{}
+	}
+	effects, _ := ctx.Value(faultEffectsContextKey{}).(faultEffects)
+	return effects
+}
+
+// NewFaultAPIError 障害注入で利用するAPIErrorを作成する
+func NewFaultAPIError(statusCode int, errorCode, message string) error {
+	return sacloud.NewAPIError("", nil, statusCode, &sacloud.APIErrorResponse{
+		Status:       fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		ErrorCode:    errorCode,
+		ErrorMessage: message,
+	})
+}
+
+// NewLockedError リソースがロックされていることを示すAPIError(423)を作成する
+func NewLockedError() error {
+	return NewFaultAPIError(http.StatusLocked, "lock", "resource is locked")
+}
+
+// NewServiceUnavailableError サービスが利用できないことを示すAPIError(503)を作成する
+func NewServiceUnavailableError() error {
+	return NewFaultAPIError(http.StatusServiceUnavailable, "service_unavailable", "service is unavailable")
+}
+
+// NewStillCreatingError リソースが作成中であることを示すAPIError(409 + still_creating)を作成する
+func NewStillCreatingError() error {
+	return NewFaultAPIError(http.StatusConflict, "still_creating", "resource is still creating")
+}
+
+// NewNotFoundError リソースが存在しないことを示すAPIError(404)を作成する
+func NewNotFoundError() error {
+	return NewFaultAPIError(http.StatusNotFound, "not_found", "resource is not found")
+}
@@ -0,0 +1,126 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newFaultInjectorTestCaller() (sacloud.APICaller, *FaultInjector) {
+	registry := sacloud.NewClientRegistry()
+	SwitchRegistryFactoryFuncToFake(registry, NewInMemoryStore())
+
+	injector := NewFaultInjector()
+	injector.AddClientFactoryHooksToRegistry(registry)
+	return sacloud.NewCallerWithRegistry(sacloud.NewClient("", ""), registry), injector
+}
+
+func TestFaultInjector_errors(t *testing.T) {
+	ctx := context.Background()
+	caller, injector := newFaultInjectorTestCaller()
+	serverOp := sacloud.NewServerOp(caller)
+
+	server1, err := serverOp.Create(ctx, "is1a", &sacloud.ServerCreateRequest{Name: "server1", CPU: 1, MemoryMB: 1024})
+	require.NoError(t, err)
+	server2, err := serverOp.Create(ctx, "is1a", &sacloud.ServerCreateRequest{Name: "server2", CPU: 1, MemoryMB: 1024})
+	require.NoError(t, err)
+
+	injector.AddRule(
+		&FaultRule{ResourceKey: ResourceServer, Operation: "Boot", ID: server1.ID, Err: NewLockedError(), Times: 2},
+		&FaultRule{ResourceKey: ResourceServer, Operation: "Read", Zone: "is1b", Err: NewServiceUnavailableError()},
+	)
+
+	for i := 0; i < 2; i++ {
+		err = serverOp.Boot(ctx, "is1a", server1.ID)
+		require.True(t, errors.Is(err, sacloud.ErrLocked))
+		require.True(t, sacloud.IsRetryableError(err))
+	}
+	require.NoError(t, serverOp.Boot(ctx, "is1a", server1.ID))
+
+	// IDやゾーンが一致しない呼び出しには適用されない
+	require.NoError(t, serverOp.Boot(ctx, "is1a", server2.ID))
+	_, err = serverOp.Read(ctx, "is1a", server2.ID)
+	require.NoError(t, err)
+	_, err = serverOp.Read(ctx, "is1b", server2.ID)
+	require.True(t, errors.Is(err, sacloud.ErrMaintenance))
+
+	injector.Reset()
+	_, err = serverOp.Read(ctx, "is1b", server2.ID)
+	require.True(t, sacloud.IsNotFoundError(err))
+
+	injector.AddRule(&FaultRule{
+		Match: func(call *FaultCall) bool {
+			return call.Operation == "Create"
+		},
+		Err: NewStillCreatingError(),
+	})
+	_, err = serverOp.Create(ctx, "is1a", &sacloud.ServerCreateRequest{Name: "server3"})
+	require.True(t, sacloud.IsStillCreatingError(err))
+}
+
+func TestFaultInjector_latency(t *testing.T) {
+	caller, injector := newFaultInjectorTestCaller()
+	zoneOp := sacloud.NewZoneOp(caller)
+
+	injector.AddRule(&FaultRule{ResourceKey: ResourceZone, Latency: 50 * time.Millisecond})
+
+	started := time.Now()
+	_, err := zoneOp.Find(context.Background(), nil)
+	require.NoError(t, err)
+	require.True(t, time.Since(started) >= 50*time.Millisecond)
+
+	// contextのキャンセルで待機を中断する
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	injector.Reset()
+	injector.AddRule(&FaultRule{ResourceKey: ResourceZone, Latency: time.Minute})
+	_, err = zoneOp.Find(ctx, nil)
+	require.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestFaultInjector_asyncEffects(t *testing.T) {
+	ctx := context.Background()
+	caller, injector := newFaultInjectorTestCaller()
+	diskOp := sacloud.NewDiskOp(caller)
+	serverOp := sacloud.NewServerOp(caller)
+
+	injector.AddRule(
+		&FaultRule{ResourceKey: ResourceDisk, Operation: "Create", DiskCopyFailure: true},
+		&FaultRule{ResourceKey: ResourceServer, Operation: "Boot", NeverBoot: true},
+	)
+
+	disk, err := diskOp.Create(ctx, "is1a", &sacloud.DiskCreateRequest{Name: "disk", SizeMB: 20 * 1024}, nil)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		read, err := diskOp.Read(ctx, "is1a", disk.ID)
+		return err == nil && read.Availability == types.Availabilities.Failed
+	}, time.Second, DiskCopyDuration)
+
+	server, err := serverOp.Create(ctx, "is1a", &sacloud.ServerCreateRequest{Name: "server", CPU: 1, MemoryMB: 1024})
+	require.NoError(t, err)
+	require.NoError(t, serverOp.Boot(ctx, "is1a", server.ID))
+
+	time.Sleep(10 * PowerOnDuration)
+	read, err := serverOp.Read(ctx, "is1a", server.ID)
+	require.NoError(t, err)
+	require.Equal(t, types.ServerInstanceStatuses.Down, read.InstanceStatus)
+}
@@ -0,0 +1,635 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sacloud/libsacloud/v2/pkg/mapconv"
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/accessor"
+	"github.com/sacloud/libsacloud/v2/sacloud/search"
+)
+
+// findResult findでの検索結果
+type findResult struct {
+	// Values ページング/フィールド選択適用後の値
+	Values []interface{}
+	// Total 検索条件に合致した全件数
+	Total int
+	// From 取得開始位置
+	From int
+	// Count Valuesの件数
+	Count int
+}
+
+// find ストア上の値をFindConditionに従って検索する
+//
+// Filter/Sort/Include/Excludeのキーにはモデルのフィールド名またはAPI上のフィールド名(mapconvタグでのパス)を
+// ドット区切りで指定する。フィールド名の大文字/小文字は区別しない。
+// Provider.ClassなどAPI側でのみ解釈されるキー(apiOnlyFilterKeys)に対する条件は評価できないため無視し、
+// それ以外のモデルに定義されていないキーが指定された場合はBadRequestエラーを返す。
+// モデルに定義されているキーであっても値を持たない場合(omitemptyで省略される場合など)は合致しないものとして扱う。
+// 検索条件に合致した値はソート後にFrom/Countでのページングが適用される
+func find(s Store, resourceKey, zone string, conditions *sacloud.FindCondition) (*findResult, error) {
+	if conditions == nil {
+		conditions = &sacloud.FindCondition{}
+	}
+
+	var matched []*findTarget
+	for _, v := range s.List(resourceKey, zone) {
+		target := &findTarget{value: v}
+		ok, err := target.match(conditions.Filter)
+		if err != nil {
+			return nil, sacloud.NewAPIError("", nil, http.StatusBadRequest, &sacloud.APIErrorResponse{
+				IsFatal:      true,
+				Status:       "400 BadRequest",
+				ErrorCode:    fmt.Sprintf("%d", http.StatusBadRequest),
+				ErrorMessage: fmt.Sprintf("request to %s is bad: %s", resourceKey, err),
+			})
+		}
+		if ok {
+			matched = append(matched, target)
+		}
+	}
+	sortFindTargets(matched, conditions.Sort)
+
+	result := &findResult{
+		Total: len(matched),
+		From:  conditions.From,
+	}
+
+	if conditions.From > 0 {
+		if conditions.From >= len(matched) {
+			matched = nil
+		} else {
+			matched = matched[conditions.From:]
+		}
+	}
+	if conditions.Count > 0 && len(matched) > conditions.Count {
+		matched = matched[:conditions.Count]
+	}
+
+	for _, target := range matched {
+		result.Values = append(result.Values, projectFields(target.value, conditions.Include, conditions.Exclude))
+	}
+	result.Count = len(result.Values)
+	return result, nil
+}
+
+// apiOnlyFilterKeys API側でのみ解釈され、ストア上の値には存在しない検索条件のキー
+//
+// 共通サービスアイテムの種別(Provider.Class)など、リクエスト時に付与されるキーが該当する
+var apiOnlyFilterKeys = []string{"Provider.Class"}
+
+func isAPIOnlyFilterKey(key string) bool {
+	for _, k := range apiOnlyFilterKeys {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+	return false
+}
+
+var filterKeysCache sync.Map // reflect.Type -> map[string]bool
+
+// isKnownFilterKey 検索条件のキーがモデルに定義されたフィールドを指しているか
+//
+// 値ではなくモデルの型(フィールド名とmapconvタグ)から判定する。構造体以外の型の場合は常にtrueを返す
+func isKnownFilterKey(t reflect.Type, key string) bool {
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return true
+	}
+
+	keys, ok := filterKeysCache.Load(t)
+	if !ok {
+		collected := make(map[string]bool)
+		collectFilterKeys(t, "", collected, make(map[reflect.Type]bool))
+		keys, _ = filterKeysCache.LoadOrStore(t, collected)
+	}
+
+	// タグは文字列のスライスとして保持している
+	if strings.EqualFold(key, "Tags.Name") {
+		key = "Tags"
+	}
+	return keys.(map[string]bool)[strings.ToLower(key)]
+}
+
+// collectFilterKeys 構造体の型からフィールド名/mapconvタグでのパスを小文字で収集する
+func collectFilterKeys(t reflect.Type, prefix string, keys map[string]bool, visiting map[reflect.Type]bool) {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || visiting[t] {
+		return
+	}
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	decoder := &mapconv.Decoder{Config: &mapconv.DecoderConfig{TagName: mapconv.DefaultMapConvTag}}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+
+		name := joinFilterKey(prefix, f.Name)
+		keys[name] = true
+		collectFilterKeys(f.Type, name, keys, visiting)
+
+		tag := decoder.ParseMapConvTag(f.Tag.Get(mapconv.DefaultMapConvTag))
+		if tag.Ignore {
+			continue
+		}
+		if tag.Squash {
+			collectFilterKeys(f.Type, prefix, keys, visiting)
+			continue
+		}
+		for _, source := range tag.SourceFields {
+			if source == "" {
+				continue
+			}
+			path := prefix
+			for _, segment := range strings.Split(strings.ReplaceAll(source, "[]", ""), ".") {
+				path = joinFilterKey(path, segment)
+				keys[path] = true
+			}
+			if tag.Recursive {
+				collectFilterKeys(f.Type, path, keys, visiting)
+			}
+		}
+	}
+}
+
+func joinFilterKey(prefix, name string) string {
+	name = strings.ToLower(name)
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// findTarget 検索対象の値
+//
+// API上のフィールド名での参照用にmapconvで変換した値を保持する
+type findTarget struct {
+	value  interface{}
+	mapped map[string]interface{}
+}
+
+// lookup 指定のパスに対応する値を返す
+//
+// パスの途中にスライスが含まれる場合は各要素の値をすべて返す
+func (t *findTarget) lookup(path string) ([]interface{}, bool) {
+	// タグは文字列のスライスとして保持している
+	if strings.EqualFold(path, "Tags.Name") {
+		path = "Tags"
+	}
+	segments := strings.Split(path, ".")
+
+	if values, ok := lookupFieldValues(reflect.ValueOf(t.value), segments); ok {
+		return values, true
+	}
+
+	if t.mapped == nil {
+		t.mapped = make(map[string]interface{})
+		if err := mapconv.ConvertTo(t.value, &t.mapped); err != nil {
+			return nil, false
+		}
+	}
+	return lookupFieldValues(reflect.ValueOf(t.mapped), segments)
+}
+
+func (t *findTarget) match(filter search.Filter) (bool, error) {
+	for key, expression := range filter {
+		if expression == nil {
+			continue
+		}
+		if !isKnownFilterKey(reflect.TypeOf(t.value), key.Field) {
+			if isAPIOnlyFilterKey(key.Field) {
+				continue
+			}
+			return false, fmt.Errorf("unknown filter key: %q", key.Field)
+		}
+		values, ok := t.lookup(key.Field)
+		if !ok {
+			return false, nil
+		}
+
+		if key.Op == search.OpEqual {
+			exp, ok := expression.(*search.EqualExpression)
+			if !ok {
+				exp = search.OrEqual(expression)
+			}
+			if !matchEqualExpression(values, exp) {
+				return false, nil
+			}
+			continue
+		}
+
+		matched := false
+		for _, v := range values {
+			if c, ok := compareFilterValue(v, expression); ok && matchComparison(c, key.Op) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func lookupFieldValues(v reflect.Value, segments []string) ([]interface{}, bool) {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return nil, true
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil, true
+	}
+
+	if len(segments) == 0 {
+		if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+			var values []interface{}
+			for i := 0; i < v.Len(); i++ {
+				elems, _ := lookupFieldValues(v.Index(i), nil)
+				values = append(values, elems...)
+			}
+			return values, true
+		}
+		return []interface{}{v.Interface()}, true
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		field, ok := fieldByNameFold(v, segments[0])
+		if !ok {
+			return nil, false
+		}
+		return lookupFieldValues(field, segments[1:])
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			if strings.EqualFold(iter.Key().String(), segments[0]) {
+				return lookupFieldValues(iter.Value(), segments[1:])
+			}
+		}
+		return nil, false
+	case reflect.Slice, reflect.Array:
+		var values []interface{}
+		found := false
+		for i := 0; i < v.Len(); i++ {
+			elems, ok := lookupFieldValues(v.Index(i), segments)
+			if ok {
+				found = true
+				values = append(values, elems...)
+			}
+		}
+		// 空のスライスの場合はフィールドの有無を判定できないため存在するものとして扱う
+		return values, found || v.Len() == 0
+	}
+	return nil, false
+}
+
+func fieldByNameFold(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		if strings.EqualFold(f.Name, name) {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// matchEqualExpression OpEqualでの条件に合致するか
+//
+// OpAndの場合は全ての条件がいずれかの値に部分一致すること、
+// OpOrの場合はいずれかの条件がいずれかの値に完全一致することを条件とする。
+// OpOrの条件が配列の場合(タグなど)は配列の全要素が値に含まれることを条件とする
+func matchEqualExpression(values []interface{}, exp *search.EqualExpression) bool {
+	if len(exp.Conditions) == 0 {
+		return true
+	}
+
+	switch exp.Op {
+	case search.OpAnd:
+		for _, cond := range exp.Conditions {
+			condStr := filterValueString(cond)
+			contained := false
+			for _, v := range values {
+				if strings.Contains(filterValueString(v), condStr) {
+					contained = true
+					break
+				}
+			}
+			if !contained {
+				return false
+			}
+		}
+		return true
+	default:
+		for _, cond := range exp.Conditions {
+			if matchAllOrAny(values, cond) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func matchAllOrAny(values []interface{}, cond interface{}) bool {
+	rv := reflect.ValueOf(cond)
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		for i := 0; i < rv.Len(); i++ {
+			if !containsFilterValue(values, rv.Index(i).Interface()) {
+				return false
+			}
+		}
+		return true
+	}
+	return containsFilterValue(values, cond)
+}
+
+func containsFilterValue(values []interface{}, cond interface{}) bool {
+	condStr := filterValueString(cond)
+	for _, v := range values {
+		if filterValueString(v) == condStr {
+			return true
+		}
+	}
+	return false
+}
+
+func matchComparison(c int, op search.ComparisonOperator) bool {
+	switch op {
+	case search.OpGreaterThan:
+		return c > 0
+	case search.OpGreaterEqual:
+		return c >= 0
+	case search.OpLessThan:
+		return c < 0
+	case search.OpLessEqual:
+		return c <= 0
+	}
+	return c == 0
+}
+
+// compareFilterValue 値vと条件condを比較する
+//
+// 日時、数値、文字列の順に比較可能な型として解釈を試みる
+func compareFilterValue(v, cond interface{}) (int, bool) {
+	if t1, ok := toFilterTime(v); ok {
+		t2, ok := toFilterTime(cond)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case t1.Before(t2):
+			return -1, true
+		case t1.After(t2):
+			return 1, true
+		}
+		return 0, true
+	}
+
+	if f1, ok := toFilterFloat(v); ok {
+		if f2, ok := toFilterFloat(cond); ok {
+			switch {
+			case f1 < f2:
+				return -1, true
+			case f1 > f2:
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+
+	return strings.Compare(filterValueString(v), filterValueString(cond)), true
+}
+
+func toFilterTime(v interface{}) (time.Time, bool) {
+	switch v := v.(type) {
+	case time.Time:
+		return v, true
+	case *time.Time:
+		if v != nil {
+			return *v, true
+		}
+	case string:
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func toFilterFloat(v interface{}) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	case reflect.String:
+		f, err := strconv.ParseFloat(rv.String(), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func filterValueString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case fmt.Stringer:
+		return v.String()
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// sortFindTargets ソートキーに従って並べ替える
+//
+// ソートキーが指定されていない、またはソートキーの値が等しい場合はIDの昇順となる
+func sortFindTargets(targets []*findTarget, keys search.SortKeys) {
+	sort.SliceStable(targets, func(i, j int) bool {
+		return findTargetID(targets[i]) < findTargetID(targets[j])
+	})
+	if len(keys) == 0 {
+		return
+	}
+
+	sortValues := make(map[*findTarget][]interface{}, len(targets))
+	for _, target := range targets {
+		var values []interface{}
+		for _, key := range keys {
+			var value interface{}
+			if v, ok := target.lookup(key.Key); ok && len(v) > 0 {
+				value = v[0]
+			}
+			values = append(values, value)
+		}
+		sortValues[target] = values
+	}
+
+	sort.SliceStable(targets, func(i, j int) bool {
+		vi, vj := sortValues[targets[i]], sortValues[targets[j]]
+		for n, key := range keys {
+			c := compareSortValue(vi[n], vj[n])
+			if c == 0 {
+				continue
+			}
+			if key.Order == search.SortDesc {
+				return c > 0
+			}
+			return c < 0
+		}
+		return false
+	})
+}
+
+func findTargetID(target *findTarget) int64 {
+	if v, ok := target.value.(accessor.ID); ok {
+		return v.GetID().Int64()
+	}
+	return 0
+}
+
+// compareSortValue ソート用の比較 値がない場合は先頭に並べる
+func compareSortValue(v1, v2 interface{}) int {
+	switch {
+	case v1 == nil && v2 == nil:
+		return 0
+	case v1 == nil:
+		return -1
+	case v2 == nil:
+		return 1
+	}
+	c, _ := compareFilterValue(v1, v2)
+	return c
+}
+
+// projectFields Include/Excludeに従い返却するフィールドを選択した値を返す
+//
+// Includeが指定された場合は指定フィールドとIDのみ、Excludeが指定された場合は指定フィールド以外を返す。
+// Includeに"*"が含まれる場合は全フィールドを対象とする。
+// ストア上の値を変更しないよう、フィールドを選択する場合はコピーを返す
+func projectFields(value interface{}, include, exclude []string) interface{} {
+	for _, path := range include {
+		if path == "*" {
+			include = nil
+			break
+		}
+	}
+	if len(include) == 0 && len(exclude) == 0 {
+		return value
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return value
+	}
+
+	copied := reflect.New(rv.Elem().Type())
+	copySameNameField(value, copied.Interface())
+
+	if len(include) > 0 {
+		projected := reflect.New(rv.Elem().Type())
+		for _, path := range append([]string{"ID"}, include...) {
+			copyFieldPath(projected.Elem(), copied.Elem(), strings.Split(path, "."))
+		}
+		copied = projected
+	}
+	for _, path := range exclude {
+		clearFieldPath(copied.Elem(), strings.Split(path, "."))
+	}
+	return copied.Interface()
+}
+
+func copyFieldPath(dest, src reflect.Value, segments []string) {
+	if len(segments) == 0 {
+		dest.Set(src)
+		return
+	}
+
+	switch src.Kind() {
+	case reflect.Ptr:
+		if src.IsNil() {
+			return
+		}
+		if dest.IsNil() {
+			dest.Set(reflect.New(src.Type().Elem()))
+		}
+		copyFieldPath(dest.Elem(), src.Elem(), segments)
+	case reflect.Struct:
+		sf, ok := fieldByNameFold(src, segments[0])
+		if !ok {
+			return
+		}
+		df, _ := fieldByNameFold(dest, segments[0])
+		copyFieldPath(df, sf, segments[1:])
+	default:
+		// スライスなどそれ以上辿れない場合は値全体をコピーする
+		dest.Set(src)
+	}
+}
+
+func clearFieldPath(v reflect.Value, segments []string) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			clearFieldPath(v.Elem(), segments)
+		}
+	case reflect.Struct:
+		f, ok := fieldByNameFold(v, segments[0])
+		if !ok {
+			return
+		}
+		if len(segments) == 1 {
+			f.Set(reflect.Zero(f.Type()))
+			return
+		}
+		clearFieldPath(f, segments[1:])
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			clearFieldPath(v.Index(i), segments)
+		}
+	}
+}
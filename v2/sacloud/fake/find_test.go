@@ -0,0 +1,259 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/search"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newFindTestStore() Store {
+	s := NewInMemoryStore()
+	createdAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	servers := []*sacloud.Server{
+		{ID: 1, Name: "web-server-01", CPU: 1, MemoryMB: 1024, Tags: types.Tags{"web", "prod"}, InstanceStatus: types.ServerInstanceStatuses.Up, CreatedAt: createdAt},
+		{ID: 2, Name: "web-server-02", CPU: 2, MemoryMB: 2048, Tags: types.Tags{"web", "dev"}, InstanceStatus: types.ServerInstanceStatuses.Down, CreatedAt: createdAt.Add(time.Hour)},
+		{ID: 3, Name: "db-server-01", CPU: 4, MemoryMB: 4096, Tags: types.Tags{"db", "prod"}, InstanceStatus: types.ServerInstanceStatuses.Up, CreatedAt: createdAt.Add(2 * time.Hour)},
+		{ID: 4, Name: "db-server-02", CPU: 2, MemoryMB: 8192, Tags: types.Tags{"db", "dev"}, InstanceStatus: types.ServerInstanceStatuses.Down, CreatedAt: createdAt.Add(3 * time.Hour)},
+	}
+	for _, v := range servers {
+		s.Put(ResourceServer, "is1a", v.ID, v)
+	}
+	return s
+}
+
+func findServerIDs(t *testing.T, s Store, conditions *sacloud.FindCondition) ([]types.ID, *findResult) {
+	result, err := find(s, ResourceServer, "is1a", conditions)
+	require.NoError(t, err)
+
+	var ids []types.ID
+	for _, v := range result.Values {
+		ids = append(ids, v.(*sacloud.Server).ID)
+	}
+	return ids, result
+}
+
+func TestFind_filter(t *testing.T) {
+	s := newFindTestStore()
+
+	cases := []struct {
+		msg    string
+		filter search.Filter
+		expect []types.ID
+	}{
+		{
+			msg:    "without filter",
+			expect: []types.ID{1, 2, 3, 4},
+		},
+		{
+			msg:    "partial match",
+			filter: search.Filter{search.Key("Name"): search.PartialMatch("server", "01")},
+			expect: []types.ID{1, 3},
+		},
+		{
+			msg:    "exact match",
+			filter: search.Filter{search.Key("Name"): search.ExactMatch("db-server-01", "web-server-02")},
+			expect: []types.ID{2, 3},
+		},
+		{
+			msg:    "scalar value",
+			filter: search.Filter{search.Key("ID"): types.ID(4)},
+			expect: []types.ID{4},
+		},
+		{
+			msg:    "tags",
+			filter: search.Filter{search.Key("Tags.Name"): search.TagsAndEqual("prod", "db")},
+			expect: []types.ID{3},
+		},
+		{
+			msg:    "API field name",
+			filter: search.Filter{search.Key("Instance.Status"): search.ExactMatch("up")},
+			expect: []types.ID{1, 3},
+		},
+		{
+			msg:    "greater than",
+			filter: search.Filter{search.KeyWithOp("CPU", search.OpGreaterThan): 1},
+			expect: []types.ID{2, 3, 4},
+		},
+		{
+			msg: "range",
+			filter: search.Filter{
+				search.KeyWithOp("ServerPlan.MemoryMB", search.OpGreaterEqual): 2048,
+				search.KeyWithOp("ServerPlan.MemoryMB", search.OpLessEqual):    4096,
+			},
+			expect: []types.ID{2, 3},
+		},
+		{
+			msg:    "time",
+			filter: search.Filter{search.KeyWithOp("CreatedAt", search.OpLessThan): time.Date(2022, 1, 1, 2, 0, 0, 0, time.UTC)},
+			expect: []types.ID{1, 2},
+		},
+		{
+			msg:    "time from query string",
+			filter: search.Filter{search.KeyWithOp("CreatedAt", search.OpGreaterThan): "2022-01-01T02:00:00Z"},
+			expect: []types.ID{4},
+		},
+		{
+			msg:    "API-only key is ignored",
+			filter: search.Filter{search.Key("Provider.Class"): "foo"},
+			expect: []types.ID{1, 2, 3, 4},
+		},
+	}
+
+	for _, tc := range cases {
+		ids, result := findServerIDs(t, s, &sacloud.FindCondition{Filter: tc.filter})
+		require.Equal(t, tc.expect, ids, tc.msg)
+		require.Equal(t, len(tc.expect), result.Total, tc.msg)
+	}
+}
+
+func TestFind_unknownFilterKey(t *testing.T) {
+	s := newFindTestStore()
+
+	_, err := find(s, ResourceServer, "is1a", &sacloud.FindCondition{
+		Filter: search.Filter{search.Key("Iconn.ID"): types.ID(1)},
+	})
+	require.True(t, errors.Is(err, sacloud.ErrBadRequest))
+	require.Contains(t, err.Error(), `unknown filter key: "Iconn.ID"`)
+
+	_, err = newServerOp(s).Find(context.Background(), "is1a", &sacloud.FindCondition{
+		Filter: search.Filter{search.Key("Iconn.ID"): types.ID(1)},
+	})
+	require.Error(t, err)
+}
+
+func TestFind_knownFilterKeyWithoutValue(t *testing.T) {
+	ctx := context.Background()
+	s := NewInMemoryStore()
+
+	// 値を持たないフィールド(omitemptyでmapconvの変換結果から省略される)を含む値
+	s.Put(ResourceInterface, "is1a", types.ID(1), &sacloud.Interface{ID: 1})
+	s.Put(ResourceInterface, "is1a", types.ID(2), &sacloud.Interface{ID: 2, PacketFilterID: 100})
+	s.Put(ResourceDisk, "is1a", types.ID(1), &sacloud.Disk{ID: 1})
+	s.Put(ResourceDisk, "is1a", types.ID(2), &sacloud.Disk{ID: 2, ServerID: 200, SourceArchiveID: 300})
+
+	ifaces, err := newInterfaceOp(s).Find(ctx, "is1a", &sacloud.FindCondition{
+		Filter: search.Filter{search.Key("PacketFilter.ID"): types.ID(100)},
+	})
+	require.NoError(t, err)
+	require.Len(t, ifaces.Interfaces, 1)
+	require.Equal(t, types.ID(2), ifaces.Interfaces[0].ID)
+
+	for _, key := range []string{"Server.ID", "SourceArchive.ID"} {
+		disks, err := newDiskOp(s).Find(ctx, "is1a", &sacloud.FindCondition{
+			Filter: search.Filter{search.Key(key): types.ID(1)},
+		})
+		require.NoError(t, err, key)
+		require.Empty(t, disks.Disks, key)
+	}
+
+	disks, err := newDiskOp(s).Find(ctx, "is1a", &sacloud.FindCondition{
+		Filter: search.Filter{search.Key("Server.ID"): types.ID(200)},
+	})
+	require.NoError(t, err)
+	require.Len(t, disks.Disks, 1)
+	require.Equal(t, types.ID(2), disks.Disks[0].ID)
+}
+
+func TestFind_sortAndPaging(t *testing.T) {
+	s := newFindTestStore()
+
+	ids, _ := findServerIDs(t, s, &sacloud.FindCondition{
+		Sort: search.SortKeys{search.SortKeyAsc("CPU"), search.SortKeyDesc("MemoryMB")},
+	})
+	require.Equal(t, []types.ID{1, 4, 2, 3}, ids)
+
+	ids, result := findServerIDs(t, s, &sacloud.FindCondition{
+		Filter: search.Filter{search.Key("Name"): search.PartialMatch("server")},
+		Sort:   search.SortKeys{search.SortKeyDesc("ID")},
+		From:   1,
+		Count:  2,
+	})
+	require.Equal(t, []types.ID{3, 2}, ids)
+	require.Equal(t, 4, result.Total)
+	require.Equal(t, 1, result.From)
+	require.Equal(t, 2, result.Count)
+
+	ids, result = findServerIDs(t, s, &sacloud.FindCondition{From: 10})
+	require.Empty(t, ids)
+	require.Equal(t, 4, result.Total)
+}
+
+func TestFind_includeExclude(t *testing.T) {
+	s := newFindTestStore()
+
+	_, result := findServerIDs(t, s, &sacloud.FindCondition{
+		Filter:  search.Filter{search.Key("ID"): types.ID(1)},
+		Include: []string{"Name"},
+	})
+	server := result.Values[0].(*sacloud.Server)
+	require.Equal(t, types.ID(1), server.ID)
+	require.Equal(t, "web-server-01", server.Name)
+	require.Empty(t, server.Tags)
+	require.Zero(t, server.CPU)
+
+	_, result = findServerIDs(t, s, &sacloud.FindCondition{
+		Filter:  search.Filter{search.Key("ID"): types.ID(1)},
+		Exclude: []string{"Tags"},
+	})
+	server = result.Values[0].(*sacloud.Server)
+	require.Equal(t, "web-server-01", server.Name)
+	require.Empty(t, server.Tags)
+
+	_, result = findServerIDs(t, s, &sacloud.FindCondition{
+		Filter:  search.Filter{search.Key("ID"): types.ID(1)},
+		Include: []string{"*", "Status.sim"},
+	})
+	server = result.Values[0].(*sacloud.Server)
+	require.Equal(t, 1, server.CPU)
+
+	// ストア上の値は変更されない
+	stored := s.Get(ResourceServer, "is1a", types.ID(1)).(*sacloud.Server)
+	require.ElementsMatch(t, types.Tags{"web", "prod"}, stored.Tags)
+}
+
+func TestFind_filterBuilder(t *testing.T) {
+	s := newFindTestStore()
+
+	condition, err := sacloud.ServerFilter().
+		NameContains("server").
+		CPUGreaterEqual(2).
+		HasTags("prod").
+		InstanceStatusEquals(types.ServerInstanceStatuses.Up).
+		CreatedAtAfter(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)).
+		Build()
+	require.NoError(t, err)
+
+	ids, _ := findServerIDs(t, s, condition)
+	require.Equal(t, []types.ID{3}, ids)
+
+	condition, err = sacloud.ServerFilter().
+		MemoryMBLessEqual(4096).
+		SortBy(sacloud.ServerFilterKeyMemoryMB, true).
+		Count(2).
+		Build()
+	require.NoError(t, err)
+
+	ids, result := findServerIDs(t, s, condition)
+	require.Equal(t, []types.ID{3, 2}, ids)
+	require.Equal(t, 3, result.Total)
+}
@@ -0,0 +1,292 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"gopkg.in/yaml.v3"
+)
+
+// FixtureGlobalZone フィクスチャでグローバルリソースを記述する際のゾーン名
+//
+// データストアへはsacloud.APIDefaultZoneとして格納される
+const FixtureGlobalZone = "global"
+
+// Fixture フェイクドライバのデータストアへ投入するリソースの定義
+//
+// リソースキー(fake.ResourceServerなど)、ゾーン、リソースのリストの順にネストした構造を持つ。
+// 各リソースはモデル(sacloud.Serverなど)のフィールド名をキーとしたマップで記述する
+//
+//	Resources:
+//	  Server:
+//	    is1a:
+//	      - ID: 123456789012
+//	        Name: example
+//	        CPU: 2
+//	        MemoryMB: 4096
+//	  Icon:
+//	    global:
+//	      - Name: example
+type Fixture struct {
+	Resources map[string]map[string][]map[string]interface{} `json:"Resources" yaml:"Resources"`
+}
+
+// LoadFixture YAMLまたはJSON形式のフィクスチャを読み込む
+func LoadFixture(r io.Reader) (*Fixture, error) {
+	fixture := &Fixture{}
+	if err := yaml.NewDecoder(r).Decode(fixture); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("decoding fixture failed: %s", err)
+	}
+	if err := fixture.Validate(); err != nil {
+		return nil, err
+	}
+	return fixture, nil
+}
+
+// LoadFixtureFile YAMLまたはJSON形式のフィクスチャファイルを読み込む
+func LoadFixtureFile(path string) (*Fixture, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close() // nolint
+
+	return LoadFixture(f)
+}
+
+// Validate リソースキーと各リソースの値がモデルに変換可能か検証する
+func (f *Fixture) Validate() error {
+	for _, resourceKey := range f.resourceKeys() {
+		if _, ok := jsonResourceTypeMap[resourceKey]; !ok {
+			return fmt.Errorf("fixture: unknown resource key %q", resourceKey)
+		}
+		for zone, values := range f.Resources[resourceKey] {
+			for i, value := range values {
+				if _, err := fixtureValueToModel(resourceKey, value); err != nil {
+					return fmt.Errorf("fixture: %s[%s][%d] is invalid: %s", resourceKey, zone, i, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Apply フィクスチャの内容をデータストアへ投入する
+//
+// IDが指定されていないリソースにはIDを採番する。同じIDのリソースがデータストアに存在する場合は上書きする。
+// データストアが未初期化の場合は初期データの投入後にフィクスチャの内容を投入する
+func (f *Fixture) Apply(s Store) error {
+	if err := f.Validate(); err != nil {
+		return err
+	}
+
+	p := poolFor(s)
+	for _, resourceKey := range f.resourceKeys() {
+		for zone, values := range f.Resources[resourceKey] {
+			if zone == FixtureGlobalZone {
+				zone = sacloud.APIDefaultZone
+			}
+			for _, value := range values {
+				model, err := fixtureValueToModel(resourceKey, value)
+				if err != nil {
+					return err
+				}
+				if v, ok := model.(*sacloud.IPv6Addr); ok {
+					// IPv6AddrはIDを持たないため、データストア上のキーとしてIDを採番する
+					id := p.generateID()
+					s.Put(resourceKey, zone, id, &ipv6Addr{ID: id, IPv6Addr: v})
+					continue
+				}
+				id := fixtureModelID(model)
+				if id.IsEmpty() {
+					id = p.generateID()
+					fixtureSetModelID(model, id)
+				} else {
+					p.reserveID(id)
+				}
+				s.Put(resourceKey, zone, id, model)
+			}
+		}
+	}
+	return nil
+}
+
+// WriteYAML フィクスチャをYAML形式で出力する
+func (f *Fixture) WriteYAML(w io.Writer) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(f); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// WriteJSON フィクスチャをJSON形式で出力する
+func (f *Fixture) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(f)
+}
+
+// WriteFile フィクスチャをファイルへ出力する
+//
+// 拡張子が.jsonの場合はJSON形式、それ以外の場合はYAML形式で出力する
+func (f *Fixture) WriteFile(path string) error {
+	buf := &bytes.Buffer{}
+	write := f.WriteYAML
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		write = f.WriteJSON
+	}
+	if err := write(buf); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0600)
+}
+
+// Add リソースを追加する
+//
+// valueにはモデル(*sacloud.Serverなど)またはフィールド名をキーとしたマップを指定する
+func (f *Fixture) Add(resourceKey, zone string, value interface{}) error {
+	if _, ok := jsonResourceTypeMap[resourceKey]; !ok {
+		return fmt.Errorf("fixture: unknown resource key %q", resourceKey)
+	}
+	v, err := toFixtureValue(value)
+	if err != nil {
+		return err
+	}
+	if f.Resources == nil {
+		f.Resources = make(map[string]map[string][]map[string]interface{})
+	}
+	if f.Resources[resourceKey] == nil {
+		f.Resources[resourceKey] = make(map[string][]map[string]interface{})
+	}
+	f.Resources[resourceKey][zone] = append(f.Resources[resourceKey][zone], v)
+	return nil
+}
+
+func (f *Fixture) resourceKeys() []string {
+	var keys []string
+	for key := range f.Resources {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func fixtureValueToModel(resourceKey string, value map[string]interface{}) (interface{}, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	model := jsonResourceTypeMap[resourceKey]()
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(model); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+func fixtureModelID(model interface{}) types.ID {
+	if v, ok := model.(interface{ GetID() types.ID }); ok {
+		return v.GetID()
+	}
+	return types.ID(0)
+}
+
+func fixtureSetModelID(model interface{}, id types.ID) {
+	if v, ok := model.(interface{ SetID(types.ID) }); ok {
+		v.SetID(id)
+	}
+}
+
+// toFixtureValue モデルをフィクスチャで利用するマップへ変換する
+//
+// 空の値(nil、空文字列、ゼロ値の日時、空のスライス/マップ)は除去され、数値はint64またはfloat64となる
+func toFixtureValue(value interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var v map[string]interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	normalized, _ := normalizeFixtureValue(v).(map[string]interface{})
+	if normalized == nil {
+		normalized = make(map[string]interface{})
+	}
+	return normalized, nil
+}
+
+// fixtureZeroTime time.Timeのゼロ値をJSONへ変換した値
+const fixtureZeroTime = "0001-01-01T00:00:00Z"
+
+func normalizeFixtureValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		ret := make(map[string]interface{})
+		for key, item := range v {
+			if normalized := normalizeFixtureValue(item); normalized != nil {
+				ret[key] = normalized
+			}
+		}
+		if len(ret) == 0 {
+			return nil
+		}
+		return ret
+	case []interface{}:
+		var ret []interface{}
+		for _, item := range v {
+			// 要素の位置に意味がある場合があるため、スライス内の空の値は除去しない
+			if normalized := normalizeFixtureValue(item); normalized != nil {
+				item = normalized
+			}
+			ret = append(ret, item)
+		}
+		if len(ret) == 0 {
+			return nil
+		}
+		return ret
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		if n, err := v.Float64(); err == nil {
+			return n
+		}
+		return v.String()
+	case string:
+		if v == "" || v == fixtureZeroTime {
+			return nil
+		}
+		return v
+	default:
+		return v
+	}
+}
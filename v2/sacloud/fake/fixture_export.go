@@ -0,0 +1,250 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/accessor"
+	"github.com/sacloud/libsacloud/v2/sacloud/search"
+	"github.com/sacloud/libsacloud/v2/sacloud/search/keys"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+// DefaultFixtureExportResourceKeys ExportFixtureでResourceKeysが指定されていない場合に対象とするリソースキー
+//
+// ゾーンやプランなど、フェイクドライバの初期データとして用意されているリソースは含まない。
+// アーカイブやアイコンなどScopeを持つリソースはユーザーのリソース(Scope=user)のみが対象となる
+var DefaultFixtureExportResourceKeys = []string{
+	ResourceArchive,
+	ResourceAutoBackup,
+	ResourceBridge,
+	ResourceCDROM,
+	ResourceCertificateAuthority,
+	ResourceContainerRegistry,
+	ResourceDatabase,
+	ResourceDisk,
+	ResourceDNS,
+	ResourceEnhancedDB,
+	ResourceESME,
+	ResourceGSLB,
+	ResourceIcon,
+	ResourceInterface,
+	ResourceInternet,
+	ResourceIPv6Net,
+	ResourceIPv6Addr,
+	ResourceLicense,
+	ResourceLoadBalancer,
+	ResourceLocalRouter,
+	ResourceMobileGateway,
+	ResourceNFS,
+	ResourceNote,
+	ResourcePacketFilter,
+	ResourcePrivateHost,
+	ResourceProxyLB,
+	ResourceServer,
+	ResourceSIM,
+	ResourceSimpleMonitor,
+	ResourceSSHKey,
+	ResourceSubnet,
+	ResourceSwitch,
+	ResourceVPCRouter,
+}
+
+// FixtureExportPageSize ExportFixtureで1回のFindで取得する件数
+var FixtureExportPageSize = 100
+
+// fixtureSecretFieldPattern フィクスチャへ出力しないフィールド名のパターン
+//
+// SlackWebhooksURLなど、URL自体が認証情報となるWebhookのURLも含む
+var fixtureSecretFieldPattern = regexp.MustCompile(`(?i)(password|passcode|secret|privatekey|sharedkey|token|webhook)`)
+
+// fixtureCredentialURLPattern フィクスチャへ出力しない、認証情報(user:password@)を含むURLのパターン
+var fixtureCredentialURLPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9+.-]*://[^/@\s]+@`)
+
+// FixtureExportOptions ExportFixtureのオプション
+type FixtureExportOptions struct {
+	// Zones 対象ゾーン 空の場合はsacloud.SakuraCloudZones
+	Zones []string
+	// ResourceKeys 対象リソースキー 空の場合はDefaultFixtureExportResourceKeys
+	ResourceKeys []string
+}
+
+// ExportFixture 各リソースのFindを用いて既存のアカウントのリソースを読み取り、フィクスチャを作成する
+//
+// callerが利用する*Op(sacloud.NewServerOpなどが返すもの)経由でリソースを取得するため、
+// 実際のAPIの他にフェイクドライバなどからも作成できる。
+// パスワードや秘密鍵、Webhook URLなどの秘匿情報は出力されない。
+// Scopeを持つリソースはユーザーのリソース(Scope=user)のみを出力し、公開アーカイブなどの共有リソースは出力しない。
+// グローバルリソースはFixtureGlobalZoneをゾーンとして出力される
+func ExportFixture(ctx context.Context, caller sacloud.APICaller, opts *FixtureExportOptions) (*Fixture, error) {
+	if opts == nil {
+		opts = &FixtureExportOptions{}
+	}
+	zones := opts.Zones
+	if len(zones) == 0 {
+		zones = sacloud.SakuraCloudZones
+	}
+	resourceKeys := opts.ResourceKeys
+	if len(resourceKeys) == 0 {
+		resourceKeys = DefaultFixtureExportResourceKeys
+	}
+
+	fixture := &Fixture{}
+	for _, resourceKey := range resourceKeys {
+		if _, ok := jsonResourceTypeMap[resourceKey]; !ok {
+			return nil, fmt.Errorf("fixture: unknown resource key %q", resourceKey)
+		}
+
+		find, global, err := fixtureFindFunc(caller, resourceKey)
+		if err != nil {
+			return nil, err
+		}
+		if global {
+			if err := exportFixtureResources(ctx, fixture, find, resourceKey, FixtureGlobalZone); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		for _, zone := range zones {
+			if err := exportFixtureResources(ctx, fixture, find, resourceKey, zone); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return fixture, nil
+}
+
+var findConditionType = reflect.TypeOf(&sacloud.FindCondition{})
+
+// fixtureFindFunc リソースキーに対応する*OpのFindメソッドと、グローバルリソースであるかを返す
+func fixtureFindFunc(caller sacloud.APICaller, resourceKey string) (reflect.Value, bool, error) {
+	factory := sacloud.GetClientFactoryFunc
+	if registry, ok := sacloud.ClientRegistryFromCaller(caller); ok {
+		factory = registry.GetClientFactoryFunc
+	}
+
+	find := reflect.ValueOf(factory(resourceKey)(caller)).MethodByName("Find")
+	if !find.IsValid() {
+		return reflect.Value{}, false, fmt.Errorf("fixture: %s does not support Find", resourceKey)
+	}
+	t := find.Type()
+	if t.NumIn() < 2 || t.In(t.NumIn()-1) != findConditionType {
+		return reflect.Value{}, false, fmt.Errorf("fixture: %s does not support Find with FindCondition", resourceKey)
+	}
+	return find, t.NumIn() == 2, nil
+}
+
+var scopeAccessorType = reflect.TypeOf((*accessor.Scope)(nil)).Elem()
+
+// hasFixtureScope Findの結果のリソースがScopeを持つか
+func hasFixtureScope(find reflect.Value) bool {
+	result := find.Type().Out(0).Elem()
+	for i := 0; i < result.NumField(); i++ {
+		if field := result.Field(i); field.Type.Kind() == reflect.Slice {
+			return field.Type.Elem().Implements(scopeAccessorType)
+		}
+	}
+	return false
+}
+
+func exportFixtureResources(ctx context.Context, fixture *Fixture, find reflect.Value, resourceKey, zone string) error {
+	scoped := hasFixtureScope(find)
+	from := 0
+	for {
+		condition := &sacloud.FindCondition{From: from, Count: FixtureExportPageSize}
+		if scoped {
+			condition.Filter = search.Filter{
+				search.Key(keys.Scope): string(types.Scopes.User),
+			}
+		}
+		args := []reflect.Value{reflect.ValueOf(ctx)}
+		if zone != FixtureGlobalZone {
+			args = append(args, reflect.ValueOf(zone))
+		}
+		args = append(args, reflect.ValueOf(condition))
+
+		results := find.Call(args)
+		if err, _ := results[1].Interface().(error); err != nil {
+			if sacloud.IsNotFoundError(err) {
+				// ゾーンによっては提供されていないリソースがあるため無視する
+				return nil
+			}
+			return fmt.Errorf("fixture: finding %s in %s failed: %s", resourceKey, zone, err)
+		}
+
+		result := results[0].Elem()
+		values := fixtureFindResultValues(result)
+		for i := 0; i < values.Len(); i++ {
+			if v, ok := values.Index(i).Interface().(accessor.Scope); ok && v.GetScope() == types.Scopes.Shared {
+				continue
+			}
+			value, err := toFixtureValue(values.Index(i).Interface())
+			if err != nil {
+				return err
+			}
+			stripFixtureSecrets(value)
+			if err := fixture.Add(resourceKey, zone, value); err != nil {
+				return err
+			}
+		}
+
+		from += values.Len()
+		if values.Len() == 0 || from >= int(result.FieldByName("Total").Int()) {
+			return nil
+		}
+	}
+}
+
+// fixtureFindResultValues XxxFindResultからリソースのスライスを返す
+func fixtureFindResultValues(result reflect.Value) reflect.Value {
+	for i := 0; i < result.NumField(); i++ {
+		if field := result.Field(i); field.Kind() == reflect.Slice {
+			return field
+		}
+	}
+	return reflect.ValueOf([]interface{}{})
+}
+
+// stripFixtureSecrets パスワードや認証情報を含むURLなどの秘匿情報を再帰的に除去する
+func stripFixtureSecrets(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if fixtureSecretFieldPattern.MatchString(key) || isFixtureCredentialURL(item) {
+				delete(v, key)
+				continue
+			}
+			stripFixtureSecrets(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			if isFixtureCredentialURL(item) {
+				v[i] = ""
+				continue
+			}
+			stripFixtureSecrets(item)
+		}
+	}
+}
+
+func isFixtureCredentialURL(value interface{}) bool {
+	s, ok := value.(string)
+	return ok && fixtureCredentialURLPattern.MatchString(s)
+}
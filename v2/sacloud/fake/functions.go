@@ -19,13 +19,11 @@ import (
 	"fmt"
 	"math/rand"
 	"net/http"
-	"reflect"
 	"strings"
 	"time"
 
 	"github.com/sacloud/libsacloud/v2/sacloud"
 	"github.com/sacloud/libsacloud/v2/sacloud/accessor"
-	"github.com/sacloud/libsacloud/v2/sacloud/search"
 	"github.com/sacloud/libsacloud/v2/sacloud/types"
 )
 
@@ -77,115 +75,6 @@ func newInternalServerError(resourceKey string, id interface{}, msgs ...string)
 	})
 }
 
-func find(resourceKey, zone string, conditions *sacloud.FindCondition) ([]interface{}, error) {
-	var results []interface{}
-	if conditions == nil {
-		conditions = &sacloud.FindCondition{}
-	}
-
-	targets := ds().List(resourceKey, zone)
-
-FILTER_APPLY_LOOP:
-	for i, target := range targets {
-		// count
-		if conditions.Count != 0 && len(results) >= conditions.Count {
-			break
-		}
-
-		// from
-		if i < conditions.From {
-			continue
-		}
-
-		// filter
-		for key, expression := range conditions.Filter {
-			// TODO OpGreater/OpLess is not implemented
-			if key.Op == search.OpEqual {
-				fieldName := key.String()
-
-				// only ID/Name/Tags
-				switch fieldName {
-				case "ID", "Name", "Tags.Name", "Scope", "Class":
-					exp, ok := expression.(*search.EqualExpression)
-					if !ok {
-						exp = search.OrEqual(expression)
-					}
-
-					if fieldName == "Tags.Name" {
-						var tags types.Tags
-						if v, ok := target.(accessor.Tags); ok {
-							tags = v.GetTags()
-						}
-
-						for _, cond := range exp.Conditions {
-							condTags, ok := cond.([]string)
-							if ok {
-								for _, v := range condTags {
-									exists := false
-									for _, tag := range tags {
-										if tag == v {
-											exists = true
-										}
-									}
-									if !exists {
-										continue FILTER_APPLY_LOOP
-									}
-								}
-							}
-						}
-					} else {
-						var value interface{}
-						switch fieldName {
-						case "ID":
-							if v, ok := target.(accessor.ID); ok {
-								value = v.GetID()
-							}
-						case "Name":
-							if v, ok := target.(accessor.Name); ok {
-								value = v.GetName()
-							}
-						case "Scope":
-							if v, ok := target.(accessor.Scope); ok {
-								value = v.GetScope()
-							}
-						case "Class":
-							if v, ok := target.(accessor.Class); ok {
-								value = v.GetClass()
-							}
-						}
-
-						switch exp.Op {
-						case search.OpAnd:
-							for _, v := range exp.Conditions {
-								v1, ok1 := v.(string)
-								v2, ok2 := value.(string)
-								if !ok1 || !ok2 || !strings.Contains(v2, v1) {
-									continue FILTER_APPLY_LOOP
-								}
-							}
-						case search.OpOr:
-							match := false
-							for _, v := range exp.Conditions {
-								if reflect.DeepEqual(value, v) {
-									match = true
-								}
-							}
-							if !match {
-								continue FILTER_APPLY_LOOP
-							}
-						}
-					}
-				}
-			}
-		}
-
-		results = append(results, target)
-	}
-
-	// TODO sort/filter/include/exclude is not implemented
-	return results, nil
-}
-
 func copySameNameField(source interface{}, dest interface{}) {
 	data, _ := json.Marshal(source)
 	json.Unmarshal(data, dest) // nolint
@@ -197,11 +86,11 @@ func fill(target interface{}, fillFuncs ...func(interface{})) {
 	}
 }
 
-func fillID(target interface{}) {
+func fillIDWithPool(p *valuePool, target interface{}) {
 	if v, ok := target.(accessor.ID); ok {
 		id := v.GetID()
 		if id.IsEmpty() {
-			v.SetID(pool().generateID())
+			v.SetID(p.generateID())
 		}
 	}
 }
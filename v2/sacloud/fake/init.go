@@ -41,19 +41,78 @@ func InitDataStore() {
 		if DataStore == nil {
 			DataStore = NewInMemoryStore()
 		}
-		if err := DataStore.Init(); err != nil {
+		vp = initStore(DataStore)
+	})
+}
+
+// initStore データストアを初期化し、データストアに対応するvaluePoolを返す
+func initStore(s Store) *valuePool {
+	if err := s.Init(); err != nil {
+		panic(err)
+	}
+
+	// pool(id,ip,subnet,etc...)
+	p := initValuePool(s)
+
+	if s.NeedInitData() {
+		if err := initData(s, p); err != nil {
 			panic(err)
 		}
+	}
+	return p
+}
 
-		// pool(id,ip,subnet,etc...)
-		p := initValuePool(DataStore)
+var (
+	storePools   = make(map[Store]*valuePool)
+	storePoolsMu sync.Mutex
+)
 
-		if DataStore.NeedInitData() {
-			if err := initData(DataStore, p); err != nil {
-				panic(err)
-			}
-		}
-	})
+// poolFor 指定のデータストアに対応するvaluePoolを返す
+//
+// DataStore以外のデータストアの場合、初回呼び出し時にデータストアの初期化を行う
+func poolFor(s Store) *valuePool {
+	if s == ds() {
+		return pool()
+	}
+
+	storePoolsMu.Lock()
+	defer storePoolsMu.Unlock()
+
+	p, ok := storePools[s]
+	if !ok {
+		p = initStore(s)
+		storePools[s] = p
+	}
+	return p
+}
+
+// storeAccessor fakeドライバの各Opが利用するデータストアを保持する
+//
+// storeが空の場合はDataStoreが利用される
+type storeAccessor struct {
+	store    Store
+	registry *sacloud.ClientRegistry
+}
+
+// ClientRegistry SwitchRegistryFactoryFuncToFakeで登録したClientRegistryを返す
+func (a *storeAccessor) ClientRegistry() *sacloud.ClientRegistry {
+	return a.registry
+}
+
+func (a *storeAccessor) ds() Store {
+	if a.store == nil {
+		return ds()
+	}
+	poolFor(a.store) // 初期化
+	return a.store
+}
+
+func (a *storeAccessor) pool() *valuePool {
+	return poolFor(a.ds())
+}
+
+func (a *storeAccessor) fillID(target interface{}) {
+	fillIDWithPool(a.pool(), target)
 }
 
 func initData(s Store, p *valuePool) error {
@@ -359,7 +418,7 @@ func initServerPlan(s Store, p *valuePool) {
 			ID:           p.generateID(),
 			Name:         "プラン/1Core-1GB",
 			CPU:          1,
-			MemoryMB:     1 * size.MiB,
+			MemoryMB:     1 * size.GiB,
 			GPU:          0,
 			Commitment:   types.Commitments.Standard,
 			Generation:   100,
@@ -369,7 +428,7 @@ func initServerPlan(s Store, p *valuePool) {
 			ID:           p.generateID(),
 			Name:         "プラン/2Core-4GB",
 			CPU:          2,
-			MemoryMB:     4 * size.MiB,
+			MemoryMB:     4 * size.GiB,
 			GPU:          0,
 			Commitment:   types.Commitments.Standard,
 			Generation:   100,
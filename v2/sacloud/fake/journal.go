@@ -0,0 +1,222 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+// Journal フェイクドライバでのAPI呼び出しを記録するジャーナル
+//
+// AddClientFactoryHooksまたはAddClientFactoryHooksToRegistryでクライアントファクトリーのフックとして登録すると、
+// 以降のAPI呼び出しが記録される。
+// FaultInjectorより後に登録した場合、FaultInjectorが返したエラーも記録される。
+//
+//	journal := fake.NewJournal()
+//	journal.AddClientFactoryHooksToRegistry(registry)
+//
+//	// テスト対象の処理
+//
+//	journal.ExpectCalled(t, &fake.JournalQuery{ResourceKey: fake.ResourceServer, Operation: "Boot", ID: id}, 1)
+//	journal.ExpectNotCalled(t, &fake.JournalQuery{Operation: "Delete"})
+type Journal struct {
+	mu      sync.RWMutex
+	entries []*JournalEntry
+}
+
+// JournalEntry ジャーナルに記録されたAPI呼び出し
+type JournalEntry struct {
+	// ResourceKey 対象リソースのキー(fake.ResourceServerなど)
+	ResourceKey string
+	// Operation オペレーション名(Boot、Createなど)
+	Operation string
+	// Zone 対象ゾーン グローバルリソースの場合はsacloud.APIDefaultZone
+	Zone string
+	// ID 対象リソースのID IDを引数に取るオペレーションの場合のみ設定される
+	ID types.ID
+	// Arguments 引数名をキーとした引数の値(zoneは含まない)
+	Arguments map[string]interface{}
+	// Results 戻り値(errorは含まない)
+	Results []interface{}
+	// Err 戻り値のerror
+	Err error
+	// Timestamp 呼び出し日時
+	Timestamp time.Time
+}
+
+// String JournalEntryの文字列表現
+func (e *JournalEntry) String() string {
+	var args []string
+	args = append(args, "zone="+e.Zone)
+	if !e.ID.IsEmpty() {
+		args = append(args, "id="+e.ID.String())
+	}
+	s := fmt.Sprintf("%s.%s(%s)", e.ResourceKey, e.Operation, strings.Join(args, ", "))
+	if e.Err != nil {
+		s += " => " + e.Err.Error()
+	}
+	return s
+}
+
+// JournalQuery ジャーナルの検索条件
+//
+// ResourceKey/Operation/Zone/IDのうち空(ゼロ値)でないものが全て一致するエントリが対象となる
+type JournalQuery struct {
+	ResourceKey string
+	Operation   string
+	Zone        string
+	ID          types.ID
+	// Match 追加の一致条件 nilの場合は常に一致とみなす
+	Match func(entry *JournalEntry) bool
+}
+
+func (q *JournalQuery) match(entry *JournalEntry) bool {
+	if q == nil {
+		return true
+	}
+	if q.ResourceKey != "" && q.ResourceKey != entry.ResourceKey {
+		return false
+	}
+	if q.Operation != "" && q.Operation != entry.Operation {
+		return false
+	}
+	if q.Zone != "" && q.Zone != entry.Zone {
+		return false
+	}
+	if !q.ID.IsEmpty() && q.ID != entry.ID {
+		return false
+	}
+	return q.Match == nil || q.Match(entry)
+}
+
+// String JournalQueryの文字列表現
+func (q *JournalQuery) String() string {
+	if q == nil {
+		return "*"
+	}
+	resourceKey, operation := q.ResourceKey, q.Operation
+	if resourceKey == "" {
+		resourceKey = "*"
+	}
+	if operation == "" {
+		operation = "*"
+	}
+	var conds []string
+	if q.Zone != "" {
+		conds = append(conds, "zone="+q.Zone)
+	}
+	if !q.ID.IsEmpty() {
+		conds = append(conds, "id="+q.ID.String())
+	}
+	if q.Match != nil {
+		conds = append(conds, "match=func")
+	}
+	return fmt.Sprintf("%s.%s(%s)", resourceKey, operation, strings.Join(conds, ", "))
+}
+
+// NewJournal Journalを作成する
+func NewJournal() *Journal {
+	return &Journal{}
+}
+
+// AddClientFactoryHooks グローバルなクライアントファクトリーにジャーナル記録用のフックを登録する
+func (j *Journal) AddClientFactoryHooks() {
+	addJournalRecorderHooks(sacloud.AddClientFacotyHookFunc, j)
+}
+
+// AddClientFactoryHooksToRegistry レジストリにジャーナル記録用のフックを登録する
+func (j *Journal) AddClientFactoryHooksToRegistry(registry *sacloud.ClientRegistry) {
+	addJournalRecorderHooks(registry.AddClientFactoryHookFunc, j)
+}
+
+func (j *Journal) record(entry *JournalEntry) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.entries = append(j.entries, entry)
+}
+
+// Entries 記録された全てのエントリを記録順に返す
+func (j *Journal) Entries() []*JournalEntry {
+	return j.Find(nil)
+}
+
+// Find 検索条件に一致するエントリを記録順に返す
+func (j *Journal) Find(query *JournalQuery) []*JournalEntry {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	var entries []*JournalEntry
+	for _, entry := range j.entries {
+		if query.match(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// Count 検索条件に一致するエントリの件数を返す
+func (j *Journal) Count(query *JournalQuery) int {
+	return len(j.Find(query))
+}
+
+// Reset 記録された全てのエントリを削除する
+func (j *Journal) Reset() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.entries = nil
+}
+
+// JournalTestingT Journalのアサーションで利用するインターフェース
+//
+// *testing.Tなどが実装している
+type JournalTestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// ExpectCalled 検索条件に一致する呼び出しがtimes回行われたか検証する
+func (j *Journal) ExpectCalled(t JournalTestingT, query *JournalQuery, times int) bool {
+	t.Helper()
+	entries := j.Find(query)
+	if len(entries) != times {
+		t.Errorf("expected %s to be called %d time(s), but called %d time(s)%s", query, times, len(entries), formatJournalEntries(entries))
+		return false
+	}
+	return true
+}
+
+// ExpectNotCalled 検索条件に一致する呼び出しが行われていないことを検証する
+func (j *Journal) ExpectNotCalled(t JournalTestingT, query *JournalQuery) bool {
+	t.Helper()
+	return j.ExpectCalled(t, query, 0)
+}
+
+func formatJournalEntries(entries []*JournalEntry) string {
+	if len(entries) == 0 {
+		return ""
+	}
+	var buf strings.Builder
+	buf.WriteString(":")
+	for _, entry := range entries {
+		buf.WriteString("\n\t" + entry.String())
+	}
+	return buf.String()
+}
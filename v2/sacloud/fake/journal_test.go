@@ -0,0 +1,92 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/stretchr/testify/require"
+)
+
+type journalTestingT struct {
+	errors []string
+}
+
+func (t *journalTestingT) Helper() {}
+
+func (t *journalTestingT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestJournal(t *testing.T) {
+	ctx := context.Background()
+
+	registry := sacloud.NewClientRegistry()
+	SwitchRegistryFactoryFuncToFake(registry, NewInMemoryStore())
+	injector := NewFaultInjector()
+	injector.AddClientFactoryHooksToRegistry(registry)
+	journal := NewJournal()
+	journal.AddClientFactoryHooksToRegistry(registry)
+
+	caller := sacloud.NewCallerWithRegistry(sacloud.NewClient("", ""), registry)
+	serverOp := sacloud.NewServerOp(caller)
+
+	server, err := serverOp.Create(ctx, "is1a", &sacloud.ServerCreateRequest{Name: "server", CPU: 1, MemoryMB: 1024})
+	require.NoError(t, err)
+	require.NoError(t, serverOp.Boot(ctx, "is1a", server.ID))
+
+	injector.AddRule(&FaultRule{ResourceKey: ResourceServer, Operation: "Shutdown", Err: NewLockedError()})
+	shutdownErr := serverOp.Shutdown(ctx, "is1a", server.ID, &sacloud.ShutdownOption{Force: true})
+	require.Error(t, shutdownErr)
+
+	_, err = sacloud.NewZoneOp(caller).Find(ctx, nil)
+	require.NoError(t, err)
+
+	entries := journal.Entries()
+	require.Len(t, entries, 4)
+
+	create := entries[0]
+	require.Equal(t, ResourceServer, create.ResourceKey)
+	require.Equal(t, "Create", create.Operation)
+	require.Equal(t, "is1a", create.Zone)
+	require.True(t, create.ID.IsEmpty())
+	require.Equal(t, "server", create.Arguments["param"].(*sacloud.ServerCreateRequest).Name)
+	require.Equal(t, server.ID, create.Results[0].(*sacloud.Server).ID)
+	require.False(t, create.Timestamp.IsZero())
+
+	require.Equal(t, server.ID, entries[1].ID)
+	require.Equal(t, shutdownErr, entries[2].Err)
+	require.Equal(t, sacloud.APIDefaultZone, entries[3].Zone)
+
+	require.Equal(t, 2, journal.Count(&JournalQuery{ResourceKey: ResourceServer, ID: server.ID}))
+	require.Len(t, journal.Find(&JournalQuery{Match: func(entry *JournalEntry) bool {
+		return entry.Err != nil
+	}}), 1)
+
+	require.True(t, journal.ExpectCalled(t, &JournalQuery{ResourceKey: ResourceServer, Operation: "Boot", ID: server.ID}, 1))
+	require.True(t, journal.ExpectNotCalled(t, &JournalQuery{Operation: "Delete"}))
+
+	mock := &journalTestingT{}
+	require.False(t, journal.ExpectNotCalled(mock, &JournalQuery{ResourceKey: ResourceServer, Operation: "Shutdown"}))
+	require.Len(t, mock.errors, 1)
+	require.Contains(t, mock.errors[0], "Server.Shutdown() to be called 0 time(s), but called 1 time(s)")
+	require.Contains(t, mock.errors[0], fmt.Sprintf("Server.Shutdown(zone=is1a, id=%s) => ", server.ID))
+
+	journal.Reset()
+	require.Empty(t, journal.Entries())
+}
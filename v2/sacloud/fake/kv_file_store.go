@@ -0,0 +1,601 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+const defaultKVFilePath = "libsacloud-fake-store.db"
+
+// ErrKVTxReadOnly 読み取り専用トランザクションで更新を行おうとした場合のエラー
+var ErrKVTxReadOnly = errors.New("fake: transaction is read-only")
+
+// KVFileStore 単一ファイルに追記型でデータを保存する組み込みキーバリュー形式のデータストア
+//
+// データはリソース種別ごとのバケットに保持される。
+// 更新(Put/Delete/Update)は変更内容のみをファイルに追記するため、JSONFileStoreのように都度ファイル全体を書き換えることはない。
+// 追記されたログはInit時、またはCompact呼び出し時に現在の内容のみに圧縮される。
+//
+// Updateを用いることで複数の更新をトランザクションとしてまとめて(全て反映されるか、全く反映されないかのいずれかで)適用できる。
+// また、Snapshot/Restoreによりある時点のデータを退避し、後から巻き戻すことができる
+type KVFileStore struct {
+	// Path データファイルのパス 空の場合はカレントディレクトリのlibsacloud-fake-store.dbが利用される
+	Path string
+	// NoInitData trueの場合、データファイルが空でも初期データを投入しない
+	NoInitData bool
+	// NoSync trueの場合、追記ごとのfsyncを行わない
+	NoSync bool
+
+	mu      sync.RWMutex
+	buckets map[string]map[string]map[string]interface{} // bucket -> zone -> id -> value
+	file    *os.File
+}
+
+// NewKVFileStore .
+func NewKVFileStore(path string) *KVFileStore {
+	return &KVFileStore{
+		Path:    path,
+		buckets: make(map[string]map[string]map[string]interface{}),
+	}
+}
+
+// Init .
+func (s *KVFileStore) Init() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file != nil {
+		return nil
+	}
+	if s.Path == "" {
+		s.Path = defaultKVFilePath
+	}
+	path, err := homedir.Expand(s.Path)
+	if err != nil {
+		return err
+	}
+	s.Path = path
+
+	if stat, err := os.Stat(s.Path); err == nil && stat.IsDir() {
+		return fmt.Errorf("path %q is directory", s.Path)
+	}
+
+	if err := s.load(); err != nil {
+		return err
+	}
+	return s.compact()
+}
+
+// Close データファイルを閉じる
+func (s *KVFileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+// NeedInitData .
+func (s *KVFileStore) NeedInitData() bool {
+	if s.NoInitData {
+		return false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.buckets) < 2
+}
+
+// Put .
+func (s *KVFileStore) Put(resourceKey, zone string, id types.ID, value interface{}) {
+	s.Update(func(tx *KVTx) error { // nolint
+		return tx.Put(resourceKey, zone, id, value)
+	})
+}
+
+// Get .
+func (s *KVFileStore) Get(resourceKey, zone string, id types.ID) interface{} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.buckets[resourceKey][zone][id.String()]
+}
+
+// List .
+func (s *KVFileStore) List(resourceKey, zone string) []interface{} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var ret []interface{}
+	for _, v := range s.buckets[resourceKey][zone] {
+		ret = append(ret, v)
+	}
+	return ret
+}
+
+// Delete .
+func (s *KVFileStore) Delete(resourceKey, zone string, id types.ID) {
+	s.Update(func(tx *KVTx) error { // nolint
+		return tx.Delete(resourceKey, zone, id)
+	})
+}
+
+// Buckets バケット(リソース種別)名のリストを返す
+func (s *KVFileStore) Buckets() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var names []string
+	for name := range s.buckets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// View 読み取り専用トランザクションでfnを実行する
+func (s *KVFileStore) View(fn func(tx *KVTx) error) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return fn(&KVTx{store: s, readOnly: true})
+}
+
+// Update 読み書き可能なトランザクションでfnを実行する
+//
+// fnがエラーを返した場合はトランザクション内の変更は全て破棄される。
+// fnが正常終了した場合は変更内容をまとめてデータファイルに追記してから反映する。
+// トランザクションの実行中は他の更新はブロックされる
+func (s *KVFileStore) Update(fn func(tx *KVTx) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx := &KVTx{store: s}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return s.commit(tx)
+}
+
+// Snapshot 現在のデータのスナップショットを返す
+//
+// スナップショットは取得時点のデータのコピーであり、以降のデータストアへの変更の影響を受けない
+func (s *KVFileStore) Snapshot() (*KVSnapshot, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	records, err := encodeKVRecords(s.buckets)
+	if err != nil {
+		return nil, err
+	}
+	return &KVSnapshot{Records: records}, nil
+}
+
+// Restore データをスナップショット取得時点の内容に戻す
+func (s *KVFileStore) Restore(snapshot *KVSnapshot) error {
+	buckets, err := decodeKVRecords(snapshot.Records)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.buckets = buckets
+	return s.compact()
+}
+
+// Compact データファイルを現在のデータのみを含む形に圧縮する
+func (s *KVFileStore) Compact() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.compact()
+}
+
+func (s *KVFileStore) commit(tx *KVTx) error {
+	if len(tx.ops) == 0 {
+		return nil
+	}
+
+	entry := &kvLogEntry{}
+	for _, op := range tx.ops {
+		record := &KVRecord{Bucket: op.bucket, Zone: op.zone, ID: op.id, Delete: op.delete}
+		if !op.delete {
+			if err := record.encode(op.value); err != nil {
+				return err
+			}
+		}
+		entry.Records = append(entry.Records, record)
+	}
+	if err := s.appendEntry(entry); err != nil {
+		return err
+	}
+
+	for _, op := range tx.ops {
+		if op.delete {
+			delete(s.buckets[op.bucket][op.zone], op.id)
+			continue
+		}
+		s.put(op.bucket, op.zone, op.id, op.value)
+	}
+	return nil
+}
+
+func (s *KVFileStore) put(bucket, zone, id string, value interface{}) {
+	if s.buckets == nil {
+		s.buckets = make(map[string]map[string]map[string]interface{})
+	}
+	zones, ok := s.buckets[bucket]
+	if !ok {
+		zones = make(map[string]map[string]interface{})
+		s.buckets[bucket] = zones
+	}
+	values, ok := zones[zone]
+	if !ok {
+		values = make(map[string]interface{})
+		zones[zone] = values
+	}
+	values[id] = value
+}
+
+func (s *KVFileStore) appendEntry(entry *kvLogEntry) error {
+	if s.file == nil {
+		// Init前の場合はメモリ上にのみ保持する
+		return nil
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := s.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	if !s.NoSync {
+		return s.file.Sync()
+	}
+	return nil
+}
+
+// load データファイルを読み込み、追記されたログを順に適用する
+//
+// 書き込み途中で中断された(末尾が不完全な)ログは無視する
+func (s *KVFileStore) load() error {
+	f, err := os.Open(s.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			// 改行で終わっていない行は書き込み途中のため無視
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		var entry kvLogEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return fmt.Errorf("reading %q is failed: %s", s.Path, err)
+		}
+		for _, record := range entry.Records {
+			if record.Delete {
+				delete(s.buckets[record.Bucket][record.Zone], record.ID)
+				continue
+			}
+			v, err := record.decode()
+			if err != nil {
+				return err
+			}
+			s.put(record.Bucket, record.Zone, record.ID, v)
+		}
+	}
+}
+
+// compact 現在のデータのみを含むデータファイルを作成し、既存のデータファイルと置き換える
+func (s *KVFileStore) compact() error {
+	if s.Path == "" {
+		return nil
+	}
+
+	records, err := encodeKVRecords(s.buckets)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // nolint
+
+	writer := bufio.NewWriter(tmp)
+	for _, record := range records {
+		data, err := json.Marshal(&kvLogEntry{Records: []*KVRecord{record}})
+		if err != nil {
+			tmp.Close() // nolint
+			return err
+		}
+		if _, err := writer.Write(append(data, '\n')); err != nil {
+			tmp.Close() // nolint
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		tmp.Close() // nolint
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close() // nolint
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if s.file != nil {
+		s.file.Close() // nolint
+		s.file = nil
+	}
+	if err := os.Rename(tmp.Name(), s.Path); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(s.Path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	s.file = file
+	return nil
+}
+
+// KVTx KVFileStoreのトランザクション
+//
+// トランザクション内での読み取りはトランザクション内で行った変更を反映した値を返す
+type KVTx struct {
+	store    *KVFileStore
+	readOnly bool
+	ops      []*kvTxOp
+}
+
+type kvTxOp struct {
+	bucket string
+	zone   string
+	id     string
+	value  interface{}
+	delete bool
+}
+
+// Put .
+func (tx *KVTx) Put(resourceKey, zone string, id types.ID, value interface{}) error {
+	if tx.readOnly {
+		return ErrKVTxReadOnly
+	}
+	tx.ops = append(tx.ops, &kvTxOp{bucket: resourceKey, zone: zone, id: id.String(), value: value})
+	return nil
+}
+
+// Delete .
+func (tx *KVTx) Delete(resourceKey, zone string, id types.ID) error {
+	if tx.readOnly {
+		return ErrKVTxReadOnly
+	}
+	tx.ops = append(tx.ops, &kvTxOp{bucket: resourceKey, zone: zone, id: id.String(), delete: true})
+	return nil
+}
+
+// Get .
+func (tx *KVTx) Get(resourceKey, zone string, id types.ID) interface{} {
+	key := id.String()
+	for i := len(tx.ops) - 1; i >= 0; i-- {
+		op := tx.ops[i]
+		if op.bucket == resourceKey && op.zone == zone && op.id == key {
+			if op.delete {
+				return nil
+			}
+			return op.value
+		}
+	}
+	return tx.store.buckets[resourceKey][zone][key]
+}
+
+// List .
+func (tx *KVTx) List(resourceKey, zone string) []interface{} {
+	values := make(map[string]interface{})
+	for id, v := range tx.store.buckets[resourceKey][zone] {
+		values[id] = v
+	}
+	for _, op := range tx.ops {
+		if op.bucket != resourceKey || op.zone != zone {
+			continue
+		}
+		if op.delete {
+			delete(values, op.id)
+			continue
+		}
+		values[op.id] = op.value
+	}
+
+	var ret []interface{}
+	for _, v := range values {
+		ret = append(ret, v)
+	}
+	return ret
+}
+
+// KVSnapshot KVFileStoreのスナップショット
+type KVSnapshot struct {
+	// Records スナップショット取得時点の全データ
+	Records []*KVRecord
+}
+
+// WriteTo スナップショットをJSON形式で書き込む
+func (s *KVSnapshot) WriteTo(w io.Writer) (int64, error) {
+	data, err := json.Marshal(s.Records)
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.Write(data)
+	return int64(n), err
+}
+
+// ReadKVSnapshot WriteToで書き込まれたスナップショットを読み込む
+func ReadKVSnapshot(r io.Reader) (*KVSnapshot, error) {
+	var records []*KVRecord
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return nil, err
+	}
+	return &KVSnapshot{Records: records}, nil
+}
+
+type kvLogEntry struct {
+	Records []*KVRecord
+}
+
+// KVRecord KVFileStoreに保存される1件分のデータ
+type KVRecord struct {
+	// Bucket バケット(リソース種別)名
+	Bucket string
+	// Zone ゾーン名
+	Zone string
+	// ID リソースのID
+	ID string
+	// Delete 削除を表すレコードの場合true
+	Delete bool `json:",omitempty"`
+	// Type 値のGoの型名
+	Type string `json:",omitempty"`
+	// Value JSONエンコードされた値
+	Value json.RawMessage `json:",omitempty"`
+}
+
+func (r *KVRecord) encode(value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	r.Type = reflect.TypeOf(value).String()
+	r.Value = data
+	return nil
+}
+
+func (r *KVRecord) decode() (interface{}, error) {
+	t, ok := kvValueTypes[r.Type]
+	if !ok {
+		return nil, fmt.Errorf("type %q is not registered: bucket=%s", r.Type, r.Bucket)
+	}
+
+	if t.Kind() == reflect.Ptr {
+		v := reflect.New(t.Elem())
+		if err := json.Unmarshal(r.Value, v.Interface()); err != nil {
+			return nil, err
+		}
+		return v.Interface(), nil
+	}
+	v := reflect.New(t)
+	if err := json.Unmarshal(r.Value, v.Interface()); err != nil {
+		return nil, err
+	}
+	return v.Elem().Interface(), nil
+}
+
+func encodeKVRecords(buckets map[string]map[string]map[string]interface{}) ([]*KVRecord, error) {
+	var records []*KVRecord
+	for bucket, zones := range buckets {
+		for zone, values := range zones {
+			for id, v := range values {
+				record := &KVRecord{Bucket: bucket, Zone: zone, ID: id}
+				if err := record.encode(v); err != nil {
+					return nil, err
+				}
+				records = append(records, record)
+			}
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Bucket != records[j].Bucket {
+			return records[i].Bucket < records[j].Bucket
+		}
+		if records[i].Zone != records[j].Zone {
+			return records[i].Zone < records[j].Zone
+		}
+		return types.StringID(records[i].ID) < types.StringID(records[j].ID)
+	})
+	return records, nil
+}
+
+func decodeKVRecords(records []*KVRecord) (map[string]map[string]map[string]interface{}, error) {
+	s := &KVFileStore{}
+	for _, record := range records {
+		v, err := record.decode()
+		if err != nil {
+			return nil, err
+		}
+		s.put(record.Bucket, record.Zone, record.ID, v)
+	}
+	if s.buckets == nil {
+		s.buckets = make(map[string]map[string]map[string]interface{})
+	}
+	return s.buckets, nil
+}
+
+// kvValueTypes KVFileStoreで復元可能な値の型
+var kvValueTypes = make(map[string]reflect.Type)
+
+func registerKVValueType(v interface{}) {
+	t := reflect.TypeOf(v)
+	kvValueTypes[t.String()] = t
+
+	// ポインタ/非ポインタのどちらで保存されていても復元できるようにする
+	if t.Kind() == reflect.Ptr {
+		kvValueTypes[t.Elem().String()] = t.Elem()
+	} else {
+		kvValueTypes[reflect.PtrTo(t).String()] = reflect.PtrTo(t)
+	}
+}
+
+func init() {
+	for _, f := range jsonResourceTypeMap {
+		registerKVValueType(f())
+	}
+	registerKVValueType(&ipv6Addr{})
+	registerKVValueType(&sacloud.ProxyLBCertificates{})
+}
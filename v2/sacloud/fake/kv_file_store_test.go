@@ -0,0 +1,158 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKVFileStore(t *testing.T, path string) *KVFileStore {
+	s := NewKVFileStore(path)
+	s.NoSync = true
+	require.NoError(t, s.Init())
+	t.Cleanup(func() {
+		s.Close() // nolint
+	})
+	return s
+}
+
+func TestKVFileStore_persistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fake.db")
+
+	s := newTestKVFileStore(t, path)
+	s.Put(ResourceServer, "is1a", types.ID(1), &sacloud.Server{ID: 1, Name: "server1"})
+	s.Put(ResourceServer, "is1a", types.ID(2), &sacloud.Server{ID: 2, Name: "server2"})
+	s.Put(ResourceContainerRegistry+"Users", sacloud.APIDefaultZone, types.ID(3), []*sacloud.ContainerRegistryUser{{UserName: "user"}})
+	s.Delete(ResourceServer, "is1a", types.ID(2))
+	require.NoError(t, s.Close())
+
+	// 更新ごとにファイル全体を書き換えず追記している
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Len(t, bytes.Split(bytes.TrimSpace(data), []byte("\n")), 4)
+
+	reopened := newTestKVFileStore(t, path)
+	require.Equal(t, []string{ResourceContainerRegistry + "Users", ResourceServer}, reopened.Buckets())
+
+	server, ok := reopened.Get(ResourceServer, "is1a", types.ID(1)).(*sacloud.Server)
+	require.True(t, ok)
+	require.Equal(t, "server1", server.Name)
+	require.Nil(t, reopened.Get(ResourceServer, "is1a", types.ID(2)))
+
+	users, ok := reopened.Get(ResourceContainerRegistry+"Users", sacloud.APIDefaultZone, types.ID(3)).([]*sacloud.ContainerRegistryUser)
+	require.True(t, ok)
+	require.Equal(t, "user", users[0].UserName)
+
+	// 再オープン時に圧縮される
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.Len(t, bytes.Split(bytes.TrimSpace(data), []byte("\n")), 2)
+}
+
+func TestKVFileStore_ignoresIncompleteEntry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fake.db")
+
+	s := newTestKVFileStore(t, path)
+	s.Put(ResourceServer, "is1a", types.ID(1), &sacloud.Server{ID: 1, Name: "server1"})
+	require.NoError(t, s.Close())
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"Records":[{"Bucket":"Server","Zone":"is1a","ID":"2"`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	reopened := newTestKVFileStore(t, path)
+	require.Len(t, reopened.List(ResourceServer, "is1a"), 1)
+}
+
+func TestKVFileStore_Update(t *testing.T) {
+	s := newTestKVFileStore(t, filepath.Join(t.TempDir(), "fake.db"))
+
+	errRollback := errors.New("rollback")
+	err := s.Update(func(tx *KVTx) error {
+		require.NoError(t, tx.Put(ResourceServer, "is1a", types.ID(1), &sacloud.Server{ID: 1}))
+		require.NotNil(t, tx.Get(ResourceServer, "is1a", types.ID(1)))
+		require.Len(t, tx.List(ResourceServer, "is1a"), 1)
+		return errRollback
+	})
+	require.Equal(t, errRollback, err)
+	require.Nil(t, s.Get(ResourceServer, "is1a", types.ID(1)))
+
+	err = s.Update(func(tx *KVTx) error {
+		if err := tx.Put(ResourceServer, "is1a", types.ID(1), &sacloud.Server{ID: 1}); err != nil {
+			return err
+		}
+		return tx.Put(ResourceDisk, "is1a", types.ID(2), &sacloud.Disk{ID: 2})
+	})
+	require.NoError(t, err)
+	require.NotNil(t, s.Get(ResourceServer, "is1a", types.ID(1)))
+	require.NotNil(t, s.Get(ResourceDisk, "is1a", types.ID(2)))
+
+	err = s.View(func(tx *KVTx) error {
+		return tx.Delete(ResourceServer, "is1a", types.ID(1))
+	})
+	require.Equal(t, ErrKVTxReadOnly, err)
+}
+
+func TestKVFileStore_SnapshotAndRestore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "fake.db")
+	s := newTestKVFileStore(t, path)
+
+	registry := sacloud.NewClientRegistry()
+	SwitchRegistryFactoryFuncToFake(registry, s)
+	switchOp := sacloud.NewSwitchOp(sacloud.NewCallerWithRegistry(sacloud.NewClient("", ""), registry))
+
+	before, err := switchOp.Find(ctx, "is1a", nil)
+	require.NoError(t, err)
+
+	snapshot, err := s.Snapshot()
+	require.NoError(t, err)
+
+	created, err := switchOp.Create(ctx, "is1a", &sacloud.SwitchCreateRequest{Name: "test"})
+	require.NoError(t, err)
+
+	require.NoError(t, s.Restore(snapshot))
+
+	after, err := switchOp.Find(ctx, "is1a", nil)
+	require.NoError(t, err)
+	require.Equal(t, before.Count, after.Count)
+
+	_, err = switchOp.Read(ctx, "is1a", created.ID)
+	require.True(t, sacloud.IsNotFoundError(err))
+
+	// スナップショットの書き出し/読み込み
+	buf := &bytes.Buffer{}
+	_, err = snapshot.WriteTo(buf)
+	require.NoError(t, err)
+	read, err := ReadKVSnapshot(buf)
+	require.NoError(t, err)
+	require.Equal(t, len(snapshot.Records), len(read.Records))
+
+	// リストア後の内容が永続化されている
+	require.NoError(t, s.Close())
+	reopened := newTestKVFileStore(t, path)
+	require.Nil(t, reopened.Get(ResourceSwitch, "is1a", created.ID))
+}
@@ -0,0 +1,224 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+var (
+	// MonitorInterval 監視データの間隔
+	MonitorInterval = 5 * time.Minute
+	// MonitorDefaultDuration MonitorConditionでStartが指定されていない場合の取得期間
+	MonitorDefaultDuration = time.Hour
+	// MonitorMaxPoints 1回の呼び出しで返す監視データの最大件数
+	MonitorMaxPoints = 12 * 24 * 31
+
+	// DefaultMonitorGenerator フェイクドライバのMonitorXxxで利用されるMonitorGenerator
+	DefaultMonitorGenerator = NewMonitorGenerator(0)
+)
+
+// MonitorPattern 監視データの値の変化パターン
+type MonitorPattern int
+
+const (
+	// MonitorPatternSine Baseを中心にAmplitudeの振幅、Periodの周期で変化する(デフォルト)
+	MonitorPatternSine MonitorPattern = iota
+	// MonitorPatternConstant 常にBaseとなる
+	MonitorPatternConstant
+	// MonitorPatternSpike Periodごとに1点だけBase+Amplitudeとなり、それ以外はBaseとなる
+	MonitorPatternSpike
+)
+
+// MonitorSeries 監視データの系列の生成パラメータ
+type MonitorSeries struct {
+	Pattern   MonitorPattern
+	Base      float64
+	Amplitude float64
+	// Period 周期 0の場合は24時間
+	Period time.Duration
+	// Phase 位相のずれ
+	Phase time.Duration
+	// Noise 値に加えるゆらぎの幅(±Noise) シードと時刻から決定的に算出される
+	Noise float64
+}
+
+func (s *MonitorSeries) period() time.Duration {
+	if s.Period <= 0 {
+		return 24 * time.Hour
+	}
+	return s.Period
+}
+
+func (s *MonitorSeries) value(t time.Time, noise float64) float64 {
+	elapsed := t.Add(s.Phase).Sub(time.Unix(0, 0))
+	period := s.period()
+
+	var v float64
+	switch s.Pattern {
+	case MonitorPatternConstant:
+		v = s.Base
+	case MonitorPatternSpike:
+		v = s.Base
+		if elapsed%period < MonitorInterval {
+			v += s.Amplitude
+		}
+	default:
+		v = s.Base + s.Amplitude*math.Sin(2*math.Pi*float64(elapsed%period)/float64(period))
+	}
+
+	v += s.Noise * noise
+	if v < 0 {
+		return 0
+	}
+	return v
+}
+
+// defaultMonitorSeries メトリクスごとのデフォルトの系列
+var defaultMonitorSeries = map[string]*MonitorSeries{
+	"CPUTime":           {Base: 300, Amplitude: 200, Noise: 50},
+	"TotalMemorySize":   {Pattern: MonitorPatternConstant, Base: 4096},
+	"UsedMemorySize":    {Base: 2048, Amplitude: 512, Noise: 64},
+	"TotalDisk1Size":    {Pattern: MonitorPatternConstant, Base: 20480},
+	"UsedDisk1Size":     {Base: 10240, Amplitude: 1024, Noise: 128},
+	"TotalDisk2Size":    {Pattern: MonitorPatternConstant, Base: 92160},
+	"UsedDisk2Size":     {Base: 40960, Amplitude: 4096, Noise: 512},
+	"BinlogUsedSizeKiB": {Base: 1024, Amplitude: 256, Noise: 32},
+	"DelayTimeSec":      {Pattern: MonitorPatternConstant},
+	"FreeDiskSize":      {Base: 80 * 1024 * 1024, Amplitude: 10 * 1024 * 1024, Noise: 1024 * 1024},
+	"ResponseTimeSec":   {Base: 0.2, Amplitude: 0.05, Noise: 0.02},
+}
+
+var fallbackMonitorSeries = &MonitorSeries{Base: 500, Amplitude: 250, Noise: 50}
+
+// MonitorGenerator フェイクドライバでの監視データを生成する
+//
+// 値はシード、リソースキー、ID、メトリクス名、時刻から決定的に算出されるため、同じ条件であれば常に同じ値となる
+type MonitorGenerator struct {
+	mu     sync.RWMutex
+	seed   int64
+	series map[monitorSeriesKey]*MonitorSeries
+}
+
+type monitorSeriesKey struct {
+	resourceKey string
+	id          types.ID
+	metric      string
+}
+
+// NewMonitorGenerator MonitorGeneratorを作成する
+func NewMonitorGenerator(seed int64) *MonitorGenerator {
+	return &MonitorGenerator{
+		seed:   seed,
+		series: make(map[monitorSeriesKey]*MonitorSeries),
+	}
+}
+
+// SetSeed シードを設定する
+func (g *MonitorGenerator) SetSeed(seed int64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.seed = seed
+}
+
+// SetSeries メトリクスの系列を設定する
+//
+// idが空の場合はresourceKeyで示されるリソース全体のデフォルトとなる。
+// metricには監視データの値のフィールド名(CPUTime、Send、Receiveなど)を指定する
+func (g *MonitorGenerator) SetSeries(resourceKey string, id types.ID, metric string, series *MonitorSeries) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.series[monitorSeriesKey{resourceKey: resourceKey, id: id, metric: metric}] = series
+}
+
+// Reset 設定した系列を全て削除する
+func (g *MonitorGenerator) Reset() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.series = make(map[monitorSeriesKey]*MonitorSeries)
+}
+
+// Value 指定時刻での値を返す
+func (g *MonitorGenerator) Value(resourceKey string, id types.ID, metric string, t time.Time) float64 {
+	g.mu.RLock()
+	series := g.lookupSeries(resourceKey, id, metric)
+	seed := g.seed
+	g.mu.RUnlock()
+
+	return series.value(t, monitorNoise(seed, resourceKey, id, metric, t))
+}
+
+func (g *MonitorGenerator) lookupSeries(resourceKey string, id types.ID, metric string) *MonitorSeries {
+	if s, ok := g.series[monitorSeriesKey{resourceKey: resourceKey, id: id, metric: metric}]; ok {
+		return s
+	}
+	if s, ok := g.series[monitorSeriesKey{resourceKey: resourceKey, metric: metric}]; ok {
+		return s
+	}
+	if s, ok := defaultMonitorSeries[metric]; ok {
+		return s
+	}
+	return fallbackMonitorSeries
+}
+
+// monitorNoise -1.0〜1.0の範囲の決定的な値を返す
+func monitorNoise(seed int64, resourceKey string, id types.ID, metric string, t time.Time) float64 {
+	h := fnv.New64a()
+	buf := make([]byte, 8)
+	for _, v := range []int64{seed, id.Int64(), t.Unix()} {
+		binary.LittleEndian.PutUint64(buf, uint64(v))
+		h.Write(buf) // nolint
+	}
+	h.Write([]byte(resourceKey + "/" + metric)) // nolint
+	return float64(h.Sum64()%2001)/1000 - 1
+}
+
+// monitorTimes MonitorConditionに従い監視データの時刻のリストを返す
+//
+// 時刻はMonitorInterval単位に切り捨て/切り上げた上でStart〜Endの範囲となる
+func monitorTimes(condition *sacloud.MonitorCondition) []time.Time {
+	var start, end time.Time
+	if condition != nil {
+		start, end = condition.Start, condition.End
+	}
+	if end.IsZero() {
+		end = time.Now()
+	}
+	if start.IsZero() {
+		start = end.Add(-MonitorDefaultDuration)
+	}
+
+	end = end.Truncate(MonitorInterval)
+	if truncated := start.Truncate(MonitorInterval); !truncated.Equal(start) {
+		start = truncated.Add(MonitorInterval)
+	}
+
+	var times []time.Time
+	for t := start; !t.After(end) && len(times) < MonitorMaxPoints; t = t.Add(MonitorInterval) {
+		times = append(times, t)
+	}
+	return times
+}
+
+func monitorValue(resourceKey string, id types.ID, metric string, t time.Time) float64 {
+	return DefaultMonitorGenerator.Value(resourceKey, id, metric, t)
+}
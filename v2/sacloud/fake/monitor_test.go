@@ -0,0 +1,100 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorTimes(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 3, 0, 0, time.UTC)
+	end := time.Date(2022, 1, 1, 1, 2, 0, 0, time.UTC)
+
+	times := monitorTimes(&sacloud.MonitorCondition{Start: start, End: end})
+	require.Len(t, times, 12)
+	require.Equal(t, time.Date(2022, 1, 1, 0, 5, 0, 0, time.UTC), times[0])
+	require.Equal(t, time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC), times[11])
+	for i := 1; i < len(times); i++ {
+		require.Equal(t, MonitorInterval, times[i].Sub(times[i-1]))
+	}
+
+	times = monitorTimes(nil)
+	require.Len(t, times, 12)
+	require.True(t, times[11].Before(time.Now()))
+
+	require.Empty(t, monitorTimes(&sacloud.MonitorCondition{Start: end, End: start}))
+}
+
+func TestMonitorGenerator(t *testing.T) {
+	at := time.Date(2022, 1, 1, 6, 0, 0, 0, time.UTC)
+
+	g := NewMonitorGenerator(1)
+	v := g.Value(ResourceServer, 1, "CPUTime", at)
+	require.Equal(t, v, NewMonitorGenerator(1).Value(ResourceServer, 1, "CPUTime", at))
+	require.NotEqual(t, v, NewMonitorGenerator(2).Value(ResourceServer, 1, "CPUTime", at))
+	require.NotEqual(t, v, g.Value(ResourceServer, 2, "CPUTime", at))
+
+	g.SetSeries(ResourceServer, types.ID(0), "CPUTime", &MonitorSeries{Pattern: MonitorPatternConstant, Base: 100})
+	g.SetSeries(ResourceServer, types.ID(2), "CPUTime", &MonitorSeries{
+		Pattern:   MonitorPatternSpike,
+		Base:      10,
+		Amplitude: 90,
+		Period:    time.Hour,
+	})
+	g.SetSeries(ResourceServer, types.ID(3), "CPUTime", &MonitorSeries{
+		Base:      50,
+		Amplitude: 50,
+		Period:    time.Hour,
+	})
+
+	require.Equal(t, float64(100), g.Value(ResourceServer, 1, "CPUTime", at))
+
+	require.Equal(t, float64(100), g.Value(ResourceServer, 2, "CPUTime", at))
+	require.Equal(t, float64(10), g.Value(ResourceServer, 2, "CPUTime", at.Add(MonitorInterval)))
+
+	require.InDelta(t, 50, g.Value(ResourceServer, 3, "CPUTime", at), 0.0001)
+	require.InDelta(t, 100, g.Value(ResourceServer, 3, "CPUTime", at.Add(15*time.Minute)), 0.0001)
+	require.InDelta(t, 0, g.Value(ResourceServer, 3, "CPUTime", at.Add(45*time.Minute)), 0.0001)
+
+	g.Reset()
+	require.Equal(t, v, g.Value(ResourceServer, 1, "CPUTime", at))
+}
+
+func TestServerOp_Monitor(t *testing.T) {
+	ctx := context.Background()
+	op := newServerOp(NewInMemoryStore())
+
+	server, err := op.Create(ctx, "is1a", &sacloud.ServerCreateRequest{Name: "server", CPU: 1, MemoryMB: 1024})
+	require.NoError(t, err)
+
+	condition := &sacloud.MonitorCondition{
+		Start: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	activity, err := op.Monitor(ctx, "is1a", server.ID, condition)
+	require.NoError(t, err)
+	require.Len(t, activity.Values, 12*24+1)
+	require.Equal(t, condition.Start, activity.Values[0].Time)
+
+	again, err := op.Monitor(ctx, "is1a", server.ID, condition)
+	require.NoError(t, err)
+	require.Equal(t, activity, again)
+}
@@ -24,17 +24,20 @@ import (
 
 // Find is fake implementation
 func (o *ArchiveOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.ArchiveFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.Archive
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.Archive{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.ArchiveFindResult{
-		Total:    len(results),
-		Count:    len(results),
-		From:     0,
+		Total:    results.Total,
+		Count:    results.Count,
+		From:     results.From,
 		Archives: values,
 	}, nil
 }
@@ -44,7 +47,7 @@ func (o *ArchiveOp) Create(ctx context.Context, zone string, param *sacloud.Arch
 	result := &sacloud.Archive{}
 
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt, fillScope)
+	fill(result, o.fillID, fillCreatedAt, fillScope)
 
 	if !param.SourceArchiveID.IsEmpty() {
 		source, err := o.Read(ctx, zone, param.SourceArchiveID)
@@ -54,7 +57,7 @@ func (o *ArchiveOp) Create(ctx context.Context, zone string, param *sacloud.Arch
 		result.SourceArchiveAvailability = source.Availability
 	}
 	if !param.SourceDiskID.IsEmpty() {
-		diskOp := NewDiskOp()
+		diskOp := newDiskOp(o.store)
 		source, err := diskOp.Read(ctx, zone, param.SourceDiskID)
 		if err != nil {
 			return nil, newErrorBadRequest(o.key, types.ID(0), "SourceDisk is not found")
@@ -68,10 +71,10 @@ func (o *ArchiveOp) Create(ctx context.Context, zone string, param *sacloud.Arch
 	result.DiskPlanName = "標準プラン"
 	result.DiskPlanStorageClass = "iscsi9999"
 
-	putArchive(zone, result)
+	putArchive(o.ds(), zone, result)
 
 	id := result.ID
-	startDiskCopy(o.key, zone, func() (interface{}, error) {
+	startDiskCopy(ctx, o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -82,11 +85,11 @@ func (o *ArchiveOp) Create(ctx context.Context, zone string, param *sacloud.Arch
 func (o *ArchiveOp) CreateBlank(ctx context.Context, zone string, param *sacloud.ArchiveCreateBlankRequest) (*sacloud.Archive, *sacloud.FTPServer, error) {
 	result := &sacloud.Archive{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt, fillScope)
+	fill(result, o.fillID, fillCreatedAt, fillScope)
 
 	result.Availability = types.Availabilities.Uploading
 
-	putArchive(zone, result)
+	putArchive(o.ds(), zone, result)
 
 	return result, &sacloud.FTPServer{
 		HostName:  fmt.Sprintf("sac-%s-ftp.example.jp", zone),
@@ -98,7 +101,7 @@ func (o *ArchiveOp) CreateBlank(ctx context.Context, zone string, param *sacloud
 
 // Read is fake implementation
 func (o *ArchiveOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Archive, error) {
-	value := getArchiveByID(zone, id)
+	value := getArchiveByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -124,7 +127,7 @@ func (o *ArchiveOp) Delete(ctx context.Context, zone string, id types.ID) error
 	if err != nil {
 		return err
 	}
-	ds().Delete(o.key, zone, id)
+	o.ds().Delete(o.key, zone, id)
 	return nil
 }
 
@@ -136,7 +139,7 @@ func (o *ArchiveOp) OpenFTP(ctx context.Context, zone string, id types.ID, openO
 	}
 
 	value.SetAvailability(types.Availabilities.Uploading)
-	putArchive(zone, value)
+	putArchive(o.ds(), zone, value)
 
 	return &sacloud.FTPServer{
 		HostName:  fmt.Sprintf("sac-%s-ftp.example.jp", zone),
@@ -156,7 +159,7 @@ func (o *ArchiveOp) CloseFTP(ctx context.Context, zone string, id types.ID) erro
 	if !value.Availability.IsUploading() {
 		value.SetAvailability(types.Availabilities.Available)
 	}
-	putArchive(zone, value)
+	putArchive(o.ds(), zone, value)
 	return nil
 }
 
@@ -168,7 +171,7 @@ func (o *ArchiveOp) Share(ctx context.Context, zone string, id types.ID) (*saclo
 	}
 
 	value.SetAvailability(types.Availabilities.Uploading)
-	putArchive(zone, value)
+	putArchive(o.ds(), zone, value)
 
 	return &sacloud.ArchiveShareInfo{
 		SharedKey: types.ArchiveShareKey(fmt.Sprintf("%s:%s:%s", zone, id.String(), "xxx")),
@@ -188,7 +191,7 @@ func (o *ArchiveOp) CreateFromShared(ctx context.Context, zone string, sourceArc
 	}
 
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt, fillScope)
+	fill(result, o.fillID, fillCreatedAt, fillScope)
 
 	result.DisplayOrder = int64(random(100))
 	result.Availability = types.Availabilities.Transferring
@@ -196,10 +199,10 @@ func (o *ArchiveOp) CreateFromShared(ctx context.Context, zone string, sourceArc
 	result.DiskPlanName = "標準プラン"
 	result.DiskPlanStorageClass = "iscsi9999"
 
-	putArchive(destZone, result)
+	putArchive(o.ds(), destZone, result)
 
 	id := result.ID
-	startDiskCopy(o.key, destZone, func() (interface{}, error) {
+	startDiskCopy(ctx, o.ds(), o.key, destZone, func() (interface{}, error) {
 		return o.Read(context.Background(), destZone, id)
 	})
 
@@ -219,7 +222,7 @@ func (o *ArchiveOp) Transfer(ctx context.Context, zone string, sourceArchiveID t
 	}
 
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt, fillScope)
+	fill(result, o.fillID, fillCreatedAt, fillScope)
 
 	result.DisplayOrder = int64(random(100))
 	result.Availability = types.Availabilities.Transferring
@@ -227,10 +230,10 @@ func (o *ArchiveOp) Transfer(ctx context.Context, zone string, sourceArchiveID t
 	result.DiskPlanName = "標準プラン"
 	result.DiskPlanStorageClass = "iscsi9999"
 
-	putArchive(destZone, result)
+	putArchive(o.ds(), destZone, result)
 
 	id := result.ID
-	startDiskCopy(o.key, destZone, func() (interface{}, error) {
+	startDiskCopy(ctx, o.ds(), o.key, destZone, func() (interface{}, error) {
 		return o.Read(context.Background(), destZone, id)
 	})
 
@@ -23,17 +23,20 @@ import (
 
 // Find is fake implementation
 func (o *AutoBackupOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.AutoBackupFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.AutoBackup
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.AutoBackup{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.AutoBackupFindResult{
-		Total:       len(results),
-		Count:       len(results),
-		From:        0,
+		Total:       results.Total,
+		Count:       results.Count,
+		From:        results.From,
 		AutoBackups: values,
 	}, nil
 }
@@ -42,7 +45,7 @@ func (o *AutoBackupOp) Find(ctx context.Context, zone string, conditions *saclou
 func (o *AutoBackupOp) Create(ctx context.Context, zone string, param *sacloud.AutoBackupCreateRequest) (*sacloud.AutoBackup, error) {
 	result := &sacloud.AutoBackup{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
 	result.Availability = types.Availabilities.Available
 	result.SettingsHash = "settingshash"
@@ -50,13 +53,13 @@ func (o *AutoBackupOp) Create(ctx context.Context, zone string, param *sacloud.A
 	result.ZoneID = zoneIDs[zone]
 	result.ZoneName = zone
 
-	putAutoBackup(zone, result)
+	putAutoBackup(o.ds(), zone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *AutoBackupOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.AutoBackup, error) {
-	value := getAutoBackupByID(zone, id)
+	value := getAutoBackupByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -74,7 +77,7 @@ func (o *AutoBackupOp) Update(ctx context.Context, zone string, id types.ID, par
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putAutoBackup(zone, value)
+	putAutoBackup(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -87,7 +90,7 @@ func (o *AutoBackupOp) UpdateSettings(ctx context.Context, zone string, id types
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putAutoBackup(zone, value)
+	putAutoBackup(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -98,6 +101,6 @@ func (o *AutoBackupOp) Delete(ctx context.Context, zone string, id types.ID) err
 		return err
 	}
 
-	ds().Delete(o.key, zone, id)
+	o.ds().Delete(o.key, zone, id)
 	return nil
 }
@@ -24,26 +24,26 @@ import (
 
 // ByContract is fake implementation
 func (o *BillOp) ByContract(ctx context.Context, accountID types.ID) (*sacloud.BillByContractResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, nil)
+	results, _ := find(o.ds(), o.key, sacloud.APIDefaultZone, nil)
 	var values []*sacloud.Bill
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.Bill{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.BillByContractResult{
-		Total: len(results),
-		Count: len(results),
-		From:  0,
+		Total: results.Total,
+		Count: results.Count,
+		From:  results.From,
 		Bills: values,
 	}, nil
 }
 
 // ByContractYear is fake implementation
 func (o *BillOp) ByContractYear(ctx context.Context, accountID types.ID, year int) (*sacloud.BillByContractYearResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, nil)
+	results, _ := find(o.ds(), o.key, sacloud.APIDefaultZone, nil)
 	var values []*sacloud.Bill
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.Bill{}
 		copySameNameField(res, dest)
 		if dest.Date.Year() == year {
@@ -51,18 +51,18 @@ func (o *BillOp) ByContractYear(ctx context.Context, accountID types.ID, year in
 		}
 	}
 	return &sacloud.BillByContractYearResult{
-		Total: len(results),
-		Count: len(results),
-		From:  0,
+		Total: results.Total,
+		Count: results.Count,
+		From:  results.From,
 		Bills: values,
 	}, nil
 }
 
 // ByContractYearMonth is fake implementation
 func (o *BillOp) ByContractYearMonth(ctx context.Context, accountID types.ID, year int, month int) (*sacloud.BillByContractYearMonthResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, nil)
+	results, _ := find(o.ds(), o.key, sacloud.APIDefaultZone, nil)
 	var values []*sacloud.Bill
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.Bill{}
 		copySameNameField(res, dest)
 		if dest.Date.Year() == year && int(dest.Date.Month()) == month {
@@ -70,18 +70,18 @@ func (o *BillOp) ByContractYearMonth(ctx context.Context, accountID types.ID, ye
 		}
 	}
 	return &sacloud.BillByContractYearMonthResult{
-		Total: len(results),
-		Count: len(results),
-		From:  0,
+		Total: results.Total,
+		Count: results.Count,
+		From:  results.From,
 		Bills: values,
 	}, nil
 }
 
 // Read is fake implementation
 func (o *BillOp) Read(ctx context.Context, id types.ID) (*sacloud.BillReadResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, nil)
+	results, _ := find(o.ds(), o.key, sacloud.APIDefaultZone, nil)
 	var values []*sacloud.Bill
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.Bill{}
 		copySameNameField(res, dest)
 		if dest.ID == id {
@@ -89,16 +89,16 @@ func (o *BillOp) Read(ctx context.Context, id types.ID) (*sacloud.BillReadResult
 		}
 	}
 	return &sacloud.BillReadResult{
-		Total: len(results),
-		Count: len(results),
-		From:  0,
+		Total: results.Total,
+		Count: results.Count,
+		From:  results.From,
 		Bills: values,
 	}, nil
 }
 
 // Details is fake implementation
 func (o *BillOp) Details(ctx context.Context, MemberCode string, id types.ID) (*sacloud.BillDetailsResult, error) {
-	rawResults := ds().Get(o.key+"Details", sacloud.APIDefaultZone, id)
+	rawResults := o.ds().Get(o.key+"Details", sacloud.APIDefaultZone, id)
 	if rawResults == nil {
 		return nil, newErrorNotFound(o.key+"Details", id)
 	}
@@ -121,7 +121,7 @@ func (o *BillOp) Details(ctx context.Context, MemberCode string, id types.ID) (*
 
 // DetailsCSV is fake implementation
 func (o *BillOp) DetailsCSV(ctx context.Context, MemberCode string, id types.ID) (*sacloud.BillDetailCSV, error) {
-	rawResults := ds().Get(o.key+"Details", sacloud.APIDefaultZone, id)
+	rawResults := o.ds().Get(o.key+"Details", sacloud.APIDefaultZone, id)
 	if rawResults == nil {
 		return nil, newErrorNotFound(o.key+"Details", id)
 	}
@@ -23,17 +23,20 @@ import (
 
 // Find is fake implementation
 func (o *BridgeOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.BridgeFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.Bridge
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.Bridge{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.BridgeFindResult{
-		Total:   len(results),
-		Count:   len(results),
-		From:    0,
+		Total:   results.Total,
+		Count:   results.Count,
+		From:    results.From,
 		Bridges: values,
 	}, nil
 }
@@ -42,15 +45,15 @@ func (o *BridgeOp) Find(ctx context.Context, zone string, conditions *sacloud.Fi
 func (o *BridgeOp) Create(ctx context.Context, zone string, param *sacloud.BridgeCreateRequest) (*sacloud.Bridge, error) {
 	result := &sacloud.Bridge{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
-	putBridge(zone, result)
+	putBridge(o.ds(), zone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *BridgeOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Bridge, error) {
-	value := getBridgeByID(zone, id)
+	value := getBridgeByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -66,7 +69,7 @@ func (o *BridgeOp) Update(ctx context.Context, zone string, id types.ID, param *
 		return nil, err
 	}
 	copySameNameField(param, value)
-	putBridge(zone, value)
+	putBridge(o.ds(), zone, value)
 
 	return value, nil
 }
@@ -78,6 +81,6 @@ func (o *BridgeOp) Delete(ctx context.Context, zone string, id types.ID) error {
 		return err
 	}
 
-	ds().Delete(o.key, zone, id)
+	o.ds().Delete(o.key, zone, id)
 	return nil
 }
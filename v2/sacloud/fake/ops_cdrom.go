@@ -24,17 +24,20 @@ import (
 
 // Find is fake implementation
 func (o *CDROMOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.CDROMFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.CDROM
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.CDROM{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.CDROMFindResult{
-		Total:  len(results),
-		Count:  len(results),
-		From:   0,
+		Total:  results.Total,
+		Count:  results.Count,
+		From:   results.From,
 		CDROMs: values,
 	}, nil
 }
@@ -43,10 +46,10 @@ func (o *CDROMOp) Find(ctx context.Context, zone string, conditions *sacloud.Fin
 func (o *CDROMOp) Create(ctx context.Context, zone string, param *sacloud.CDROMCreateRequest) (*sacloud.CDROM, *sacloud.FTPServer, error) {
 	result := &sacloud.CDROM{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt, fillAvailability, fillScope)
+	fill(result, o.fillID, fillCreatedAt, fillAvailability, fillScope)
 	result.Availability = types.Availabilities.Uploading
 
-	putCDROM(zone, result)
+	putCDROM(o.ds(), zone, result)
 	return result, &sacloud.FTPServer{
 		HostName:  fmt.Sprintf("sac-%s-ftp.example.jp", zone),
 		IPAddress: "192.0.2.1",
@@ -57,7 +60,7 @@ func (o *CDROMOp) Create(ctx context.Context, zone string, param *sacloud.CDROMC
 
 // Read is fake implementation
 func (o *CDROMOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.CDROM, error) {
-	value := getCDROMByID(zone, id)
+	value := getCDROMByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -75,7 +78,7 @@ func (o *CDROMOp) Update(ctx context.Context, zone string, id types.ID, param *s
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putCDROM(zone, value)
+	putCDROM(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -85,7 +88,7 @@ func (o *CDROMOp) Delete(ctx context.Context, zone string, id types.ID) error {
 	if err != nil {
 		return err
 	}
-	ds().Delete(o.key, zone, id)
+	o.ds().Delete(o.key, zone, id)
 	return nil
 }
 
@@ -97,7 +100,7 @@ func (o *CDROMOp) OpenFTP(ctx context.Context, zone string, id types.ID, openOpt
 	}
 
 	value.SetAvailability(types.Availabilities.Uploading)
-	putCDROM(zone, value)
+	putCDROM(o.ds(), zone, value)
 
 	return &sacloud.FTPServer{
 		HostName:  fmt.Sprintf("sac-%s-ftp.example.jp", zone),
@@ -116,6 +119,6 @@ func (o *CDROMOp) CloseFTP(ctx context.Context, zone string, id types.ID) error
 	if !value.Availability.IsUploading() {
 		value.SetAvailability(types.Availabilities.Available)
 	}
-	putCDROM(zone, value)
+	putCDROM(o.ds(), zone, value)
 	return nil
 }
@@ -25,17 +25,20 @@ import (
 
 // Find is fake implementation
 func (o *CertificateAuthorityOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.CertificateAuthorityFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, conditions)
+	results, err := find(o.ds(), o.key, sacloud.APIDefaultZone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.CertificateAuthority
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.CertificateAuthority{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.CertificateAuthorityFindResult{
-		Total:                  len(results),
-		Count:                  len(results),
-		From:                   0,
+		Total:                  results.Total,
+		Count:                  results.Count,
+		From:                   results.From,
 		CertificateAuthorities: values,
 	}, nil
 }
@@ -44,16 +47,16 @@ func (o *CertificateAuthorityOp) Find(ctx context.Context, conditions *sacloud.F
 func (o *CertificateAuthorityOp) Create(ctx context.Context, param *sacloud.CertificateAuthorityCreateRequest) (*sacloud.CertificateAuthority, error) {
 	result := &sacloud.CertificateAuthority{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 	result.Availability = types.Availabilities.Available
 
-	putCertificateAuthority(sacloud.APIDefaultZone, result)
+	putCertificateAuthority(o.ds(), sacloud.APIDefaultZone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *CertificateAuthorityOp) Read(ctx context.Context, id types.ID) (*sacloud.CertificateAuthority, error) {
-	value := getCertificateAuthorityByID(sacloud.APIDefaultZone, id)
+	value := getCertificateAuthorityByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -81,7 +84,7 @@ func (o *CertificateAuthorityOp) Delete(ctx context.Context, id types.ID) error
 		return err
 	}
 
-	ds().Delete(o.key, sacloud.APIDefaultZone, id)
+	o.ds().Delete(o.key, sacloud.APIDefaultZone, id)
 	return nil
 }
 
@@ -23,17 +23,20 @@ import (
 
 // Find is fake implementation
 func (o *ContainerRegistryOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.ContainerRegistryFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, conditions)
+	results, err := find(o.ds(), o.key, sacloud.APIDefaultZone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.ContainerRegistry
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.ContainerRegistry{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.ContainerRegistryFindResult{
-		Total:               len(results),
-		Count:               len(results),
-		From:                0,
+		Total:               results.Total,
+		Count:               results.Count,
+		From:                results.From,
 		ContainerRegistries: values,
 	}, nil
 }
@@ -42,17 +45,17 @@ func (o *ContainerRegistryOp) Find(ctx context.Context, conditions *sacloud.Find
 func (o *ContainerRegistryOp) Create(ctx context.Context, param *sacloud.ContainerRegistryCreateRequest) (*sacloud.ContainerRegistry, error) {
 	result := &sacloud.ContainerRegistry{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
 	result.FQDN = result.SubDomainLabel + ".sakuracr.jp"
 	result.Availability = types.Availabilities.Available
-	putContainerRegistry(sacloud.APIDefaultZone, result)
+	putContainerRegistry(o.ds(), sacloud.APIDefaultZone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *ContainerRegistryOp) Read(ctx context.Context, id types.ID) (*sacloud.ContainerRegistry, error) {
-	value := getContainerRegistryByID(sacloud.APIDefaultZone, id)
+	value := getContainerRegistryByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -69,7 +72,7 @@ func (o *ContainerRegistryOp) Update(ctx context.Context, id types.ID, param *sa
 	}
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
-	putContainerRegistry(sacloud.APIDefaultZone, value)
+	putContainerRegistry(o.ds(), sacloud.APIDefaultZone, value)
 	return value, nil
 }
 
@@ -81,7 +84,7 @@ func (o *ContainerRegistryOp) UpdateSettings(ctx context.Context, id types.ID, p
 	}
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
-	putContainerRegistry(sacloud.APIDefaultZone, value)
+	putContainerRegistry(o.ds(), sacloud.APIDefaultZone, value)
 	return value, nil
 }
 
@@ -91,7 +94,7 @@ func (o *ContainerRegistryOp) Delete(ctx context.Context, id types.ID) error {
 	if err != nil {
 		return err
 	}
-	ds().Delete(o.key, sacloud.APIDefaultZone, id)
+	o.ds().Delete(o.key, sacloud.APIDefaultZone, id)
 	return nil
 }
 
@@ -102,7 +105,7 @@ func (o *ContainerRegistryOp) ListUsers(ctx context.Context, id types.ID) (*sacl
 		return nil, err
 	}
 
-	v := ds().Get(ResourceContainerRegistry+"Users", sacloud.APIDefaultZone, id)
+	v := o.ds().Get(ResourceContainerRegistry+"Users", sacloud.APIDefaultZone, id)
 	if v != nil {
 		users := v.([]*sacloud.ContainerRegistryUser)
 		return &sacloud.ContainerRegistryUsers{
@@ -121,7 +124,7 @@ func (o *ContainerRegistryOp) AddUser(ctx context.Context, id types.ID, param *s
 	}
 
 	var users []*sacloud.ContainerRegistryUser
-	v := ds().Get(ResourceContainerRegistry+"Users", sacloud.APIDefaultZone, id)
+	v := o.ds().Get(ResourceContainerRegistry+"Users", sacloud.APIDefaultZone, id)
 	if v != nil {
 		users = v.([]*sacloud.ContainerRegistryUser)
 	}
@@ -130,7 +133,7 @@ func (o *ContainerRegistryOp) AddUser(ctx context.Context, id types.ID, param *s
 		Permission: param.Permission,
 	})
 
-	ds().Put(ResourceContainerRegistry+"Users", sacloud.APIDefaultZone, id, users)
+	o.ds().Put(ResourceContainerRegistry+"Users", sacloud.APIDefaultZone, id, users)
 	return nil
 }
 
@@ -141,7 +144,7 @@ func (o *ContainerRegistryOp) UpdateUser(ctx context.Context, id types.ID, usern
 		return err
 	}
 
-	v := ds().Get(ResourceContainerRegistry+"Users", sacloud.APIDefaultZone, id)
+	v := o.ds().Get(ResourceContainerRegistry+"Users", sacloud.APIDefaultZone, id)
 	if v == nil {
 		return newErrorNotFound(ResourceContainerRegistry+"Users", id)
 	}
@@ -151,7 +154,7 @@ func (o *ContainerRegistryOp) UpdateUser(ctx context.Context, id types.ID, usern
 			u.Permission = param.Permission
 		}
 	}
-	ds().Put(ResourceContainerRegistry+"Users", sacloud.APIDefaultZone, id, users)
+	o.ds().Put(ResourceContainerRegistry+"Users", sacloud.APIDefaultZone, id, users)
 	return nil
 }
 
@@ -162,7 +165,7 @@ func (o *ContainerRegistryOp) DeleteUser(ctx context.Context, id types.ID, usern
 		return err
 	}
 
-	v := ds().Get(ResourceContainerRegistry+"Users", sacloud.APIDefaultZone, id)
+	v := o.ds().Get(ResourceContainerRegistry+"Users", sacloud.APIDefaultZone, id)
 	if v == nil {
 		return newErrorNotFound(ResourceContainerRegistry+"Users", id)
 	}
@@ -174,6 +177,6 @@ func (o *ContainerRegistryOp) DeleteUser(ctx context.Context, id types.ID, usern
 		}
 	}
 
-	ds().Put(ResourceContainerRegistry+"Users", sacloud.APIDefaultZone, id, newUsers)
+	o.ds().Put(ResourceContainerRegistry+"Users", sacloud.APIDefaultZone, id, newUsers)
 	return nil
 }
@@ -23,17 +23,17 @@ import (
 
 // Find is fake implementation
 func (o *CouponOp) Find(ctx context.Context, accountID types.ID) (*sacloud.CouponFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, nil)
+	results, _ := find(o.ds(), o.key, sacloud.APIDefaultZone, nil)
 	var values []*sacloud.Coupon
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.Coupon{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.CouponFindResult{
-		Total:   len(results),
-		Count:   len(results),
-		From:    0,
+		Total:   results.Total,
+		Count:   results.Count,
+		From:    results.From,
 		Coupons: values,
 	}, nil
 }
@@ -16,7 +16,6 @@ package fake
 
 import (
 	"context"
-	"time"
 
 	"github.com/sacloud/libsacloud/v2/sacloud"
 	"github.com/sacloud/libsacloud/v2/sacloud/types"
@@ -24,17 +23,20 @@ import (
 
 // Find is fake implementation
 func (o *DatabaseOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.DatabaseFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.Database
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.Database{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.DatabaseFindResult{
-		Total:     len(results),
-		Count:     len(results),
-		From:      0,
+		Total:     results.Total,
+		Count:     results.Count,
+		From:      results.From,
 		Databases: values,
 	}, nil
 }
@@ -43,15 +45,15 @@ func (o *DatabaseOp) Find(ctx context.Context, zone string, conditions *sacloud.
 func (o *DatabaseOp) Create(ctx context.Context, zone string, param *sacloud.DatabaseCreateRequest) (*sacloud.Database, error) {
 	result := &sacloud.Database{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
 	result.Class = "database"
 	result.Availability = types.Availabilities.Available
 
-	putDatabase(zone, result)
+	putDatabase(o.ds(), zone, result)
 
 	id := result.ID
-	startPowerOn(o.key, zone, func() (interface{}, error) {
+	startPowerOn(ctx, o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 	return result, nil
@@ -59,7 +61,7 @@ func (o *DatabaseOp) Create(ctx context.Context, zone string, param *sacloud.Dat
 
 // Read is fake implementation
 func (o *DatabaseOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Database, error) {
-	value := getDatabaseByID(zone, id)
+	value := getDatabaseByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -77,7 +79,7 @@ func (o *DatabaseOp) Update(ctx context.Context, zone string, id types.ID, param
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putDatabase(zone, value)
+	putDatabase(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -90,7 +92,7 @@ func (o *DatabaseOp) UpdateSettings(ctx context.Context, zone string, id types.I
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putDatabase(zone, value)
+	putDatabase(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -101,7 +103,7 @@ func (o *DatabaseOp) Delete(ctx context.Context, zone string, id types.ID) error
 		return err
 	}
 
-	ds().Delete(o.key, zone, id)
+	o.ds().Delete(o.key, zone, id)
 	return nil
 }
 
@@ -124,7 +126,7 @@ func (o *DatabaseOp) Boot(ctx context.Context, zone string, id types.ID) error {
 		return newErrorConflict(o.key, id, "Boot is failed")
 	}
 
-	startPowerOn(o.key, zone, func() (interface{}, error) {
+	startPowerOn(ctx, o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -141,7 +143,7 @@ func (o *DatabaseOp) Shutdown(ctx context.Context, zone string, id types.ID, shu
 		return newErrorConflict(o.key, id, "Shutdown is failed")
 	}
 
-	startPowerOff(o.key, zone, func() (interface{}, error) {
+	startPowerOff(o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -158,7 +160,7 @@ func (o *DatabaseOp) Reset(ctx context.Context, zone string, id types.ID) error
 		return newErrorConflict(o.key, id, "Reset is failed")
 	}
 
-	startPowerOn(o.key, zone, func() (interface{}, error) {
+	startPowerOn(ctx, o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -172,17 +174,11 @@ func (o *DatabaseOp) MonitorCPU(ctx context.Context, zone string, id types.ID, c
 		return nil, err
 	}
 
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.CPUTimeActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorCPUTimeValue{
-			Time:    now.Add(time.Duration(i*-5) * time.Minute),
-			CPUTime: float64(random(1000)),
+			Time:    t,
+			CPUTime: monitorValue(o.key, id, "CPUTime", t),
 		})
 	}
 
@@ -196,18 +192,12 @@ func (o *DatabaseOp) MonitorDisk(ctx context.Context, zone string, id types.ID,
 		return nil, err
 	}
 
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.DiskActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorDiskValue{
-			Time:  now.Add(time.Duration(i*-5) * time.Minute),
-			Read:  float64(random(1000)),
-			Write: float64(random(1000)),
+			Time:  t,
+			Read:  monitorValue(o.key, id, "Read", t),
+			Write: monitorValue(o.key, id, "Write", t),
 		})
 	}
 
@@ -221,18 +211,12 @@ func (o *DatabaseOp) MonitorInterface(ctx context.Context, zone string, id types
 		return nil, err
 	}
 
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.InterfaceActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorInterfaceValue{
-			Time:    now.Add(time.Duration(i*-5) * time.Minute),
-			Receive: float64(random(1000)),
-			Send:    float64(random(1000)),
+			Time:    t,
+			Receive: monitorValue(o.key, id, "Receive", t),
+			Send:    monitorValue(o.key, id, "Send", t),
 		})
 	}
 
@@ -246,24 +230,18 @@ func (o *DatabaseOp) MonitorDatabase(ctx context.Context, zone string, id types.
 		return nil, err
 	}
 
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.DatabaseActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorDatabaseValue{
-			Time:              now.Add(time.Duration(i*-5) * time.Minute),
-			TotalMemorySize:   float64(random(1000)),
-			UsedMemorySize:    float64(random(1000)),
-			TotalDisk1Size:    float64(random(1000)),
-			UsedDisk1Size:     float64(random(1000)),
-			TotalDisk2Size:    float64(random(1000)),
-			UsedDisk2Size:     float64(random(1000)),
-			BinlogUsedSizeKiB: float64(random(1000)),
-			DelayTimeSec:      float64(random(1000)),
+			Time:              t,
+			TotalMemorySize:   monitorValue(o.key, id, "TotalMemorySize", t),
+			UsedMemorySize:    monitorValue(o.key, id, "UsedMemorySize", t),
+			TotalDisk1Size:    monitorValue(o.key, id, "TotalDisk1Size", t),
+			UsedDisk1Size:     monitorValue(o.key, id, "UsedDisk1Size", t),
+			TotalDisk2Size:    monitorValue(o.key, id, "TotalDisk2Size", t),
+			UsedDisk2Size:     monitorValue(o.key, id, "UsedDisk2Size", t),
+			BinlogUsedSizeKiB: monitorValue(o.key, id, "BinlogUsedSizeKiB", t),
+			DelayTimeSec:      monitorValue(o.key, id, "DelayTimeSec", t),
 		})
 	}
 
@@ -297,7 +275,7 @@ func (o *DatabaseOp) GetParameter(ctx context.Context, zone string, id types.ID)
 	}
 
 	var settings map[string]interface{}
-	raw := ds().Get(ResourceDatabase+"Parameter", zone, id)
+	raw := o.ds().Get(ResourceDatabase+"Parameter", zone, id)
 	if raw != nil {
 		settings = raw.(map[string]interface{})
 	}
@@ -370,7 +348,7 @@ func (o *DatabaseOp) SetParameter(ctx context.Context, zone string, id types.ID,
 	}
 
 	var settings map[string]interface{}
-	raw := ds().Get(ResourceDatabase+"Parameter", zone, id)
+	raw := o.ds().Get(ResourceDatabase+"Parameter", zone, id)
 	if raw != nil {
 		settings = raw.(map[string]interface{})
 	} else {
@@ -389,6 +367,6 @@ func (o *DatabaseOp) SetParameter(ctx context.Context, zone string, id types.ID,
 		}
 	}
 
-	ds().Put(ResourceDatabase+"Parameter", zone, id, settings)
+	o.ds().Put(ResourceDatabase+"Parameter", zone, id, settings)
 	return nil
 }
@@ -17,7 +17,6 @@ package fake
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/sacloud/libsacloud/v2/sacloud"
 	"github.com/sacloud/libsacloud/v2/sacloud/types"
@@ -25,17 +24,20 @@ import (
 
 // Find is fake implementation
 func (o *DiskOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.DiskFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.Disk
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.Disk{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.DiskFindResult{
-		Total: len(results),
-		Count: len(results),
-		From:  0,
+		Total: results.Total,
+		Count: results.Count,
+		From:  results.From,
 		Disks: values,
 	}, nil
 }
@@ -44,7 +46,7 @@ func (o *DiskOp) Find(ctx context.Context, zone string, conditions *sacloud.Find
 func (o *DiskOp) Create(ctx context.Context, zone string, param *sacloud.DiskCreateRequest, distantFrom []types.ID) (*sacloud.Disk, error) {
 	result := &sacloud.Disk{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt, fillDiskPlan)
+	fill(result, o.fillID, fillCreatedAt, fillDiskPlan)
 	result.Availability = types.Availabilities.Migrating
 
 	result.Storage = &sacloud.Storage{
@@ -56,7 +58,7 @@ func (o *DiskOp) Create(ctx context.Context, zone string, param *sacloud.DiskCre
 		result.Connection = types.DiskConnections.VirtIO
 	}
 	if !param.SourceArchiveID.IsEmpty() {
-		archiveOp := NewArchiveOp()
+		archiveOp := newArchiveOp(o.store)
 		source, err := archiveOp.Read(ctx, zone, param.SourceArchiveID)
 		if err != nil {
 			return nil, newErrorBadRequest(o.key, types.ID(0), "SourceArchive is not found")
@@ -71,7 +73,7 @@ func (o *DiskOp) Create(ctx context.Context, zone string, param *sacloud.DiskCre
 		result.SourceDiskAvailability = source.Availability
 	}
 	if !param.ServerID.IsEmpty() {
-		server, err := NewServerOp().Read(ctx, zone, param.ServerID)
+		server, err := newServerOp(o.store).Read(ctx, zone, param.ServerID)
 		if err != nil {
 			return nil, newErrorConflict(o.key, types.ID(0), "Server is not found")
 		}
@@ -86,13 +88,13 @@ func (o *DiskOp) Create(ctx context.Context, zone string, param *sacloud.DiskCre
 			DiskPlanID:      result.DiskPlanID,
 			Storage:         result.Storage,
 		})
-		putServer(zone, server)
+		putServer(o.ds(), zone, server)
 	}
 
-	putDisk(zone, result)
+	putDisk(o.ds(), zone, result)
 
 	id := result.ID
-	startDiskCopy(o.key, zone, func() (interface{}, error) {
+	startDiskCopy(ctx, o.ds(), o.key, zone, func() (interface{}, error) {
 		disk, err := o.Read(context.Background(), zone, id)
 		if err != nil {
 			return nil, err
@@ -113,7 +115,7 @@ func (o *DiskOp) Config(ctx context.Context, zone string, id types.ID, edit *sac
 		return nil
 	}
 
-	serverOp := NewServerOp()
+	serverOp := newServerOp(o.store)
 	server, err := serverOp.Read(ctx, zone, disk.ServerID)
 	if err != nil {
 		return err
@@ -121,18 +123,18 @@ func (o *DiskOp) Config(ctx context.Context, zone string, id types.ID, edit *sac
 
 	if edit.HostName != "" {
 		server.HostName = edit.HostName
-		putServer(zone, server)
+		putServer(o.ds(), zone, server)
 	}
 
 	if len(server.Interfaces) > 0 {
 		nic := server.Interfaces[0]
 		if nic.SwitchScope == types.Scopes.Shared {
-			nic.IPAddress = pool().nextSharedIP().String()
+			nic.IPAddress = o.pool().nextSharedIP().String()
 		} else {
 			nic.UserIPAddress = edit.UserIPAddress
 		}
 
-		swOp := NewSwitchOp()
+		swOp := newSwitchOp(o.store)
 		sw, err := swOp.Read(ctx, zone, nic.SwitchID)
 		if err != nil {
 			return err
@@ -148,7 +150,7 @@ func (o *DiskOp) Config(ctx context.Context, zone string, id types.ID, edit *sac
 			nic.SubnetNetworkAddress = sw.Subnets[0].NetworkAddress
 		}
 
-		putServer(zone, server)
+		putServer(o.ds(), zone, server)
 	}
 
 	return nil
@@ -158,7 +160,7 @@ func (o *DiskOp) Config(ctx context.Context, zone string, id types.ID, edit *sac
 func (o *DiskOp) CreateWithConfig(ctx context.Context, zone string, createParam *sacloud.DiskCreateRequest, editParam *sacloud.DiskEditRequest, bootAtAvailable bool, distantFrom []types.ID) (*sacloud.Disk, error) {
 	// check
 	if !createParam.ServerID.IsEmpty() {
-		serverOp := NewServerOp()
+		serverOp := newServerOp(o.store)
 		_, err := serverOp.Read(ctx, zone, createParam.ServerID)
 		if err != nil {
 			return nil, newErrorBadRequest(o.key, types.ID(0), fmt.Sprintf("Server %s is not found", createParam.ServerID))
@@ -182,14 +184,14 @@ func (o *DiskOp) CreateWithConfig(ctx context.Context, zone string, createParam
 			}
 			return disk, nil
 		})
-		res, err := waiter.WaitForState(ctx)
+		res, err := waiter.WaitForState(sacloud.WithClientRegistryOf(ctx, o))
 		if err != nil {
 			return nil, err
 		}
 		result = res.(*sacloud.Disk)
 
 		// boot server
-		serverOp := NewServerOp()
+		serverOp := newServerOp(o.store)
 		if err := serverOp.Boot(ctx, zone, createParam.ServerID); err != nil {
 			return nil, err
 		}
@@ -213,7 +215,7 @@ func (o *DiskOp) ConnectToServer(ctx context.Context, zone string, id types.ID,
 		return err
 	}
 
-	serverOp := NewServerOp()
+	serverOp := newServerOp(o.store)
 	server, err := serverOp.Read(ctx, zone, serverID)
 	if err != nil {
 		return newErrorBadRequest(o.key, id, fmt.Sprintf("Server[%d] is not exists", serverID))
@@ -230,10 +232,10 @@ func (o *DiskOp) ConnectToServer(ctx context.Context, zone string, id types.ID,
 	connectedDisk := &sacloud.ServerConnectedDisk{}
 	copySameNameField(value, connectedDisk)
 	server.Disks = append(server.Disks, connectedDisk)
-	putServer(zone, server)
+	putServer(o.ds(), zone, server)
 	value.ServerID = serverID
 	value.ServerName = server.Name
-	putDisk(zone, value)
+	putDisk(o.ds(), zone, value)
 
 	return nil
 }
@@ -249,7 +251,7 @@ func (o *DiskOp) DisconnectFromServer(ctx context.Context, zone string, id types
 		return newErrorBadRequest(o.key, id, fmt.Sprintf("Disk[%d] is not connected to Server", id))
 	}
 
-	serverOp := NewServerOp()
+	serverOp := newServerOp(o.store)
 	server, err := serverOp.Read(ctx, zone, value.ServerID)
 	if err != nil {
 		return newErrorBadRequest(o.key, id, fmt.Sprintf("Server[%d] is not exists", value.ServerID))
@@ -269,17 +271,17 @@ func (o *DiskOp) DisconnectFromServer(ctx context.Context, zone string, id types
 	}
 
 	server.Disks = disks
-	putServer(zone, server)
+	putServer(o.ds(), zone, server)
 	value.ServerID = types.ID(0)
 	value.ServerName = ""
-	putDisk(zone, value)
+	putDisk(o.ds(), zone, value)
 
 	return nil
 }
 
 // Read is fake implementation
 func (o *DiskOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Disk, error) {
-	value := getDiskByID(zone, id)
+	value := getDiskByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -298,7 +300,7 @@ func (o *DiskOp) Update(ctx context.Context, zone string, id types.ID, param *sa
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putDisk(zone, value)
+	putDisk(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -308,7 +310,7 @@ func (o *DiskOp) Delete(ctx context.Context, zone string, id types.ID) error {
 	if err != nil {
 		return err
 	}
-	ds().Delete(o.key, zone, id)
+	o.ds().Delete(o.key, zone, id)
 	return nil
 }
 
@@ -318,18 +320,12 @@ func (o *DiskOp) Monitor(ctx context.Context, zone string, id types.ID, conditio
 	if err != nil {
 		return nil, err
 	}
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.DiskActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorDiskValue{
-			Time:  now.Add(time.Duration(i*-5) * time.Minute),
-			Read:  float64(random(1000)),
-			Write: float64(random(1000)),
+			Time:  t,
+			Read:  monitorValue(o.key, id, "Read", t),
+			Write: monitorValue(o.key, id, "Write", t),
 		})
 	}
 
@@ -23,24 +23,27 @@ import (
 
 // Find is fake implementation
 func (o *DiskPlanOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.DiskPlanFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.DiskPlan
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.DiskPlan{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.DiskPlanFindResult{
-		Total:     len(results),
-		Count:     len(results),
-		From:      0,
+		Total:     results.Total,
+		Count:     results.Count,
+		From:      results.From,
 		DiskPlans: values,
 	}, nil
 }
 
 // Read is fake implementation
 func (o *DiskPlanOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.DiskPlan, error) {
-	value := getDiskPlanByID(zone, id)
+	value := getDiskPlanByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
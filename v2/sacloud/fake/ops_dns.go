@@ -23,17 +23,20 @@ import (
 
 // Find is fake implementation
 func (o *DNSOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.DNSFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, conditions)
+	results, err := find(o.ds(), o.key, sacloud.APIDefaultZone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.DNS
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.DNS{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.DNSFindResult{
-		Total: len(results),
-		Count: len(results),
-		From:  0,
+		Total: results.Total,
+		Count: results.Count,
+		From:  results.From,
 		DNS:   values,
 	}, nil
 }
@@ -42,20 +45,20 @@ func (o *DNSOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*s
 func (o *DNSOp) Create(ctx context.Context, param *sacloud.DNSCreateRequest) (*sacloud.DNS, error) {
 	result := &sacloud.DNS{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
 	result.Availability = types.Availabilities.Available
 	result.SettingsHash = "settingshash"
 	result.DNSZone = param.Name
 	result.DNSNameServers = []string{"ns1.gslb4.sakura.ne.jp", "ns2.gslb4.sakura.ne.jp"}
 
-	putDNS(sacloud.APIDefaultZone, result)
+	putDNS(o.ds(), sacloud.APIDefaultZone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *DNSOp) Read(ctx context.Context, id types.ID) (*sacloud.DNS, error) {
-	value := getDNSByID(sacloud.APIDefaultZone, id)
+	value := getDNSByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -73,7 +76,7 @@ func (o *DNSOp) Update(ctx context.Context, id types.ID, param *sacloud.DNSUpdat
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putDNS(sacloud.APIDefaultZone, value)
+	putDNS(o.ds(), sacloud.APIDefaultZone, value)
 	return value, nil
 }
 
@@ -86,7 +89,7 @@ func (o *DNSOp) UpdateSettings(ctx context.Context, id types.ID, param *sacloud.
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putDNS(sacloud.APIDefaultZone, value)
+	putDNS(o.ds(), sacloud.APIDefaultZone, value)
 	return value, nil
 }
 
@@ -97,6 +100,6 @@ func (o *DNSOp) Delete(ctx context.Context, id types.ID) error {
 		return err
 	}
 
-	ds().Delete(o.key, sacloud.APIDefaultZone, id)
+	o.ds().Delete(o.key, sacloud.APIDefaultZone, id)
 	return nil
 }
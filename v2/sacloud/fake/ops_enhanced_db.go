@@ -23,17 +23,20 @@ import (
 
 // Find is fake implementation
 func (o *EnhancedDBOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.EnhancedDBFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, conditions)
+	results, err := find(o.ds(), o.key, sacloud.APIDefaultZone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.EnhancedDB
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.EnhancedDB{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.EnhancedDBFindResult{
-		Total:       len(results),
-		Count:       len(results),
-		From:        0,
+		Total:       results.Total,
+		Count:       results.Count,
+		From:        results.From,
 		EnhancedDBs: values,
 	}, nil
 }
@@ -42,7 +45,7 @@ func (o *EnhancedDBOp) Find(ctx context.Context, conditions *sacloud.FindConditi
 func (o *EnhancedDBOp) Create(ctx context.Context, param *sacloud.EnhancedDBCreateRequest) (*sacloud.EnhancedDB, error) {
 	result := &sacloud.EnhancedDB{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
 	result.DatabaseType = "tidb"
 	result.Region = "is1"
@@ -51,13 +54,13 @@ func (o *EnhancedDBOp) Create(ctx context.Context, param *sacloud.EnhancedDBCrea
 	result.MaxConnections = 50
 	result.Availability = types.Availabilities.Available
 
-	putEnhancedDB(sacloud.APIDefaultZone, result)
+	putEnhancedDB(o.ds(), sacloud.APIDefaultZone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *EnhancedDBOp) Read(ctx context.Context, id types.ID) (*sacloud.EnhancedDB, error) {
-	value := getEnhancedDBByID(sacloud.APIDefaultZone, id)
+	value := getEnhancedDBByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -76,7 +79,7 @@ func (o *EnhancedDBOp) Update(ctx context.Context, id types.ID, param *sacloud.E
 	value.MaxConnections = 50
 	fill(value, fillModifiedAt)
 
-	putEnhancedDB(sacloud.APIDefaultZone, value)
+	putEnhancedDB(o.ds(), sacloud.APIDefaultZone, value)
 	return value, nil
 }
 
@@ -87,7 +90,7 @@ func (o *EnhancedDBOp) Delete(ctx context.Context, id types.ID) error {
 		return err
 	}
 
-	ds().Delete(o.key, sacloud.APIDefaultZone, id)
+	o.ds().Delete(o.key, sacloud.APIDefaultZone, id)
 	return nil
 }
 
@@ -26,17 +26,20 @@ import (
 
 // Find is fake implementation
 func (o *ESMEOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.ESMEFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, conditions)
+	results, err := find(o.ds(), o.key, sacloud.APIDefaultZone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.ESME
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.ESME{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.ESMEFindResult{
-		Total: len(results),
-		Count: len(results),
-		From:  0,
+		Total: results.Total,
+		Count: results.Count,
+		From:  results.From,
 		ESME:  values,
 	}, nil
 }
@@ -45,16 +48,16 @@ func (o *ESMEOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*
 func (o *ESMEOp) Create(ctx context.Context, param *sacloud.ESMECreateRequest) (*sacloud.ESME, error) {
 	result := &sacloud.ESME{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 	result.Availability = types.Availabilities.Available
 
-	putESME(sacloud.APIDefaultZone, result)
+	putESME(o.ds(), sacloud.APIDefaultZone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *ESMEOp) Read(ctx context.Context, id types.ID) (*sacloud.ESME, error) {
-	value := getESMEByID(sacloud.APIDefaultZone, id)
+	value := getESMEByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -71,7 +74,7 @@ func (o *ESMEOp) Update(ctx context.Context, id types.ID, param *sacloud.ESMEUpd
 	}
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
-	putESME(sacloud.APIDefaultZone, value)
+	putESME(o.ds(), sacloud.APIDefaultZone, value)
 
 	return value, nil
 }
@@ -83,7 +86,7 @@ func (o *ESMEOp) Delete(ctx context.Context, id types.ID) error {
 		return err
 	}
 
-	ds().Delete(o.key, sacloud.APIDefaultZone, id)
+	o.ds().Delete(o.key, sacloud.APIDefaultZone, id)
 	return nil
 }
 
@@ -131,7 +134,7 @@ func (o *ESMEOp) SendMessageWithGeneratedOTP(ctx context.Context, id types.ID, p
 		SentAt:      time.Now(),
 		RetryCount:  0,
 	})
-	ds().Put(o.key+"Logs", sacloud.APIDefaultZone, id, logs)
+	o.ds().Put(o.key+"Logs", sacloud.APIDefaultZone, id, logs)
 
 	return result, nil
 }
@@ -160,7 +163,7 @@ func (o *ESMEOp) SendMessageWithInputtedOTP(ctx context.Context, id types.ID, pa
 		SentAt:      time.Now(),
 		RetryCount:  0,
 	})
-	ds().Put(o.key+"Logs", sacloud.APIDefaultZone, id, logs)
+	o.ds().Put(o.key+"Logs", sacloud.APIDefaultZone, id, logs)
 
 	return result, nil
 }
@@ -171,7 +174,7 @@ func (o *ESMEOp) Logs(ctx context.Context, id types.ID) ([]*sacloud.ESMELogs, er
 		return nil, err
 	}
 
-	v := ds().Get(o.key+"Logs", sacloud.APIDefaultZone, id)
+	v := o.ds().Get(o.key+"Logs", sacloud.APIDefaultZone, id)
 	if v == nil {
 		return nil, nil
 	}
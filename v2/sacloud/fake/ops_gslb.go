@@ -24,17 +24,20 @@ import (
 
 // Find is fake implementation
 func (o *GSLBOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.GSLBFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, conditions)
+	results, err := find(o.ds(), o.key, sacloud.APIDefaultZone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.GSLB
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.GSLB{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.GSLBFindResult{
-		Total: len(results),
-		Count: len(results),
-		From:  0,
+		Total: results.Total,
+		Count: results.Count,
+		From:  results.From,
 		GSLBs: values,
 	}, nil
 }
@@ -43,18 +46,18 @@ func (o *GSLBOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*
 func (o *GSLBOp) Create(ctx context.Context, param *sacloud.GSLBCreateRequest) (*sacloud.GSLB, error) {
 	result := &sacloud.GSLB{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt, fillAvailability)
+	fill(result, o.fillID, fillCreatedAt, fillAvailability)
 
 	result.FQDN = fmt.Sprintf("site-%d.gslb7.example.ne.jp", result.ID)
 	result.SettingsHash = "settingshash"
 
-	putGSLB(sacloud.APIDefaultZone, result)
+	putGSLB(o.ds(), sacloud.APIDefaultZone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *GSLBOp) Read(ctx context.Context, id types.ID) (*sacloud.GSLB, error) {
-	value := getGSLBByID(sacloud.APIDefaultZone, id)
+	value := getGSLBByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -76,7 +79,7 @@ func (o *GSLBOp) Update(ctx context.Context, id types.ID, param *sacloud.GSLBUpd
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putGSLB(sacloud.APIDefaultZone, value)
+	putGSLB(o.ds(), sacloud.APIDefaultZone, value)
 	return value, nil
 }
 
@@ -89,7 +92,7 @@ func (o *GSLBOp) UpdateSettings(ctx context.Context, id types.ID, param *sacloud
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putGSLB(sacloud.APIDefaultZone, value)
+	putGSLB(o.ds(), sacloud.APIDefaultZone, value)
 	return value, nil
 }
 
@@ -99,6 +102,6 @@ func (o *GSLBOp) Delete(ctx context.Context, id types.ID) error {
 	if err != nil {
 		return err
 	}
-	ds().Delete(o.key, sacloud.APIDefaultZone, id)
+	o.ds().Delete(o.key, sacloud.APIDefaultZone, id)
 	return nil
 }
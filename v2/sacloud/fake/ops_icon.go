@@ -24,17 +24,20 @@ import (
 
 // Find is fake implementation
 func (o *IconOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.IconFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, conditions)
+	results, err := find(o.ds(), o.key, sacloud.APIDefaultZone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.Icon
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.Icon{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.IconFindResult{
-		Total: len(results),
-		Count: len(results),
-		From:  0,
+		Total: results.Total,
+		Count: results.Count,
+		From:  results.From,
 		Icons: values,
 	}, nil
 }
@@ -43,19 +46,19 @@ func (o *IconOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*
 func (o *IconOp) Create(ctx context.Context, param *sacloud.IconCreateRequest) (*sacloud.Icon, error) {
 	result := &sacloud.Icon{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt, fillModifiedAt)
+	fill(result, o.fillID, fillCreatedAt, fillModifiedAt)
 
 	result.Availability = types.Availabilities.Available
 	result.Scope = types.Scopes.User
 	result.URL = fmt.Sprintf("https://secure.sakura.ad.jp/cloud/zone/is1a/api/cloud/1.1/icon/%d.png", result.ID)
 
-	putIcon(sacloud.APIDefaultZone, result)
+	putIcon(o.ds(), sacloud.APIDefaultZone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *IconOp) Read(ctx context.Context, id types.ID) (*sacloud.Icon, error) {
-	value := getIconByID(sacloud.APIDefaultZone, id)
+	value := getIconByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -73,7 +76,7 @@ func (o *IconOp) Update(ctx context.Context, id types.ID, param *sacloud.IconUpd
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putIcon(sacloud.APIDefaultZone, value)
+	putIcon(o.ds(), sacloud.APIDefaultZone, value)
 	return value, nil
 }
 
@@ -84,6 +87,6 @@ func (o *IconOp) Delete(ctx context.Context, id types.ID) error {
 		return err
 	}
 
-	ds().Delete(o.key, sacloud.APIDefaultZone, id)
+	o.ds().Delete(o.key, sacloud.APIDefaultZone, id)
 	return nil
 }
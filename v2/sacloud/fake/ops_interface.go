@@ -17,7 +17,6 @@ package fake
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/sacloud/libsacloud/v2/sacloud"
 	"github.com/sacloud/libsacloud/v2/sacloud/types"
@@ -25,17 +24,20 @@ import (
 
 // Find is fake implementation
 func (o *InterfaceOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.InterfaceFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.Interface
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.Interface{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.InterfaceFindResult{
-		Total:      len(results),
-		Count:      len(results),
-		From:       0,
+		Total:      results.Total,
+		Count:      results.Count,
+		From:       results.From,
 		Interfaces: values,
 	}, nil
 }
@@ -44,29 +46,29 @@ func (o *InterfaceOp) Find(ctx context.Context, zone string, conditions *sacloud
 func (o *InterfaceOp) Create(ctx context.Context, zone string, param *sacloud.InterfaceCreateRequest) (*sacloud.Interface, error) {
 	result := &sacloud.Interface{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
-	result.MACAddress = pool().nextMACAddress().String()
+	result.MACAddress = o.pool().nextMACAddress().String()
 
 	// connect to server
 	if param != nil && !param.ServerID.IsEmpty() {
-		serverOp := NewServerOp()
+		serverOp := newServerOp(o.store)
 		server, err := serverOp.Read(ctx, zone, param.ServerID)
 		if err == nil {
 			ifaceView := &sacloud.InterfaceView{}
 			copySameNameField(result, ifaceView)
 			server.Interfaces = append(server.Interfaces, ifaceView)
-			putServer(zone, server)
+			putServer(o.ds(), zone, server)
 		}
 	}
 
-	putInterface(zone, result)
+	putInterface(o.ds(), zone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *InterfaceOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Interface, error) {
-	value := getInterfaceByID(zone, id)
+	value := getInterfaceByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -86,20 +88,20 @@ func (o *InterfaceOp) Update(ctx context.Context, zone string, id types.ID, para
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	serverOp := NewServerOp()
+	serverOp := newServerOp(o.store)
 	searched, err := serverOp.Find(ctx, zone, nil)
 	if err == nil {
 		for _, server := range searched.Servers {
 			for _, iface := range server.Interfaces {
 				if iface.ID == id {
 					iface.UserIPAddress = param.UserIPAddress
-					putServer(zone, server)
+					putServer(o.ds(), zone, server)
 				}
 			}
 		}
 	}
 
-	putInterface(zone, value)
+	putInterface(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -109,10 +111,10 @@ func (o *InterfaceOp) Delete(ctx context.Context, zone string, id types.ID) erro
 	if err != nil {
 		return err
 	}
-	ds().Delete(o.key, zone, id)
+	o.ds().Delete(o.key, zone, id)
 
 	if !value.ServerID.IsEmpty() {
-		server, err := NewServerOp().Read(ctx, zone, value.ServerID)
+		server, err := newServerOp(o.store).Read(ctx, zone, value.ServerID)
 		if err == nil {
 			var deleted []*sacloud.InterfaceView
 			for _, iface := range server.Interfaces {
@@ -121,7 +123,7 @@ func (o *InterfaceOp) Delete(ctx context.Context, zone string, id types.ID) erro
 				}
 			}
 			server.Interfaces = deleted
-			putServer(zone, server)
+			putServer(o.ds(), zone, server)
 		}
 	}
 
@@ -135,18 +137,12 @@ func (o *InterfaceOp) Monitor(ctx context.Context, zone string, id types.ID, con
 		return nil, err
 	}
 
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.InterfaceActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorInterfaceValue{
-			Time:    now.Add(time.Duration(i*-5) * time.Minute),
-			Send:    float64(random(1000)),
-			Receive: float64(random(1000)),
+			Time:    t,
+			Send:    monitorValue(o.key, id, "Send", t),
+			Receive: monitorValue(o.key, id, "Receive", t),
 		})
 	}
 
@@ -166,10 +162,10 @@ func (o *InterfaceOp) ConnectToSharedSegment(ctx context.Context, zone string, i
 	}
 
 	value.SwitchID = sharedSegmentSwitch.ID
-	putInterface(zone, value)
+	putInterface(o.ds(), zone, value)
 
 	if !value.ServerID.IsEmpty() {
-		server, err := NewServerOp().Read(ctx, zone, value.ServerID)
+		server, err := newServerOp(o.store).Read(ctx, zone, value.ServerID)
 		if err == nil {
 			for _, iface := range server.Interfaces {
 				if iface.ID == id {
@@ -178,7 +174,7 @@ func (o *InterfaceOp) ConnectToSharedSegment(ctx context.Context, zone string, i
 					iface.SwitchName = sharedSegmentSwitch.Name
 				}
 			}
-			putServer(zone, server)
+			putServer(o.ds(), zone, server)
 		}
 	}
 
@@ -196,18 +192,18 @@ func (o *InterfaceOp) ConnectToSwitch(ctx context.Context, zone string, id types
 			fmt.Sprintf("Interface[%d] is already connected to switch[%d]", value.ID, switchID))
 	}
 
-	sw, err := NewSwitchOp().Read(ctx, zone, switchID)
+	sw, err := newSwitchOp(o.store).Read(ctx, zone, switchID)
 	if err != nil {
 		return err
 	}
 	sw.ServerCount++
-	putSwitch(zone, sw)
+	putSwitch(o.ds(), zone, sw)
 
 	value.SwitchID = switchID
-	putInterface(zone, value)
+	putInterface(o.ds(), zone, value)
 
 	if !value.ServerID.IsEmpty() {
-		server, err := NewServerOp().Read(ctx, zone, value.ServerID)
+		server, err := newServerOp(o.store).Read(ctx, zone, value.ServerID)
 		if err == nil {
 			for _, iface := range server.Interfaces {
 				if iface.ID == id {
@@ -216,7 +212,7 @@ func (o *InterfaceOp) ConnectToSwitch(ctx context.Context, zone string, id types
 					iface.SwitchName = sw.Name
 				}
 			}
-			putServer(zone, server)
+			putServer(o.ds(), zone, server)
 		}
 	}
 
@@ -235,10 +231,10 @@ func (o *InterfaceOp) DisconnectFromSwitch(ctx context.Context, zone string, id
 	}
 
 	value.SwitchID = types.ID(0)
-	putInterface(zone, value)
+	putInterface(o.ds(), zone, value)
 
 	if !value.ServerID.IsEmpty() {
-		server, err := NewServerOp().Read(ctx, zone, value.ServerID)
+		server, err := newServerOp(o.store).Read(ctx, zone, value.ServerID)
 		if err == nil {
 			for _, iface := range server.Interfaces {
 				if iface.ID == id {
@@ -247,7 +243,7 @@ func (o *InterfaceOp) DisconnectFromSwitch(ctx context.Context, zone string, id
 					iface.SwitchName = ""
 				}
 			}
-			putServer(zone, server)
+			putServer(o.ds(), zone, server)
 		}
 	}
 	return nil
@@ -265,10 +261,10 @@ func (o *InterfaceOp) ConnectToPacketFilter(ctx context.Context, zone string, id
 	}
 
 	value.PacketFilterID = packetFilterID
-	putInterface(zone, value)
+	putInterface(o.ds(), zone, value)
 
 	// server配下のInterfaceの修正
-	searched, err := NewServerOp().Find(ctx, zone, nil)
+	searched, err := newServerOp(o.store).Find(ctx, zone, nil)
 	if err != nil {
 		return err
 	}
@@ -281,7 +277,7 @@ func (o *InterfaceOp) ConnectToPacketFilter(ctx context.Context, zone string, id
 			}
 		}
 		if upd {
-			putServer(zone, server)
+			putServer(o.ds(), zone, server)
 		}
 	}
 
@@ -300,10 +296,10 @@ func (o *InterfaceOp) DisconnectFromPacketFilter(ctx context.Context, zone strin
 	}
 
 	value.PacketFilterID = types.ID(0)
-	putInterface(zone, value)
+	putInterface(o.ds(), zone, value)
 
 	// server配下のInterfaceの修正
-	searched, err := NewServerOp().Find(ctx, zone, nil)
+	searched, err := newServerOp(o.store).Find(ctx, zone, nil)
 	if err != nil {
 		return err
 	}
@@ -316,7 +312,7 @@ func (o *InterfaceOp) DisconnectFromPacketFilter(ctx context.Context, zone strin
 			}
 		}
 		if upd {
-			putServer(zone, server)
+			putServer(o.ds(), zone, server)
 		}
 	}
 	return nil
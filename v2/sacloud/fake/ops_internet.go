@@ -17,7 +17,6 @@ package fake
 import (
 	"context"
 	"net"
-	"time"
 
 	"github.com/sacloud/libsacloud/v2/sacloud"
 	"github.com/sacloud/libsacloud/v2/sacloud/types"
@@ -25,17 +24,20 @@ import (
 
 // Find is fake implementation
 func (o *InternetOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.InternetFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.Internet
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.Internet{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.InternetFindResult{
-		Total:    len(results),
-		Count:    len(results),
-		From:     0,
+		Total:    results.Total,
+		Count:    results.Count,
+		From:     results.From,
 		Internet: values,
 	}, nil
 }
@@ -51,13 +53,13 @@ func (o *InternetOp) Create(ctx context.Context, zone string, param *sacloud.Int
 
 	result := &sacloud.Internet{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
 	// assign global address
-	subnet := pool().nextSubnet(result.NetworkMaskLen)
+	subnet := o.pool().nextSubnet(result.NetworkMaskLen)
 
 	// create switch
-	swOp := NewSwitchOp()
+	swOp := newSwitchOp(o.store)
 	sw, err := swOp.Create(ctx, zone, &sacloud.SwitchCreateRequest{
 		Name:           result.Name,
 		NetworkMaskLen: subnet.networkMaskLen,
@@ -68,7 +70,7 @@ func (o *InternetOp) Create(ctx context.Context, zone string, param *sacloud.Int
 	}
 
 	sSubnet := &sacloud.SwitchSubnet{
-		ID:                   pool().generateID(),
+		ID:                   o.pool().generateID(),
 		DefaultRoute:         subnet.defaultRoute,
 		NetworkAddress:       subnet.networkAddress,
 		NetworkMaskLen:       subnet.networkMaskLen,
@@ -100,15 +102,15 @@ func (o *InternetOp) Create(ctx context.Context, zone string, param *sacloud.Int
 	rSubnet.SwitchID = sw.ID
 	rSubnet.InternetID = result.ID
 
-	putSwitch(zone, sw)
-	putInternet(zone, result)
-	putSubnet(zone, rSubnet)
+	putSwitch(o.ds(), zone, sw)
+	putInternet(o.ds(), zone, result)
+	putSubnet(o.ds(), zone, rSubnet)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *InternetOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Internet, error) {
-	value := getInternetByID(zone, id)
+	value := getInternetByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -125,7 +127,7 @@ func (o *InternetOp) Update(ctx context.Context, zone string, id types.ID, param
 	}
 	copySameNameField(param, value)
 
-	putInternet(zone, value)
+	putInternet(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -144,12 +146,12 @@ func (o *InternetOp) Delete(ctx context.Context, zone string, id types.ID) error
 		return newErrorBadRequest(ResourceInternet, value.ID, "Internet resource still have Subnets")
 	}
 
-	swOp := NewSwitchOp()
+	swOp := newSwitchOp(o.store)
 	if err := swOp.Delete(ctx, zone, value.Switch.ID); err != nil {
 		return err
 	}
 
-	ds().Delete(o.key, zone, id)
+	o.ds().Delete(o.key, zone, id)
 	return nil
 }
 
@@ -161,7 +163,7 @@ func (o *InternetOp) UpdateBandWidth(ctx context.Context, zone string, id types.
 	}
 
 	value.BandWidthMbps = param.BandWidthMbps
-	putInternet(zone, value)
+	putInternet(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -173,17 +175,17 @@ func (o *InternetOp) AddSubnet(ctx context.Context, zone string, id types.ID, pa
 	}
 
 	// assign global address
-	subnet := pool().nextSubnetFull(param.NetworkMaskLen, param.NextHop)
+	subnet := o.pool().nextSubnetFull(param.NetworkMaskLen, param.NextHop)
 
 	// create switch
-	swOp := NewSwitchOp()
+	swOp := newSwitchOp(o.store)
 	sw, err := swOp.Read(ctx, zone, value.Switch.ID)
 	if err != nil {
 		return nil, err
 	}
 
 	sSubnet := &sacloud.SwitchSubnet{
-		ID:                   pool().generateID(),
+		ID:                   o.pool().generateID(),
 		NetworkAddress:       subnet.networkAddress,
 		NetworkMaskLen:       subnet.networkMaskLen,
 		NextHop:              param.NextHop,
@@ -214,9 +216,9 @@ func (o *InternetOp) AddSubnet(ctx context.Context, zone string, id types.ID, pa
 	rSubnet.SwitchID = sw.ID
 	rSubnet.InternetID = value.ID
 
-	putSwitch(zone, sw)
-	putInternet(zone, value)
-	putSubnet(zone, rSubnet)
+	putSwitch(o.ds(), zone, sw)
+	putInternet(o.ds(), zone, value)
+	putSubnet(o.ds(), zone, rSubnet)
 
 	return &sacloud.InternetSubnetOperationResult{
 		ID:             sSubnet.ID,
@@ -235,13 +237,13 @@ func (o *InternetOp) UpdateSubnet(ctx context.Context, zone string, id types.ID,
 		return nil, err
 	}
 	// create switch
-	swOp := NewSwitchOp()
+	swOp := newSwitchOp(o.store)
 	sw, err := swOp.Read(ctx, zone, value.Switch.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	rSubnet, err := NewSubnetOp().Read(ctx, zone, subnetID)
+	rSubnet, err := newSubnetOp(o.store).Read(ctx, zone, subnetID)
 	if err != nil {
 		return nil, err
 	}
@@ -282,9 +284,9 @@ func (o *InternetOp) UpdateSubnet(ctx context.Context, zone string, id types.ID,
 		i++
 	}
 
-	putSubnet(zone, rSubnet)
-	putSwitch(zone, sw)
-	putInternet(zone, value)
+	putSubnet(o.ds(), zone, rSubnet)
+	putSwitch(o.ds(), zone, sw)
+	putInternet(o.ds(), zone, value)
 	return &sacloud.InternetSubnetOperationResult{
 		ID:             subnetID,
 		NextHop:        param.NextHop,
@@ -303,7 +305,7 @@ func (o *InternetOp) DeleteSubnet(ctx context.Context, zone string, id types.ID,
 	}
 
 	// create switch
-	swOp := NewSwitchOp()
+	swOp := newSwitchOp(o.store)
 	sw, err := swOp.Read(ctx, zone, value.Switch.ID)
 	if err != nil {
 		return err
@@ -325,9 +327,9 @@ func (o *InternetOp) DeleteSubnet(ctx context.Context, zone string, id types.ID,
 	}
 	value.Switch.Subnets = iSubnets
 
-	ds().Delete(ResourceSubnet, zone, subnetID)
-	putSwitch(zone, sw)
-	putInternet(zone, value)
+	o.ds().Delete(ResourceSubnet, zone, subnetID)
+	putSwitch(o.ds(), zone, sw)
+	putInternet(o.ds(), zone, value)
 	return nil
 }
 
@@ -338,18 +340,12 @@ func (o *InternetOp) Monitor(ctx context.Context, zone string, id types.ID, cond
 		return nil, err
 	}
 
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.RouterActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorRouterValue{
-			Time: now.Add(time.Duration(i*-5) * time.Minute),
-			In:   float64(random(1000)),
-			Out:  float64(random(1000)),
+			Time: t,
+			In:   monitorValue(o.key, id, "In", t),
+			Out:  monitorValue(o.key, id, "Out", t),
 		})
 	}
 
@@ -369,17 +365,17 @@ func (o *InternetOp) EnableIPv6(ctx context.Context, zone string, id types.ID) (
 	}
 
 	ipv6net := &sacloud.IPv6Net{
-		ID:            pool().generateID(),
+		ID:            o.pool().generateID(),
 		IPv6Prefix:    "2001:db8:11aa:22bb::/64",
 		IPv6PrefixLen: 64,
 	}
-	putIPv6Net(zone, ipv6net)
+	putIPv6Net(o.ds(), zone, ipv6net)
 
 	ipv6netInfo := &sacloud.IPv6NetInfo{}
 	copySameNameField(ipv6net, ipv6netInfo)
 
 	value.Switch.IPv6Nets = []*sacloud.IPv6NetInfo{ipv6netInfo}
-	putInternet(zone, value)
+	putInternet(o.ds(), zone, value)
 	return ipv6netInfo, nil
 }
 
@@ -394,8 +390,8 @@ func (o *InternetOp) DisableIPv6(ctx context.Context, zone string, id types.ID,
 		return nil
 	}
 
-	ds().Delete(ResourceIPv6Net, zone, value.Switch.IPv6Nets[0].ID)
+	o.ds().Delete(ResourceIPv6Net, zone, value.Switch.IPv6Nets[0].ID)
 	value.Switch.IPv6Nets = []*sacloud.IPv6NetInfo{}
-	putInternet(zone, value)
+	putInternet(o.ds(), zone, value)
 	return nil
 }
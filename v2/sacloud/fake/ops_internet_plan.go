@@ -23,24 +23,27 @@ import (
 
 // Find is fake implementation
 func (o *InternetPlanOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.InternetPlanFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.InternetPlan
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.InternetPlan{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.InternetPlanFindResult{
-		Total:         len(results),
-		Count:         len(results),
-		From:          0,
+		Total:         results.Total,
+		Count:         results.Count,
+		From:          results.From,
 		InternetPlans: values,
 	}, nil
 }
 
 // Read is fake implementation
 func (o *InternetPlanOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.InternetPlan, error) {
-	value := getInternetPlanByID(zone, id)
+	value := getInternetPlanByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
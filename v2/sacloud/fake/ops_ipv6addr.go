@@ -28,17 +28,20 @@ type ipv6Addr struct {
 
 // Find is fake implementation
 func (o *IPv6AddrOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.IPv6AddrFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.IPv6Addr
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.IPv6Addr{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.IPv6AddrFindResult{
-		Total:     len(results),
-		Count:     len(results),
-		From:      0,
+		Total:     results.Total,
+		Count:     results.Count,
+		From:      results.From,
 		IPv6Addrs: values,
 	}, nil
 }
@@ -48,7 +51,7 @@ func (o *IPv6AddrOp) Create(ctx context.Context, zone string, param *sacloud.IPv
 	result := &sacloud.IPv6Addr{}
 	copySameNameField(param, result)
 
-	ds().Put(ResourceIPv6Addr, zone, pool().generateID(), &ipv6Addr{IPv6Addr: result})
+	o.ds().Put(ResourceIPv6Addr, zone, o.pool().generateID(), &ipv6Addr{IPv6Addr: result})
 	return result, nil
 }
 
@@ -56,7 +59,7 @@ func (o *IPv6AddrOp) Create(ctx context.Context, zone string, param *sacloud.IPv
 func (o *IPv6AddrOp) Read(ctx context.Context, zone string, ipv6addr string) (*sacloud.IPv6Addr, error) {
 	var value *sacloud.IPv6Addr
 
-	results := ds().List(o.key, zone)
+	results := o.ds().List(o.key, zone)
 	for _, res := range results {
 		v := res.(*ipv6Addr)
 		if v.IPv6Addr.IPv6Addr == ipv6addr {
@@ -74,14 +77,14 @@ func (o *IPv6AddrOp) Read(ctx context.Context, zone string, ipv6addr string) (*s
 // Update is fake implementation
 func (o *IPv6AddrOp) Update(ctx context.Context, zone string, ipv6addr string, param *sacloud.IPv6AddrUpdateRequest) (*sacloud.IPv6Addr, error) {
 	found := false
-	results := ds().List(o.key, zone)
+	results := o.ds().List(o.key, zone)
 	var value *sacloud.IPv6Addr
 	for _, res := range results {
 		v := res.(*ipv6Addr)
 		if v.IPv6Addr.IPv6Addr == ipv6addr {
 			copySameNameField(param, v.IPv6Addr)
 			found = true
-			ds().Put(o.key, zone, v.ID, v)
+			o.ds().Put(o.key, zone, v.ID, v)
 			value = v.IPv6Addr
 		}
 	}
@@ -96,12 +99,12 @@ func (o *IPv6AddrOp) Update(ctx context.Context, zone string, ipv6addr string, p
 // Delete is fake implementation
 func (o *IPv6AddrOp) Delete(ctx context.Context, zone string, ipv6addr string) error {
 	found := false
-	results := ds().List(o.key, zone)
+	results := o.ds().List(o.key, zone)
 	for _, res := range results {
 		v := res.(*ipv6Addr)
 		if v.IPv6Addr.IPv6Addr == ipv6addr {
 			found = true
-			ds().Delete(o.key, zone, v.ID)
+			o.ds().Delete(o.key, zone, v.ID)
 		}
 	}
 
@@ -23,41 +23,41 @@ import (
 
 // List is fake implementation
 func (o *IPv6NetOp) List(ctx context.Context, zone string) (*sacloud.IPv6NetListResult, error) {
-	results, _ := find(o.key, zone, nil)
+	results, _ := find(o.ds(), o.key, zone, nil)
 	var values []*sacloud.IPv6Net
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.IPv6Net{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.IPv6NetListResult{
-		Total:    len(results),
-		Count:    len(results),
-		From:     0,
+		Total:    results.Total,
+		Count:    results.Count,
+		From:     results.From,
 		IPv6Nets: values,
 	}, nil
 }
 
 // List is fake implementation
 func (o *IPv6NetOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.IPv6NetFindResult, error) {
-	results, _ := find(o.key, zone, nil)
+	results, _ := find(o.ds(), o.key, zone, nil)
 	var values []*sacloud.IPv6Net
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.IPv6Net{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.IPv6NetFindResult{
-		Total:    len(results),
-		Count:    len(results),
-		From:     0,
+		Total:    results.Total,
+		Count:    results.Count,
+		From:     results.From,
 		IPv6Nets: values,
 	}, nil
 }
 
 // Read is fake implementation
 func (o *IPv6NetOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.IPv6Net, error) {
-	value := getIPv6NetByID(zone, id)
+	value := getIPv6NetByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
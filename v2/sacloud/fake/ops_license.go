@@ -23,17 +23,20 @@ import (
 
 // Find is fake implementation
 func (o *LicenseOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.LicenseFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, conditions)
+	results, err := find(o.ds(), o.key, sacloud.APIDefaultZone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.License
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.License{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.LicenseFindResult{
-		Total:    len(results),
-		Count:    len(results),
-		From:     0,
+		Total:    results.Total,
+		Count:    results.Count,
+		From:     results.From,
 		Licenses: values,
 	}, nil
 }
@@ -42,15 +45,15 @@ func (o *LicenseOp) Find(ctx context.Context, conditions *sacloud.FindCondition)
 func (o *LicenseOp) Create(ctx context.Context, param *sacloud.LicenseCreateRequest) (*sacloud.License, error) {
 	result := &sacloud.License{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt, fillModifiedAt)
+	fill(result, o.fillID, fillCreatedAt, fillModifiedAt)
 	result.LicenseInfoName = "Windows RDS SAL"
-	putLicense(sacloud.APIDefaultZone, result)
+	putLicense(o.ds(), sacloud.APIDefaultZone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *LicenseOp) Read(ctx context.Context, id types.ID) (*sacloud.License, error) {
-	value := getLicenseByID(sacloud.APIDefaultZone, id)
+	value := getLicenseByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -68,7 +71,7 @@ func (o *LicenseOp) Update(ctx context.Context, id types.ID, param *sacloud.Lice
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putLicense(sacloud.APIDefaultZone, value)
+	putLicense(o.ds(), sacloud.APIDefaultZone, value)
 	return value, nil
 }
 
@@ -78,6 +81,6 @@ func (o *LicenseOp) Delete(ctx context.Context, id types.ID) error {
 	if err != nil {
 		return err
 	}
-	ds().Delete(o.key, sacloud.APIDefaultZone, id)
+	o.ds().Delete(o.key, sacloud.APIDefaultZone, id)
 	return nil
 }
@@ -23,24 +23,27 @@ import (
 
 // Find is fake implementation
 func (o *LicenseInfoOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.LicenseInfoFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, conditions)
+	results, err := find(o.ds(), o.key, sacloud.APIDefaultZone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.LicenseInfo
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.LicenseInfo{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.LicenseInfoFindResult{
-		Total:       len(results),
-		Count:       len(results),
-		From:        0,
+		Total:       results.Total,
+		Count:       results.Count,
+		From:        results.From,
 		LicenseInfo: values,
 	}, nil
 }
 
 // Read is fake implementation
 func (o *LicenseInfoOp) Read(ctx context.Context, id types.ID) (*sacloud.LicenseInfo, error) {
-	value := getLicenseInfoByID(sacloud.APIDefaultZone, id)
+	value := getLicenseInfoByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
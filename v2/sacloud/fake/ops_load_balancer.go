@@ -16,7 +16,6 @@ package fake
 
 import (
 	"context"
-	"time"
 
 	"github.com/sacloud/libsacloud/v2/sacloud"
 	"github.com/sacloud/libsacloud/v2/sacloud/types"
@@ -24,17 +23,20 @@ import (
 
 // Find is fake implementation
 func (o *LoadBalancerOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.LoadBalancerFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.LoadBalancer
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.LoadBalancer{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.LoadBalancerFindResult{
-		Total:         len(results),
-		Count:         len(results),
-		From:          0,
+		Total:         results.Total,
+		Count:         results.Count,
+		From:          results.From,
 		LoadBalancers: values,
 	}, nil
 }
@@ -43,7 +45,7 @@ func (o *LoadBalancerOp) Find(ctx context.Context, zone string, conditions *sacl
 func (o *LoadBalancerOp) Create(ctx context.Context, zone string, param *sacloud.LoadBalancerCreateRequest) (*sacloud.LoadBalancer, error) {
 	result := &sacloud.LoadBalancer{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
 	result.Class = "loadbalancer"
 	result.Availability = types.Availabilities.Migrating
@@ -55,10 +57,10 @@ func (o *LoadBalancerOp) Create(ctx context.Context, zone string, param *sacloud
 		}
 	}
 
-	putLoadBalancer(zone, result)
+	putLoadBalancer(o.ds(), zone, result)
 
 	id := result.ID
-	startPowerOn(o.key, zone, func() (interface{}, error) {
+	startPowerOn(ctx, o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 	return result, nil
@@ -66,7 +68,7 @@ func (o *LoadBalancerOp) Create(ctx context.Context, zone string, param *sacloud
 
 // Read is fake implementation
 func (o *LoadBalancerOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.LoadBalancer, error) {
-	value := getLoadBalancerByID(zone, id)
+	value := getLoadBalancerByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -90,7 +92,7 @@ func (o *LoadBalancerOp) Update(ctx context.Context, zone string, id types.ID, p
 			vip.DelayLoop = 10 // default value
 		}
 	}
-	putLoadBalancer(zone, value)
+	putLoadBalancer(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -108,7 +110,7 @@ func (o *LoadBalancerOp) UpdateSettings(ctx context.Context, zone string, id typ
 			vip.DelayLoop = 10 // default value
 		}
 	}
-	putLoadBalancer(zone, value)
+	putLoadBalancer(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -118,7 +120,7 @@ func (o *LoadBalancerOp) Delete(ctx context.Context, zone string, id types.ID) e
 	if err != nil {
 		return err
 	}
-	ds().Delete(o.key, zone, id)
+	o.ds().Delete(o.key, zone, id)
 	return nil
 }
 
@@ -138,7 +140,7 @@ func (o *LoadBalancerOp) Boot(ctx context.Context, zone string, id types.ID) err
 		return newErrorConflict(o.key, id, "Boot is failed")
 	}
 
-	startPowerOn(o.key, zone, func() (interface{}, error) {
+	startPowerOn(ctx, o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -155,7 +157,7 @@ func (o *LoadBalancerOp) Shutdown(ctx context.Context, zone string, id types.ID,
 		return newErrorConflict(o.key, id, "Shutdown is failed")
 	}
 
-	startPowerOff(o.key, zone, func() (interface{}, error) {
+	startPowerOff(o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -172,7 +174,7 @@ func (o *LoadBalancerOp) Reset(ctx context.Context, zone string, id types.ID) er
 		return newErrorConflict(o.key, id, "Reset is failed")
 	}
 
-	startPowerOn(o.key, zone, func() (interface{}, error) {
+	startPowerOn(ctx, o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -186,18 +188,12 @@ func (o *LoadBalancerOp) MonitorInterface(ctx context.Context, zone string, id t
 		return nil, err
 	}
 
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.InterfaceActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorInterfaceValue{
-			Time:    now.Add(time.Duration(i*-5) * time.Minute),
-			Send:    float64(random(1000)),
-			Receive: float64(random(1000)),
+			Time:    t,
+			Send:    monitorValue(o.key, id, "Send", t),
+			Receive: monitorValue(o.key, id, "Receive", t),
 		})
 	}
 
@@ -245,17 +241,11 @@ func (o *LoadBalancerOp) MonitorCPU(ctx context.Context, zone string, id types.I
 		return nil, err
 	}
 
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.CPUTimeActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorCPUTimeValue{
-			Time:    now.Add(time.Duration(i*-5) * time.Minute),
-			CPUTime: float64(random(1000)),
+			Time:    t,
+			CPUTime: monitorValue(o.key, id, "CPUTime", t),
 		})
 	}
 
@@ -18,7 +18,6 @@ import (
 	"context"
 	"fmt"
 	"net"
-	"time"
 
 	"github.com/sacloud/libsacloud/v2/sacloud"
 	"github.com/sacloud/libsacloud/v2/sacloud/types"
@@ -26,17 +25,20 @@ import (
 
 // Find is fake implementation
 func (o *LocalRouterOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.LocalRouterFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, conditions)
+	results, err := find(o.ds(), o.key, sacloud.APIDefaultZone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.LocalRouter
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.LocalRouter{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.LocalRouterFindResult{
-		Total:        len(results),
-		Count:        len(results),
-		From:         0,
+		Total:        results.Total,
+		Count:        results.Count,
+		From:         results.From,
 		LocalRouters: values,
 	}, nil
 }
@@ -45,7 +47,7 @@ func (o *LocalRouterOp) Find(ctx context.Context, conditions *sacloud.FindCondit
 func (o *LocalRouterOp) Create(ctx context.Context, param *sacloud.LocalRouterCreateRequest) (*sacloud.LocalRouter, error) {
 	result := &sacloud.LocalRouter{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
 	result.Availability = types.Availabilities.Available
 	result.SecretKeys = []string{"dummy"}
@@ -53,15 +55,15 @@ func (o *LocalRouterOp) Create(ctx context.Context, param *sacloud.LocalRouterCr
 	status := &sacloud.LocalRouterHealth{
 		Peers: []*sacloud.LocalRouterHealthPeer{},
 	}
-	ds().Put(ResourceLocalRouter+"Status", sacloud.APIDefaultZone, result.ID, status)
+	o.ds().Put(ResourceLocalRouter+"Status", sacloud.APIDefaultZone, result.ID, status)
 
-	putLocalRouter(sacloud.APIDefaultZone, result)
+	putLocalRouter(o.ds(), sacloud.APIDefaultZone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *LocalRouterOp) Read(ctx context.Context, id types.ID) (*sacloud.LocalRouter, error) {
-	value := getLocalRouterByID(sacloud.APIDefaultZone, id)
+	value := getLocalRouterByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -109,9 +111,9 @@ func (o *LocalRouterOp) Update(ctx context.Context, id types.ID, param *sacloud.
 		})
 	}
 
-	ds().Put(ResourceLocalRouter+"Status", sacloud.APIDefaultZone, value.ID, status)
+	o.ds().Put(ResourceLocalRouter+"Status", sacloud.APIDefaultZone, value.ID, status)
 
-	putLocalRouter(sacloud.APIDefaultZone, value)
+	putLocalRouter(o.ds(), sacloud.APIDefaultZone, value)
 	return value, nil
 }
 
@@ -154,9 +156,9 @@ func (o *LocalRouterOp) UpdateSettings(ctx context.Context, id types.ID, param *
 		})
 	}
 
-	ds().Put(ResourceLocalRouter+"Status", sacloud.APIDefaultZone, value.ID, status)
+	o.ds().Put(ResourceLocalRouter+"Status", sacloud.APIDefaultZone, value.ID, status)
 
-	putLocalRouter(sacloud.APIDefaultZone, value)
+	putLocalRouter(o.ds(), sacloud.APIDefaultZone, value)
 	return value, nil
 }
 
@@ -167,7 +169,7 @@ func (o *LocalRouterOp) Delete(ctx context.Context, id types.ID) error {
 		return err
 	}
 
-	ds().Delete(o.key, sacloud.APIDefaultZone, id)
+	o.ds().Delete(o.key, sacloud.APIDefaultZone, id)
 	return nil
 }
 
@@ -178,7 +180,7 @@ func (o *LocalRouterOp) HealthStatus(ctx context.Context, id types.ID) (*sacloud
 		return nil, err
 	}
 
-	result := ds().Get(ResourceLocalRouter+"Status", sacloud.APIDefaultZone, id)
+	result := o.ds().Get(ResourceLocalRouter+"Status", sacloud.APIDefaultZone, id)
 	if result == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -192,18 +194,12 @@ func (o *LocalRouterOp) MonitorLocalRouter(ctx context.Context, id types.ID, con
 		return nil, err
 	}
 
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.LocalRouterActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorLocalRouterValue{
-			Time:               now.Add(time.Duration(i*-5) * time.Minute),
-			ReceiveBytesPerSec: float64(random(1000)),
-			SendBytesPerSec:    float64(random(1000)),
+			Time:               t,
+			ReceiveBytesPerSec: monitorValue(o.key, id, "ReceiveBytesPerSec", t),
+			SendBytesPerSec:    monitorValue(o.key, id, "SendBytesPerSec", t),
 		})
 	}
 
@@ -25,17 +25,20 @@ import (
 
 // Find is fake implementation
 func (o *MobileGatewayOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.MobileGatewayFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.MobileGateway
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.MobileGateway{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.MobileGatewayFindResult{
-		Total:          len(results),
-		Count:          len(results),
-		From:           0,
+		Total:          results.Total,
+		Count:          results.Count,
+		From:           results.From,
 		MobileGateways: values,
 	}, nil
 }
@@ -44,7 +47,7 @@ func (o *MobileGatewayOp) Find(ctx context.Context, zone string, conditions *sac
 func (o *MobileGatewayOp) Create(ctx context.Context, zone string, param *sacloud.MobileGatewayCreateRequest) (*sacloud.MobileGateway, error) {
 	result := &sacloud.MobileGateway{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
 	result.Availability = types.Availabilities.Available
 	result.Class = "mobilegateway"
@@ -52,7 +55,7 @@ func (o *MobileGatewayOp) Create(ctx context.Context, zone string, param *saclou
 	result.SettingsHash = ""
 
 	// set interface
-	ifOp := NewInterfaceOp()
+	ifOp := newInterfaceOp(o.store)
 	iface, err := ifOp.Create(ctx, zone, &sacloud.InterfaceCreateRequest{ServerID: result.ID})
 	if err != nil {
 		return nil, newErrorConflict(o.key, types.ID(0), err.Error())
@@ -68,13 +71,13 @@ func (o *MobileGatewayOp) Create(ctx context.Context, zone string, param *saclou
 	copySameNameField(iface, ifaceView)
 	result.Interfaces = append(result.Interfaces, ifaceView)
 
-	putMobileGateway(zone, result)
+	putMobileGateway(o.ds(), zone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *MobileGatewayOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGateway, error) {
-	value := getMobileGatewayByID(zone, id)
+	value := getMobileGatewayByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -92,7 +95,7 @@ func (o *MobileGatewayOp) Update(ctx context.Context, zone string, id types.ID,
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putMobileGateway(zone, value)
+	putMobileGateway(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -105,7 +108,7 @@ func (o *MobileGatewayOp) UpdateSettings(ctx context.Context, zone string, id ty
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putMobileGateway(zone, value)
+	putMobileGateway(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -116,7 +119,7 @@ func (o *MobileGatewayOp) Delete(ctx context.Context, zone string, id types.ID)
 		return err
 	}
 
-	ds().Delete(o.key, zone, id)
+	o.ds().Delete(o.key, zone, id)
 	return nil
 }
 
@@ -136,7 +139,7 @@ func (o *MobileGatewayOp) Boot(ctx context.Context, zone string, id types.ID) er
 		return newErrorConflict(o.key, id, "Boot is failed")
 	}
 
-	startPowerOn(o.key, zone, func() (interface{}, error) {
+	startPowerOn(ctx, o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -153,7 +156,7 @@ func (o *MobileGatewayOp) Shutdown(ctx context.Context, zone string, id types.ID
 		return newErrorConflict(o.key, id, "Shutdown is failed")
 	}
 
-	startPowerOff(o.key, zone, func() (interface{}, error) {
+	startPowerOff(o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -170,7 +173,7 @@ func (o *MobileGatewayOp) Reset(ctx context.Context, zone string, id types.ID) e
 		return newErrorConflict(o.key, id, "Reset is failed")
 	}
 
-	startPowerOn(o.key, zone, func() (interface{}, error) {
+	startPowerOn(ctx, o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -191,14 +194,14 @@ func (o *MobileGatewayOp) ConnectToSwitch(ctx context.Context, zone string, id t
 	}
 
 	// find switch
-	swOp := NewSwitchOp()
+	swOp := newSwitchOp(o.store)
 	_, err = swOp.Read(ctx, zone, switchID)
 	if err != nil {
 		return fmt.Errorf("ConnectToSwitch is failed: %s", err)
 	}
 
 	// create interface
-	ifOp := NewInterfaceOp()
+	ifOp := newInterfaceOp(o.store)
 	iface, err := ifOp.Create(ctx, zone, &sacloud.InterfaceCreateRequest{ServerID: id})
 	if err != nil {
 		return newErrorConflict(o.key, types.ID(0), err.Error())
@@ -218,7 +221,7 @@ func (o *MobileGatewayOp) ConnectToSwitch(ctx context.Context, zone string, id t
 	mobileGatewayInterface.Index = 1 // 1固定
 	value.Interfaces = append(value.Interfaces, mobileGatewayInterface)
 
-	putMobileGateway(zone, value)
+	putMobileGateway(o.ds(), zone, value)
 	return nil
 }
 
@@ -245,13 +248,13 @@ func (o *MobileGatewayOp) DisconnectFromSwitch(ctx context.Context, zone string,
 		return newErrorBadRequest(o.key, id, fmt.Sprintf("nic[%d] is not exists", 1))
 	}
 
-	ifOp := NewInterfaceOp()
+	ifOp := newInterfaceOp(o.store)
 	if err := ifOp.DisconnectFromSwitch(ctx, zone, nicID); err != nil {
 		return newErrorConflict(o.key, types.ID(0), err.Error())
 	}
 
 	value.Interfaces = interfaces
-	putMobileGateway(zone, value)
+	putMobileGateway(o.ds(), zone, value)
 	return nil
 }
 
@@ -262,7 +265,7 @@ func (o *MobileGatewayOp) GetDNS(ctx context.Context, zone string, id types.ID)
 		return nil, err
 	}
 
-	dns := ds().Get(o.dnsStoreKey(), zone, id)
+	dns := o.ds().Get(o.dnsStoreKey(), zone, id)
 	if dns == nil {
 		return &sacloud.MobileGatewayDNSSetting{
 			DNS1: "133.242.0.1",
@@ -279,7 +282,7 @@ func (o *MobileGatewayOp) SetDNS(ctx context.Context, zone string, id types.ID,
 		return err
 	}
 
-	ds().Put(o.dnsStoreKey(), zone, id, param)
+	o.ds().Put(o.dnsStoreKey(), zone, id, param)
 	return nil
 }
 
@@ -290,7 +293,7 @@ func (o *MobileGatewayOp) GetSIMRoutes(ctx context.Context, zone string, id type
 		return nil, err
 	}
 
-	routes := ds().Get(o.simRoutesStoreKey(), zone, id)
+	routes := o.ds().Get(o.simRoutesStoreKey(), zone, id)
 	if routes == nil {
 		return nil, nil
 	}
@@ -308,7 +311,7 @@ func (o *MobileGatewayOp) SetSIMRoutes(ctx context.Context, zone string, id type
 		return err
 	}
 
-	simOp := NewSIMOp()
+	simOp := newSIMOp(o.store)
 	var values []*sacloud.MobileGatewaySIMRoute
 	for _, p := range param {
 		sim, err := simOp.Read(ctx, types.StringID(p.ResourceID))
@@ -322,7 +325,7 @@ func (o *MobileGatewayOp) SetSIMRoutes(ctx context.Context, zone string, id type
 		})
 	}
 
-	ds().Put(o.simRoutesStoreKey(), zone, id, &values)
+	o.ds().Put(o.simRoutesStoreKey(), zone, id, &values)
 	return nil
 }
 
@@ -333,7 +336,7 @@ func (o *MobileGatewayOp) ListSIM(ctx context.Context, zone string, id types.ID)
 		return nil, err
 	}
 
-	sims := ds().Get(o.simsStoreKey(), zone, id)
+	sims := o.ds().Get(o.simsStoreKey(), zone, id)
 	if sims == nil {
 		return nil, nil
 	}
@@ -352,7 +355,7 @@ func (o *MobileGatewayOp) AddSIM(ctx context.Context, zone string, id types.ID,
 	}
 
 	var sims []*sacloud.MobileGatewaySIMInfo
-	rawSIMs := ds().Get(o.simsStoreKey(), zone, id)
+	rawSIMs := o.ds().Get(o.simsStoreKey(), zone, id)
 	if rawSIMs != nil {
 		sims = rawSIMs.([]*sacloud.MobileGatewaySIMInfo)
 		for _, sim := range sims {
@@ -362,7 +365,7 @@ func (o *MobileGatewayOp) AddSIM(ctx context.Context, zone string, id types.ID,
 		}
 	}
 
-	simOp := NewSIMOp()
+	simOp := newSIMOp(o.store)
 	simInfo, err := simOp.Status(context.Background(), types.StringID(param.SIMID))
 	if err != nil {
 		return err
@@ -372,7 +375,7 @@ func (o *MobileGatewayOp) AddSIM(ctx context.Context, zone string, id types.ID,
 
 	sims = append(sims, sim)
 
-	ds().Put(o.simsStoreKey(), zone, id, &sims)
+	o.ds().Put(o.simsStoreKey(), zone, id, &sims)
 	return nil
 }
 
@@ -384,7 +387,7 @@ func (o *MobileGatewayOp) DeleteSIM(ctx context.Context, zone string, id types.I
 	}
 
 	var updSIMs []*sacloud.MobileGatewaySIMInfo
-	rawSIMs := ds().Get(o.simsStoreKey(), zone, id)
+	rawSIMs := o.ds().Get(o.simsStoreKey(), zone, id)
 	if rawSIMs != nil {
 		ss := rawSIMs.(*[]*sacloud.MobileGatewaySIMInfo)
 		for _, sim := range *ss {
@@ -393,7 +396,7 @@ func (o *MobileGatewayOp) DeleteSIM(ctx context.Context, zone string, id types.I
 			}
 		}
 		if len(*ss) != len(updSIMs) {
-			ds().Put(o.simsStoreKey(), zone, id, &updSIMs)
+			o.ds().Put(o.simsStoreKey(), zone, id, &updSIMs)
 			return nil
 		}
 	}
@@ -423,7 +426,7 @@ func (o *MobileGatewayOp) GetTrafficConfig(ctx context.Context, zone string, id
 		return nil, err
 	}
 
-	config := ds().Get(o.trafficConfigStoreKey(), zone, id)
+	config := o.ds().Get(o.trafficConfigStoreKey(), zone, id)
 	if config == nil {
 		return nil, nil
 	}
@@ -437,7 +440,7 @@ func (o *MobileGatewayOp) SetTrafficConfig(ctx context.Context, zone string, id
 		return err
 	}
 
-	ds().Put(o.trafficConfigStoreKey(), zone, id, param)
+	o.ds().Put(o.trafficConfigStoreKey(), zone, id, param)
 	return nil
 }
 
@@ -448,7 +451,7 @@ func (o *MobileGatewayOp) DeleteTrafficConfig(ctx context.Context, zone string,
 		return err
 	}
 
-	ds().Delete(o.trafficConfigStoreKey(), zone, id)
+	o.ds().Delete(o.trafficConfigStoreKey(), zone, id)
 	return nil
 }
 
@@ -473,18 +476,12 @@ func (o *MobileGatewayOp) MonitorInterface(ctx context.Context, zone string, id
 		return nil, err
 	}
 
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.InterfaceActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorInterfaceValue{
-			Time:    now.Add(time.Duration(i*-5) * time.Minute),
-			Send:    float64(random(1000)),
-			Receive: float64(random(1000)),
+			Time:    t,
+			Send:    monitorValue(o.key, id, "Send", t),
+			Receive: monitorValue(o.key, id, "Receive", t),
 		})
 	}
 
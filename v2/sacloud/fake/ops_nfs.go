@@ -17,7 +17,6 @@ package fake
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/sacloud/libsacloud/v2/sacloud"
 	"github.com/sacloud/libsacloud/v2/sacloud/types"
@@ -25,17 +24,20 @@ import (
 
 // Find is fake implementation
 func (o *NFSOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.NFSFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.NFS
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.NFS{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.NFSFindResult{
-		Total: len(results),
-		Count: len(results),
-		From:  0,
+		Total: results.Total,
+		Count: results.Count,
+		From:  results.From,
 		NFS:   values,
 	}, nil
 }
@@ -44,16 +46,16 @@ func (o *NFSOp) Find(ctx context.Context, zone string, conditions *sacloud.FindC
 func (o *NFSOp) Create(ctx context.Context, zone string, param *sacloud.NFSCreateRequest) (*sacloud.NFS, error) {
 	result := &sacloud.NFS{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
 	result.Class = "nfs"
 	result.Availability = types.Availabilities.Migrating
 	result.ZoneID = zoneIDs[zone]
 
-	putNFS(zone, result)
+	putNFS(o.ds(), zone, result)
 
 	id := result.ID
-	startPowerOn(o.key, zone, func() (interface{}, error) {
+	startPowerOn(ctx, o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 	return result, nil
@@ -61,7 +63,7 @@ func (o *NFSOp) Create(ctx context.Context, zone string, param *sacloud.NFSCreat
 
 // Read is fake implementation
 func (o *NFSOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.NFS, error) {
-	value := getNFSByID(zone, id)
+	value := getNFSByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -80,7 +82,7 @@ func (o *NFSOp) Update(ctx context.Context, zone string, id types.ID, param *sac
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putNFS(zone, value)
+	putNFS(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -94,7 +96,7 @@ func (o *NFSOp) Delete(ctx context.Context, zone string, id types.ID) error {
 		return newErrorConflict(o.key, id, fmt.Sprintf("NFS[%s] is still running", id))
 	}
 
-	ds().Delete(o.key, zone, id)
+	o.ds().Delete(o.key, zone, id)
 	return nil
 }
 
@@ -108,7 +110,7 @@ func (o *NFSOp) Boot(ctx context.Context, zone string, id types.ID) error {
 		return newErrorConflict(o.key, id, "Boot is failed")
 	}
 
-	startPowerOn(o.key, zone, func() (interface{}, error) {
+	startPowerOn(ctx, o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -125,7 +127,7 @@ func (o *NFSOp) Shutdown(ctx context.Context, zone string, id types.ID, shutdown
 		return newErrorConflict(o.key, id, "Shutdown is failed")
 	}
 
-	startPowerOff(o.key, zone, func() (interface{}, error) {
+	startPowerOff(o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -142,7 +144,7 @@ func (o *NFSOp) Reset(ctx context.Context, zone string, id types.ID) error {
 		return newErrorConflict(o.key, id, "Reset is failed")
 	}
 
-	startPowerOn(o.key, zone, func() (interface{}, error) {
+	startPowerOn(ctx, o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -156,17 +158,11 @@ func (o *NFSOp) MonitorFreeDiskSize(ctx context.Context, zone string, id types.I
 		return nil, err
 	}
 
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.FreeDiskSizeActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorFreeDiskSizeValue{
-			Time:         now.Add(time.Duration(i*-5) * time.Minute),
-			FreeDiskSize: float64(random(1000)),
+			Time:         t,
+			FreeDiskSize: monitorValue(o.key, id, "FreeDiskSize", t),
 		})
 	}
 
@@ -180,18 +176,12 @@ func (o *NFSOp) MonitorInterface(ctx context.Context, zone string, id types.ID,
 		return nil, err
 	}
 
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.InterfaceActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorInterfaceValue{
-			Time:    now.Add(time.Duration(i*-5) * time.Minute),
-			Send:    float64(random(1000)),
-			Receive: float64(random(1000)),
+			Time:    t,
+			Send:    monitorValue(o.key, id, "Send", t),
+			Receive: monitorValue(o.key, id, "Receive", t),
 		})
 	}
 
@@ -205,17 +195,11 @@ func (o *NFSOp) MonitorCPU(ctx context.Context, zone string, id types.ID, condit
 		return nil, err
 	}
 
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.CPUTimeActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorCPUTimeValue{
-			Time:    now.Add(time.Duration(i*-5) * time.Minute),
-			CPUTime: float64(random(1000)),
+			Time:    t,
+			CPUTime: monitorValue(o.key, id, "CPUTime", t),
 		})
 	}
 
@@ -23,17 +23,20 @@ import (
 
 // Find is fake implementation
 func (o *NoteOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.NoteFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, conditions)
+	results, err := find(o.ds(), o.key, sacloud.APIDefaultZone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.Note
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.Note{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.NoteFindResult{
-		Total: len(results),
-		Count: len(results),
-		From:  0,
+		Total: results.Total,
+		Count: results.Count,
+		From:  results.From,
 		Notes: values,
 	}, nil
 }
@@ -42,14 +45,14 @@ func (o *NoteOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*
 func (o *NoteOp) Create(ctx context.Context, param *sacloud.NoteCreateRequest) (*sacloud.Note, error) {
 	result := &sacloud.Note{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt, fillAvailability, fillScope)
-	putNote(sacloud.APIDefaultZone, result)
+	fill(result, o.fillID, fillCreatedAt, fillAvailability, fillScope)
+	putNote(o.ds(), sacloud.APIDefaultZone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *NoteOp) Read(ctx context.Context, id types.ID) (*sacloud.Note, error) {
-	value := getNoteByID(sacloud.APIDefaultZone, id)
+	value := getNoteByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -69,7 +72,7 @@ func (o *NoteOp) Update(ctx context.Context, id types.ID, param *sacloud.NoteUpd
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putNote(sacloud.APIDefaultZone, value)
+	putNote(o.ds(), sacloud.APIDefaultZone, value)
 	return value, nil
 }
 
@@ -79,6 +82,6 @@ func (o *NoteOp) Delete(ctx context.Context, id types.ID) error {
 	if err != nil {
 		return err
 	}
-	ds().Delete(o.key, sacloud.APIDefaultZone, id)
+	o.ds().Delete(o.key, sacloud.APIDefaultZone, id)
 	return nil
 }
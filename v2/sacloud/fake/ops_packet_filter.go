@@ -23,17 +23,20 @@ import (
 
 // Find is fake implementation
 func (o *PacketFilterOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.PacketFilterFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.PacketFilter
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.PacketFilter{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.PacketFilterFindResult{
-		Total:         len(results),
-		Count:         len(results),
-		From:          0,
+		Total:         results.Total,
+		Count:         results.Count,
+		From:          results.From,
 		PacketFilters: values,
 	}, nil
 }
@@ -42,15 +45,15 @@ func (o *PacketFilterOp) Find(ctx context.Context, zone string, conditions *sacl
 func (o *PacketFilterOp) Create(ctx context.Context, zone string, param *sacloud.PacketFilterCreateRequest) (*sacloud.PacketFilter, error) {
 	result := &sacloud.PacketFilter{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
-	putPacketFilter(zone, result)
+	putPacketFilter(o.ds(), zone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *PacketFilterOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.PacketFilter, error) {
-	value := getPacketFilterByID(zone, id)
+	value := getPacketFilterByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -68,7 +71,7 @@ func (o *PacketFilterOp) Update(ctx context.Context, zone string, id types.ID, p
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putPacketFilter(zone, value)
+	putPacketFilter(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -79,6 +82,6 @@ func (o *PacketFilterOp) Delete(ctx context.Context, zone string, id types.ID) e
 		return err
 	}
 
-	ds().Delete(o.key, zone, id)
+	o.ds().Delete(o.key, zone, id)
 	return nil
 }
@@ -23,24 +23,27 @@ import (
 
 // Find is fake implementation
 func (o *PrivateHostOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.PrivateHostFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.PrivateHost
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.PrivateHost{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.PrivateHostFindResult{
-		Total:        len(results),
-		Count:        len(results),
-		From:         0,
+		Total:        results.Total,
+		Count:        results.Count,
+		From:         results.From,
 		PrivateHosts: values,
 	}, nil
 }
 
 // Create is fake implementation
 func (o *PrivateHostOp) Create(ctx context.Context, zone string, param *sacloud.PrivateHostCreateRequest) (*sacloud.PrivateHost, error) {
-	planOp := NewPrivateHostPlanOp()
+	planOp := newPrivateHostPlanOp(o.store)
 	plan, err := planOp.Read(ctx, zone, param.PlanID)
 	if err != nil {
 		return nil, err
@@ -48,20 +51,20 @@ func (o *PrivateHostOp) Create(ctx context.Context, zone string, param *sacloud.
 
 	result := &sacloud.PrivateHost{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
 	result.PlanName = plan.Name
 	result.PlanClass = plan.Class
 	result.CPU = plan.CPU
 	result.MemoryMB = plan.MemoryMB
 	result.HostName = "sac-zone-svNNN"
-	putPrivateHost(zone, result)
+	putPrivateHost(o.ds(), zone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *PrivateHostOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.PrivateHost, error) {
-	value := getPrivateHostByID(zone, id)
+	value := getPrivateHostByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -79,7 +82,7 @@ func (o *PrivateHostOp) Update(ctx context.Context, zone string, id types.ID, pa
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putPrivateHost(zone, value)
+	putPrivateHost(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -90,6 +93,6 @@ func (o *PrivateHostOp) Delete(ctx context.Context, zone string, id types.ID) er
 		return err
 	}
 
-	ds().Delete(o.key, zone, id)
+	o.ds().Delete(o.key, zone, id)
 	return nil
 }
@@ -23,24 +23,27 @@ import (
 
 // Find is fake implementation
 func (o *PrivateHostPlanOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.PrivateHostPlanFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.PrivateHostPlan
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.PrivateHostPlan{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.PrivateHostPlanFindResult{
-		Total:            len(results),
-		Count:            len(results),
-		From:             0,
+		Total:            results.Total,
+		Count:            results.Count,
+		From:             results.From,
 		PrivateHostPlans: values,
 	}, nil
 }
 
 // Read is fake implementation
 func (o *PrivateHostPlanOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.PrivateHostPlan, error) {
-	value := getPrivateHostPlanByID(zone, id)
+	value := getPrivateHostPlanByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -25,17 +25,20 @@ import (
 
 // Find is fake implementation
 func (o *ProxyLBOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.ProxyLBFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, conditions)
+	results, err := find(o.ds(), o.key, sacloud.APIDefaultZone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.ProxyLB
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.ProxyLB{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.ProxyLBFindResult{
-		Total:    len(results),
-		Count:    len(results),
-		From:     0,
+		Total:    results.Total,
+		Count:    results.Count,
+		From:     results.From,
 		ProxyLBs: values,
 	}, nil
 }
@@ -44,11 +47,11 @@ func (o *ProxyLBOp) Find(ctx context.Context, conditions *sacloud.FindCondition)
 func (o *ProxyLBOp) Create(ctx context.Context, param *sacloud.ProxyLBCreateRequest) (*sacloud.ProxyLB, error) {
 	result := &sacloud.ProxyLB{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
 	result.Availability = types.Availabilities.Available
 
-	vip := pool().nextSharedIP()
+	vip := o.pool().nextSharedIP()
 	vipNet := net.IPNet{IP: vip, Mask: []byte{255, 255, 255, 0}}
 	result.ProxyNetworks = []string{vipNet.String()}
 	if param.UseVIPFailover {
@@ -78,15 +81,15 @@ func (o *ProxyLBOp) Create(ctx context.Context, param *sacloud.ProxyLBCreateRequ
 			CPS:        10,
 		})
 	}
-	ds().Put(ResourceProxyLB+"Status", sacloud.APIDefaultZone, result.ID, status)
+	o.ds().Put(ResourceProxyLB+"Status", sacloud.APIDefaultZone, result.ID, status)
 
-	putProxyLB(sacloud.APIDefaultZone, result)
+	putProxyLB(o.ds(), sacloud.APIDefaultZone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *ProxyLBOp) Read(ctx context.Context, id types.ID) (*sacloud.ProxyLB, error) {
-	value := getProxyLBByID(sacloud.APIDefaultZone, id)
+	value := getProxyLBByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -112,9 +115,9 @@ func (o *ProxyLBOp) Update(ctx context.Context, id types.ID, param *sacloud.Prox
 	if value.Timeout.InactiveSec == 0 {
 		value.Timeout.InactiveSec = 10
 	}
-	putProxyLB(sacloud.APIDefaultZone, value)
+	putProxyLB(o.ds(), sacloud.APIDefaultZone, value)
 
-	status := ds().Get(ResourceProxyLB+"Status", sacloud.APIDefaultZone, id).(*sacloud.ProxyLBHealth)
+	status := o.ds().Get(ResourceProxyLB+"Status", sacloud.APIDefaultZone, id).(*sacloud.ProxyLBHealth)
 	status.Servers = []*sacloud.LoadBalancerServerStatus{}
 	for _, server := range param.Servers {
 		status.Servers = append(status.Servers, &sacloud.LoadBalancerServerStatus{
@@ -125,7 +128,7 @@ func (o *ProxyLBOp) Update(ctx context.Context, id types.ID, param *sacloud.Prox
 			CPS:        10,
 		})
 	}
-	ds().Put(ResourceProxyLB+"Status", sacloud.APIDefaultZone, id, status)
+	o.ds().Put(ResourceProxyLB+"Status", sacloud.APIDefaultZone, id, status)
 
 	return value, nil
 }
@@ -147,9 +150,9 @@ func (o *ProxyLBOp) UpdateSettings(ctx context.Context, id types.ID, param *sacl
 	if value.Timeout.InactiveSec == 0 {
 		value.Timeout.InactiveSec = 10
 	}
-	putProxyLB(sacloud.APIDefaultZone, value)
+	putProxyLB(o.ds(), sacloud.APIDefaultZone, value)
 
-	status := ds().Get(ResourceProxyLB+"Status", sacloud.APIDefaultZone, id).(*sacloud.ProxyLBHealth)
+	status := o.ds().Get(ResourceProxyLB+"Status", sacloud.APIDefaultZone, id).(*sacloud.ProxyLBHealth)
 	status.Servers = []*sacloud.LoadBalancerServerStatus{}
 	for _, server := range param.Servers {
 		status.Servers = append(status.Servers, &sacloud.LoadBalancerServerStatus{
@@ -160,7 +163,7 @@ func (o *ProxyLBOp) UpdateSettings(ctx context.Context, id types.ID, param *sacl
 			CPS:        10,
 		})
 	}
-	ds().Put(ResourceProxyLB+"Status", sacloud.APIDefaultZone, id, status)
+	o.ds().Put(ResourceProxyLB+"Status", sacloud.APIDefaultZone, id, status)
 
 	return value, nil
 }
@@ -172,9 +175,9 @@ func (o *ProxyLBOp) Delete(ctx context.Context, id types.ID) error {
 		return err
 	}
 
-	ds().Delete(ResourceProxyLB+"Status", sacloud.APIDefaultZone, id)
-	ds().Delete(ResourceProxyLB+"Certs", sacloud.APIDefaultZone, id)
-	ds().Delete(o.key, sacloud.APIDefaultZone, id)
+	o.ds().Delete(ResourceProxyLB+"Status", sacloud.APIDefaultZone, id)
+	o.ds().Delete(ResourceProxyLB+"Certs", sacloud.APIDefaultZone, id)
+	o.ds().Delete(o.key, sacloud.APIDefaultZone, id)
 
 	return nil
 }
@@ -187,7 +190,7 @@ func (o *ProxyLBOp) ChangePlan(ctx context.Context, id types.ID, param *sacloud.
 	}
 
 	value.Plan = types.ProxyLBPlanFromServiceClass(param.ServiceClass)
-	putProxyLB(sacloud.APIDefaultZone, value)
+	putProxyLB(o.ds(), sacloud.APIDefaultZone, value)
 
 	return value, err
 }
@@ -199,7 +202,7 @@ func (o *ProxyLBOp) GetCertificates(ctx context.Context, id types.ID) (*sacloud.
 		return nil, err
 	}
 
-	v := ds().Get(ResourceProxyLB+"Certs", sacloud.APIDefaultZone, id)
+	v := o.ds().Get(ResourceProxyLB+"Certs", sacloud.APIDefaultZone, id)
 	if v != nil {
 		return v.(*sacloud.ProxyLBCertificates), nil
 	}
@@ -219,7 +222,7 @@ func (o *ProxyLBOp) SetCertificates(ctx context.Context, id types.ID, param *sac
 	cert.PrimaryCert.CertificateCommonName = "dummy-common-name.org"
 	cert.PrimaryCert.CertificateEndDate = time.Now().Add(365 * 24 * time.Hour)
 
-	ds().Put(ResourceProxyLB+"Certs", sacloud.APIDefaultZone, id, cert)
+	o.ds().Put(ResourceProxyLB+"Certs", sacloud.APIDefaultZone, id, cert)
 	return cert, nil
 }
 
@@ -230,9 +233,9 @@ func (o *ProxyLBOp) DeleteCertificates(ctx context.Context, id types.ID) error {
 		return err
 	}
 
-	v := ds().Get(ResourceProxyLB+"Certs", sacloud.APIDefaultZone, id)
+	v := o.ds().Get(ResourceProxyLB+"Certs", sacloud.APIDefaultZone, id)
 	if v != nil {
-		ds().Delete(ResourceProxyLB+"Certs", sacloud.APIDefaultZone, id)
+		o.ds().Delete(ResourceProxyLB+"Certs", sacloud.APIDefaultZone, id)
 	}
 	return nil
 }
@@ -249,7 +252,7 @@ func (o *ProxyLBOp) HealthStatus(ctx context.Context, id types.ID) (*sacloud.Pro
 		return nil, err
 	}
 
-	return ds().Get(ResourceProxyLB+"Status", sacloud.APIDefaultZone, id).(*sacloud.ProxyLBHealth), nil
+	return o.ds().Get(ResourceProxyLB+"Status", sacloud.APIDefaultZone, id).(*sacloud.ProxyLBHealth), nil
 }
 
 // MonitorConnection is fake implementation
@@ -259,18 +262,12 @@ func (o *ProxyLBOp) MonitorConnection(ctx context.Context, id types.ID, conditio
 		return nil, err
 	}
 
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.ConnectionActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorConnectionValue{
-			Time:              now.Add(time.Duration(i*-5) * time.Minute),
-			ConnectionsPerSec: float64(random(1000)),
-			ActiveConnections: float64(random(1000)),
+			Time:              t,
+			ConnectionsPerSec: monitorValue(o.key, id, "ConnectionsPerSec", t),
+			ActiveConnections: monitorValue(o.key, id, "ActiveConnections", t),
 		})
 	}
 
@@ -23,24 +23,27 @@ import (
 
 // Find is fake implementation
 func (o *RegionOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.RegionFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, conditions)
+	results, err := find(o.ds(), o.key, sacloud.APIDefaultZone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.Region
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.Region{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.RegionFindResult{
-		Total:   len(results),
-		Count:   len(results),
-		From:    0,
+		Total:   results.Total,
+		Count:   results.Count,
+		From:    results.From,
 		Regions: values,
 	}, nil
 }
 
 // Read is fake implementation
 func (o *RegionOp) Read(ctx context.Context, id types.ID) (*sacloud.Region, error) {
-	value := getRegionByID(sacloud.APIDefaultZone, id)
+	value := getRegionByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
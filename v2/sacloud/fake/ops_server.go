@@ -17,7 +17,6 @@ package fake
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/sacloud/libsacloud/v2/sacloud"
 	"github.com/sacloud/libsacloud/v2/sacloud/types"
@@ -25,17 +24,20 @@ import (
 
 // Find is fake implementation
 func (o *ServerOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.ServerFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.Server
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.Server{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.ServerFindResult{
-		Total:   len(results),
-		Count:   len(results),
-		From:    0,
+		Total:   results.Total,
+		Count:   results.Count,
+		From:    results.From,
 		Servers: values,
 	}, nil
 }
@@ -44,7 +46,7 @@ func (o *ServerOp) Find(ctx context.Context, zone string, conditions *sacloud.Fi
 func (o *ServerOp) Create(ctx context.Context, zone string, param *sacloud.ServerCreateRequest) (*sacloud.Server, error) {
 	result := &sacloud.Server{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
 	result.Availability = types.Availabilities.Migrating
 	if param.ServerPlanGeneration == types.PlanGenerations.Default {
@@ -60,11 +62,11 @@ func (o *ServerOp) Create(ctx context.Context, zone string, param *sacloud.Serve
 	result.ServerPlanName = fmt.Sprintf("世代:%03d メモリ:%03d CPU:%03d", result.ServerPlanGeneration, result.GetMemoryGB(), result.CPU)
 
 	// NIC操作のためにあらかじめ登録しておく
-	putServer(zone, result)
+	putServer(o.ds(), zone, result)
 
 	for _, cs := range param.ConnectedSwitches {
-		ifOp := NewInterfaceOp()
-		swOp := NewSwitchOp()
+		ifOp := newInterfaceOp(o.store)
+		swOp := newSwitchOp(o.store)
 
 		ifCreateParam := &sacloud.InterfaceCreateRequest{}
 		if cs != nil {
@@ -133,7 +135,7 @@ func (o *ServerOp) Create(ctx context.Context, zone string, param *sacloud.Serve
 
 		result.Interfaces = append(result.Interfaces, ifaceView)
 	}
-	zoneOp := NewZoneOp()
+	zoneOp := newZoneOp(o.store)
 	zones, _ := zoneOp.Find(ctx, nil)
 	for _, z := range zones.Zones {
 		if zone == z.Name {
@@ -144,13 +146,13 @@ func (o *ServerOp) Create(ctx context.Context, zone string, param *sacloud.Serve
 	}
 
 	result.Availability = types.Availabilities.Available
-	putServer(zone, result)
+	putServer(o.ds(), zone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *ServerOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Server, error) {
-	value := getServerByID(zone, id)
+	value := getServerByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -170,7 +172,7 @@ func (o *ServerOp) Update(ctx context.Context, zone string, id types.ID, param *
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putServer(zone, value)
+	putServer(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -185,21 +187,21 @@ func (o *ServerOp) Delete(ctx context.Context, zone string, id types.ID) error {
 		return newErrorConflict(o.key, id, fmt.Sprintf("Server[%s] is still running", id))
 	}
 
-	ifOp := NewInterfaceOp()
+	ifOp := newInterfaceOp(o.store)
 	for _, iface := range value.Interfaces {
 		if err := ifOp.Delete(ctx, zone, iface.ID); err != nil {
 			return err
 		}
 	}
 
-	diskOp := NewDiskOp()
+	diskOp := newDiskOp(o.store)
 	for _, disk := range value.Disks {
 		if err := diskOp.DisconnectFromServer(ctx, zone, disk.ID); err != nil {
 			return err
 		}
 	}
 
-	ds().Delete(o.key, zone, id)
+	o.ds().Delete(o.key, zone, id)
 	return nil
 }
 
@@ -208,7 +210,7 @@ func (o *ServerOp) DeleteWithDisks(ctx context.Context, zone string, id types.ID
 	if err := o.Delete(ctx, zone, id); err != nil {
 		return err
 	}
-	diskOp := NewDiskOp()
+	diskOp := newDiskOp(o.store)
 	for _, diskID := range disks.IDs {
 		if err := diskOp.Delete(ctx, zone, diskID); err != nil {
 			return err
@@ -236,25 +238,25 @@ func (o *ServerOp) ChangePlan(ctx context.Context, zone string, id types.ID, pla
 	value.ServerPlanName = fmt.Sprintf("世代:%03d メモリ:%03d CPU:%03d", value.ServerPlanGeneration, value.GetMemoryGB(), value.CPU)
 
 	// ID変更
-	ds().Delete(o.key, zone, value.ID)
+	o.ds().Delete(o.key, zone, value.ID)
 	newServer := &sacloud.Server{}
 	copySameNameField(value, newServer)
-	newServer.ID = pool().generateID()
-	putServer(zone, newServer)
+	newServer.ID = o.pool().generateID()
+	putServer(o.ds(), zone, newServer)
 
 	// DiskのServerIDも変更
-	searched, _ := NewDiskOp().Find(ctx, zone, nil)
+	searched, _ := newDiskOp(o.store).Find(ctx, zone, nil)
 	for _, disk := range searched.Disks {
 		if disk.ServerID == value.ID {
 			disk.ServerID = newServer.ID
-			putDisk(zone, disk)
+			putDisk(o.ds(), zone, disk)
 		}
 	}
 	for _, nic := range newServer.Interfaces {
-		iface, err := NewInterfaceOp().Read(ctx, zone, nic.ID)
+		iface, err := newInterfaceOp(o.store).Read(ctx, zone, nic.ID)
 		if err == nil {
 			iface.ServerID = newServer.ID
-			putInterface(zone, iface)
+			putInterface(o.ds(), zone, iface)
 		}
 	}
 
@@ -268,13 +270,13 @@ func (o *ServerOp) InsertCDROM(ctx context.Context, zone string, id types.ID, in
 		return err
 	}
 
-	cdromOp := NewCDROMOp()
+	cdromOp := newCDROMOp(o.store)
 	if _, err = cdromOp.Read(ctx, zone, insertParam.ID); err != nil {
 		return newErrorBadRequest(o.key, id, fmt.Sprintf("CDROM[%d] is not exists", insertParam.ID))
 	}
 
 	value.CDROMID = insertParam.ID
-	putServer(zone, value)
+	putServer(o.ds(), zone, value)
 	return nil
 }
 
@@ -285,13 +287,13 @@ func (o *ServerOp) EjectCDROM(ctx context.Context, zone string, id types.ID, ins
 		return err
 	}
 
-	cdromOp := NewCDROMOp()
+	cdromOp := newCDROMOp(o.store)
 	if _, err = cdromOp.Read(ctx, zone, insertParam.ID); err != nil {
 		return newErrorBadRequest(o.key, id, fmt.Sprintf("CDROM[%d] is not exists", insertParam.ID))
 	}
 
 	value.CDROMID = types.ID(0)
-	putServer(zone, value)
+	putServer(o.ds(), zone, value)
 	return nil
 }
 
@@ -305,7 +307,7 @@ func (o *ServerOp) Boot(ctx context.Context, zone string, id types.ID) error {
 		return newErrorConflict(o.key, id, "Boot is failed")
 	}
 
-	startPowerOn(o.key, zone, func() (interface{}, error) {
+	startPowerOn(ctx, o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -327,7 +329,7 @@ func (o *ServerOp) Shutdown(ctx context.Context, zone string, id types.ID, shutd
 		return newErrorConflict(o.key, id, "Shutdown is failed")
 	}
 
-	startPowerOff(o.key, zone, func() (interface{}, error) {
+	startPowerOff(o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -344,7 +346,7 @@ func (o *ServerOp) Reset(ctx context.Context, zone string, id types.ID) error {
 		return newErrorConflict(o.key, id, "Reset is failed")
 	}
 
-	startPowerOn(o.key, zone, func() (interface{}, error) {
+	startPowerOn(ctx, o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -392,22 +394,16 @@ password=aaabababababa`
 
 // Monitor is fake implementation
 func (o *ServerOp) Monitor(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.CPUTimeActivity, error) {
-	value, err := o.Read(ctx, zone, id)
+	_, err := o.Read(ctx, zone, id)
 	if err != nil {
 		return nil, err
 	}
 
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.CPUTimeActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorCPUTimeValue{
-			Time:    now.Add(time.Duration(i*-5) * time.Minute),
-			CPUTime: float64(random(value.CPU * 1000)),
+			Time:    t,
+			CPUTime: monitorValue(o.key, id, "CPUTime", t),
 		})
 	}
 
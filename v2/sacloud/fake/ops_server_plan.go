@@ -23,24 +23,27 @@ import (
 
 // Find is fake implementation
 func (o *ServerPlanOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.ServerPlanFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, conditions)
+	results, err := find(o.ds(), o.key, sacloud.APIDefaultZone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.ServerPlan
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.ServerPlan{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.ServerPlanFindResult{
-		Total:       len(results),
-		Count:       len(results),
-		From:        0,
+		Total:       results.Total,
+		Count:       results.Count,
+		From:        results.From,
 		ServerPlans: values,
 	}, nil
 }
 
 // Read is fake implementation
 func (o *ServerPlanOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.ServerPlan, error) {
-	value := getServerPlanByID(sacloud.APIDefaultZone, id)
+	value := getServerPlanByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -22,17 +22,20 @@ import (
 
 // Find is fake implementation
 func (o *ServiceClassOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.ServiceClassFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.ServiceClass
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.ServiceClass{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.ServiceClassFindResult{
-		Total:          len(results),
-		Count:          len(results),
-		From:           0,
+		Total:          results.Total,
+		Count:          results.Count,
+		From:           results.From,
 		ServiceClasses: values,
 	}, nil
 }
@@ -26,17 +26,20 @@ import (
 
 // Find is fake implementation
 func (o *SIMOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.SIMFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, conditions)
+	results, err := find(o.ds(), o.key, sacloud.APIDefaultZone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.SIM
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.SIM{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.SIMFindResult{
-		Total: len(results),
-		Count: len(results),
-		From:  0,
+		Total: results.Total,
+		Count: results.Count,
+		From:  results.From,
 		SIMs:  values,
 	}, nil
 }
@@ -45,7 +48,7 @@ func (o *SIMOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*s
 func (o *SIMOp) Create(ctx context.Context, param *sacloud.SIMCreateRequest) (*sacloud.SIM, error) {
 	result := &sacloud.SIM{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt, fillModifiedAt)
+	fill(result, o.fillID, fillCreatedAt, fillModifiedAt)
 
 	result.Class = "sim"
 	result.Availability = types.Availabilities.Available
@@ -56,13 +59,13 @@ func (o *SIMOp) Create(ctx context.Context, param *sacloud.SIMCreateRequest) (*s
 		ResourceID:     result.ID.String(),
 	}
 
-	putSIM(sacloud.APIDefaultZone, result)
+	putSIM(o.ds(), sacloud.APIDefaultZone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *SIMOp) Read(ctx context.Context, id types.ID) (*sacloud.SIM, error) {
-	value := getSIMByID(sacloud.APIDefaultZone, id)
+	value := getSIMByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -79,7 +82,7 @@ func (o *SIMOp) Update(ctx context.Context, id types.ID, param *sacloud.SIMUpdat
 	}
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
-	putSIM(sacloud.APIDefaultZone, value)
+	putSIM(o.ds(), sacloud.APIDefaultZone, value)
 	return value, nil
 }
 
@@ -90,7 +93,7 @@ func (o *SIMOp) Delete(ctx context.Context, id types.ID) error {
 		return err
 	}
 
-	ds().Delete(o.key, sacloud.APIDefaultZone, id)
+	o.ds().Delete(o.key, sacloud.APIDefaultZone, id)
 	return nil
 }
 
@@ -106,7 +109,7 @@ func (o *SIMOp) Activate(ctx context.Context, id types.ID) error {
 	value.Info.Activated = true
 	value.Info.ActivatedDate = time.Now()
 	value.Info.DeactivatedDate = time.Time{}
-	putSIM(sacloud.APIDefaultZone, value)
+	putSIM(o.ds(), sacloud.APIDefaultZone, value)
 	return nil
 }
 
@@ -122,7 +125,7 @@ func (o *SIMOp) Deactivate(ctx context.Context, id types.ID) error {
 	value.Info.Activated = false
 	value.Info.ActivatedDate = time.Time{}
 	value.Info.DeactivatedDate = time.Now()
-	putSIM(sacloud.APIDefaultZone, value)
+	putSIM(o.ds(), sacloud.APIDefaultZone, value)
 	return nil
 }
 
@@ -136,7 +139,7 @@ func (o *SIMOp) AssignIP(ctx context.Context, id types.ID, param *sacloud.SIMAss
 		return errors.New("SIM[%d] already has IPAddress")
 	}
 	value.Info.IP = param.IP
-	putSIM(sacloud.APIDefaultZone, value)
+	putSIM(o.ds(), sacloud.APIDefaultZone, value)
 
 	return nil
 }
@@ -151,7 +154,7 @@ func (o *SIMOp) ClearIP(ctx context.Context, id types.ID) error {
 		return errors.New("SIM[%d] doesn't have IPAddress")
 	}
 	value.Info.IP = ""
-	putSIM(sacloud.APIDefaultZone, value)
+	putSIM(o.ds(), sacloud.APIDefaultZone, value)
 	return nil
 }
 
@@ -166,7 +169,7 @@ func (o *SIMOp) IMEILock(ctx context.Context, id types.ID, param *sacloud.SIMIME
 	}
 	value.Info.IMEILock = true
 	value.Info.IMEI = param.IMEI
-	putSIM(sacloud.APIDefaultZone, value)
+	putSIM(o.ds(), sacloud.APIDefaultZone, value)
 	return nil
 }
 
@@ -181,7 +184,7 @@ func (o *SIMOp) IMEIUnlock(ctx context.Context, id types.ID) error {
 	}
 	value.Info.IMEILock = false
 	value.Info.IMEI = ""
-	putSIM(sacloud.APIDefaultZone, value)
+	putSIM(o.ds(), sacloud.APIDefaultZone, value)
 	return nil
 }
 
@@ -214,7 +217,7 @@ func (o *SIMOp) GetNetworkOperator(ctx context.Context, id types.ID) ([]*sacloud
 	if err != nil {
 		return nil, err
 	}
-	v := ds().Get(o.key+"NetworkOperator", sacloud.APIDefaultZone, id)
+	v := o.ds().Get(o.key+"NetworkOperator", sacloud.APIDefaultZone, id)
 	if v != nil {
 		var res []*sacloud.SIMNetworkOperatorConfig
 		configs := v.(*[]*sacloud.SIMNetworkOperatorConfig)
@@ -232,7 +235,7 @@ func (o *SIMOp) SetNetworkOperator(ctx context.Context, id types.ID, configs []*
 		return err
 	}
 
-	ds().Put(o.key+"NetworkOperator", sacloud.APIDefaultZone, id, &configs)
+	o.ds().Put(o.key+"NetworkOperator", sacloud.APIDefaultZone, id, &configs)
 	return nil
 }
 
@@ -242,18 +245,12 @@ func (o *SIMOp) MonitorSIM(ctx context.Context, id types.ID, condition *sacloud.
 	if err != nil {
 		return nil, err
 	}
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.LinkActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorLinkValue{
-			Time:        now.Add(time.Duration(i*-5) * time.Minute),
-			UplinkBPS:   float64(random(1000)),
-			DownlinkBPS: float64(random(1000)),
+			Time:        t,
+			UplinkBPS:   monitorValue(o.key, id, "UplinkBPS", t),
+			DownlinkBPS: monitorValue(o.key, id, "DownlinkBPS", t),
 		})
 	}
 
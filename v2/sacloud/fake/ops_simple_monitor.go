@@ -24,17 +24,20 @@ import (
 
 // Find is fake implementation
 func (o *SimpleMonitorOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.SimpleMonitorFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, conditions)
+	results, err := find(o.ds(), o.key, sacloud.APIDefaultZone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.SimpleMonitor
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.SimpleMonitor{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.SimpleMonitorFindResult{
-		Total:          len(results),
-		Count:          len(results),
-		From:           0,
+		Total:          results.Total,
+		Count:          results.Count,
+		From:           results.From,
 		SimpleMonitors: values,
 	}, nil
 }
@@ -43,7 +46,7 @@ func (o *SimpleMonitorOp) Find(ctx context.Context, conditions *sacloud.FindCond
 func (o *SimpleMonitorOp) Create(ctx context.Context, param *sacloud.SimpleMonitorCreateRequest) (*sacloud.SimpleMonitor, error) {
 	result := &sacloud.SimpleMonitor{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
 	result.Name = param.Target
 	result.Class = "simplemon"
@@ -62,13 +65,13 @@ func (o *SimpleMonitorOp) Create(ctx context.Context, param *sacloud.SimpleMonit
 		result.NotifyInterval = 7200
 	}
 
-	putSimpleMonitor(sacloud.APIDefaultZone, result)
+	putSimpleMonitor(o.ds(), sacloud.APIDefaultZone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *SimpleMonitorOp) Read(ctx context.Context, id types.ID) (*sacloud.SimpleMonitor, error) {
-	value := getSimpleMonitorByID(sacloud.APIDefaultZone, id)
+	value := getSimpleMonitorByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -97,7 +100,7 @@ func (o *SimpleMonitorOp) Update(ctx context.Context, id types.ID, param *saclou
 	if value.NotifyInterval == 0 {
 		value.NotifyInterval = 7200
 	}
-	putSimpleMonitor(sacloud.APIDefaultZone, value)
+	putSimpleMonitor(o.ds(), sacloud.APIDefaultZone, value)
 	return value, nil
 }
 
@@ -115,7 +118,7 @@ func (o *SimpleMonitorOp) UpdateSettings(ctx context.Context, id types.ID, param
 	if value.NotifyInterval == 0 {
 		value.NotifyInterval = 7200
 	}
-	putSimpleMonitor(sacloud.APIDefaultZone, value)
+	putSimpleMonitor(o.ds(), sacloud.APIDefaultZone, value)
 	return value, nil
 }
 
@@ -126,7 +129,7 @@ func (o *SimpleMonitorOp) Delete(ctx context.Context, id types.ID) error {
 		return err
 	}
 
-	ds().Delete(o.key, sacloud.APIDefaultZone, id)
+	o.ds().Delete(o.key, sacloud.APIDefaultZone, id)
 	return nil
 }
 
@@ -136,17 +139,11 @@ func (o *SimpleMonitorOp) MonitorResponseTime(ctx context.Context, id types.ID,
 	if err != nil {
 		return nil, err
 	}
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.ResponseTimeSecActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorResponseTimeSecValue{
-			Time:            now.Add(time.Duration(i*-5) * time.Minute),
-			ResponseTimeSec: float64(random(1000)),
+			Time:            t,
+			ResponseTimeSec: monitorValue(o.key, id, "ResponseTimeSec", t),
 		})
 	}
 
@@ -33,17 +33,20 @@ var (
 
 // Find is fake implementation
 func (o *SSHKeyOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.SSHKeyFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, conditions)
+	results, err := find(o.ds(), o.key, sacloud.APIDefaultZone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.SSHKey
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.SSHKey{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.SSHKeyFindResult{
-		Total:   len(results),
-		Count:   len(results),
-		From:    0,
+		Total:   results.Total,
+		Count:   results.Count,
+		From:    results.From,
 		SSHKeys: values,
 	}, nil
 }
@@ -52,7 +55,7 @@ func (o *SSHKeyOp) Find(ctx context.Context, conditions *sacloud.FindCondition)
 func (o *SSHKeyOp) Create(ctx context.Context, param *sacloud.SSHKeyCreateRequest) (*sacloud.SSHKey, error) {
 	result := &sacloud.SSHKey{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
 	pk, _, _, _, err := ssh.ParseAuthorizedKey([]byte(result.PublicKey))
 	if err != nil {
@@ -60,7 +63,7 @@ func (o *SSHKeyOp) Create(ctx context.Context, param *sacloud.SSHKeyCreateReques
 	}
 	result.Fingerprint = ssh.FingerprintLegacyMD5(pk)
 
-	putSSHKey(sacloud.APIDefaultZone, result)
+	putSSHKey(o.ds(), sacloud.APIDefaultZone, result)
 	return result, nil
 }
 
@@ -68,7 +71,7 @@ func (o *SSHKeyOp) Create(ctx context.Context, param *sacloud.SSHKeyCreateReques
 func (o *SSHKeyOp) Generate(ctx context.Context, param *sacloud.SSHKeyGenerateRequest) (*sacloud.SSHKeyGenerated, error) {
 	key := &sacloud.SSHKey{}
 	copySameNameField(param, key)
-	fill(key, fillID, fillCreatedAt)
+	fill(key, o.fillID, fillCreatedAt)
 
 	result := &sacloud.SSHKeyGenerated{}
 	copySameNameField(key, result)
@@ -77,13 +80,13 @@ func (o *SSHKeyOp) Generate(ctx context.Context, param *sacloud.SSHKeyGenerateRe
 	result.PrivateKey = GeneratedPrivateKey
 	result.Fingerprint = GeneratedFingerprint
 
-	putSSHKey(sacloud.APIDefaultZone, key)
+	putSSHKey(o.ds(), sacloud.APIDefaultZone, key)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *SSHKeyOp) Read(ctx context.Context, id types.ID) (*sacloud.SSHKey, error) {
-	value := getSSHKeyByID(sacloud.APIDefaultZone, id)
+	value := getSSHKeyByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -100,7 +103,7 @@ func (o *SSHKeyOp) Update(ctx context.Context, id types.ID, param *sacloud.SSHKe
 	}
 	copySameNameField(param, value)
 
-	putSSHKey(sacloud.APIDefaultZone, value)
+	putSSHKey(o.ds(), sacloud.APIDefaultZone, value)
 	return value, nil
 }
 
@@ -111,6 +114,6 @@ func (o *SSHKeyOp) Delete(ctx context.Context, id types.ID) error {
 		return err
 	}
 
-	ds().Delete(o.key, sacloud.APIDefaultZone, id)
+	o.ds().Delete(o.key, sacloud.APIDefaultZone, id)
 	return nil
 }
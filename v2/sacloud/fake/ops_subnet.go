@@ -23,24 +23,27 @@ import (
 
 // Find is fake implementation
 func (o *SubnetOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.SubnetFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.Subnet
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.Subnet{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.SubnetFindResult{
-		Total:   len(results),
-		Count:   len(results),
-		From:    0,
+		Total:   results.Total,
+		Count:   results.Count,
+		From:    results.From,
 		Subnets: values,
 	}, nil
 }
 
 // Read is fake implementation
 func (o *SubnetOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Subnet, error) {
-	value := getSubnetByID(zone, id)
+	value := getSubnetByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -24,17 +24,20 @@ import (
 
 // Find is fake implementation
 func (o *SwitchOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.SwitchFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.Switch
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.Switch{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.SwitchFindResult{
-		Total:    len(results),
-		Count:    len(results),
-		From:     0,
+		Total:    results.Total,
+		Count:    results.Count,
+		From:     results.From,
 		Switches: values,
 	}, nil
 }
@@ -43,15 +46,15 @@ func (o *SwitchOp) Find(ctx context.Context, zone string, conditions *sacloud.Fi
 func (o *SwitchOp) Create(ctx context.Context, zone string, param *sacloud.SwitchCreateRequest) (*sacloud.Switch, error) {
 	result := &sacloud.Switch{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt, fillAvailability, fillScope)
+	fill(result, o.fillID, fillCreatedAt, fillAvailability, fillScope)
 	result.Scope = types.Scopes.User
-	putSwitch(zone, result)
+	putSwitch(o.ds(), zone, result)
 	return result, nil
 }
 
 // Read is fake implementation
 func (o *SwitchOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Switch, error) {
-	value := getSwitchByID(zone, id)
+	value := getSwitchByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -70,7 +73,7 @@ func (o *SwitchOp) Update(ctx context.Context, zone string, id types.ID, param *
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putSwitch(zone, value)
+	putSwitch(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -80,7 +83,7 @@ func (o *SwitchOp) Delete(ctx context.Context, zone string, id types.ID) error {
 	if err != nil {
 		return err
 	}
-	ds().Delete(o.key, zone, id)
+	o.ds().Delete(o.key, zone, id)
 	return nil
 }
 
@@ -91,7 +94,7 @@ func (o *SwitchOp) ConnectToBridge(ctx context.Context, zone string, id types.ID
 		return err
 	}
 
-	bridgeOp := NewBridgeOp()
+	bridgeOp := newBridgeOp(o.store)
 	bridge, err := bridgeOp.Read(ctx, zone, bridgeID)
 	if err != nil {
 		return fmt.Errorf("ConnectToBridge is failed: %s", err)
@@ -113,8 +116,8 @@ func (o *SwitchOp) ConnectToBridge(ctx context.Context, zone string, id types.ID
 	//	ZoneID: zoneIDs[zone],
 	//})
 
-	putBridge(zone, bridge)
-	putSwitch(zone, value)
+	putBridge(o.ds(), zone, bridge)
+	putSwitch(o.ds(), zone, value)
 	return nil
 }
 
@@ -129,7 +132,7 @@ func (o *SwitchOp) DisconnectFromBridge(ctx context.Context, zone string, id typ
 		return newErrorConflict(o.key, id, fmt.Sprintf("Switch[%d] already disconnected from switch", id))
 	}
 
-	bridgeOp := NewBridgeOp()
+	bridgeOp := newBridgeOp(o.store)
 	bridge, err := bridgeOp.Read(ctx, zone, value.BridgeID)
 	if err != nil {
 		return fmt.Errorf("DisconnectFromBridge is failed: %s", err)
@@ -147,8 +150,8 @@ func (o *SwitchOp) DisconnectFromBridge(ctx context.Context, zone string, id typ
 	// fakeドライバーではBridgeInfoに非対応
 	//bridge.BridgeInfo = bridgeInfo
 
-	putBridge(zone, bridge)
-	putSwitch(zone, value)
+	putBridge(o.ds(), zone, bridge)
+	putSwitch(o.ds(), zone, value)
 	return nil
 }
 
@@ -163,7 +166,7 @@ func (o *SwitchOp) GetServers(ctx context.Context, zone string, id types.ID) (*s
 		return res, nil
 	}
 
-	searched, err := NewServerOp().Find(ctx, zone, nil)
+	searched, err := newServerOp(o.store).Find(ctx, zone, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -18,7 +18,6 @@ import (
 	"context"
 	"fmt"
 	"net"
-	"time"
 
 	"github.com/sacloud/libsacloud/v2/sacloud"
 	"github.com/sacloud/libsacloud/v2/sacloud/types"
@@ -26,17 +25,20 @@ import (
 
 // Find is fake implementation
 func (o *VPCRouterOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.VPCRouterFindResult, error) {
-	results, _ := find(o.key, zone, conditions)
+	results, err := find(o.ds(), o.key, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.VPCRouter
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.VPCRouter{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.VPCRouterFindResult{
-		Total:      len(results),
-		Count:      len(results),
-		From:       0,
+		Total:      results.Total,
+		Count:      results.Count,
+		From:       results.From,
 		VPCRouters: values,
 	}, nil
 }
@@ -45,7 +47,7 @@ func (o *VPCRouterOp) Find(ctx context.Context, zone string, conditions *sacloud
 func (o *VPCRouterOp) Create(ctx context.Context, zone string, param *sacloud.VPCRouterCreateRequest) (*sacloud.VPCRouter, error) {
 	result := &sacloud.VPCRouter{}
 	copySameNameField(param, result)
-	fill(result, fillID, fillCreatedAt)
+	fill(result, o.fillID, fillCreatedAt)
 
 	result.Class = "vpcrouter"
 	result.Availability = types.Availabilities.Migrating
@@ -55,8 +57,8 @@ func (o *VPCRouterOp) Create(ctx context.Context, zone string, param *sacloud.VP
 		result.Version = 2
 	}
 
-	ifOp := NewInterfaceOp()
-	swOp := NewSwitchOp()
+	ifOp := newInterfaceOp(o.store)
+	swOp := newSwitchOp(o.store)
 
 	ifCreateParam := &sacloud.InterfaceCreateRequest{}
 	if param.Switch.Scope == types.Scopes.Shared {
@@ -91,20 +93,20 @@ func (o *VPCRouterOp) Create(ctx context.Context, zone string, param *sacloud.VP
 	vpcRouterInterface := &sacloud.VPCRouterInterface{}
 	copySameNameField(iface, vpcRouterInterface)
 	if param.Switch.Scope == types.Scopes.Shared {
-		sharedIP := pool().nextSharedIP()
+		sharedIP := o.pool().nextSharedIP()
 		vpcRouterInterface.IPAddress = sharedIP.String()
 		vpcRouterInterface.SubnetNetworkMaskLen = sharedSegmentSwitch.NetworkMaskLen
 
-		ipv4Mask := net.CIDRMask(pool().SharedNetMaskLen, 32)
+		ipv4Mask := net.CIDRMask(o.pool().SharedNetMaskLen, 32)
 		vpcRouterInterface.SubnetNetworkAddress = sharedIP.Mask(ipv4Mask).String()
-		vpcRouterInterface.SubnetDefaultRoute = pool().SharedDefaultGateway.String()
+		vpcRouterInterface.SubnetDefaultRoute = o.pool().SharedDefaultGateway.String()
 	}
 	result.Interfaces = append(result.Interfaces, vpcRouterInterface)
 
-	putVPCRouter(zone, result)
+	putVPCRouter(o.ds(), zone, result)
 
 	id := result.ID
-	startMigration(o.key, zone, func() (interface{}, error) {
+	startMigration(o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 	return result, nil
@@ -112,7 +114,7 @@ func (o *VPCRouterOp) Create(ctx context.Context, zone string, param *sacloud.VP
 
 // Read is fake implementation
 func (o *VPCRouterOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.VPCRouter, error) {
-	value := getVPCRouterByID(zone, id)
+	value := getVPCRouterByID(o.ds(), zone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -130,7 +132,7 @@ func (o *VPCRouterOp) Update(ctx context.Context, zone string, id types.ID, para
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putVPCRouter(zone, value)
+	putVPCRouter(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -143,7 +145,7 @@ func (o *VPCRouterOp) UpdateSettings(ctx context.Context, zone string, id types.
 	copySameNameField(param, value)
 	fill(value, fillModifiedAt)
 
-	putVPCRouter(zone, value)
+	putVPCRouter(o.ds(), zone, value)
 	return value, nil
 }
 
@@ -153,7 +155,7 @@ func (o *VPCRouterOp) Delete(ctx context.Context, zone string, id types.ID) erro
 	if err != nil {
 		return err
 	}
-	ds().Delete(o.key, zone, id)
+	o.ds().Delete(o.key, zone, id)
 	return nil
 }
 
@@ -172,7 +174,7 @@ func (o *VPCRouterOp) Boot(ctx context.Context, zone string, id types.ID) error
 		return newErrorConflict(o.key, id, "Boot is failed")
 	}
 
-	startPowerOn(o.key, zone, func() (interface{}, error) {
+	startPowerOn(ctx, o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -189,7 +191,7 @@ func (o *VPCRouterOp) Shutdown(ctx context.Context, zone string, id types.ID, sh
 		return newErrorConflict(o.key, id, "Shutdown is failed")
 	}
 
-	startPowerOff(o.key, zone, func() (interface{}, error) {
+	startPowerOff(o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -206,7 +208,7 @@ func (o *VPCRouterOp) Reset(ctx context.Context, zone string, id types.ID) error
 		return newErrorConflict(o.key, id, "Reset is failed")
 	}
 
-	startPowerOn(o.key, zone, func() (interface{}, error) {
+	startPowerOn(ctx, o.ds(), o.key, zone, func() (interface{}, error) {
 		return o.Read(context.Background(), zone, id)
 	})
 
@@ -227,14 +229,14 @@ func (o *VPCRouterOp) ConnectToSwitch(ctx context.Context, zone string, id types
 	}
 
 	// find switch
-	swOp := NewSwitchOp()
+	swOp := newSwitchOp(o.store)
 	_, err = swOp.Read(ctx, zone, switchID)
 	if err != nil {
 		return fmt.Errorf("ConnectToSwitch is failed: %s", err)
 	}
 
 	// create interface
-	ifOp := NewInterfaceOp()
+	ifOp := newInterfaceOp(o.store)
 	iface, err := ifOp.Create(ctx, zone, &sacloud.InterfaceCreateRequest{ServerID: id})
 	if err != nil {
 		return newErrorConflict(o.key, types.ID(0), err.Error())
@@ -254,7 +256,7 @@ func (o *VPCRouterOp) ConnectToSwitch(ctx context.Context, zone string, id types
 	vpcRouterInterface.Index = nicIndex
 	value.Interfaces = append(value.Interfaces, vpcRouterInterface)
 
-	putVPCRouter(zone, value)
+	putVPCRouter(o.ds(), zone, value)
 	return nil
 }
 
@@ -281,13 +283,13 @@ func (o *VPCRouterOp) DisconnectFromSwitch(ctx context.Context, zone string, id
 		return newErrorBadRequest(o.key, id, fmt.Sprintf("nic[%d] is not exists", nicIndex))
 	}
 
-	ifOp := NewInterfaceOp()
+	ifOp := newInterfaceOp(o.store)
 	if err := ifOp.DisconnectFromSwitch(ctx, zone, nicID); err != nil {
 		return newErrorConflict(o.key, types.ID(0), err.Error())
 	}
 
 	value.Interfaces = interfaces
-	putVPCRouter(zone, value)
+	putVPCRouter(o.ds(), zone, value)
 	return nil
 }
 
@@ -298,18 +300,12 @@ func (o *VPCRouterOp) MonitorInterface(ctx context.Context, zone string, id type
 		return nil, err
 	}
 
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.InterfaceActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorInterfaceValue{
-			Time:    now.Add(time.Duration(i*-5) * time.Minute),
-			Send:    float64(random(1000)),
-			Receive: float64(random(1000)),
+			Time:    t,
+			Send:    monitorValue(o.key, id, "Send", t),
+			Receive: monitorValue(o.key, id, "Receive", t),
 		})
 	}
 
@@ -354,17 +350,11 @@ func (o *VPCRouterOp) MonitorCPU(ctx context.Context, zone string, id types.ID,
 		return nil, err
 	}
 
-	now := time.Now().Truncate(time.Second)
-	m := now.Minute() % 5
-	if m != 0 {
-		now.Add(time.Duration(m) * time.Minute)
-	}
-
 	res := &sacloud.CPUTimeActivity{}
-	for i := 0; i < 5; i++ {
+	for _, t := range monitorTimes(condition) {
 		res.Values = append(res.Values, &sacloud.MonitorCPUTimeValue{
-			Time:    now.Add(time.Duration(i*-5) * time.Minute),
-			CPUTime: float64(random(1000)),
+			Time:    t,
+			CPUTime: monitorValue(o.key, id, "CPUTime", t),
 		})
 	}
 
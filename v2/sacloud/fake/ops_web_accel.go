@@ -24,24 +24,24 @@ import (
 
 // List is fake implementation
 func (o *WebAccelOp) List(ctx context.Context) (*sacloud.WebAccelListResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, nil)
+	results, _ := find(o.ds(), o.key, sacloud.APIDefaultZone, nil)
 	var values []*sacloud.WebAccel
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.WebAccel{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.WebAccelListResult{
-		Total:     len(results),
-		Count:     len(results),
-		From:      0,
+		Total:     results.Total,
+		Count:     results.Count,
+		From:      results.From,
 		WebAccels: values,
 	}, nil
 }
 
 // Read is fake implementation
 func (o *WebAccelOp) Read(ctx context.Context, id types.ID) (*sacloud.WebAccel, error) {
-	value := getWebAccelByID(sacloud.APIDefaultZone, id)
+	value := getWebAccelByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
@@ -23,24 +23,27 @@ import (
 
 // Find is fake implementation
 func (o *ZoneOp) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.ZoneFindResult, error) {
-	results, _ := find(o.key, sacloud.APIDefaultZone, conditions)
+	results, err := find(o.ds(), o.key, sacloud.APIDefaultZone, conditions)
+	if err != nil {
+		return nil, err
+	}
 	var values []*sacloud.Zone
-	for _, res := range results {
+	for _, res := range results.Values {
 		dest := &sacloud.Zone{}
 		copySameNameField(res, dest)
 		values = append(values, dest)
 	}
 	return &sacloud.ZoneFindResult{
-		Total: len(results),
-		Count: len(results),
-		From:  0,
+		Total: results.Total,
+		Count: results.Count,
+		From:  results.From,
 		Zones: values,
 	}, nil
 }
 
 // Read is fake implementation
 func (o *ZoneOp) Read(ctx context.Context, id types.ID) (*sacloud.Zone, error) {
-	value := getZoneByID(sacloud.APIDefaultZone, id)
+	value := getZoneByID(o.ds(), sacloud.APIDefaultZone, id)
 	if value == nil {
 		return nil, newErrorNotFound(o.key, id)
 	}
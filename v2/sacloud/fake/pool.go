@@ -55,12 +55,12 @@ func initValuePool(s Store) *valuePool {
 
 	v := s.Get(valuePoolResourceKey, sacloud.APIDefaultZone, valuePoolMagicID)
 	if v != nil {
-		vp = v.(*valuePool)
-		vp.dataStore = s
-		return vp
+		p := v.(*valuePool)
+		p.dataStore = s
+		return p
 	}
 
-	vp = &valuePool{
+	return &valuePool{
 		CurrentID:            int64(100000000000),
 		CurrentSharedIP:      net.IP{192, 0, 2, 2},
 		SharedNetMaskLen:     24,
@@ -75,7 +75,6 @@ func initValuePool(s Store) *valuePool {
 		},
 		dataStore: s,
 	}
-	return vp
 }
 
 func (p *valuePool) store() {
@@ -92,6 +91,17 @@ func (p *valuePool) generateID() types.ID {
 	return types.ID(p.CurrentID)
 }
 
+// reserveID 指定のIDが以降のgenerateIDで払い出されないようにする
+func (p *valuePool) reserveID(id types.ID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if id.Int64() > p.CurrentID {
+		p.CurrentID = id.Int64()
+		p.store()
+	}
+}
+
 func (p *valuePool) nextSharedIP() net.IP {
 	p.mu.Lock()
 	defer p.mu.Unlock()
@@ -0,0 +1,664 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+// APIHandler さくらのクラウドAPIと同じパス(/cloud/zone/{zone}/api/cloud/1.1/...)でリクエストを受け付け、
+// Callerから取得した各リソースのAPI(XxxAPI)を呼び出すhttp.Handler
+//
+// リクエスト/レスポンスの変換にはAPIクライアントと同じエンベロープ/naked型が用いられるため、
+// SakuraCloudAPIRootにこのハンドラーを指すURLを設定したClientからそのまま利用できる。
+// fakeドライバを登録したClientRegistryを持つCallerと組み合わせることでフェイクAPIサーバとして利用できる
+//
+// Note: さくらのクラウドAPIでは同一パスに複数のリソース/操作が割り当てられている場合があるため、
+// リクエスト中のClassや各リソースのRead結果、リクエストボディの内容から呼び出す操作を判別している
+type APIHandler struct {
+	// Caller 各リソースのAPIを取得するためのAPICaller
+	Caller sacloud.APICaller
+	// PathPrefix ゾーン名より前のパス 空の場合はSakuraCloudAPIRootのパス部分が利用される
+	PathPrefix string
+
+	initOnce sync.Once
+	routes   []*apiHandlerRoute
+}
+
+// NewAPIHandler 指定のAPICallerを利用するAPIHandlerを返す
+func NewAPIHandler(caller sacloud.APICaller) *APIHandler {
+	return &APIHandler{Caller: caller}
+}
+
+// ServeHTTP http.Handlerの実装
+func (h *APIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.initOnce.Do(h.init)
+
+	path := r.URL.Path
+	prefix := h.pathPrefix()
+	if !strings.HasPrefix(path, prefix) {
+		h.writeError(w, newAPIHandlerError(http.StatusNotFound, "path %q is not found", path))
+		return
+	}
+	path = strings.TrimPrefix(path, prefix)
+
+	body, err := h.readBody(r)
+	if err != nil {
+		h.writeError(w, newAPIHandlerError(http.StatusBadRequest, "invalid request: %s", err))
+		return
+	}
+
+	var matches []*apiHandlerMatch
+	methodAllowed := false
+	for _, route := range h.routes {
+		params, ok := route.match(path)
+		if !ok {
+			continue
+		}
+		if route.method != r.Method {
+			methodAllowed = true
+			continue
+		}
+		matches = append(matches, &apiHandlerMatch{
+			route: route,
+			req:   &apiHandlerRequest{zone: params["zone"], params: params, body: body},
+		})
+	}
+	if len(matches) == 0 {
+		if methodAllowed {
+			h.writeError(w, newAPIHandlerError(http.StatusMethodNotAllowed, "method %s is not allowed: %s", r.Method, path))
+			return
+		}
+		h.writeError(w, newAPIHandlerError(http.StatusNotFound, "path %q is not found", path))
+		return
+	}
+
+	ctx := r.Context()
+	matches = h.resolve(ctx, matches, body)
+
+	// 複数の操作の結果をマージする場合、ページングはマージ後の結果に対して行う
+	var paging *apiHandlerPaging
+	if len(matches) > 1 {
+		paging, body = extractAPIHandlerPaging(body)
+		for _, m := range matches {
+			m.req.body = body
+		}
+	}
+
+	var results []interface{}
+	for _, m := range matches {
+		result, err := m.route.handle(ctx, h.Caller, m.req)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		results = append(results, result)
+	}
+
+	response, err := mergeAPIHandlerResults(results)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if paging != nil {
+		paging.apply(response)
+	}
+	h.writeJSON(w, http.StatusOK, response)
+}
+
+func (h *APIHandler) init() {
+	h.routes = compiledAPIHandlerRoutes()
+}
+
+func (h *APIHandler) pathPrefix() string {
+	if h.PathPrefix != "" {
+		return strings.TrimRight(h.PathPrefix, "/")
+	}
+	u, err := url.Parse(sacloud.SakuraCloudAPIRoot)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimRight(u.Path, "/")
+}
+
+// readBody リクエストボディを読み込む、GETの場合はクエリストリングをボディとして扱う
+func (h *APIHandler) readBody(r *http.Request) ([]byte, error) {
+	if r.Method == http.MethodGet {
+		if r.URL.RawQuery == "" {
+			return nil, nil
+		}
+		query, err := url.PathUnescape(r.URL.RawQuery)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(query), nil
+	}
+	if r.Body == nil {
+		return nil, nil
+	}
+	return io.ReadAll(r.Body)
+}
+
+// resolve 同一パスに複数の操作が割り当てられている場合に呼び出す操作を絞り込む
+//
+// GETの場合で絞り込めなかった場合は残った全ての操作を呼び出しレスポンスをマージする(検索結果の場合は一覧を連結し件数を合算する)
+func (h *APIHandler) resolve(ctx context.Context, matches []*apiHandlerMatch, body []byte) []*apiHandlerMatch {
+	if len(matches) < 2 {
+		return matches
+	}
+
+	var request map[string]interface{}
+	if len(body) > 0 {
+		json.Unmarshal(body, &request) // nolint - 不正なボディは各操作でのデコード時にエラーとする
+	}
+
+	// Class
+	if request != nil {
+		requestClasses := apiHandlerClassValues(request)
+		matches = filterAPIHandlerMatches(matches, func(m *apiHandlerMatch) int {
+			score := 0
+			for key, v := range m.route.classes {
+				if requestClasses[key] != v {
+					return -1
+				}
+				score++
+			}
+			return score
+		})
+	}
+
+	// リソースの存在確認
+	if id, ok := matches[0].req.params["id"]; ok && len(matches) > 1 {
+		matches = filterAPIHandlerMatches(matches, func(m *apiHandlerMatch) int {
+			reader, ok := apiHandlerReaders[m.route.resource]
+			if !ok {
+				return 0
+			}
+			if err := reader(ctx, h.Caller, m.req.zone, types.StringID(id)); err != nil {
+				return -1
+			}
+			return 1
+		})
+	}
+
+	// リクエストボディの内容
+	if request != nil && len(matches) > 1 {
+		requestKeys := apiHandlerKeys(request)
+		matches = filterAPIHandlerMatches(matches, func(m *apiHandlerMatch) int {
+			return m.route.fitness(m.req, requestKeys)
+		})
+	}
+
+	if len(matches) > 1 && matches[0].route.method != http.MethodGet {
+		return matches[:1]
+	}
+	return matches
+}
+
+func (h *APIHandler) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v) // nolint - ignore error
+}
+
+func (h *APIHandler) writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	response := &sacloud.APIErrorResponse{
+		IsFatal:      true,
+		ErrorMessage: err.Error(),
+	}
+
+	switch e := err.(type) {
+	case *apiHandlerError:
+		status = e.status
+		response.ErrorMessage = e.message
+	default:
+		if apiErr, ok := sacloud.AsAPIError(err); ok {
+			status = apiErr.ResponseCode()
+			response.ErrorCode = apiErr.Code()
+			response.ErrorMessage = apiErr.Message()
+			response.Serial = apiErr.Serial()
+		}
+	}
+	if response.ErrorCode == "" {
+		response.ErrorCode = strconv.Itoa(status)
+	}
+	response.Status = fmt.Sprintf("%d %s", status, http.StatusText(status))
+	h.writeJSON(w, status, response)
+}
+
+// apiHandlerError APIHandlerでのリクエスト不正などのエラー
+type apiHandlerError struct {
+	status  int
+	message string
+}
+
+func newAPIHandlerError(status int, format string, args ...interface{}) error {
+	return &apiHandlerError{status: status, message: fmt.Sprintf(format, args...)}
+}
+
+func (e *apiHandlerError) Error() string {
+	return e.message
+}
+
+// apiHandlerFunc リクエストを元にAPIを呼び出し、レスポンスエンベロープを返すfunc
+type apiHandlerFunc func(ctx context.Context, caller sacloud.APICaller, req *apiHandlerRequest) (interface{}, error)
+
+// apiHandlerRoute APIHandlerでのルーティング定義
+type apiHandlerRoute struct {
+	resource   string
+	operation  string
+	method     string
+	pathFormat string
+	pathSuffix string
+	pathName   string
+	params     map[string]string // パスパラメータ名と型名
+	envelope   func(req *apiHandlerRequest) (interface{}, error)
+	handle     apiHandlerFunc
+
+	pattern *regexp.Regexp
+	classes map[string]string
+}
+
+var (
+	apiHandlerRoutes  []*apiHandlerRoute
+	apiHandlerReaders = make(map[string]func(ctx context.Context, caller sacloud.APICaller, zone string, id types.ID) error)
+
+	compileAPIHandlerRoutesOnce sync.Once
+	apiHandlerCompiledRoutes    []*apiHandlerRoute
+)
+
+func registerAPIHandlerRoute(route *apiHandlerRoute) {
+	apiHandlerRoutes = append(apiHandlerRoutes, route)
+}
+
+// registerAPIHandlerReader 同一パスを持つリソースの判別に利用するRead関数を登録する
+func registerAPIHandlerReader(resource string, reader func(ctx context.Context, caller sacloud.APICaller, zone string, id types.ID) error) {
+	apiHandlerReaders[resource] = reader
+}
+
+// compiledAPIHandlerRoutes パスのパターンを構築したルーティング定義をパスパラメータの少ない順で返す
+func compiledAPIHandlerRoutes() []*apiHandlerRoute {
+	compileAPIHandlerRoutesOnce.Do(func() {
+		for _, route := range apiHandlerRoutes {
+			route.compile()
+			apiHandlerCompiledRoutes = append(apiHandlerCompiledRoutes, route)
+		}
+		sort.SliceStable(apiHandlerCompiledRoutes, func(i, j int) bool {
+			return len(apiHandlerCompiledRoutes[i].params) < len(apiHandlerCompiledRoutes[j].params)
+		})
+	})
+	return apiHandlerCompiledRoutes
+}
+
+var apiHandlerPathTokenPattern = regexp.MustCompile(`\{\{if ne \.(\w+) 0\}\}/\{\{\.\w+\}\}\{\{end\}\}|\{\{\.(\w+)\}\}`)
+
+func (r *apiHandlerRoute) compile() {
+	var buf strings.Builder
+	buf.WriteString("^")
+	format := r.pathFormat
+	for {
+		loc := apiHandlerPathTokenPattern.FindStringSubmatchIndex(format)
+		if loc == nil {
+			buf.WriteString(regexp.QuoteMeta(format))
+			break
+		}
+		buf.WriteString(regexp.QuoteMeta(format[:loc[0]]))
+		if loc[2] >= 0 {
+			// {{if ne .index 0}}/{{.index}}{{end}}
+			name := format[loc[2]:loc[3]]
+			buf.WriteString(fmt.Sprintf(`(?:/(?P<%s>[0-9]+))?`, name))
+		} else {
+			name := format[loc[4]:loc[5]]
+			switch name {
+			case "rootURL":
+			case "pathSuffix":
+				buf.WriteString(regexp.QuoteMeta(r.pathSuffix))
+			case "pathName":
+				buf.WriteString(regexp.QuoteMeta(r.pathName))
+			default:
+				buf.WriteString(fmt.Sprintf(`(?P<%s>%s)`, name, r.paramPattern(name)))
+			}
+		}
+		format = format[loc[1]:]
+	}
+	buf.WriteString("$")
+	r.pattern = regexp.MustCompile(buf.String())
+
+	// 引数を省略した場合のリクエストエンベロープからClassを取得しておく
+	r.classes = make(map[string]string)
+	if r.envelope != nil {
+		if m, err := r.requestValues(&apiHandlerRequest{}); err == nil {
+			r.classes = apiHandlerClassValues(m)
+		}
+	}
+}
+
+// requestValues リクエストを引数に変換した上で再度リクエストエンベロープに変換し、map形式で返す
+func (r *apiHandlerRoute) requestValues(req *apiHandlerRequest) (map[string]interface{}, error) {
+	v, err := r.envelope(req)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (r *apiHandlerRoute) paramPattern(name string) string {
+	switch r.params[name] {
+	case "types.ID", "int":
+		return "[0-9]+"
+	default:
+		return "[^/]+"
+	}
+}
+
+func (r *apiHandlerRoute) match(path string) (map[string]string, bool) {
+	values := r.pattern.FindStringSubmatch(path)
+	if values == nil {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for i, name := range r.pattern.SubexpNames() {
+		if name != "" {
+			params[name] = values[i]
+		}
+	}
+	return params, true
+}
+
+// fitness リクエストボディのうち引数として受け付けられる項目数を返す
+func (r *apiHandlerRoute) fitness(req *apiHandlerRequest, requestKeys map[string]bool) int {
+	if r.envelope == nil {
+		return 0
+	}
+	m, err := r.requestValues(req)
+	if err != nil {
+		return -1
+	}
+
+	score := 0
+	for key := range apiHandlerKeys(m) {
+		if requestKeys[key] {
+			score++
+		}
+	}
+	return score
+}
+
+type apiHandlerMatch struct {
+	route *apiHandlerRoute
+	req   *apiHandlerRequest
+}
+
+// filterAPIHandlerMatches scoreFuncの結果が最大となるものに絞り込む、scoreFuncが負の値を返したものは除外される
+//
+// 全て除外された場合は元のmatchesを返す
+func filterAPIHandlerMatches(matches []*apiHandlerMatch, scoreFunc func(m *apiHandlerMatch) int) []*apiHandlerMatch {
+	var results []*apiHandlerMatch
+	max := -1
+	for _, m := range matches {
+		score := scoreFunc(m)
+		switch {
+		case score < 0 || score < max:
+			continue
+		case score > max:
+			max = score
+			results = []*apiHandlerMatch{m}
+		default:
+			results = append(results, m)
+		}
+	}
+	if len(results) == 0 {
+		return matches
+	}
+	return results
+}
+
+// apiHandlerRequest APIHandlerで受け付けたリクエスト
+type apiHandlerRequest struct {
+	zone   string
+	params map[string]string
+	body   []byte
+}
+
+func (r *apiHandlerRequest) decode(v interface{}) error {
+	if len(r.body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(r.body, v); err != nil {
+		return newAPIHandlerError(http.StatusBadRequest, "invalid request body: %s", err)
+	}
+	return nil
+}
+
+func (r *apiHandlerRequest) idParam(name string) (types.ID, error) {
+	id := types.StringID(r.params[name])
+	if id.IsEmpty() {
+		return id, newAPIHandlerError(http.StatusBadRequest, "invalid path parameter %s: %q", name, r.params[name])
+	}
+	return id, nil
+}
+
+func (r *apiHandlerRequest) intParam(name string) (int, error) {
+	v := r.params[name]
+	if v == "" {
+		return 0, nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, newAPIHandlerError(http.StatusBadRequest, "invalid path parameter %s: %q", name, v)
+	}
+	return i, nil
+}
+
+func (r *apiHandlerRequest) stringParam(name string) (string, error) {
+	v := r.params[name]
+	if v == "" {
+		return "", newAPIHandlerError(http.StatusBadRequest, "path parameter %s is required", name)
+	}
+	return v, nil
+}
+
+// apiHandlerKeys JSONをデコードしたmapから値を持つ項目のキー(ドット区切り)を列挙する
+func apiHandlerKeys(m map[string]interface{}) map[string]bool {
+	keys := make(map[string]bool)
+	walkAPIHandlerValues("", m, func(key string, v interface{}) {
+		keys[key] = true
+	})
+	return keys
+}
+
+// apiHandlerClassValues JSONをデコードしたmapからリソースの判別に利用するClass項目の値を抽出する
+func apiHandlerClassValues(m map[string]interface{}) map[string]string {
+	classes := make(map[string]string)
+	walkAPIHandlerValues("", m, func(key string, v interface{}) {
+		if key != "Class" && !strings.HasSuffix(key, ".Class") {
+			return
+		}
+		switch v := v.(type) {
+		case string:
+			classes[key] = v
+		case []interface{}:
+			// 検索条件(Filter)
+			if len(v) == 1 {
+				if s, ok := v[0].(string); ok {
+					classes[key] = s
+				}
+			}
+		}
+	})
+	return classes
+}
+
+func walkAPIHandlerValues(prefix string, v interface{}, fn func(key string, v interface{})) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			key := k
+			if prefix != "" {
+				key = prefix + "." + k
+			}
+			walkAPIHandlerValues(key, value, fn)
+		}
+	case nil:
+	default:
+		fn(prefix, v)
+	}
+}
+
+// mergeAPIHandlerResults 複数の操作を呼び出した場合のレスポンスをマージする
+func mergeAPIHandlerResults(results []interface{}) (interface{}, error) {
+	if len(results) == 1 {
+		if results[0] == nil {
+			return map[string]interface{}{"is_ok": true}, nil
+		}
+		return results[0], nil
+	}
+
+	merged := make(map[string]interface{})
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		data, err := json.Marshal(result)
+		if err != nil {
+			return nil, err
+		}
+		var m map[string]interface{}
+		if err := json.Unmarshal(data, &m); err != nil {
+			return nil, err
+		}
+		mergeAPIHandlerValues(merged, m)
+	}
+	return merged, nil
+}
+
+// mergeAPIHandlerValues srcの値をdestへマージする
+//
+// mapは再帰的にマージし、配列(検索結果など)は連結、検索結果の件数(Total/Count)は合算する。
+// それ以外の値は先にマージされた値を優先する
+func mergeAPIHandlerValues(dest, src map[string]interface{}) {
+	for k, v := range src {
+		current, exists := dest[k]
+		if !exists || current == nil {
+			dest[k] = v
+			continue
+		}
+		switch current := current.(type) {
+		case map[string]interface{}:
+			if srcMap, ok := v.(map[string]interface{}); ok {
+				mergeAPIHandlerValues(current, srcMap)
+			}
+		case []interface{}:
+			if srcSlice, ok := v.([]interface{}); ok {
+				dest[k] = append(current, srcSlice...)
+			}
+		case float64:
+			if n, ok := v.(float64); ok && (k == "Total" || k == "Count") {
+				dest[k] = current + n
+			}
+		}
+	}
+}
+
+// apiHandlerPaging 複数の操作の検索結果をマージした後に適用するページング条件
+type apiHandlerPaging struct {
+	from  int
+	count int
+}
+
+// extractAPIHandlerPaging リクエストボディからページング条件(From/Count)を取り除き、ページング条件と取り除いた後のボディを返す
+//
+// ボディがJSONとして不正な場合は各操作でのデコード時にエラーとするためそのまま返す
+func extractAPIHandlerPaging(body []byte) (*apiHandlerPaging, []byte) {
+	paging := &apiHandlerPaging{}
+	if len(body) == 0 {
+		return paging, body
+	}
+	var request map[string]interface{}
+	if err := json.Unmarshal(body, &request); err != nil || request == nil {
+		return paging, body
+	}
+	_, hasFrom := request["From"]
+	_, hasCount := request["Count"]
+	if !hasFrom && !hasCount {
+		return paging, body
+	}
+	if v, ok := request["From"].(float64); ok {
+		paging.from = int(v)
+	}
+	if v, ok := request["Count"].(float64); ok {
+		paging.count = int(v)
+	}
+	delete(request, "From")
+	delete(request, "Count")
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		return paging, body
+	}
+	return paging, data
+}
+
+// apply マージ後の検索結果にページングを適用する 検索結果(Totalを持つもの)以外は何もしない
+func (p *apiHandlerPaging) apply(v interface{}) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return
+	}
+	if _, ok := m["Total"]; !ok {
+		return
+	}
+	for k, value := range m {
+		values, ok := value.([]interface{})
+		if !ok {
+			continue
+		}
+		if p.from > 0 {
+			if p.from >= len(values) {
+				values = []interface{}{}
+			} else {
+				values = values[p.from:]
+			}
+		}
+		if p.count > 0 && len(values) > p.count {
+			values = values[:p.count]
+		}
+		m[k] = values
+		m["Count"] = len(values)
+	}
+	m["From"] = p.from
+}
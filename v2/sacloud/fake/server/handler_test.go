@@ -0,0 +1,51 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIHandlerRoute(t *testing.T) {
+	routes := make(map[string]*apiHandlerRoute)
+	for _, route := range compiledAPIHandlerRoutes() {
+		routes[route.resource+"."+route.operation] = route
+	}
+
+	t.Run("path parameters", func(t *testing.T) {
+		params, ok := routes["Server.Read"].match("/is1a/api/cloud/1.1/server/123456789012")
+		require.True(t, ok)
+		require.Equal(t, map[string]string{"zone": "is1a", "id": "123456789012"}, params)
+
+		_, ok = routes["Server.Read"].match("/is1a/api/cloud/1.1/server/foobar")
+		require.False(t, ok)
+
+		params, ok = routes["VPCRouter.MonitorInterface"].match("/is1a/api/cloud/1.1/appliance/123456789012/interface/2/monitor")
+		require.True(t, ok)
+		require.Equal(t, "2", params["index"])
+
+		params, ok = routes["VPCRouter.MonitorInterface"].match("/is1a/api/cloud/1.1/appliance/123456789012/interface/monitor")
+		require.True(t, ok)
+		require.Equal(t, "", params["index"])
+	})
+
+	t.Run("classes", func(t *testing.T) {
+		require.Equal(t, map[string]string{"Filter.Class": "database"}, routes["Database.Find"].classes)
+		require.Equal(t, map[string]string{"Appliance.Class": "nfs"}, routes["NFS.Create"].classes)
+		require.Equal(t, map[string]string{"CommonServiceItem.Provider.Class": "dns"}, routes["DNS.Create"].classes)
+	})
+}
@@ -0,0 +1,55 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package server fakeドライバをバックエンドとしたさくらのクラウドAPI互換のHTTPサーバ
+//
+// さくらのクラウドAPIと同じパスでリクエストを受け付けるため、
+// APIルートURL(SakuraCloudAPIRootやSAKURACLOUD_API_ROOT_URL)にこのサーバのURLを指定することで
+// 任意のAPIクライアントから利用できる
+package server
+
+import (
+	"net/http"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/fake"
+)
+
+// DefaultPathPrefix ゾーン名より前のパスのデフォルト値
+const DefaultPathPrefix = "/cloud/zone"
+
+// NewHandler 指定のデータストアを利用するfakeドライバをバックエンドとしたhttp.Handlerを返す
+//
+// storeがnilの場合はfake.DataStoreが利用される
+func NewHandler(store fake.Store) http.Handler {
+	registry := sacloud.NewClientRegistry()
+	fake.SwitchRegistryFactoryFuncToFake(registry, store)
+
+	handler := NewAPIHandler(sacloud.NewCallerWithRegistry(&sacloud.Client{}, registry))
+	handler.PathPrefix = DefaultPathPrefix
+	return handler
+}
+
+// Server fakeドライバをバックエンドとしたHTTPサーバ
+type Server struct {
+	// Addr 待ち受けアドレス
+	Addr string
+	// Store fakeドライバが利用するデータストア 省略した場合はfake.DataStoreが利用される
+	Store fake.Store
+}
+
+// ListenAndServe サーバを起動する
+func (s *Server) ListenAndServe() error {
+	return http.ListenAndServe(s.Addr, NewHandler(s.Store))
+}
@@ -0,0 +1,222 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/fake"
+	"github.com/sacloud/libsacloud/v2/sacloud/search"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestServer(t *testing.T) sacloud.APICaller {
+	ts := httptest.NewServer(NewHandler(fake.NewInMemoryStore()))
+	t.Cleanup(ts.Close)
+
+	apiRoot := sacloud.SakuraCloudAPIRoot
+	sacloud.SakuraCloudAPIRoot = ts.URL + DefaultPathPrefix
+	t.Cleanup(func() {
+		sacloud.SakuraCloudAPIRoot = apiRoot
+	})
+	return sacloud.NewClient("token", "secret")
+}
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	caller := setupTestServer(t)
+	zone := "is1a"
+
+	t.Run("CRUD", func(t *testing.T) {
+		switchOp := sacloud.NewSwitchOp(caller)
+
+		created, err := switchOp.Create(ctx, zone, &sacloud.SwitchCreateRequest{
+			Name: "switch",
+			Tags: types.Tags{"tag1", "tag2"},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, created.ID)
+		require.Equal(t, "switch", created.Name)
+
+		read, err := switchOp.Read(ctx, zone, created.ID)
+		require.NoError(t, err)
+		require.Equal(t, created, read)
+
+		found, err := switchOp.Find(ctx, zone, &sacloud.FindCondition{
+			Filter: search.Filter{
+				search.Key("Name"): search.PartialMatch("switch"),
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, found.Switches, 1)
+
+		updated, err := switchOp.Update(ctx, zone, created.ID, &sacloud.SwitchUpdateRequest{Name: "switch-upd"})
+		require.NoError(t, err)
+		require.Equal(t, "switch-upd", updated.Name)
+
+		require.NoError(t, switchOp.Delete(ctx, zone, created.ID))
+
+		_, err = switchOp.Read(ctx, zone, created.ID)
+		require.True(t, sacloud.IsNotFoundError(err))
+	})
+
+	t.Run("initial data", func(t *testing.T) {
+		zones, err := sacloud.NewZoneOp(caller).Find(ctx, nil)
+		require.NoError(t, err)
+		require.NotEmpty(t, zones.Zones)
+	})
+
+	t.Run("resources sharing the same path", func(t *testing.T) {
+		nfsOp := sacloud.NewNFSOp(caller)
+		noteOp := sacloud.NewNoteOp(caller)
+		dnsOp := sacloud.NewDNSOp(caller)
+		gslbOp := sacloud.NewGSLBOp(caller)
+
+		sw, err := sacloud.NewSwitchOp(caller).Create(ctx, zone, &sacloud.SwitchCreateRequest{Name: "switch"})
+		require.NoError(t, err)
+
+		nfs, err := nfsOp.Create(ctx, zone, &sacloud.NFSCreateRequest{
+			SwitchID:       sw.ID,
+			PlanID:         types.ID(1),
+			IPAddresses:    []string{"192.168.0.11"},
+			NetworkMaskLen: 24,
+			Name:           "nfs",
+		})
+		require.NoError(t, err)
+
+		nfsList, err := nfsOp.Find(ctx, zone, nil)
+		require.NoError(t, err)
+		require.Len(t, nfsList.NFS, 1)
+
+		dbList, err := sacloud.NewDatabaseOp(caller).Find(ctx, zone, nil)
+		require.NoError(t, err)
+		require.Empty(t, dbList.Databases)
+
+		read, err := nfsOp.Read(ctx, zone, nfs.ID)
+		require.NoError(t, err)
+		require.Equal(t, "nfs", read.Name)
+
+		dns, err := dnsOp.Create(ctx, &sacloud.DNSCreateRequest{Name: "example.com"})
+		require.NoError(t, err)
+		gslb, err := gslbOp.Create(ctx, &sacloud.GSLBCreateRequest{
+			Name:        "gslb",
+			HealthCheck: &sacloud.GSLBHealthCheck{Protocol: types.GSLBHealthCheckProtocols.Ping},
+		})
+		require.NoError(t, err)
+
+		dnsList, err := dnsOp.Find(ctx, nil)
+		require.NoError(t, err)
+		require.Len(t, dnsList.DNS, 1)
+		require.Equal(t, dns.ID, dnsList.DNS[0].ID)
+
+		readGSLB, err := gslbOp.Read(ctx, gslb.ID)
+		require.NoError(t, err)
+		require.Equal(t, "gslb", readGSLB.Name)
+
+		notes, err := noteOp.Find(ctx, nil)
+		require.NoError(t, err)
+		require.NotEmpty(t, notes.Notes)
+	})
+
+	t.Run("operations sharing the same path", func(t *testing.T) {
+		archiveOp := sacloud.NewArchiveOp(caller)
+
+		archive, ftpServer, err := archiveOp.CreateBlank(ctx, zone, &sacloud.ArchiveCreateBlankRequest{
+			Name:   "blank",
+			SizeMB: 20 * 1024,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, archive)
+		require.NotNil(t, ftpServer)
+		require.NotEmpty(t, ftpServer.HostName)
+
+		diskOp := sacloud.NewDiskOp(caller)
+		disk, err := diskOp.Create(ctx, zone, &sacloud.DiskCreateRequest{
+			DiskPlanID: types.DiskPlans.SSD,
+			SizeMB:     20 * 1024,
+			Name:       "disk",
+		}, nil)
+		require.NoError(t, err)
+
+		// 同一パスのGETは全ての操作の結果がマージされる
+		condition := &sacloud.MonitorCondition{Start: time.Now().Add(-time.Hour), End: time.Now()}
+		activity, err := diskOp.Monitor(ctx, zone, disk.ID, condition)
+		require.NoError(t, err)
+		require.NotEmpty(t, activity.Values)
+
+		diskActivity, err := diskOp.MonitorDisk(ctx, zone, disk.ID, condition)
+		require.NoError(t, err)
+		require.NotEmpty(t, diskActivity.Values)
+	})
+}
+
+func TestServer_mergedSearchResults(t *testing.T) {
+	ctx := context.Background()
+	caller := setupTestServer(t)
+	zone := "is1a"
+
+	sw, err := sacloud.NewSwitchOp(caller).Create(ctx, zone, &sacloud.SwitchCreateRequest{Name: "switch"})
+	require.NoError(t, err)
+	_, err = sacloud.NewNFSOp(caller).Create(ctx, zone, &sacloud.NFSCreateRequest{
+		SwitchID:       sw.ID,
+		PlanID:         types.ID(1),
+		IPAddresses:    []string{"192.168.0.11"},
+		NetworkMaskLen: 24,
+		Name:           "nfs",
+	})
+	require.NoError(t, err)
+	for _, name := range []string{"router1", "router2"} {
+		_, err = sacloud.NewVPCRouterOp(caller).Create(ctx, zone, &sacloud.VPCRouterCreateRequest{
+			Name:        name,
+			PlanID:      types.VPCRouterPlans.Standard,
+			Switch:      &sacloud.ApplianceConnectedSwitch{Scope: types.Scopes.Shared},
+			IPAddresses: []string{"192.168.0.1"},
+		})
+		require.NoError(t, err)
+	}
+
+	type findResult struct {
+		Total      int
+		From       int
+		Count      int
+		Appliances []map[string]interface{}
+	}
+	find := func(condition interface{}) *findResult {
+		data, err := caller.Do(ctx, "GET", sacloud.SakuraCloudAPIRoot+"/"+zone+"/api/cloud/1.1/appliance", condition)
+		require.NoError(t, err)
+		result := &findResult{}
+		require.NoError(t, json.Unmarshal(data, result))
+		return result
+	}
+
+	// Classを指定しない場合は全ての種別の検索結果が連結される
+	result := find(nil)
+	require.Equal(t, 3, result.Total)
+	require.Equal(t, 3, result.Count)
+	require.Len(t, result.Appliances, 3)
+
+	// ページングは連結後の検索結果に対して行われる
+	result = find(map[string]interface{}{"From": 1, "Count": 1})
+	require.Equal(t, 3, result.Total)
+	require.Equal(t, 1, result.From)
+	require.Equal(t, 1, result.Count)
+	require.Len(t, result.Appliances, 1)
+}
@@ -15,6 +15,7 @@
 package fake
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -31,7 +32,8 @@ var (
 	PowerOffDuration = 10 * time.Millisecond
 )
 
-func startDiskCopy(resourceKey, zone string, readFunc func() (interface{}, error)) {
+func startDiskCopy(ctx context.Context, s Store, resourceKey, zone string, readFunc func() (interface{}, error)) {
+	effects := faultEffectsFromContext(ctx)
 	counter := 0
 	ticker := time.NewTicker(DiskCopyDuration)
 	go func() {
@@ -56,18 +58,22 @@ func startDiskCopy(resourceKey, zone string, readFunc func() (interface{}, error
 					target.SetMigratedMB(target.GetSizeMB() / counter)
 				}
 			} else {
-				target.SetAvailability(types.Availabilities.Available)
-				target.SetMigratedMB(target.GetSizeMB())
-				ds().Put(resourceKey, zone, target.(accessor.ID).GetID(), target)
+				if effects.diskCopyFailure {
+					target.SetAvailability(types.Availabilities.Failed)
+				} else {
+					target.SetAvailability(types.Availabilities.Available)
+					target.SetMigratedMB(target.GetSizeMB())
+				}
+				s.Put(resourceKey, zone, target.(accessor.ID).GetID(), target)
 				return
 			}
-			ds().Put(resourceKey, zone, target.(accessor.ID).GetID(), target)
+			s.Put(resourceKey, zone, target.(accessor.ID).GetID(), target)
 			counter++
 		}
 	}()
 }
 
-func startMigration(resourceKey, zone string, readFunc func() (interface{}, error)) {
+func startMigration(s Store, resourceKey, zone string, readFunc func() (interface{}, error)) {
 	counter := 0
 	ticker := time.NewTicker(DiskCopyDuration)
 	go func() {
@@ -88,16 +94,17 @@ func startMigration(resourceKey, zone string, readFunc func() (interface{}, erro
 				target.SetAvailability(types.Availabilities.Migrating)
 			} else {
 				target.SetAvailability(types.Availabilities.Available)
-				ds().Put(resourceKey, zone, target.(accessor.ID).GetID(), target)
+				s.Put(resourceKey, zone, target.(accessor.ID).GetID(), target)
 				return
 			}
-			ds().Put(resourceKey, zone, target.(accessor.ID).GetID(), target)
+			s.Put(resourceKey, zone, target.(accessor.ID).GetID(), target)
 			counter++
 		}
 	}()
 }
 
-func startPowerOn(resourceKey, zone string, readFunc func() (interface{}, error)) {
+func startPowerOn(ctx context.Context, s Store, resourceKey, zone string, readFunc func() (interface{}, error)) {
+	effects := faultEffectsFromContext(ctx)
 	counter := 0
 	ticker := time.NewTicker(PowerOnDuration)
 	go func() {
@@ -116,6 +123,9 @@ func startPowerOn(resourceKey, zone string, readFunc func() (interface{}, error)
 
 			if counter < 3 {
 				target.SetInstanceStatus(types.ServerInstanceStatuses.Down)
+			} else if effects.neverBoot {
+				// upにならないまま処理を終える
+				return
 			} else {
 				target.SetInstanceStatus(types.ServerInstanceStatuses.Up)
 				if status, ok := target.(accessor.Instance); ok {
@@ -126,16 +136,16 @@ func startPowerOn(resourceKey, zone string, readFunc func() (interface{}, error)
 				if available, ok := target.(accessor.Availability); ok {
 					available.SetAvailability(types.Availabilities.Available)
 				}
-				ds().Put(resourceKey, zone, target.(accessor.ID).GetID(), target)
+				s.Put(resourceKey, zone, target.(accessor.ID).GetID(), target)
 				return
 			}
-			ds().Put(resourceKey, zone, target.(accessor.ID).GetID(), target)
+			s.Put(resourceKey, zone, target.(accessor.ID).GetID(), target)
 			counter++
 		}
 	}()
 }
 
-func startPowerOff(resourceKey, zone string, readFunc func() (interface{}, error)) {
+func startPowerOff(s Store, resourceKey, zone string, readFunc func() (interface{}, error)) {
 	counter := 0
 	ticker := time.NewTicker(PowerOffDuration)
 	go func() {
@@ -162,11 +172,11 @@ func startPowerOff(resourceKey, zone string, readFunc func() (interface{}, error
 				target.SetInstanceStatus(types.ServerInstanceStatuses.Cleaning)
 			} else {
 				target.SetInstanceStatus(types.ServerInstanceStatuses.Down)
-				ds().Put(resourceKey, zone, target.(accessor.ID).GetID(), target)
+				s.Put(resourceKey, zone, target.(accessor.ID).GetID(), target)
 				return
 			}
 
-			ds().Put(resourceKey, zone, target.(accessor.ID).GetID(), target)
+			s.Put(resourceKey, zone, target.(accessor.ID).GetID(), target)
 			counter++
 		}
 	}()
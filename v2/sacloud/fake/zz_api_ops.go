@@ -172,19 +172,261 @@ func switchFactoryFuncToFake() {
 	})
 }
 
+// SwitchRegistryFactoryFuncToFake switches factory methods in the registry to use fake client with the store
+//
+// If store is nil, fake.DataStore is used
+func SwitchRegistryFactoryFuncToFake(registry *sacloud.ClientRegistry, store Store) {
+	registry.SetClientFactoryFunc(ResourceArchive, func(caller sacloud.APICaller) interface{} {
+		op := newArchiveOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceAuthStatus, func(caller sacloud.APICaller) interface{} {
+		op := newAuthStatusOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceAutoBackup, func(caller sacloud.APICaller) interface{} {
+		op := newAutoBackupOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceBill, func(caller sacloud.APICaller) interface{} {
+		op := newBillOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceBridge, func(caller sacloud.APICaller) interface{} {
+		op := newBridgeOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceCDROM, func(caller sacloud.APICaller) interface{} {
+		op := newCDROMOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceCertificateAuthority, func(caller sacloud.APICaller) interface{} {
+		op := newCertificateAuthorityOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceContainerRegistry, func(caller sacloud.APICaller) interface{} {
+		op := newContainerRegistryOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceCoupon, func(caller sacloud.APICaller) interface{} {
+		op := newCouponOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceDatabase, func(caller sacloud.APICaller) interface{} {
+		op := newDatabaseOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceDisk, func(caller sacloud.APICaller) interface{} {
+		op := newDiskOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceDiskPlan, func(caller sacloud.APICaller) interface{} {
+		op := newDiskPlanOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceDNS, func(caller sacloud.APICaller) interface{} {
+		op := newDNSOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceEnhancedDB, func(caller sacloud.APICaller) interface{} {
+		op := newEnhancedDBOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceESME, func(caller sacloud.APICaller) interface{} {
+		op := newESMEOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceGSLB, func(caller sacloud.APICaller) interface{} {
+		op := newGSLBOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceIcon, func(caller sacloud.APICaller) interface{} {
+		op := newIconOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceInterface, func(caller sacloud.APICaller) interface{} {
+		op := newInterfaceOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceInternet, func(caller sacloud.APICaller) interface{} {
+		op := newInternetOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceInternetPlan, func(caller sacloud.APICaller) interface{} {
+		op := newInternetPlanOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceIPAddress, func(caller sacloud.APICaller) interface{} {
+		op := newIPAddressOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceIPv6Net, func(caller sacloud.APICaller) interface{} {
+		op := newIPv6NetOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceIPv6Addr, func(caller sacloud.APICaller) interface{} {
+		op := newIPv6AddrOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceLicense, func(caller sacloud.APICaller) interface{} {
+		op := newLicenseOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceLicenseInfo, func(caller sacloud.APICaller) interface{} {
+		op := newLicenseInfoOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceLoadBalancer, func(caller sacloud.APICaller) interface{} {
+		op := newLoadBalancerOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceLocalRouter, func(caller sacloud.APICaller) interface{} {
+		op := newLocalRouterOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceMobileGateway, func(caller sacloud.APICaller) interface{} {
+		op := newMobileGatewayOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceNFS, func(caller sacloud.APICaller) interface{} {
+		op := newNFSOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceNote, func(caller sacloud.APICaller) interface{} {
+		op := newNoteOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourcePacketFilter, func(caller sacloud.APICaller) interface{} {
+		op := newPacketFilterOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourcePrivateHost, func(caller sacloud.APICaller) interface{} {
+		op := newPrivateHostOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourcePrivateHostPlan, func(caller sacloud.APICaller) interface{} {
+		op := newPrivateHostPlanOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceProxyLB, func(caller sacloud.APICaller) interface{} {
+		op := newProxyLBOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceRegion, func(caller sacloud.APICaller) interface{} {
+		op := newRegionOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceServer, func(caller sacloud.APICaller) interface{} {
+		op := newServerOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceServerPlan, func(caller sacloud.APICaller) interface{} {
+		op := newServerPlanOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceServiceClass, func(caller sacloud.APICaller) interface{} {
+		op := newServiceClassOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceSIM, func(caller sacloud.APICaller) interface{} {
+		op := newSIMOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceSimpleMonitor, func(caller sacloud.APICaller) interface{} {
+		op := newSimpleMonitorOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceSSHKey, func(caller sacloud.APICaller) interface{} {
+		op := newSSHKeyOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceSubnet, func(caller sacloud.APICaller) interface{} {
+		op := newSubnetOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceSwitch, func(caller sacloud.APICaller) interface{} {
+		op := newSwitchOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceVPCRouter, func(caller sacloud.APICaller) interface{} {
+		op := newVPCRouterOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceWebAccel, func(caller sacloud.APICaller) interface{} {
+		op := newWebAccelOp(store)
+		op.registry = registry
+		return op
+	})
+	registry.SetClientFactoryFunc(ResourceZone, func(caller sacloud.APICaller) interface{} {
+		op := newZoneOp(store)
+		op.registry = registry
+		return op
+	})
+}
+
 /*************************************************
 * ArchiveOp
 *************************************************/
 
 // ArchiveOp is fake implementation of ArchiveAPI interface
 type ArchiveOp struct {
+	storeAccessor
 	key string
 }
 
 // NewArchiveOp creates new ArchiveOp instance
 func NewArchiveOp() sacloud.ArchiveAPI {
+	return newArchiveOp(nil)
+}
+
+func newArchiveOp(store Store) *ArchiveOp {
 	return &ArchiveOp{
-		key: ResourceArchive,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceArchive,
 	}
 }
 
@@ -194,13 +436,19 @@ func NewArchiveOp() sacloud.ArchiveAPI {
 
 // AuthStatusOp is fake implementation of AuthStatusAPI interface
 type AuthStatusOp struct {
+	storeAccessor
 	key string
 }
 
 // NewAuthStatusOp creates new AuthStatusOp instance
 func NewAuthStatusOp() sacloud.AuthStatusAPI {
+	return newAuthStatusOp(nil)
+}
+
+func newAuthStatusOp(store Store) *AuthStatusOp {
 	return &AuthStatusOp{
-		key: ResourceAuthStatus,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceAuthStatus,
 	}
 }
 
@@ -210,13 +458,19 @@ func NewAuthStatusOp() sacloud.AuthStatusAPI {
 
 // AutoBackupOp is fake implementation of AutoBackupAPI interface
 type AutoBackupOp struct {
+	storeAccessor
 	key string
 }
 
 // NewAutoBackupOp creates new AutoBackupOp instance
 func NewAutoBackupOp() sacloud.AutoBackupAPI {
+	return newAutoBackupOp(nil)
+}
+
+func newAutoBackupOp(store Store) *AutoBackupOp {
 	return &AutoBackupOp{
-		key: ResourceAutoBackup,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceAutoBackup,
 	}
 }
 
@@ -226,13 +480,19 @@ func NewAutoBackupOp() sacloud.AutoBackupAPI {
 
 // BillOp is fake implementation of BillAPI interface
 type BillOp struct {
+	storeAccessor
 	key string
 }
 
 // NewBillOp creates new BillOp instance
 func NewBillOp() sacloud.BillAPI {
+	return newBillOp(nil)
+}
+
+func newBillOp(store Store) *BillOp {
 	return &BillOp{
-		key: ResourceBill,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceBill,
 	}
 }
 
@@ -242,13 +502,19 @@ func NewBillOp() sacloud.BillAPI {
 
 // BridgeOp is fake implementation of BridgeAPI interface
 type BridgeOp struct {
+	storeAccessor
 	key string
 }
 
 // NewBridgeOp creates new BridgeOp instance
 func NewBridgeOp() sacloud.BridgeAPI {
+	return newBridgeOp(nil)
+}
+
+func newBridgeOp(store Store) *BridgeOp {
 	return &BridgeOp{
-		key: ResourceBridge,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceBridge,
 	}
 }
 
@@ -258,13 +524,19 @@ func NewBridgeOp() sacloud.BridgeAPI {
 
 // CDROMOp is fake implementation of CDROMAPI interface
 type CDROMOp struct {
+	storeAccessor
 	key string
 }
 
 // NewCDROMOp creates new CDROMOp instance
 func NewCDROMOp() sacloud.CDROMAPI {
+	return newCDROMOp(nil)
+}
+
+func newCDROMOp(store Store) *CDROMOp {
 	return &CDROMOp{
-		key: ResourceCDROM,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceCDROM,
 	}
 }
 
@@ -274,13 +546,19 @@ func NewCDROMOp() sacloud.CDROMAPI {
 
 // CertificateAuthorityOp is fake implementation of CertificateAuthorityAPI interface
 type CertificateAuthorityOp struct {
+	storeAccessor
 	key string
 }
 
 // NewCertificateAuthorityOp creates new CertificateAuthorityOp instance
 func NewCertificateAuthorityOp() sacloud.CertificateAuthorityAPI {
+	return newCertificateAuthorityOp(nil)
+}
+
+func newCertificateAuthorityOp(store Store) *CertificateAuthorityOp {
 	return &CertificateAuthorityOp{
-		key: ResourceCertificateAuthority,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceCertificateAuthority,
 	}
 }
 
@@ -290,13 +568,19 @@ func NewCertificateAuthorityOp() sacloud.CertificateAuthorityAPI {
 
 // ContainerRegistryOp is fake implementation of ContainerRegistryAPI interface
 type ContainerRegistryOp struct {
+	storeAccessor
 	key string
 }
 
 // NewContainerRegistryOp creates new ContainerRegistryOp instance
 func NewContainerRegistryOp() sacloud.ContainerRegistryAPI {
+	return newContainerRegistryOp(nil)
+}
+
+func newContainerRegistryOp(store Store) *ContainerRegistryOp {
 	return &ContainerRegistryOp{
-		key: ResourceContainerRegistry,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceContainerRegistry,
 	}
 }
 
@@ -306,13 +590,19 @@ func NewContainerRegistryOp() sacloud.ContainerRegistryAPI {
 
 // CouponOp is fake implementation of CouponAPI interface
 type CouponOp struct {
+	storeAccessor
 	key string
 }
 
 // NewCouponOp creates new CouponOp instance
 func NewCouponOp() sacloud.CouponAPI {
+	return newCouponOp(nil)
+}
+
+func newCouponOp(store Store) *CouponOp {
 	return &CouponOp{
-		key: ResourceCoupon,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceCoupon,
 	}
 }
 
@@ -322,13 +612,19 @@ func NewCouponOp() sacloud.CouponAPI {
 
 // DatabaseOp is fake implementation of DatabaseAPI interface
 type DatabaseOp struct {
+	storeAccessor
 	key string
 }
 
 // NewDatabaseOp creates new DatabaseOp instance
 func NewDatabaseOp() sacloud.DatabaseAPI {
+	return newDatabaseOp(nil)
+}
+
+func newDatabaseOp(store Store) *DatabaseOp {
 	return &DatabaseOp{
-		key: ResourceDatabase,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceDatabase,
 	}
 }
 
@@ -338,13 +634,19 @@ func NewDatabaseOp() sacloud.DatabaseAPI {
 
 // DiskOp is fake implementation of DiskAPI interface
 type DiskOp struct {
+	storeAccessor
 	key string
 }
 
 // NewDiskOp creates new DiskOp instance
 func NewDiskOp() sacloud.DiskAPI {
+	return newDiskOp(nil)
+}
+
+func newDiskOp(store Store) *DiskOp {
 	return &DiskOp{
-		key: ResourceDisk,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceDisk,
 	}
 }
 
@@ -354,13 +656,19 @@ func NewDiskOp() sacloud.DiskAPI {
 
 // DiskPlanOp is fake implementation of DiskPlanAPI interface
 type DiskPlanOp struct {
+	storeAccessor
 	key string
 }
 
 // NewDiskPlanOp creates new DiskPlanOp instance
 func NewDiskPlanOp() sacloud.DiskPlanAPI {
+	return newDiskPlanOp(nil)
+}
+
+func newDiskPlanOp(store Store) *DiskPlanOp {
 	return &DiskPlanOp{
-		key: ResourceDiskPlan,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceDiskPlan,
 	}
 }
 
@@ -370,13 +678,19 @@ func NewDiskPlanOp() sacloud.DiskPlanAPI {
 
 // DNSOp is fake implementation of DNSAPI interface
 type DNSOp struct {
+	storeAccessor
 	key string
 }
 
 // NewDNSOp creates new DNSOp instance
 func NewDNSOp() sacloud.DNSAPI {
+	return newDNSOp(nil)
+}
+
+func newDNSOp(store Store) *DNSOp {
 	return &DNSOp{
-		key: ResourceDNS,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceDNS,
 	}
 }
 
@@ -386,13 +700,19 @@ func NewDNSOp() sacloud.DNSAPI {
 
 // EnhancedDBOp is fake implementation of EnhancedDBAPI interface
 type EnhancedDBOp struct {
+	storeAccessor
 	key string
 }
 
 // NewEnhancedDBOp creates new EnhancedDBOp instance
 func NewEnhancedDBOp() sacloud.EnhancedDBAPI {
+	return newEnhancedDBOp(nil)
+}
+
+func newEnhancedDBOp(store Store) *EnhancedDBOp {
 	return &EnhancedDBOp{
-		key: ResourceEnhancedDB,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceEnhancedDB,
 	}
 }
 
@@ -402,13 +722,19 @@ func NewEnhancedDBOp() sacloud.EnhancedDBAPI {
 
 // ESMEOp is fake implementation of ESMEAPI interface
 type ESMEOp struct {
+	storeAccessor
 	key string
 }
 
 // NewESMEOp creates new ESMEOp instance
 func NewESMEOp() sacloud.ESMEAPI {
+	return newESMEOp(nil)
+}
+
+func newESMEOp(store Store) *ESMEOp {
 	return &ESMEOp{
-		key: ResourceESME,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceESME,
 	}
 }
 
@@ -418,13 +744,19 @@ func NewESMEOp() sacloud.ESMEAPI {
 
 // GSLBOp is fake implementation of GSLBAPI interface
 type GSLBOp struct {
+	storeAccessor
 	key string
 }
 
 // NewGSLBOp creates new GSLBOp instance
 func NewGSLBOp() sacloud.GSLBAPI {
+	return newGSLBOp(nil)
+}
+
+func newGSLBOp(store Store) *GSLBOp {
 	return &GSLBOp{
-		key: ResourceGSLB,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceGSLB,
 	}
 }
 
@@ -434,13 +766,19 @@ func NewGSLBOp() sacloud.GSLBAPI {
 
 // IconOp is fake implementation of IconAPI interface
 type IconOp struct {
+	storeAccessor
 	key string
 }
 
 // NewIconOp creates new IconOp instance
 func NewIconOp() sacloud.IconAPI {
+	return newIconOp(nil)
+}
+
+func newIconOp(store Store) *IconOp {
 	return &IconOp{
-		key: ResourceIcon,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceIcon,
 	}
 }
 
@@ -450,13 +788,19 @@ func NewIconOp() sacloud.IconAPI {
 
 // InterfaceOp is fake implementation of InterfaceAPI interface
 type InterfaceOp struct {
+	storeAccessor
 	key string
 }
 
 // NewInterfaceOp creates new InterfaceOp instance
 func NewInterfaceOp() sacloud.InterfaceAPI {
+	return newInterfaceOp(nil)
+}
+
+func newInterfaceOp(store Store) *InterfaceOp {
 	return &InterfaceOp{
-		key: ResourceInterface,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceInterface,
 	}
 }
 
@@ -466,13 +810,19 @@ func NewInterfaceOp() sacloud.InterfaceAPI {
 
 // InternetOp is fake implementation of InternetAPI interface
 type InternetOp struct {
+	storeAccessor
 	key string
 }
 
 // NewInternetOp creates new InternetOp instance
 func NewInternetOp() sacloud.InternetAPI {
+	return newInternetOp(nil)
+}
+
+func newInternetOp(store Store) *InternetOp {
 	return &InternetOp{
-		key: ResourceInternet,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceInternet,
 	}
 }
 
@@ -482,13 +832,19 @@ func NewInternetOp() sacloud.InternetAPI {
 
 // InternetPlanOp is fake implementation of InternetPlanAPI interface
 type InternetPlanOp struct {
+	storeAccessor
 	key string
 }
 
 // NewInternetPlanOp creates new InternetPlanOp instance
 func NewInternetPlanOp() sacloud.InternetPlanAPI {
+	return newInternetPlanOp(nil)
+}
+
+func newInternetPlanOp(store Store) *InternetPlanOp {
 	return &InternetPlanOp{
-		key: ResourceInternetPlan,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceInternetPlan,
 	}
 }
 
@@ -498,13 +854,19 @@ func NewInternetPlanOp() sacloud.InternetPlanAPI {
 
 // IPAddressOp is fake implementation of IPAddressAPI interface
 type IPAddressOp struct {
+	storeAccessor
 	key string
 }
 
 // NewIPAddressOp creates new IPAddressOp instance
 func NewIPAddressOp() sacloud.IPAddressAPI {
+	return newIPAddressOp(nil)
+}
+
+func newIPAddressOp(store Store) *IPAddressOp {
 	return &IPAddressOp{
-		key: ResourceIPAddress,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceIPAddress,
 	}
 }
 
@@ -514,13 +876,19 @@ func NewIPAddressOp() sacloud.IPAddressAPI {
 
 // IPv6NetOp is fake implementation of IPv6NetAPI interface
 type IPv6NetOp struct {
+	storeAccessor
 	key string
 }
 
 // NewIPv6NetOp creates new IPv6NetOp instance
 func NewIPv6NetOp() sacloud.IPv6NetAPI {
+	return newIPv6NetOp(nil)
+}
+
+func newIPv6NetOp(store Store) *IPv6NetOp {
 	return &IPv6NetOp{
-		key: ResourceIPv6Net,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceIPv6Net,
 	}
 }
 
@@ -530,13 +898,19 @@ func NewIPv6NetOp() sacloud.IPv6NetAPI {
 
 // IPv6AddrOp is fake implementation of IPv6AddrAPI interface
 type IPv6AddrOp struct {
+	storeAccessor
 	key string
 }
 
 // NewIPv6AddrOp creates new IPv6AddrOp instance
 func NewIPv6AddrOp() sacloud.IPv6AddrAPI {
+	return newIPv6AddrOp(nil)
+}
+
+func newIPv6AddrOp(store Store) *IPv6AddrOp {
 	return &IPv6AddrOp{
-		key: ResourceIPv6Addr,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceIPv6Addr,
 	}
 }
 
@@ -546,13 +920,19 @@ func NewIPv6AddrOp() sacloud.IPv6AddrAPI {
 
 // LicenseOp is fake implementation of LicenseAPI interface
 type LicenseOp struct {
+	storeAccessor
 	key string
 }
 
 // NewLicenseOp creates new LicenseOp instance
 func NewLicenseOp() sacloud.LicenseAPI {
+	return newLicenseOp(nil)
+}
+
+func newLicenseOp(store Store) *LicenseOp {
 	return &LicenseOp{
-		key: ResourceLicense,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceLicense,
 	}
 }
 
@@ -562,13 +942,19 @@ func NewLicenseOp() sacloud.LicenseAPI {
 
 // LicenseInfoOp is fake implementation of LicenseInfoAPI interface
 type LicenseInfoOp struct {
+	storeAccessor
 	key string
 }
 
 // NewLicenseInfoOp creates new LicenseInfoOp instance
 func NewLicenseInfoOp() sacloud.LicenseInfoAPI {
+	return newLicenseInfoOp(nil)
+}
+
+func newLicenseInfoOp(store Store) *LicenseInfoOp {
 	return &LicenseInfoOp{
-		key: ResourceLicenseInfo,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceLicenseInfo,
 	}
 }
 
@@ -578,13 +964,19 @@ func NewLicenseInfoOp() sacloud.LicenseInfoAPI {
 
 // LoadBalancerOp is fake implementation of LoadBalancerAPI interface
 type LoadBalancerOp struct {
+	storeAccessor
 	key string
 }
 
 // NewLoadBalancerOp creates new LoadBalancerOp instance
 func NewLoadBalancerOp() sacloud.LoadBalancerAPI {
+	return newLoadBalancerOp(nil)
+}
+
+func newLoadBalancerOp(store Store) *LoadBalancerOp {
 	return &LoadBalancerOp{
-		key: ResourceLoadBalancer,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceLoadBalancer,
 	}
 }
 
@@ -594,13 +986,19 @@ func NewLoadBalancerOp() sacloud.LoadBalancerAPI {
 
 // LocalRouterOp is fake implementation of LocalRouterAPI interface
 type LocalRouterOp struct {
+	storeAccessor
 	key string
 }
 
 // NewLocalRouterOp creates new LocalRouterOp instance
 func NewLocalRouterOp() sacloud.LocalRouterAPI {
+	return newLocalRouterOp(nil)
+}
+
+func newLocalRouterOp(store Store) *LocalRouterOp {
 	return &LocalRouterOp{
-		key: ResourceLocalRouter,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceLocalRouter,
 	}
 }
 
@@ -610,13 +1008,19 @@ func NewLocalRouterOp() sacloud.LocalRouterAPI {
 
 // MobileGatewayOp is fake implementation of MobileGatewayAPI interface
 type MobileGatewayOp struct {
+	storeAccessor
 	key string
 }
 
 // NewMobileGatewayOp creates new MobileGatewayOp instance
 func NewMobileGatewayOp() sacloud.MobileGatewayAPI {
+	return newMobileGatewayOp(nil)
+}
+
+func newMobileGatewayOp(store Store) *MobileGatewayOp {
 	return &MobileGatewayOp{
-		key: ResourceMobileGateway,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceMobileGateway,
 	}
 }
 
@@ -626,13 +1030,19 @@ func NewMobileGatewayOp() sacloud.MobileGatewayAPI {
 
 // NFSOp is fake implementation of NFSAPI interface
 type NFSOp struct {
+	storeAccessor
 	key string
 }
 
 // NewNFSOp creates new NFSOp instance
 func NewNFSOp() sacloud.NFSAPI {
+	return newNFSOp(nil)
+}
+
+func newNFSOp(store Store) *NFSOp {
 	return &NFSOp{
-		key: ResourceNFS,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceNFS,
 	}
 }
 
@@ -642,13 +1052,19 @@ func NewNFSOp() sacloud.NFSAPI {
 
 // NoteOp is fake implementation of NoteAPI interface
 type NoteOp struct {
+	storeAccessor
 	key string
 }
 
 // NewNoteOp creates new NoteOp instance
 func NewNoteOp() sacloud.NoteAPI {
+	return newNoteOp(nil)
+}
+
+func newNoteOp(store Store) *NoteOp {
 	return &NoteOp{
-		key: ResourceNote,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceNote,
 	}
 }
 
@@ -658,13 +1074,19 @@ func NewNoteOp() sacloud.NoteAPI {
 
 // PacketFilterOp is fake implementation of PacketFilterAPI interface
 type PacketFilterOp struct {
+	storeAccessor
 	key string
 }
 
 // NewPacketFilterOp creates new PacketFilterOp instance
 func NewPacketFilterOp() sacloud.PacketFilterAPI {
+	return newPacketFilterOp(nil)
+}
+
+func newPacketFilterOp(store Store) *PacketFilterOp {
 	return &PacketFilterOp{
-		key: ResourcePacketFilter,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourcePacketFilter,
 	}
 }
 
@@ -674,13 +1096,19 @@ func NewPacketFilterOp() sacloud.PacketFilterAPI {
 
 // PrivateHostOp is fake implementation of PrivateHostAPI interface
 type PrivateHostOp struct {
+	storeAccessor
 	key string
 }
 
 // NewPrivateHostOp creates new PrivateHostOp instance
 func NewPrivateHostOp() sacloud.PrivateHostAPI {
+	return newPrivateHostOp(nil)
+}
+
+func newPrivateHostOp(store Store) *PrivateHostOp {
 	return &PrivateHostOp{
-		key: ResourcePrivateHost,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourcePrivateHost,
 	}
 }
 
@@ -690,13 +1118,19 @@ func NewPrivateHostOp() sacloud.PrivateHostAPI {
 
 // PrivateHostPlanOp is fake implementation of PrivateHostPlanAPI interface
 type PrivateHostPlanOp struct {
+	storeAccessor
 	key string
 }
 
 // NewPrivateHostPlanOp creates new PrivateHostPlanOp instance
 func NewPrivateHostPlanOp() sacloud.PrivateHostPlanAPI {
+	return newPrivateHostPlanOp(nil)
+}
+
+func newPrivateHostPlanOp(store Store) *PrivateHostPlanOp {
 	return &PrivateHostPlanOp{
-		key: ResourcePrivateHostPlan,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourcePrivateHostPlan,
 	}
 }
 
@@ -706,13 +1140,19 @@ func NewPrivateHostPlanOp() sacloud.PrivateHostPlanAPI {
 
 // ProxyLBOp is fake implementation of ProxyLBAPI interface
 type ProxyLBOp struct {
+	storeAccessor
 	key string
 }
 
 // NewProxyLBOp creates new ProxyLBOp instance
 func NewProxyLBOp() sacloud.ProxyLBAPI {
+	return newProxyLBOp(nil)
+}
+
+func newProxyLBOp(store Store) *ProxyLBOp {
 	return &ProxyLBOp{
-		key: ResourceProxyLB,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceProxyLB,
 	}
 }
 
@@ -722,13 +1162,19 @@ func NewProxyLBOp() sacloud.ProxyLBAPI {
 
 // RegionOp is fake implementation of RegionAPI interface
 type RegionOp struct {
+	storeAccessor
 	key string
 }
 
 // NewRegionOp creates new RegionOp instance
 func NewRegionOp() sacloud.RegionAPI {
+	return newRegionOp(nil)
+}
+
+func newRegionOp(store Store) *RegionOp {
 	return &RegionOp{
-		key: ResourceRegion,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceRegion,
 	}
 }
 
@@ -738,13 +1184,19 @@ func NewRegionOp() sacloud.RegionAPI {
 
 // ServerOp is fake implementation of ServerAPI interface
 type ServerOp struct {
+	storeAccessor
 	key string
 }
 
 // NewServerOp creates new ServerOp instance
 func NewServerOp() sacloud.ServerAPI {
+	return newServerOp(nil)
+}
+
+func newServerOp(store Store) *ServerOp {
 	return &ServerOp{
-		key: ResourceServer,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceServer,
 	}
 }
 
@@ -754,13 +1206,19 @@ func NewServerOp() sacloud.ServerAPI {
 
 // ServerPlanOp is fake implementation of ServerPlanAPI interface
 type ServerPlanOp struct {
+	storeAccessor
 	key string
 }
 
 // NewServerPlanOp creates new ServerPlanOp instance
 func NewServerPlanOp() sacloud.ServerPlanAPI {
+	return newServerPlanOp(nil)
+}
+
+func newServerPlanOp(store Store) *ServerPlanOp {
 	return &ServerPlanOp{
-		key: ResourceServerPlan,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceServerPlan,
 	}
 }
 
@@ -770,13 +1228,19 @@ func NewServerPlanOp() sacloud.ServerPlanAPI {
 
 // ServiceClassOp is fake implementation of ServiceClassAPI interface
 type ServiceClassOp struct {
+	storeAccessor
 	key string
 }
 
 // NewServiceClassOp creates new ServiceClassOp instance
 func NewServiceClassOp() sacloud.ServiceClassAPI {
+	return newServiceClassOp(nil)
+}
+
+func newServiceClassOp(store Store) *ServiceClassOp {
 	return &ServiceClassOp{
-		key: ResourceServiceClass,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceServiceClass,
 	}
 }
 
@@ -786,13 +1250,19 @@ func NewServiceClassOp() sacloud.ServiceClassAPI {
 
 // SIMOp is fake implementation of SIMAPI interface
 type SIMOp struct {
+	storeAccessor
 	key string
 }
 
 // NewSIMOp creates new SIMOp instance
 func NewSIMOp() sacloud.SIMAPI {
+	return newSIMOp(nil)
+}
+
+func newSIMOp(store Store) *SIMOp {
 	return &SIMOp{
-		key: ResourceSIM,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceSIM,
 	}
 }
 
@@ -802,13 +1272,19 @@ func NewSIMOp() sacloud.SIMAPI {
 
 // SimpleMonitorOp is fake implementation of SimpleMonitorAPI interface
 type SimpleMonitorOp struct {
+	storeAccessor
 	key string
 }
 
 // NewSimpleMonitorOp creates new SimpleMonitorOp instance
 func NewSimpleMonitorOp() sacloud.SimpleMonitorAPI {
+	return newSimpleMonitorOp(nil)
+}
+
+func newSimpleMonitorOp(store Store) *SimpleMonitorOp {
 	return &SimpleMonitorOp{
-		key: ResourceSimpleMonitor,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceSimpleMonitor,
 	}
 }
 
@@ -818,13 +1294,19 @@ func NewSimpleMonitorOp() sacloud.SimpleMonitorAPI {
 
 // SSHKeyOp is fake implementation of SSHKeyAPI interface
 type SSHKeyOp struct {
+	storeAccessor
 	key string
 }
 
 // NewSSHKeyOp creates new SSHKeyOp instance
 func NewSSHKeyOp() sacloud.SSHKeyAPI {
+	return newSSHKeyOp(nil)
+}
+
+func newSSHKeyOp(store Store) *SSHKeyOp {
 	return &SSHKeyOp{
-		key: ResourceSSHKey,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceSSHKey,
 	}
 }
 
@@ -834,13 +1316,19 @@ func NewSSHKeyOp() sacloud.SSHKeyAPI {
 
 // SubnetOp is fake implementation of SubnetAPI interface
 type SubnetOp struct {
+	storeAccessor
 	key string
 }
 
 // NewSubnetOp creates new SubnetOp instance
 func NewSubnetOp() sacloud.SubnetAPI {
+	return newSubnetOp(nil)
+}
+
+func newSubnetOp(store Store) *SubnetOp {
 	return &SubnetOp{
-		key: ResourceSubnet,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceSubnet,
 	}
 }
 
@@ -850,13 +1338,19 @@ func NewSubnetOp() sacloud.SubnetAPI {
 
 // SwitchOp is fake implementation of SwitchAPI interface
 type SwitchOp struct {
+	storeAccessor
 	key string
 }
 
 // NewSwitchOp creates new SwitchOp instance
 func NewSwitchOp() sacloud.SwitchAPI {
+	return newSwitchOp(nil)
+}
+
+func newSwitchOp(store Store) *SwitchOp {
 	return &SwitchOp{
-		key: ResourceSwitch,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceSwitch,
 	}
 }
 
@@ -866,13 +1360,19 @@ func NewSwitchOp() sacloud.SwitchAPI {
 
 // VPCRouterOp is fake implementation of VPCRouterAPI interface
 type VPCRouterOp struct {
+	storeAccessor
 	key string
 }
 
 // NewVPCRouterOp creates new VPCRouterOp instance
 func NewVPCRouterOp() sacloud.VPCRouterAPI {
+	return newVPCRouterOp(nil)
+}
+
+func newVPCRouterOp(store Store) *VPCRouterOp {
 	return &VPCRouterOp{
-		key: ResourceVPCRouter,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceVPCRouter,
 	}
 }
 
@@ -882,13 +1382,19 @@ func NewVPCRouterOp() sacloud.VPCRouterAPI {
 
 // WebAccelOp is fake implementation of WebAccelAPI interface
 type WebAccelOp struct {
+	storeAccessor
 	key string
 }
 
 // NewWebAccelOp creates new WebAccelOp instance
 func NewWebAccelOp() sacloud.WebAccelAPI {
+	return newWebAccelOp(nil)
+}
+
+func newWebAccelOp(store Store) *WebAccelOp {
 	return &WebAccelOp{
-		key: ResourceWebAccel,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceWebAccel,
 	}
 }
 
@@ -898,12 +1404,18 @@ func NewWebAccelOp() sacloud.WebAccelAPI {
 
 // ZoneOp is fake implementation of ZoneAPI interface
 type ZoneOp struct {
+	storeAccessor
 	key string
 }
 
 // NewZoneOp creates new ZoneOp instance
 func NewZoneOp() sacloud.ZoneAPI {
+	return newZoneOp(nil)
+}
+
+func newZoneOp(store Store) *ZoneOp {
 	return &ZoneOp{
-		key: ResourceZone,
+		storeAccessor: storeAccessor{store: store},
+		key:           ResourceZone,
 	}
 }
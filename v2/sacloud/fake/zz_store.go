@@ -22,8 +22,8 @@ import (
 	"github.com/sacloud/libsacloud/v2/sacloud/types"
 )
 
-func getArchive(zone string) []*sacloud.Archive {
-	values := ds().List(ResourceArchive, zone)
+func getArchive(s Store, zone string) []*sacloud.Archive {
+	values := s.List(ResourceArchive, zone)
 	var ret []*sacloud.Archive
 	for _, v := range values {
 		if v, ok := v.(*sacloud.Archive); ok {
@@ -33,25 +33,25 @@ func getArchive(zone string) []*sacloud.Archive {
 	return ret
 }
 
-func getArchiveByID(zone string, id types.ID) *sacloud.Archive {
-	v := ds().Get(ResourceArchive, zone, id)
+func getArchiveByID(s Store, zone string, id types.ID) *sacloud.Archive {
+	v := s.Get(ResourceArchive, zone, id)
 	if v, ok := v.(*sacloud.Archive); ok {
 		return v
 	}
 	return nil
 }
 
-func putArchive(zone string, value *sacloud.Archive) {
+func putArchive(s Store, zone string, value *sacloud.Archive) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceArchive, zone, id.GetID(), value)
+		s.Put(ResourceArchive, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceArchive, zone, 0, value)
+	s.Put(ResourceArchive, zone, 0, value)
 }
 
-func getAuthStatus(zone string) []*sacloud.AuthStatus {
-	values := ds().List(ResourceAuthStatus, zone)
+func getAuthStatus(s Store, zone string) []*sacloud.AuthStatus {
+	values := s.List(ResourceAuthStatus, zone)
 	var ret []*sacloud.AuthStatus
 	for _, v := range values {
 		if v, ok := v.(*sacloud.AuthStatus); ok {
@@ -61,25 +61,25 @@ func getAuthStatus(zone string) []*sacloud.AuthStatus {
 	return ret
 }
 
-func getAuthStatusByID(zone string, id types.ID) *sacloud.AuthStatus {
-	v := ds().Get(ResourceAuthStatus, zone, id)
+func getAuthStatusByID(s Store, zone string, id types.ID) *sacloud.AuthStatus {
+	v := s.Get(ResourceAuthStatus, zone, id)
 	if v, ok := v.(*sacloud.AuthStatus); ok {
 		return v
 	}
 	return nil
 }
 
-func putAuthStatus(zone string, value *sacloud.AuthStatus) {
+func putAuthStatus(s Store, zone string, value *sacloud.AuthStatus) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceAuthStatus, zone, id.GetID(), value)
+		s.Put(ResourceAuthStatus, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceAuthStatus, zone, 0, value)
+	s.Put(ResourceAuthStatus, zone, 0, value)
 }
 
-func getAutoBackup(zone string) []*sacloud.AutoBackup {
-	values := ds().List(ResourceAutoBackup, zone)
+func getAutoBackup(s Store, zone string) []*sacloud.AutoBackup {
+	values := s.List(ResourceAutoBackup, zone)
 	var ret []*sacloud.AutoBackup
 	for _, v := range values {
 		if v, ok := v.(*sacloud.AutoBackup); ok {
@@ -89,25 +89,25 @@ func getAutoBackup(zone string) []*sacloud.AutoBackup {
 	return ret
 }
 
-func getAutoBackupByID(zone string, id types.ID) *sacloud.AutoBackup {
-	v := ds().Get(ResourceAutoBackup, zone, id)
+func getAutoBackupByID(s Store, zone string, id types.ID) *sacloud.AutoBackup {
+	v := s.Get(ResourceAutoBackup, zone, id)
 	if v, ok := v.(*sacloud.AutoBackup); ok {
 		return v
 	}
 	return nil
 }
 
-func putAutoBackup(zone string, value *sacloud.AutoBackup) {
+func putAutoBackup(s Store, zone string, value *sacloud.AutoBackup) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceAutoBackup, zone, id.GetID(), value)
+		s.Put(ResourceAutoBackup, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceAutoBackup, zone, 0, value)
+	s.Put(ResourceAutoBackup, zone, 0, value)
 }
 
-func getBill(zone string) []*sacloud.Bill {
-	values := ds().List(ResourceBill, zone)
+func getBill(s Store, zone string) []*sacloud.Bill {
+	values := s.List(ResourceBill, zone)
 	var ret []*sacloud.Bill
 	for _, v := range values {
 		if v, ok := v.(*sacloud.Bill); ok {
@@ -117,25 +117,25 @@ func getBill(zone string) []*sacloud.Bill {
 	return ret
 }
 
-func getBillByID(zone string, id types.ID) *sacloud.Bill {
-	v := ds().Get(ResourceBill, zone, id)
+func getBillByID(s Store, zone string, id types.ID) *sacloud.Bill {
+	v := s.Get(ResourceBill, zone, id)
 	if v, ok := v.(*sacloud.Bill); ok {
 		return v
 	}
 	return nil
 }
 
-func putBill(zone string, value *sacloud.Bill) {
+func putBill(s Store, zone string, value *sacloud.Bill) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceBill, zone, id.GetID(), value)
+		s.Put(ResourceBill, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceBill, zone, 0, value)
+	s.Put(ResourceBill, zone, 0, value)
 }
 
-func getBridge(zone string) []*sacloud.Bridge {
-	values := ds().List(ResourceBridge, zone)
+func getBridge(s Store, zone string) []*sacloud.Bridge {
+	values := s.List(ResourceBridge, zone)
 	var ret []*sacloud.Bridge
 	for _, v := range values {
 		if v, ok := v.(*sacloud.Bridge); ok {
@@ -145,25 +145,25 @@ func getBridge(zone string) []*sacloud.Bridge {
 	return ret
 }
 
-func getBridgeByID(zone string, id types.ID) *sacloud.Bridge {
-	v := ds().Get(ResourceBridge, zone, id)
+func getBridgeByID(s Store, zone string, id types.ID) *sacloud.Bridge {
+	v := s.Get(ResourceBridge, zone, id)
 	if v, ok := v.(*sacloud.Bridge); ok {
 		return v
 	}
 	return nil
 }
 
-func putBridge(zone string, value *sacloud.Bridge) {
+func putBridge(s Store, zone string, value *sacloud.Bridge) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceBridge, zone, id.GetID(), value)
+		s.Put(ResourceBridge, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceBridge, zone, 0, value)
+	s.Put(ResourceBridge, zone, 0, value)
 }
 
-func getCDROM(zone string) []*sacloud.CDROM {
-	values := ds().List(ResourceCDROM, zone)
+func getCDROM(s Store, zone string) []*sacloud.CDROM {
+	values := s.List(ResourceCDROM, zone)
 	var ret []*sacloud.CDROM
 	for _, v := range values {
 		if v, ok := v.(*sacloud.CDROM); ok {
@@ -173,25 +173,25 @@ func getCDROM(zone string) []*sacloud.CDROM {
 	return ret
 }
 
-func getCDROMByID(zone string, id types.ID) *sacloud.CDROM {
-	v := ds().Get(ResourceCDROM, zone, id)
+func getCDROMByID(s Store, zone string, id types.ID) *sacloud.CDROM {
+	v := s.Get(ResourceCDROM, zone, id)
 	if v, ok := v.(*sacloud.CDROM); ok {
 		return v
 	}
 	return nil
 }
 
-func putCDROM(zone string, value *sacloud.CDROM) {
+func putCDROM(s Store, zone string, value *sacloud.CDROM) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceCDROM, zone, id.GetID(), value)
+		s.Put(ResourceCDROM, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceCDROM, zone, 0, value)
+	s.Put(ResourceCDROM, zone, 0, value)
 }
 
-func getCertificateAuthority(zone string) []*sacloud.CertificateAuthority {
-	values := ds().List(ResourceCertificateAuthority, zone)
+func getCertificateAuthority(s Store, zone string) []*sacloud.CertificateAuthority {
+	values := s.List(ResourceCertificateAuthority, zone)
 	var ret []*sacloud.CertificateAuthority
 	for _, v := range values {
 		if v, ok := v.(*sacloud.CertificateAuthority); ok {
@@ -201,25 +201,25 @@ func getCertificateAuthority(zone string) []*sacloud.CertificateAuthority {
 	return ret
 }
 
-func getCertificateAuthorityByID(zone string, id types.ID) *sacloud.CertificateAuthority {
-	v := ds().Get(ResourceCertificateAuthority, zone, id)
+func getCertificateAuthorityByID(s Store, zone string, id types.ID) *sacloud.CertificateAuthority {
+	v := s.Get(ResourceCertificateAuthority, zone, id)
 	if v, ok := v.(*sacloud.CertificateAuthority); ok {
 		return v
 	}
 	return nil
 }
 
-func putCertificateAuthority(zone string, value *sacloud.CertificateAuthority) {
+func putCertificateAuthority(s Store, zone string, value *sacloud.CertificateAuthority) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceCertificateAuthority, zone, id.GetID(), value)
+		s.Put(ResourceCertificateAuthority, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceCertificateAuthority, zone, 0, value)
+	s.Put(ResourceCertificateAuthority, zone, 0, value)
 }
 
-func getContainerRegistry(zone string) []*sacloud.ContainerRegistry {
-	values := ds().List(ResourceContainerRegistry, zone)
+func getContainerRegistry(s Store, zone string) []*sacloud.ContainerRegistry {
+	values := s.List(ResourceContainerRegistry, zone)
 	var ret []*sacloud.ContainerRegistry
 	for _, v := range values {
 		if v, ok := v.(*sacloud.ContainerRegistry); ok {
@@ -229,25 +229,25 @@ func getContainerRegistry(zone string) []*sacloud.ContainerRegistry {
 	return ret
 }
 
-func getContainerRegistryByID(zone string, id types.ID) *sacloud.ContainerRegistry {
-	v := ds().Get(ResourceContainerRegistry, zone, id)
+func getContainerRegistryByID(s Store, zone string, id types.ID) *sacloud.ContainerRegistry {
+	v := s.Get(ResourceContainerRegistry, zone, id)
 	if v, ok := v.(*sacloud.ContainerRegistry); ok {
 		return v
 	}
 	return nil
 }
 
-func putContainerRegistry(zone string, value *sacloud.ContainerRegistry) {
+func putContainerRegistry(s Store, zone string, value *sacloud.ContainerRegistry) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceContainerRegistry, zone, id.GetID(), value)
+		s.Put(ResourceContainerRegistry, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceContainerRegistry, zone, 0, value)
+	s.Put(ResourceContainerRegistry, zone, 0, value)
 }
 
-func getCoupon(zone string) []*sacloud.Coupon {
-	values := ds().List(ResourceCoupon, zone)
+func getCoupon(s Store, zone string) []*sacloud.Coupon {
+	values := s.List(ResourceCoupon, zone)
 	var ret []*sacloud.Coupon
 	for _, v := range values {
 		if v, ok := v.(*sacloud.Coupon); ok {
@@ -257,25 +257,25 @@ func getCoupon(zone string) []*sacloud.Coupon {
 	return ret
 }
 
-func getCouponByID(zone string, id types.ID) *sacloud.Coupon {
-	v := ds().Get(ResourceCoupon, zone, id)
+func getCouponByID(s Store, zone string, id types.ID) *sacloud.Coupon {
+	v := s.Get(ResourceCoupon, zone, id)
 	if v, ok := v.(*sacloud.Coupon); ok {
 		return v
 	}
 	return nil
 }
 
-func putCoupon(zone string, value *sacloud.Coupon) {
+func putCoupon(s Store, zone string, value *sacloud.Coupon) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceCoupon, zone, id.GetID(), value)
+		s.Put(ResourceCoupon, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceCoupon, zone, 0, value)
+	s.Put(ResourceCoupon, zone, 0, value)
 }
 
-func getDatabase(zone string) []*sacloud.Database {
-	values := ds().List(ResourceDatabase, zone)
+func getDatabase(s Store, zone string) []*sacloud.Database {
+	values := s.List(ResourceDatabase, zone)
 	var ret []*sacloud.Database
 	for _, v := range values {
 		if v, ok := v.(*sacloud.Database); ok {
@@ -285,25 +285,25 @@ func getDatabase(zone string) []*sacloud.Database {
 	return ret
 }
 
-func getDatabaseByID(zone string, id types.ID) *sacloud.Database {
-	v := ds().Get(ResourceDatabase, zone, id)
+func getDatabaseByID(s Store, zone string, id types.ID) *sacloud.Database {
+	v := s.Get(ResourceDatabase, zone, id)
 	if v, ok := v.(*sacloud.Database); ok {
 		return v
 	}
 	return nil
 }
 
-func putDatabase(zone string, value *sacloud.Database) {
+func putDatabase(s Store, zone string, value *sacloud.Database) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceDatabase, zone, id.GetID(), value)
+		s.Put(ResourceDatabase, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceDatabase, zone, 0, value)
+	s.Put(ResourceDatabase, zone, 0, value)
 }
 
-func getDisk(zone string) []*sacloud.Disk {
-	values := ds().List(ResourceDisk, zone)
+func getDisk(s Store, zone string) []*sacloud.Disk {
+	values := s.List(ResourceDisk, zone)
 	var ret []*sacloud.Disk
 	for _, v := range values {
 		if v, ok := v.(*sacloud.Disk); ok {
@@ -313,25 +313,25 @@ func getDisk(zone string) []*sacloud.Disk {
 	return ret
 }
 
-func getDiskByID(zone string, id types.ID) *sacloud.Disk {
-	v := ds().Get(ResourceDisk, zone, id)
+func getDiskByID(s Store, zone string, id types.ID) *sacloud.Disk {
+	v := s.Get(ResourceDisk, zone, id)
 	if v, ok := v.(*sacloud.Disk); ok {
 		return v
 	}
 	return nil
 }
 
-func putDisk(zone string, value *sacloud.Disk) {
+func putDisk(s Store, zone string, value *sacloud.Disk) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceDisk, zone, id.GetID(), value)
+		s.Put(ResourceDisk, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceDisk, zone, 0, value)
+	s.Put(ResourceDisk, zone, 0, value)
 }
 
-func getDiskPlan(zone string) []*sacloud.DiskPlan {
-	values := ds().List(ResourceDiskPlan, zone)
+func getDiskPlan(s Store, zone string) []*sacloud.DiskPlan {
+	values := s.List(ResourceDiskPlan, zone)
 	var ret []*sacloud.DiskPlan
 	for _, v := range values {
 		if v, ok := v.(*sacloud.DiskPlan); ok {
@@ -341,25 +341,25 @@ func getDiskPlan(zone string) []*sacloud.DiskPlan {
 	return ret
 }
 
-func getDiskPlanByID(zone string, id types.ID) *sacloud.DiskPlan {
-	v := ds().Get(ResourceDiskPlan, zone, id)
+func getDiskPlanByID(s Store, zone string, id types.ID) *sacloud.DiskPlan {
+	v := s.Get(ResourceDiskPlan, zone, id)
 	if v, ok := v.(*sacloud.DiskPlan); ok {
 		return v
 	}
 	return nil
 }
 
-func putDiskPlan(zone string, value *sacloud.DiskPlan) {
+func putDiskPlan(s Store, zone string, value *sacloud.DiskPlan) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceDiskPlan, zone, id.GetID(), value)
+		s.Put(ResourceDiskPlan, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceDiskPlan, zone, 0, value)
+	s.Put(ResourceDiskPlan, zone, 0, value)
 }
 
-func getDNS(zone string) []*sacloud.DNS {
-	values := ds().List(ResourceDNS, zone)
+func getDNS(s Store, zone string) []*sacloud.DNS {
+	values := s.List(ResourceDNS, zone)
 	var ret []*sacloud.DNS
 	for _, v := range values {
 		if v, ok := v.(*sacloud.DNS); ok {
@@ -369,25 +369,25 @@ func getDNS(zone string) []*sacloud.DNS {
 	return ret
 }
 
-func getDNSByID(zone string, id types.ID) *sacloud.DNS {
-	v := ds().Get(ResourceDNS, zone, id)
+func getDNSByID(s Store, zone string, id types.ID) *sacloud.DNS {
+	v := s.Get(ResourceDNS, zone, id)
 	if v, ok := v.(*sacloud.DNS); ok {
 		return v
 	}
 	return nil
 }
 
-func putDNS(zone string, value *sacloud.DNS) {
+func putDNS(s Store, zone string, value *sacloud.DNS) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceDNS, zone, id.GetID(), value)
+		s.Put(ResourceDNS, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceDNS, zone, 0, value)
+	s.Put(ResourceDNS, zone, 0, value)
 }
 
-func getEnhancedDB(zone string) []*sacloud.EnhancedDB {
-	values := ds().List(ResourceEnhancedDB, zone)
+func getEnhancedDB(s Store, zone string) []*sacloud.EnhancedDB {
+	values := s.List(ResourceEnhancedDB, zone)
 	var ret []*sacloud.EnhancedDB
 	for _, v := range values {
 		if v, ok := v.(*sacloud.EnhancedDB); ok {
@@ -397,25 +397,25 @@ func getEnhancedDB(zone string) []*sacloud.EnhancedDB {
 	return ret
 }
 
-func getEnhancedDBByID(zone string, id types.ID) *sacloud.EnhancedDB {
-	v := ds().Get(ResourceEnhancedDB, zone, id)
+func getEnhancedDBByID(s Store, zone string, id types.ID) *sacloud.EnhancedDB {
+	v := s.Get(ResourceEnhancedDB, zone, id)
 	if v, ok := v.(*sacloud.EnhancedDB); ok {
 		return v
 	}
 	return nil
 }
 
-func putEnhancedDB(zone string, value *sacloud.EnhancedDB) {
+func putEnhancedDB(s Store, zone string, value *sacloud.EnhancedDB) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceEnhancedDB, zone, id.GetID(), value)
+		s.Put(ResourceEnhancedDB, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceEnhancedDB, zone, 0, value)
+	s.Put(ResourceEnhancedDB, zone, 0, value)
 }
 
-func getESME(zone string) []*sacloud.ESME {
-	values := ds().List(ResourceESME, zone)
+func getESME(s Store, zone string) []*sacloud.ESME {
+	values := s.List(ResourceESME, zone)
 	var ret []*sacloud.ESME
 	for _, v := range values {
 		if v, ok := v.(*sacloud.ESME); ok {
@@ -425,25 +425,25 @@ func getESME(zone string) []*sacloud.ESME {
 	return ret
 }
 
-func getESMEByID(zone string, id types.ID) *sacloud.ESME {
-	v := ds().Get(ResourceESME, zone, id)
+func getESMEByID(s Store, zone string, id types.ID) *sacloud.ESME {
+	v := s.Get(ResourceESME, zone, id)
 	if v, ok := v.(*sacloud.ESME); ok {
 		return v
 	}
 	return nil
 }
 
-func putESME(zone string, value *sacloud.ESME) {
+func putESME(s Store, zone string, value *sacloud.ESME) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceESME, zone, id.GetID(), value)
+		s.Put(ResourceESME, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceESME, zone, 0, value)
+	s.Put(ResourceESME, zone, 0, value)
 }
 
-func getGSLB(zone string) []*sacloud.GSLB {
-	values := ds().List(ResourceGSLB, zone)
+func getGSLB(s Store, zone string) []*sacloud.GSLB {
+	values := s.List(ResourceGSLB, zone)
 	var ret []*sacloud.GSLB
 	for _, v := range values {
 		if v, ok := v.(*sacloud.GSLB); ok {
@@ -453,25 +453,25 @@ func getGSLB(zone string) []*sacloud.GSLB {
 	return ret
 }
 
-func getGSLBByID(zone string, id types.ID) *sacloud.GSLB {
-	v := ds().Get(ResourceGSLB, zone, id)
+func getGSLBByID(s Store, zone string, id types.ID) *sacloud.GSLB {
+	v := s.Get(ResourceGSLB, zone, id)
 	if v, ok := v.(*sacloud.GSLB); ok {
 		return v
 	}
 	return nil
 }
 
-func putGSLB(zone string, value *sacloud.GSLB) {
+func putGSLB(s Store, zone string, value *sacloud.GSLB) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceGSLB, zone, id.GetID(), value)
+		s.Put(ResourceGSLB, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceGSLB, zone, 0, value)
+	s.Put(ResourceGSLB, zone, 0, value)
 }
 
-func getIcon(zone string) []*sacloud.Icon {
-	values := ds().List(ResourceIcon, zone)
+func getIcon(s Store, zone string) []*sacloud.Icon {
+	values := s.List(ResourceIcon, zone)
 	var ret []*sacloud.Icon
 	for _, v := range values {
 		if v, ok := v.(*sacloud.Icon); ok {
@@ -481,25 +481,25 @@ func getIcon(zone string) []*sacloud.Icon {
 	return ret
 }
 
-func getIconByID(zone string, id types.ID) *sacloud.Icon {
-	v := ds().Get(ResourceIcon, zone, id)
+func getIconByID(s Store, zone string, id types.ID) *sacloud.Icon {
+	v := s.Get(ResourceIcon, zone, id)
 	if v, ok := v.(*sacloud.Icon); ok {
 		return v
 	}
 	return nil
 }
 
-func putIcon(zone string, value *sacloud.Icon) {
+func putIcon(s Store, zone string, value *sacloud.Icon) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceIcon, zone, id.GetID(), value)
+		s.Put(ResourceIcon, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceIcon, zone, 0, value)
+	s.Put(ResourceIcon, zone, 0, value)
 }
 
-func getInterface(zone string) []*sacloud.Interface {
-	values := ds().List(ResourceInterface, zone)
+func getInterface(s Store, zone string) []*sacloud.Interface {
+	values := s.List(ResourceInterface, zone)
 	var ret []*sacloud.Interface
 	for _, v := range values {
 		if v, ok := v.(*sacloud.Interface); ok {
@@ -509,25 +509,25 @@ func getInterface(zone string) []*sacloud.Interface {
 	return ret
 }
 
-func getInterfaceByID(zone string, id types.ID) *sacloud.Interface {
-	v := ds().Get(ResourceInterface, zone, id)
+func getInterfaceByID(s Store, zone string, id types.ID) *sacloud.Interface {
+	v := s.Get(ResourceInterface, zone, id)
 	if v, ok := v.(*sacloud.Interface); ok {
 		return v
 	}
 	return nil
 }
 
-func putInterface(zone string, value *sacloud.Interface) {
+func putInterface(s Store, zone string, value *sacloud.Interface) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceInterface, zone, id.GetID(), value)
+		s.Put(ResourceInterface, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceInterface, zone, 0, value)
+	s.Put(ResourceInterface, zone, 0, value)
 }
 
-func getInternet(zone string) []*sacloud.Internet {
-	values := ds().List(ResourceInternet, zone)
+func getInternet(s Store, zone string) []*sacloud.Internet {
+	values := s.List(ResourceInternet, zone)
 	var ret []*sacloud.Internet
 	for _, v := range values {
 		if v, ok := v.(*sacloud.Internet); ok {
@@ -537,25 +537,25 @@ func getInternet(zone string) []*sacloud.Internet {
 	return ret
 }
 
-func getInternetByID(zone string, id types.ID) *sacloud.Internet {
-	v := ds().Get(ResourceInternet, zone, id)
+func getInternetByID(s Store, zone string, id types.ID) *sacloud.Internet {
+	v := s.Get(ResourceInternet, zone, id)
 	if v, ok := v.(*sacloud.Internet); ok {
 		return v
 	}
 	return nil
 }
 
-func putInternet(zone string, value *sacloud.Internet) {
+func putInternet(s Store, zone string, value *sacloud.Internet) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceInternet, zone, id.GetID(), value)
+		s.Put(ResourceInternet, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceInternet, zone, 0, value)
+	s.Put(ResourceInternet, zone, 0, value)
 }
 
-func getInternetPlan(zone string) []*sacloud.InternetPlan {
-	values := ds().List(ResourceInternetPlan, zone)
+func getInternetPlan(s Store, zone string) []*sacloud.InternetPlan {
+	values := s.List(ResourceInternetPlan, zone)
 	var ret []*sacloud.InternetPlan
 	for _, v := range values {
 		if v, ok := v.(*sacloud.InternetPlan); ok {
@@ -565,25 +565,25 @@ func getInternetPlan(zone string) []*sacloud.InternetPlan {
 	return ret
 }
 
-func getInternetPlanByID(zone string, id types.ID) *sacloud.InternetPlan {
-	v := ds().Get(ResourceInternetPlan, zone, id)
+func getInternetPlanByID(s Store, zone string, id types.ID) *sacloud.InternetPlan {
+	v := s.Get(ResourceInternetPlan, zone, id)
 	if v, ok := v.(*sacloud.InternetPlan); ok {
 		return v
 	}
 	return nil
 }
 
-func putInternetPlan(zone string, value *sacloud.InternetPlan) {
+func putInternetPlan(s Store, zone string, value *sacloud.InternetPlan) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceInternetPlan, zone, id.GetID(), value)
+		s.Put(ResourceInternetPlan, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceInternetPlan, zone, 0, value)
+	s.Put(ResourceInternetPlan, zone, 0, value)
 }
 
-func getIPAddress(zone string) []*sacloud.IPAddress {
-	values := ds().List(ResourceIPAddress, zone)
+func getIPAddress(s Store, zone string) []*sacloud.IPAddress {
+	values := s.List(ResourceIPAddress, zone)
 	var ret []*sacloud.IPAddress
 	for _, v := range values {
 		if v, ok := v.(*sacloud.IPAddress); ok {
@@ -593,25 +593,25 @@ func getIPAddress(zone string) []*sacloud.IPAddress {
 	return ret
 }
 
-func getIPAddressByID(zone string, id types.ID) *sacloud.IPAddress {
-	v := ds().Get(ResourceIPAddress, zone, id)
+func getIPAddressByID(s Store, zone string, id types.ID) *sacloud.IPAddress {
+	v := s.Get(ResourceIPAddress, zone, id)
 	if v, ok := v.(*sacloud.IPAddress); ok {
 		return v
 	}
 	return nil
 }
 
-func putIPAddress(zone string, value *sacloud.IPAddress) {
+func putIPAddress(s Store, zone string, value *sacloud.IPAddress) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceIPAddress, zone, id.GetID(), value)
+		s.Put(ResourceIPAddress, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceIPAddress, zone, 0, value)
+	s.Put(ResourceIPAddress, zone, 0, value)
 }
 
-func getIPv6Net(zone string) []*sacloud.IPv6Net {
-	values := ds().List(ResourceIPv6Net, zone)
+func getIPv6Net(s Store, zone string) []*sacloud.IPv6Net {
+	values := s.List(ResourceIPv6Net, zone)
 	var ret []*sacloud.IPv6Net
 	for _, v := range values {
 		if v, ok := v.(*sacloud.IPv6Net); ok {
@@ -621,25 +621,25 @@ func getIPv6Net(zone string) []*sacloud.IPv6Net {
 	return ret
 }
 
-func getIPv6NetByID(zone string, id types.ID) *sacloud.IPv6Net {
-	v := ds().Get(ResourceIPv6Net, zone, id)
+func getIPv6NetByID(s Store, zone string, id types.ID) *sacloud.IPv6Net {
+	v := s.Get(ResourceIPv6Net, zone, id)
 	if v, ok := v.(*sacloud.IPv6Net); ok {
 		return v
 	}
 	return nil
 }
 
-func putIPv6Net(zone string, value *sacloud.IPv6Net) {
+func putIPv6Net(s Store, zone string, value *sacloud.IPv6Net) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceIPv6Net, zone, id.GetID(), value)
+		s.Put(ResourceIPv6Net, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceIPv6Net, zone, 0, value)
+	s.Put(ResourceIPv6Net, zone, 0, value)
 }
 
-func getIPv6Addr(zone string) []*sacloud.IPv6Addr {
-	values := ds().List(ResourceIPv6Addr, zone)
+func getIPv6Addr(s Store, zone string) []*sacloud.IPv6Addr {
+	values := s.List(ResourceIPv6Addr, zone)
 	var ret []*sacloud.IPv6Addr
 	for _, v := range values {
 		if v, ok := v.(*sacloud.IPv6Addr); ok {
@@ -649,25 +649,25 @@ func getIPv6Addr(zone string) []*sacloud.IPv6Addr {
 	return ret
 }
 
-func getIPv6AddrByID(zone string, id types.ID) *sacloud.IPv6Addr {
-	v := ds().Get(ResourceIPv6Addr, zone, id)
+func getIPv6AddrByID(s Store, zone string, id types.ID) *sacloud.IPv6Addr {
+	v := s.Get(ResourceIPv6Addr, zone, id)
 	if v, ok := v.(*sacloud.IPv6Addr); ok {
 		return v
 	}
 	return nil
 }
 
-func putIPv6Addr(zone string, value *sacloud.IPv6Addr) {
+func putIPv6Addr(s Store, zone string, value *sacloud.IPv6Addr) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceIPv6Addr, zone, id.GetID(), value)
+		s.Put(ResourceIPv6Addr, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceIPv6Addr, zone, 0, value)
+	s.Put(ResourceIPv6Addr, zone, 0, value)
 }
 
-func getLicense(zone string) []*sacloud.License {
-	values := ds().List(ResourceLicense, zone)
+func getLicense(s Store, zone string) []*sacloud.License {
+	values := s.List(ResourceLicense, zone)
 	var ret []*sacloud.License
 	for _, v := range values {
 		if v, ok := v.(*sacloud.License); ok {
@@ -677,25 +677,25 @@ func getLicense(zone string) []*sacloud.License {
 	return ret
 }
 
-func getLicenseByID(zone string, id types.ID) *sacloud.License {
-	v := ds().Get(ResourceLicense, zone, id)
+func getLicenseByID(s Store, zone string, id types.ID) *sacloud.License {
+	v := s.Get(ResourceLicense, zone, id)
 	if v, ok := v.(*sacloud.License); ok {
 		return v
 	}
 	return nil
 }
 
-func putLicense(zone string, value *sacloud.License) {
+func putLicense(s Store, zone string, value *sacloud.License) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceLicense, zone, id.GetID(), value)
+		s.Put(ResourceLicense, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceLicense, zone, 0, value)
+	s.Put(ResourceLicense, zone, 0, value)
 }
 
-func getLicenseInfo(zone string) []*sacloud.LicenseInfo {
-	values := ds().List(ResourceLicenseInfo, zone)
+func getLicenseInfo(s Store, zone string) []*sacloud.LicenseInfo {
+	values := s.List(ResourceLicenseInfo, zone)
 	var ret []*sacloud.LicenseInfo
 	for _, v := range values {
 		if v, ok := v.(*sacloud.LicenseInfo); ok {
@@ -705,25 +705,25 @@ func getLicenseInfo(zone string) []*sacloud.LicenseInfo {
 	return ret
 }
 
-func getLicenseInfoByID(zone string, id types.ID) *sacloud.LicenseInfo {
-	v := ds().Get(ResourceLicenseInfo, zone, id)
+func getLicenseInfoByID(s Store, zone string, id types.ID) *sacloud.LicenseInfo {
+	v := s.Get(ResourceLicenseInfo, zone, id)
 	if v, ok := v.(*sacloud.LicenseInfo); ok {
 		return v
 	}
 	return nil
 }
 
-func putLicenseInfo(zone string, value *sacloud.LicenseInfo) {
+func putLicenseInfo(s Store, zone string, value *sacloud.LicenseInfo) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceLicenseInfo, zone, id.GetID(), value)
+		s.Put(ResourceLicenseInfo, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceLicenseInfo, zone, 0, value)
+	s.Put(ResourceLicenseInfo, zone, 0, value)
 }
 
-func getLoadBalancer(zone string) []*sacloud.LoadBalancer {
-	values := ds().List(ResourceLoadBalancer, zone)
+func getLoadBalancer(s Store, zone string) []*sacloud.LoadBalancer {
+	values := s.List(ResourceLoadBalancer, zone)
 	var ret []*sacloud.LoadBalancer
 	for _, v := range values {
 		if v, ok := v.(*sacloud.LoadBalancer); ok {
@@ -733,25 +733,25 @@ func getLoadBalancer(zone string) []*sacloud.LoadBalancer {
 	return ret
 }
 
-func getLoadBalancerByID(zone string, id types.ID) *sacloud.LoadBalancer {
-	v := ds().Get(ResourceLoadBalancer, zone, id)
+func getLoadBalancerByID(s Store, zone string, id types.ID) *sacloud.LoadBalancer {
+	v := s.Get(ResourceLoadBalancer, zone, id)
 	if v, ok := v.(*sacloud.LoadBalancer); ok {
 		return v
 	}
 	return nil
 }
 
-func putLoadBalancer(zone string, value *sacloud.LoadBalancer) {
+func putLoadBalancer(s Store, zone string, value *sacloud.LoadBalancer) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceLoadBalancer, zone, id.GetID(), value)
+		s.Put(ResourceLoadBalancer, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceLoadBalancer, zone, 0, value)
+	s.Put(ResourceLoadBalancer, zone, 0, value)
 }
 
-func getLocalRouter(zone string) []*sacloud.LocalRouter {
-	values := ds().List(ResourceLocalRouter, zone)
+func getLocalRouter(s Store, zone string) []*sacloud.LocalRouter {
+	values := s.List(ResourceLocalRouter, zone)
 	var ret []*sacloud.LocalRouter
 	for _, v := range values {
 		if v, ok := v.(*sacloud.LocalRouter); ok {
@@ -761,25 +761,25 @@ func getLocalRouter(zone string) []*sacloud.LocalRouter {
 	return ret
 }
 
-func getLocalRouterByID(zone string, id types.ID) *sacloud.LocalRouter {
-	v := ds().Get(ResourceLocalRouter, zone, id)
+func getLocalRouterByID(s Store, zone string, id types.ID) *sacloud.LocalRouter {
+	v := s.Get(ResourceLocalRouter, zone, id)
 	if v, ok := v.(*sacloud.LocalRouter); ok {
 		return v
 	}
 	return nil
 }
 
-func putLocalRouter(zone string, value *sacloud.LocalRouter) {
+func putLocalRouter(s Store, zone string, value *sacloud.LocalRouter) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceLocalRouter, zone, id.GetID(), value)
+		s.Put(ResourceLocalRouter, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceLocalRouter, zone, 0, value)
+	s.Put(ResourceLocalRouter, zone, 0, value)
 }
 
-func getMobileGateway(zone string) []*sacloud.MobileGateway {
-	values := ds().List(ResourceMobileGateway, zone)
+func getMobileGateway(s Store, zone string) []*sacloud.MobileGateway {
+	values := s.List(ResourceMobileGateway, zone)
 	var ret []*sacloud.MobileGateway
 	for _, v := range values {
 		if v, ok := v.(*sacloud.MobileGateway); ok {
@@ -789,25 +789,25 @@ func getMobileGateway(zone string) []*sacloud.MobileGateway {
 	return ret
 }
 
-func getMobileGatewayByID(zone string, id types.ID) *sacloud.MobileGateway {
-	v := ds().Get(ResourceMobileGateway, zone, id)
+func getMobileGatewayByID(s Store, zone string, id types.ID) *sacloud.MobileGateway {
+	v := s.Get(ResourceMobileGateway, zone, id)
 	if v, ok := v.(*sacloud.MobileGateway); ok {
 		return v
 	}
 	return nil
 }
 
-func putMobileGateway(zone string, value *sacloud.MobileGateway) {
+func putMobileGateway(s Store, zone string, value *sacloud.MobileGateway) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceMobileGateway, zone, id.GetID(), value)
+		s.Put(ResourceMobileGateway, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceMobileGateway, zone, 0, value)
+	s.Put(ResourceMobileGateway, zone, 0, value)
 }
 
-func getNFS(zone string) []*sacloud.NFS {
-	values := ds().List(ResourceNFS, zone)
+func getNFS(s Store, zone string) []*sacloud.NFS {
+	values := s.List(ResourceNFS, zone)
 	var ret []*sacloud.NFS
 	for _, v := range values {
 		if v, ok := v.(*sacloud.NFS); ok {
@@ -817,25 +817,25 @@ func getNFS(zone string) []*sacloud.NFS {
 	return ret
 }
 
-func getNFSByID(zone string, id types.ID) *sacloud.NFS {
-	v := ds().Get(ResourceNFS, zone, id)
+func getNFSByID(s Store, zone string, id types.ID) *sacloud.NFS {
+	v := s.Get(ResourceNFS, zone, id)
 	if v, ok := v.(*sacloud.NFS); ok {
 		return v
 	}
 	return nil
 }
 
-func putNFS(zone string, value *sacloud.NFS) {
+func putNFS(s Store, zone string, value *sacloud.NFS) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceNFS, zone, id.GetID(), value)
+		s.Put(ResourceNFS, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceNFS, zone, 0, value)
+	s.Put(ResourceNFS, zone, 0, value)
 }
 
-func getNote(zone string) []*sacloud.Note {
-	values := ds().List(ResourceNote, zone)
+func getNote(s Store, zone string) []*sacloud.Note {
+	values := s.List(ResourceNote, zone)
 	var ret []*sacloud.Note
 	for _, v := range values {
 		if v, ok := v.(*sacloud.Note); ok {
@@ -845,25 +845,25 @@ func getNote(zone string) []*sacloud.Note {
 	return ret
 }
 
-func getNoteByID(zone string, id types.ID) *sacloud.Note {
-	v := ds().Get(ResourceNote, zone, id)
+func getNoteByID(s Store, zone string, id types.ID) *sacloud.Note {
+	v := s.Get(ResourceNote, zone, id)
 	if v, ok := v.(*sacloud.Note); ok {
 		return v
 	}
 	return nil
 }
 
-func putNote(zone string, value *sacloud.Note) {
+func putNote(s Store, zone string, value *sacloud.Note) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceNote, zone, id.GetID(), value)
+		s.Put(ResourceNote, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceNote, zone, 0, value)
+	s.Put(ResourceNote, zone, 0, value)
 }
 
-func getPacketFilter(zone string) []*sacloud.PacketFilter {
-	values := ds().List(ResourcePacketFilter, zone)
+func getPacketFilter(s Store, zone string) []*sacloud.PacketFilter {
+	values := s.List(ResourcePacketFilter, zone)
 	var ret []*sacloud.PacketFilter
 	for _, v := range values {
 		if v, ok := v.(*sacloud.PacketFilter); ok {
@@ -873,25 +873,25 @@ func getPacketFilter(zone string) []*sacloud.PacketFilter {
 	return ret
 }
 
-func getPacketFilterByID(zone string, id types.ID) *sacloud.PacketFilter {
-	v := ds().Get(ResourcePacketFilter, zone, id)
+func getPacketFilterByID(s Store, zone string, id types.ID) *sacloud.PacketFilter {
+	v := s.Get(ResourcePacketFilter, zone, id)
 	if v, ok := v.(*sacloud.PacketFilter); ok {
 		return v
 	}
 	return nil
 }
 
-func putPacketFilter(zone string, value *sacloud.PacketFilter) {
+func putPacketFilter(s Store, zone string, value *sacloud.PacketFilter) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourcePacketFilter, zone, id.GetID(), value)
+		s.Put(ResourcePacketFilter, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourcePacketFilter, zone, 0, value)
+	s.Put(ResourcePacketFilter, zone, 0, value)
 }
 
-func getPrivateHost(zone string) []*sacloud.PrivateHost {
-	values := ds().List(ResourcePrivateHost, zone)
+func getPrivateHost(s Store, zone string) []*sacloud.PrivateHost {
+	values := s.List(ResourcePrivateHost, zone)
 	var ret []*sacloud.PrivateHost
 	for _, v := range values {
 		if v, ok := v.(*sacloud.PrivateHost); ok {
@@ -901,25 +901,25 @@ func getPrivateHost(zone string) []*sacloud.PrivateHost {
 	return ret
 }
 
-func getPrivateHostByID(zone string, id types.ID) *sacloud.PrivateHost {
-	v := ds().Get(ResourcePrivateHost, zone, id)
+func getPrivateHostByID(s Store, zone string, id types.ID) *sacloud.PrivateHost {
+	v := s.Get(ResourcePrivateHost, zone, id)
 	if v, ok := v.(*sacloud.PrivateHost); ok {
 		return v
 	}
 	return nil
 }
 
-func putPrivateHost(zone string, value *sacloud.PrivateHost) {
+func putPrivateHost(s Store, zone string, value *sacloud.PrivateHost) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourcePrivateHost, zone, id.GetID(), value)
+		s.Put(ResourcePrivateHost, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourcePrivateHost, zone, 0, value)
+	s.Put(ResourcePrivateHost, zone, 0, value)
 }
 
-func getPrivateHostPlan(zone string) []*sacloud.PrivateHostPlan {
-	values := ds().List(ResourcePrivateHostPlan, zone)
+func getPrivateHostPlan(s Store, zone string) []*sacloud.PrivateHostPlan {
+	values := s.List(ResourcePrivateHostPlan, zone)
 	var ret []*sacloud.PrivateHostPlan
 	for _, v := range values {
 		if v, ok := v.(*sacloud.PrivateHostPlan); ok {
@@ -929,25 +929,25 @@ func getPrivateHostPlan(zone string) []*sacloud.PrivateHostPlan {
 	return ret
 }
 
-func getPrivateHostPlanByID(zone string, id types.ID) *sacloud.PrivateHostPlan {
-	v := ds().Get(ResourcePrivateHostPlan, zone, id)
+func getPrivateHostPlanByID(s Store, zone string, id types.ID) *sacloud.PrivateHostPlan {
+	v := s.Get(ResourcePrivateHostPlan, zone, id)
 	if v, ok := v.(*sacloud.PrivateHostPlan); ok {
 		return v
 	}
 	return nil
 }
 
-func putPrivateHostPlan(zone string, value *sacloud.PrivateHostPlan) {
+func putPrivateHostPlan(s Store, zone string, value *sacloud.PrivateHostPlan) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourcePrivateHostPlan, zone, id.GetID(), value)
+		s.Put(ResourcePrivateHostPlan, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourcePrivateHostPlan, zone, 0, value)
+	s.Put(ResourcePrivateHostPlan, zone, 0, value)
 }
 
-func getProxyLB(zone string) []*sacloud.ProxyLB {
-	values := ds().List(ResourceProxyLB, zone)
+func getProxyLB(s Store, zone string) []*sacloud.ProxyLB {
+	values := s.List(ResourceProxyLB, zone)
 	var ret []*sacloud.ProxyLB
 	for _, v := range values {
 		if v, ok := v.(*sacloud.ProxyLB); ok {
@@ -957,25 +957,25 @@ func getProxyLB(zone string) []*sacloud.ProxyLB {
 	return ret
 }
 
-func getProxyLBByID(zone string, id types.ID) *sacloud.ProxyLB {
-	v := ds().Get(ResourceProxyLB, zone, id)
+func getProxyLBByID(s Store, zone string, id types.ID) *sacloud.ProxyLB {
+	v := s.Get(ResourceProxyLB, zone, id)
 	if v, ok := v.(*sacloud.ProxyLB); ok {
 		return v
 	}
 	return nil
 }
 
-func putProxyLB(zone string, value *sacloud.ProxyLB) {
+func putProxyLB(s Store, zone string, value *sacloud.ProxyLB) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceProxyLB, zone, id.GetID(), value)
+		s.Put(ResourceProxyLB, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceProxyLB, zone, 0, value)
+	s.Put(ResourceProxyLB, zone, 0, value)
 }
 
-func getRegion(zone string) []*sacloud.Region {
-	values := ds().List(ResourceRegion, zone)
+func getRegion(s Store, zone string) []*sacloud.Region {
+	values := s.List(ResourceRegion, zone)
 	var ret []*sacloud.Region
 	for _, v := range values {
 		if v, ok := v.(*sacloud.Region); ok {
@@ -985,25 +985,25 @@ func getRegion(zone string) []*sacloud.Region {
 	return ret
 }
 
-func getRegionByID(zone string, id types.ID) *sacloud.Region {
-	v := ds().Get(ResourceRegion, zone, id)
+func getRegionByID(s Store, zone string, id types.ID) *sacloud.Region {
+	v := s.Get(ResourceRegion, zone, id)
 	if v, ok := v.(*sacloud.Region); ok {
 		return v
 	}
 	return nil
 }
 
-func putRegion(zone string, value *sacloud.Region) {
+func putRegion(s Store, zone string, value *sacloud.Region) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceRegion, zone, id.GetID(), value)
+		s.Put(ResourceRegion, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceRegion, zone, 0, value)
+	s.Put(ResourceRegion, zone, 0, value)
 }
 
-func getServer(zone string) []*sacloud.Server {
-	values := ds().List(ResourceServer, zone)
+func getServer(s Store, zone string) []*sacloud.Server {
+	values := s.List(ResourceServer, zone)
 	var ret []*sacloud.Server
 	for _, v := range values {
 		if v, ok := v.(*sacloud.Server); ok {
@@ -1013,25 +1013,25 @@ func getServer(zone string) []*sacloud.Server {
 	return ret
 }
 
-func getServerByID(zone string, id types.ID) *sacloud.Server {
-	v := ds().Get(ResourceServer, zone, id)
+func getServerByID(s Store, zone string, id types.ID) *sacloud.Server {
+	v := s.Get(ResourceServer, zone, id)
 	if v, ok := v.(*sacloud.Server); ok {
 		return v
 	}
 	return nil
 }
 
-func putServer(zone string, value *sacloud.Server) {
+func putServer(s Store, zone string, value *sacloud.Server) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceServer, zone, id.GetID(), value)
+		s.Put(ResourceServer, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceServer, zone, 0, value)
+	s.Put(ResourceServer, zone, 0, value)
 }
 
-func getServerPlan(zone string) []*sacloud.ServerPlan {
-	values := ds().List(ResourceServerPlan, zone)
+func getServerPlan(s Store, zone string) []*sacloud.ServerPlan {
+	values := s.List(ResourceServerPlan, zone)
 	var ret []*sacloud.ServerPlan
 	for _, v := range values {
 		if v, ok := v.(*sacloud.ServerPlan); ok {
@@ -1041,25 +1041,25 @@ func getServerPlan(zone string) []*sacloud.ServerPlan {
 	return ret
 }
 
-func getServerPlanByID(zone string, id types.ID) *sacloud.ServerPlan {
-	v := ds().Get(ResourceServerPlan, zone, id)
+func getServerPlanByID(s Store, zone string, id types.ID) *sacloud.ServerPlan {
+	v := s.Get(ResourceServerPlan, zone, id)
 	if v, ok := v.(*sacloud.ServerPlan); ok {
 		return v
 	}
 	return nil
 }
 
-func putServerPlan(zone string, value *sacloud.ServerPlan) {
+func putServerPlan(s Store, zone string, value *sacloud.ServerPlan) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceServerPlan, zone, id.GetID(), value)
+		s.Put(ResourceServerPlan, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceServerPlan, zone, 0, value)
+	s.Put(ResourceServerPlan, zone, 0, value)
 }
 
-func getServiceClass(zone string) []*sacloud.ServiceClass {
-	values := ds().List(ResourceServiceClass, zone)
+func getServiceClass(s Store, zone string) []*sacloud.ServiceClass {
+	values := s.List(ResourceServiceClass, zone)
 	var ret []*sacloud.ServiceClass
 	for _, v := range values {
 		if v, ok := v.(*sacloud.ServiceClass); ok {
@@ -1069,25 +1069,25 @@ func getServiceClass(zone string) []*sacloud.ServiceClass {
 	return ret
 }
 
-func getServiceClassByID(zone string, id types.ID) *sacloud.ServiceClass {
-	v := ds().Get(ResourceServiceClass, zone, id)
+func getServiceClassByID(s Store, zone string, id types.ID) *sacloud.ServiceClass {
+	v := s.Get(ResourceServiceClass, zone, id)
 	if v, ok := v.(*sacloud.ServiceClass); ok {
 		return v
 	}
 	return nil
 }
 
-func putServiceClass(zone string, value *sacloud.ServiceClass) {
+func putServiceClass(s Store, zone string, value *sacloud.ServiceClass) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceServiceClass, zone, id.GetID(), value)
+		s.Put(ResourceServiceClass, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceServiceClass, zone, 0, value)
+	s.Put(ResourceServiceClass, zone, 0, value)
 }
 
-func getSIM(zone string) []*sacloud.SIM {
-	values := ds().List(ResourceSIM, zone)
+func getSIM(s Store, zone string) []*sacloud.SIM {
+	values := s.List(ResourceSIM, zone)
 	var ret []*sacloud.SIM
 	for _, v := range values {
 		if v, ok := v.(*sacloud.SIM); ok {
@@ -1097,25 +1097,25 @@ func getSIM(zone string) []*sacloud.SIM {
 	return ret
 }
 
-func getSIMByID(zone string, id types.ID) *sacloud.SIM {
-	v := ds().Get(ResourceSIM, zone, id)
+func getSIMByID(s Store, zone string, id types.ID) *sacloud.SIM {
+	v := s.Get(ResourceSIM, zone, id)
 	if v, ok := v.(*sacloud.SIM); ok {
 		return v
 	}
 	return nil
 }
 
-func putSIM(zone string, value *sacloud.SIM) {
+func putSIM(s Store, zone string, value *sacloud.SIM) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceSIM, zone, id.GetID(), value)
+		s.Put(ResourceSIM, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceSIM, zone, 0, value)
+	s.Put(ResourceSIM, zone, 0, value)
 }
 
-func getSimpleMonitor(zone string) []*sacloud.SimpleMonitor {
-	values := ds().List(ResourceSimpleMonitor, zone)
+func getSimpleMonitor(s Store, zone string) []*sacloud.SimpleMonitor {
+	values := s.List(ResourceSimpleMonitor, zone)
 	var ret []*sacloud.SimpleMonitor
 	for _, v := range values {
 		if v, ok := v.(*sacloud.SimpleMonitor); ok {
@@ -1125,25 +1125,25 @@ func getSimpleMonitor(zone string) []*sacloud.SimpleMonitor {
 	return ret
 }
 
-func getSimpleMonitorByID(zone string, id types.ID) *sacloud.SimpleMonitor {
-	v := ds().Get(ResourceSimpleMonitor, zone, id)
+func getSimpleMonitorByID(s Store, zone string, id types.ID) *sacloud.SimpleMonitor {
+	v := s.Get(ResourceSimpleMonitor, zone, id)
 	if v, ok := v.(*sacloud.SimpleMonitor); ok {
 		return v
 	}
 	return nil
 }
 
-func putSimpleMonitor(zone string, value *sacloud.SimpleMonitor) {
+func putSimpleMonitor(s Store, zone string, value *sacloud.SimpleMonitor) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceSimpleMonitor, zone, id.GetID(), value)
+		s.Put(ResourceSimpleMonitor, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceSimpleMonitor, zone, 0, value)
+	s.Put(ResourceSimpleMonitor, zone, 0, value)
 }
 
-func getSSHKey(zone string) []*sacloud.SSHKey {
-	values := ds().List(ResourceSSHKey, zone)
+func getSSHKey(s Store, zone string) []*sacloud.SSHKey {
+	values := s.List(ResourceSSHKey, zone)
 	var ret []*sacloud.SSHKey
 	for _, v := range values {
 		if v, ok := v.(*sacloud.SSHKey); ok {
@@ -1153,25 +1153,25 @@ func getSSHKey(zone string) []*sacloud.SSHKey {
 	return ret
 }
 
-func getSSHKeyByID(zone string, id types.ID) *sacloud.SSHKey {
-	v := ds().Get(ResourceSSHKey, zone, id)
+func getSSHKeyByID(s Store, zone string, id types.ID) *sacloud.SSHKey {
+	v := s.Get(ResourceSSHKey, zone, id)
 	if v, ok := v.(*sacloud.SSHKey); ok {
 		return v
 	}
 	return nil
 }
 
-func putSSHKey(zone string, value *sacloud.SSHKey) {
+func putSSHKey(s Store, zone string, value *sacloud.SSHKey) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceSSHKey, zone, id.GetID(), value)
+		s.Put(ResourceSSHKey, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceSSHKey, zone, 0, value)
+	s.Put(ResourceSSHKey, zone, 0, value)
 }
 
-func getSubnet(zone string) []*sacloud.Subnet {
-	values := ds().List(ResourceSubnet, zone)
+func getSubnet(s Store, zone string) []*sacloud.Subnet {
+	values := s.List(ResourceSubnet, zone)
 	var ret []*sacloud.Subnet
 	for _, v := range values {
 		if v, ok := v.(*sacloud.Subnet); ok {
@@ -1181,25 +1181,25 @@ func getSubnet(zone string) []*sacloud.Subnet {
 	return ret
 }
 
-func getSubnetByID(zone string, id types.ID) *sacloud.Subnet {
-	v := ds().Get(ResourceSubnet, zone, id)
+func getSubnetByID(s Store, zone string, id types.ID) *sacloud.Subnet {
+	v := s.Get(ResourceSubnet, zone, id)
 	if v, ok := v.(*sacloud.Subnet); ok {
 		return v
 	}
 	return nil
 }
 
-func putSubnet(zone string, value *sacloud.Subnet) {
+func putSubnet(s Store, zone string, value *sacloud.Subnet) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceSubnet, zone, id.GetID(), value)
+		s.Put(ResourceSubnet, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceSubnet, zone, 0, value)
+	s.Put(ResourceSubnet, zone, 0, value)
 }
 
-func getSwitch(zone string) []*sacloud.Switch {
-	values := ds().List(ResourceSwitch, zone)
+func getSwitch(s Store, zone string) []*sacloud.Switch {
+	values := s.List(ResourceSwitch, zone)
 	var ret []*sacloud.Switch
 	for _, v := range values {
 		if v, ok := v.(*sacloud.Switch); ok {
@@ -1209,25 +1209,25 @@ func getSwitch(zone string) []*sacloud.Switch {
 	return ret
 }
 
-func getSwitchByID(zone string, id types.ID) *sacloud.Switch {
-	v := ds().Get(ResourceSwitch, zone, id)
+func getSwitchByID(s Store, zone string, id types.ID) *sacloud.Switch {
+	v := s.Get(ResourceSwitch, zone, id)
 	if v, ok := v.(*sacloud.Switch); ok {
 		return v
 	}
 	return nil
 }
 
-func putSwitch(zone string, value *sacloud.Switch) {
+func putSwitch(s Store, zone string, value *sacloud.Switch) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceSwitch, zone, id.GetID(), value)
+		s.Put(ResourceSwitch, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceSwitch, zone, 0, value)
+	s.Put(ResourceSwitch, zone, 0, value)
 }
 
-func getVPCRouter(zone string) []*sacloud.VPCRouter {
-	values := ds().List(ResourceVPCRouter, zone)
+func getVPCRouter(s Store, zone string) []*sacloud.VPCRouter {
+	values := s.List(ResourceVPCRouter, zone)
 	var ret []*sacloud.VPCRouter
 	for _, v := range values {
 		if v, ok := v.(*sacloud.VPCRouter); ok {
@@ -1237,25 +1237,25 @@ func getVPCRouter(zone string) []*sacloud.VPCRouter {
 	return ret
 }
 
-func getVPCRouterByID(zone string, id types.ID) *sacloud.VPCRouter {
-	v := ds().Get(ResourceVPCRouter, zone, id)
+func getVPCRouterByID(s Store, zone string, id types.ID) *sacloud.VPCRouter {
+	v := s.Get(ResourceVPCRouter, zone, id)
 	if v, ok := v.(*sacloud.VPCRouter); ok {
 		return v
 	}
 	return nil
 }
 
-func putVPCRouter(zone string, value *sacloud.VPCRouter) {
+func putVPCRouter(s Store, zone string, value *sacloud.VPCRouter) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceVPCRouter, zone, id.GetID(), value)
+		s.Put(ResourceVPCRouter, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceVPCRouter, zone, 0, value)
+	s.Put(ResourceVPCRouter, zone, 0, value)
 }
 
-func getWebAccel(zone string) []*sacloud.WebAccel {
-	values := ds().List(ResourceWebAccel, zone)
+func getWebAccel(s Store, zone string) []*sacloud.WebAccel {
+	values := s.List(ResourceWebAccel, zone)
 	var ret []*sacloud.WebAccel
 	for _, v := range values {
 		if v, ok := v.(*sacloud.WebAccel); ok {
@@ -1265,25 +1265,25 @@ func getWebAccel(zone string) []*sacloud.WebAccel {
 	return ret
 }
 
-func getWebAccelByID(zone string, id types.ID) *sacloud.WebAccel {
-	v := ds().Get(ResourceWebAccel, zone, id)
+func getWebAccelByID(s Store, zone string, id types.ID) *sacloud.WebAccel {
+	v := s.Get(ResourceWebAccel, zone, id)
 	if v, ok := v.(*sacloud.WebAccel); ok {
 		return v
 	}
 	return nil
 }
 
-func putWebAccel(zone string, value *sacloud.WebAccel) {
+func putWebAccel(s Store, zone string, value *sacloud.WebAccel) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceWebAccel, zone, id.GetID(), value)
+		s.Put(ResourceWebAccel, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceWebAccel, zone, 0, value)
+	s.Put(ResourceWebAccel, zone, 0, value)
 }
 
-func getZone(zone string) []*sacloud.Zone {
-	values := ds().List(ResourceZone, zone)
+func getZone(s Store, zone string) []*sacloud.Zone {
+	values := s.List(ResourceZone, zone)
 	var ret []*sacloud.Zone
 	for _, v := range values {
 		if v, ok := v.(*sacloud.Zone); ok {
@@ -1293,19 +1293,19 @@ func getZone(zone string) []*sacloud.Zone {
 	return ret
 }
 
-func getZoneByID(zone string, id types.ID) *sacloud.Zone {
-	v := ds().Get(ResourceZone, zone, id)
+func getZoneByID(s Store, zone string, id types.ID) *sacloud.Zone {
+	v := s.Get(ResourceZone, zone, id)
 	if v, ok := v.(*sacloud.Zone); ok {
 		return v
 	}
 	return nil
 }
 
-func putZone(zone string, value *sacloud.Zone) {
+func putZone(s Store, zone string, value *sacloud.Zone) {
 	var v interface{} = value
 	if id, ok := v.(accessor.ID); ok {
-		ds().Put(ResourceZone, zone, id.GetID(), value)
+		s.Put(ResourceZone, zone, id.GetID(), value)
 		return
 	}
-	ds().Put(ResourceZone, zone, 0, value)
+	s.Put(ResourceZone, zone, 0, value)
 }
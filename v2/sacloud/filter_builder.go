@@ -0,0 +1,162 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sacloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/sacloud/libsacloud/v2/sacloud/search"
+)
+
+// filterBuilder 型付きの検索条件ビルダー(XxxFilterBuilder)の共通処理
+//
+// XxxFilterBuilderはDSLの定義から生成され、リソースごとに利用可能なキー/値の型/演算子のみを受け付ける。
+// 値の妥当性(空の値や同一キーへの重複指定など)はBuildの呼び出し時に検証される
+//
+//	condition, err := sacloud.ServerFilter().
+//		NameContains("web").
+//		CPUGreaterEqual(2).
+//		HasTags("production").
+//		SortBy(sacloud.ServerFilterKeyName, false).
+//		Build()
+type filterBuilder struct {
+	filter  search.Filter
+	sort    search.SortKeys
+	from    int
+	count   int
+	include []string
+	exclude []string
+	errors  *multierror.Error
+}
+
+// Build 検索条件を検証し、FindConditionを返す
+func (b *filterBuilder) Build() (*FindCondition, error) {
+	if err := b.errors.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return &FindCondition{
+		Count:   b.count,
+		From:    b.from,
+		Sort:    b.sort,
+		Filter:  b.filter,
+		Include: b.include,
+		Exclude: b.exclude,
+	}, nil
+}
+
+func (b *filterBuilder) addError(format string, args ...interface{}) {
+	b.errors = multierror.Append(b.errors, fmt.Errorf(format, args...))
+}
+
+func (b *filterBuilder) set(key search.FilterKey, value interface{}) {
+	if b.filter == nil {
+		b.filter = search.Filter{}
+	}
+	if current, ok := b.filter[key]; ok {
+		// 部分一致のAND条件同士の場合は条件を連結する
+		currentExp, ok1 := current.(*search.EqualExpression)
+		exp, ok2 := value.(*search.EqualExpression)
+		if ok1 && ok2 && currentExp.Op == search.OpAnd && exp.Op == search.OpAnd {
+			currentExp.Conditions = append(currentExp.Conditions, exp.Conditions...)
+			return
+		}
+		b.addError("filter: %q is already specified", key.String())
+		return
+	}
+	b.filter[key] = value
+}
+
+func (b *filterBuilder) setAndEqual(field string, values []string) {
+	if len(values) == 0 {
+		b.addError("filter: %q requires at least one value", field)
+		return
+	}
+	for _, v := range values {
+		if v == "" {
+			b.addError("filter: %q does not accept an empty string", field)
+			return
+		}
+	}
+	b.set(search.Key(field), search.AndEqual(values...))
+}
+
+func (b *filterBuilder) setOrEqual(field string, values []interface{}) {
+	if len(values) == 0 {
+		b.addError("filter: %q requires at least one value", field)
+		return
+	}
+	b.set(search.Key(field), search.OrEqual(values...))
+}
+
+func (b *filterBuilder) setTags(field string, tags []string) {
+	if len(tags) == 0 {
+		b.addError("filter: %q requires at least one tag", field)
+		return
+	}
+	for _, tag := range tags {
+		if tag == "" {
+			b.addError("filter: %q does not accept an empty tag", field)
+			return
+		}
+	}
+
+	key := search.Key(field)
+	if current, ok := b.filter[key].(*search.EqualExpression); ok && len(current.Conditions) == 1 {
+		// 複数回指定された場合は全てのタグを持つ条件とする
+		if currentTags, ok := current.Conditions[0].([]string); ok {
+			current.Conditions[0] = append(currentTags, tags...)
+			return
+		}
+	}
+	b.set(key, search.TagsAndEqual(tags...))
+}
+
+func (b *filterBuilder) setWithOp(field string, op search.ComparisonOperator, value interface{}) {
+	b.set(search.KeyWithOp(field, op), value)
+}
+
+func (b *filterBuilder) setTime(field string, op search.ComparisonOperator, value time.Time) {
+	if value.IsZero() {
+		b.addError("filter: %q requires a non-zero time", field)
+		return
+	}
+	b.setWithOp(field, op, value)
+}
+
+func (b *filterBuilder) sortBy(key string, desc bool) {
+	if desc {
+		b.sort = append(b.sort, search.SortKeyDesc(key))
+		return
+	}
+	b.sort = append(b.sort, search.SortKeyAsc(key))
+}
+
+func (b *filterBuilder) setFrom(from int) {
+	if from < 0 {
+		b.addError("filter: from must be zero or a positive number: %d", from)
+		return
+	}
+	b.from = from
+}
+
+func (b *filterBuilder) setCount(count int) {
+	if count < 0 {
+		b.addError("filter: count must be zero or a positive number: %d", count)
+		return
+	}
+	b.count = count
+}
@@ -0,0 +1,154 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sacloud
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sacloud/libsacloud/v2/sacloud/search"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterBuilder(t *testing.T) {
+	createdAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	condition, err := ServerFilter().
+		NameContains("web").
+		NameContains("01").
+		CPUGreaterEqual(2).
+		MemoryMBLessThan(8192).
+		HasTags("prod").
+		HasTags("web").
+		InstanceStatusEquals(types.ServerInstanceStatuses.Up).
+		IDEquals(types.ID(1), types.ID(2)).
+		CreatedAtAfter(createdAt).
+		SortBy(ServerFilterKeyName, true).
+		Include(ServerFilterKeyID, ServerFilterKeyName).
+		From(10).
+		Count(5).
+		Build()
+	require.NoError(t, err)
+
+	require.Equal(t, &FindCondition{
+		From:  10,
+		Count: 5,
+		Sort:  search.SortKeys{search.SortKeyDesc("Name")},
+		Filter: search.Filter{
+			search.Key("Name"): search.AndEqual("web", "01"),
+			search.KeyWithOp("ServerPlan.CPU", search.OpGreaterEqual):  2,
+			search.KeyWithOp("ServerPlan.MemoryMB", search.OpLessThan): 8192,
+			search.Key("Tags.Name"):                             search.TagsAndEqual("prod", "web"),
+			search.Key("Instance.Status"):                       search.OrEqual(types.ServerInstanceStatuses.Up),
+			search.Key("ID"):                                    search.OrEqual(types.ID(1), types.ID(2)),
+			search.KeyWithOp("CreatedAt", search.OpGreaterThan): createdAt,
+		},
+		Include: []string{"ID", "Name"},
+	}, condition)
+
+	data, err := json.Marshal(condition.Filter)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"Name": "web%2001",
+		"ServerPlan.CPU>=": "2",
+		"ServerPlan.MemoryMB<": "8192",
+		"Tags.Name": [["prod", "web"]],
+		"Instance.Status": ["up"],
+		"ID": [1, 2],
+		"CreatedAt>": "2022-01-01T00:00:00Z"
+	}`, string(data))
+}
+
+func TestFilterBuilder_errors(t *testing.T) {
+	cases := []struct {
+		msg     string
+		builder interface {
+			Build() (*FindCondition, error)
+		}
+		expect string
+	}{
+		{
+			msg:     "without values",
+			builder: ServerFilter().NameContains(),
+			expect:  `"Name" requires at least one value`,
+		},
+		{
+			msg:     "empty string",
+			builder: DiskFilter().NameContains(""),
+			expect:  `"Name" does not accept an empty string`,
+		},
+		{
+			msg:     "without tags",
+			builder: SwitchFilter().HasTags(),
+			expect:  `"Tags.Name" requires at least one tag`,
+		},
+		{
+			msg:     "duplicated key",
+			builder: ServerFilter().CPUGreaterThan(1).CPUGreaterThan(2),
+			expect:  `"ServerPlan.CPU>" is already specified`,
+		},
+		{
+			msg:     "duplicated exact match",
+			builder: ServerFilter().IDEquals(1).IDEquals(2),
+			expect:  `"ID" is already specified`,
+		},
+		{
+			msg:     "zero time",
+			builder: ArchiveFilter().CreatedAtBefore(time.Time{}),
+			expect:  `"CreatedAt" requires a non-zero time`,
+		},
+		{
+			msg:     "negative count",
+			builder: ServerFilter().Count(-1),
+			expect:  "count must be zero or a positive number",
+		},
+	}
+
+	for _, tc := range cases {
+		condition, err := tc.builder.Build()
+		require.Nil(t, condition, tc.msg)
+		require.Error(t, err, tc.msg)
+		require.Contains(t, err.Error(), tc.expect, tc.msg)
+	}
+}
+
+func TestFilterBuilder_allowedOperators(t *testing.T) {
+	cases := []struct {
+		builder interface{}
+		method  string
+		expect  bool
+	}{
+		// Bigint(文字列)として扱われる項目は完全一致のみ
+		{builder: ArchiveFilter(), method: "DisplayOrderEquals", expect: true},
+		{builder: ArchiveFilter(), method: "DisplayOrderGreaterThan", expect: false},
+		{builder: CDROMFilter(), method: "DisplayOrderLessEqual", expect: false},
+		{builder: ZoneFilter(), method: "DisplayOrderGreaterEqual", expect: false},
+		// 大小比較が可能な項目
+		{builder: ServerFilter(), method: "CPUGreaterThan", expect: true},
+		{builder: DiskFilter(), method: "SizeMBLessThan", expect: true},
+		// 検索条件として利用できないキー
+		{builder: ServerFilter(), method: "InstanceWarningsValueEquals", expect: false},
+		{builder: ServerFilter(), method: "InstanceHostInfoURLContains", expect: false},
+		{builder: DiskFilter(), method: "MigratedMBEquals", expect: false},
+	}
+
+	for _, tc := range cases {
+		_, ok := reflect.TypeOf(tc.builder).MethodByName(tc.method)
+		require.Equal(t, tc.expect, ok, tc.method)
+	}
+}
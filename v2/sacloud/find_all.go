@@ -0,0 +1,209 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sacloud
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultFindAllPageSize FindAllOptions.PageSizeが指定されていない場合の1ページあたりの取得件数
+var DefaultFindAllPageSize = 100
+
+// FindAllOptions FindAllXxxやXxxIteratorでのページング処理のオプション
+type FindAllOptions struct {
+	// PageSize 1回のFindで取得する件数 0の場合はDefaultFindAllPageSize
+	PageSize int
+	// Parallelism 同時に取得するページ数 1以下の場合は1ページずつ取得する
+	//
+	// 2ページ目以降を並列に取得する。APIリクエストはAPICallerに設定されたレートリミットに従う
+	Parallelism int
+}
+
+func mergeFindAllOptions(opts ...*FindAllOptions) *FindAllOptions {
+	merged := &FindAllOptions{}
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		if opt.PageSize > 0 {
+			merged.PageSize = opt.PageSize
+		}
+		if opt.Parallelism > 0 {
+			merged.Parallelism = opt.Parallelism
+		}
+	}
+	if merged.PageSize <= 0 {
+		merged.PageSize = DefaultFindAllPageSize
+	}
+	if merged.Parallelism <= 0 {
+		merged.Parallelism = 1
+	}
+	return merged
+}
+
+// findPageFunc 1ページ分のFindを行い、取得した値と全件数を返す
+type findPageFunc func(ctx context.Context, conditions *FindCondition) ([]interface{}, int, error)
+
+// findIterator XxxIteratorの共通処理
+//
+// conditions.Fromを開始位置、conditions.Countを取得する最大件数(0の場合は全件)として扱い、
+// FindAllOptions.PageSize件ずつ取得する
+type findIterator struct {
+	ctx        context.Context
+	conditions *FindCondition
+	opts       *FindAllOptions
+	find       findPageFunc
+
+	started bool
+	done    bool
+	next    int // 次に取得するページの開始位置
+	end     int // 取得を終了する位置
+	buf     []interface{}
+	current interface{}
+	err     error
+}
+
+func newFindIterator(ctx context.Context, conditions *FindCondition, opts []*FindAllOptions, find findPageFunc) *findIterator {
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	return &findIterator{
+		ctx:        ctx,
+		conditions: conditions,
+		opts:       mergeFindAllOptions(opts...),
+		find:       find,
+		next:       conditions.From,
+	}
+}
+
+// Next 次の値に進む
+//
+// 次の値が存在しない場合やエラーが発生した場合はfalseを返す。エラーはErrで取得する
+func (it *findIterator) Next() bool {
+	for len(it.buf) == 0 {
+		if it.done || it.err != nil {
+			it.current = nil
+			return false
+		}
+		if err := it.fetch(); err != nil {
+			it.err = err
+		}
+	}
+	it.current = it.buf[0]
+	it.buf = it.buf[1:]
+	return true
+}
+
+// Err 取得中に発生したエラーを返す
+func (it *findIterator) Err() error {
+	return it.err
+}
+
+func (it *findIterator) value() interface{} {
+	return it.current
+}
+
+func (it *findIterator) fetch() error {
+	if err := it.ctx.Err(); err != nil {
+		return err
+	}
+
+	if !it.started {
+		// 1ページ目で全件数を確定させる
+		values, total, err := it.fetchPage(it.ctx, it.next)
+		if err != nil {
+			return err
+		}
+		it.started = true
+		it.end = total
+		if limit := it.conditions.Count; limit > 0 && it.conditions.From+limit < total {
+			it.end = it.conditions.From + limit
+		}
+		it.appendPage(values)
+		return nil
+	}
+
+	var froms []int
+	for from := it.next; from < it.end && len(froms) < it.opts.Parallelism; from += it.opts.PageSize {
+		froms = append(froms, from)
+	}
+	pages, err := it.fetchPages(froms)
+	if err != nil {
+		return err
+	}
+	for _, values := range pages {
+		it.appendPage(values)
+		if it.done {
+			break
+		}
+	}
+	return nil
+}
+
+func (it *findIterator) appendPage(values []interface{}) {
+	if rest := it.end - it.next; len(values) > rest {
+		values = values[:rest]
+	}
+	it.buf = append(it.buf, values...)
+	it.next += len(values)
+	if len(values) == 0 || it.next >= it.end {
+		it.done = true
+	}
+}
+
+func (it *findIterator) fetchPage(ctx context.Context, from int) ([]interface{}, int, error) {
+	conditions := *it.conditions
+	conditions.From = from
+	conditions.Count = it.opts.PageSize
+	return it.find(ctx, &conditions)
+}
+
+func (it *findIterator) fetchPages(froms []int) ([][]interface{}, error) {
+	pages := make([][]interface{}, len(froms))
+	if len(froms) == 1 {
+		values, _, err := it.fetchPage(it.ctx, froms[0])
+		pages[0] = values
+		return pages, err
+	}
+
+	ctx, cancel := context.WithCancel(it.ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	for i, from := range froms {
+		wg.Add(1)
+		go func(i, from int) {
+			defer wg.Done()
+			values, _, err := it.fetchPage(ctx, from)
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			pages[i] = values
+		}(i, from)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return pages, nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sacloud
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+type findAllTestServerAPI struct {
+	ServerAPI
+	servers []*Server
+	failAt  int
+
+	mu    sync.Mutex
+	froms []int
+}
+
+func newFindAllTestServerAPI(count int) *findAllTestServerAPI {
+	api := &findAllTestServerAPI{failAt: -1}
+	for i := 0; i < count; i++ {
+		api.servers = append(api.servers, &Server{ID: types.ID(i + 1)})
+	}
+	return api
+}
+
+func (api *findAllTestServerAPI) Find(ctx context.Context, zone string, conditions *FindCondition) (*ServerFindResult, error) {
+	api.mu.Lock()
+	api.froms = append(api.froms, conditions.From)
+	api.mu.Unlock()
+
+	if conditions.From == api.failAt {
+		return nil, errors.New("find failed")
+	}
+
+	from, to := conditions.From, conditions.From+conditions.Count
+	if from > len(api.servers) {
+		from = len(api.servers)
+	}
+	if to > len(api.servers) {
+		to = len(api.servers)
+	}
+	return &ServerFindResult{
+		Total:   len(api.servers),
+		From:    conditions.From,
+		Count:   to - from,
+		Servers: api.servers[from:to],
+	}, nil
+}
+
+func TestFindAll(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("all pages", func(t *testing.T) {
+		api := newFindAllTestServerAPI(25)
+		servers, err := FindAllServers(ctx, api, "is1a", nil, &FindAllOptions{PageSize: 10})
+		require.NoError(t, err)
+		require.Len(t, servers, 25)
+		require.Equal(t, types.ID(1), servers[0].ID)
+		require.Equal(t, types.ID(25), servers[24].ID)
+		require.Equal(t, []int{0, 10, 20}, api.froms)
+	})
+
+	t.Run("from and count", func(t *testing.T) {
+		api := newFindAllTestServerAPI(25)
+		servers, err := FindAllServers(ctx, api, "is1a", &FindCondition{From: 5, Count: 12}, &FindAllOptions{PageSize: 10})
+		require.NoError(t, err)
+		require.Len(t, servers, 12)
+		require.Equal(t, types.ID(6), servers[0].ID)
+		require.Equal(t, types.ID(17), servers[11].ID)
+		require.Equal(t, []int{5, 15}, api.froms)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		api := newFindAllTestServerAPI(0)
+		servers, err := FindAllServers(ctx, api, "is1a", nil)
+		require.NoError(t, err)
+		require.Empty(t, servers)
+		require.Equal(t, []int{0}, api.froms)
+	})
+
+	t.Run("parallel", func(t *testing.T) {
+		api := newFindAllTestServerAPI(95)
+		servers, err := FindAllServers(ctx, api, "is1a", nil, &FindAllOptions{PageSize: 10, Parallelism: 4})
+		require.NoError(t, err)
+		require.Len(t, servers, 95)
+		for i, server := range servers {
+			require.Equal(t, types.ID(i+1), server.ID)
+		}
+		require.Len(t, api.froms, 10)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		api := newFindAllTestServerAPI(50)
+		api.failAt = 30
+		servers, err := FindAllServers(ctx, api, "is1a", nil, &FindAllOptions{PageSize: 10, Parallelism: 2})
+		require.EqualError(t, err, "find failed")
+		require.Nil(t, servers)
+	})
+}
+
+func TestIterator(t *testing.T) {
+	api := newFindAllTestServerAPI(30)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	iter := NewServerIterator(ctx, api, "is1a", nil, &FindAllOptions{PageSize: 10})
+	require.Empty(t, api.froms)
+
+	var ids []types.ID
+	for iter.Next() {
+		ids = append(ids, iter.Value().ID)
+		if len(ids) == 15 {
+			cancel()
+		}
+	}
+	require.Equal(t, context.Canceled, iter.Err())
+	require.Len(t, ids, 20)
+	require.Nil(t, iter.Value())
+	require.Equal(t, []int{0, 10}, api.froms)
+}
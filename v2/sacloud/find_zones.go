@@ -0,0 +1,123 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sacloud
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// FindInZonesOptions FindXxxInZonesのオプション
+type FindInZonesOptions struct {
+	// Parallelism 同時に検索するゾーン数 0の場合は全てのゾーンを同時に検索する
+	//
+	// APIリクエストはAPICallerに設定されたレートリミットに従う
+	Parallelism int
+	// PageSize 各ゾーンでの1回のFindで取得する件数 0の場合はDefaultFindAllPageSize
+	PageSize int
+}
+
+// ZoneError ゾーンごとの処理で発生したエラー
+type ZoneError struct {
+	Zone string
+	Err  error
+}
+
+// Error errorインターフェースの実装
+func (e *ZoneError) Error() string {
+	return fmt.Sprintf("zone %s: %s", e.Zone, e.Err)
+}
+
+// Unwrap 元のエラーを返す
+func (e *ZoneError) Unwrap() error {
+	return e.Err
+}
+
+// findInZonesFunc 1ゾーン分の検索を行う
+type findInZonesFunc func(ctx context.Context, zone string, opts *FindAllOptions) ([]interface{}, error)
+
+type zonedValues struct {
+	zone   string
+	values []interface{}
+}
+
+// findInZones 各ゾーンでfindを並列に実行し、ゾーンの指定順に結果を返す
+//
+// 一部のゾーンでエラーが発生した場合でも他のゾーンの検索は継続し、
+// 成功したゾーンの結果と*ZoneErrorをまとめた*multierror.Errorを返す
+func findInZones(ctx context.Context, zones []string, opts []*FindInZonesOptions, find findInZonesFunc) ([]*zonedValues, error) {
+	if len(zones) == 0 {
+		zones = SakuraCloudZones
+	}
+	merged := &FindInZonesOptions{}
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		if opt.Parallelism > 0 {
+			merged.Parallelism = opt.Parallelism
+		}
+		if opt.PageSize > 0 {
+			merged.PageSize = opt.PageSize
+		}
+	}
+	parallelism := merged.Parallelism
+	if parallelism <= 0 || parallelism > len(zones) {
+		parallelism = len(zones)
+	}
+	findAllOpts := &FindAllOptions{PageSize: merged.PageSize}
+
+	results := make([]*zonedValues, len(zones))
+	errs := make([]error, len(zones))
+	sem := make(chan struct{}, parallelism)
+
+	var wg sync.WaitGroup
+	for i, zone := range zones {
+		wg.Add(1)
+		go func(i int, zone string) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[i] = &ZoneError{Zone: zone, Err: ctx.Err()}
+				return
+			}
+
+			values, err := find(ctx, zone, findAllOpts)
+			if err != nil {
+				errs[i] = &ZoneError{Zone: zone, Err: err}
+				return
+			}
+			results[i] = &zonedValues{zone: zone, values: values}
+		}(i, zone)
+	}
+	wg.Wait()
+
+	var merr *multierror.Error
+	var found []*zonedValues
+	for i := range zones {
+		if errs[i] != nil {
+			merr = multierror.Append(merr, errs[i])
+			continue
+		}
+		found = append(found, results[i])
+	}
+	return found, merr.ErrorOrNil()
+}
@@ -0,0 +1,107 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sacloud
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+type findInZonesTestServerAPI struct {
+	ServerAPI
+	servers map[string][]*Server
+	errs    map[string]error
+
+	mu      sync.Mutex
+	running int
+	maxRun  int
+}
+
+func (api *findInZonesTestServerAPI) Find(ctx context.Context, zone string, conditions *FindCondition) (*ServerFindResult, error) {
+	api.mu.Lock()
+	api.running++
+	if api.running > api.maxRun {
+		api.maxRun = api.running
+	}
+	api.mu.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+
+	api.mu.Lock()
+	api.running--
+	api.mu.Unlock()
+
+	if err := api.errs[zone]; err != nil {
+		return nil, err
+	}
+	servers := api.servers[zone]
+	return &ServerFindResult{Total: len(servers), Count: len(servers), Servers: servers}, nil
+}
+
+func TestFindInZones(t *testing.T) {
+	ctx := context.Background()
+	api := &findInZonesTestServerAPI{
+		servers: map[string][]*Server{
+			"is1a": {{ID: 1}, {ID: 2}},
+			"is1b": {{ID: 3}},
+			"tk1a": {{ID: 4}},
+		},
+	}
+
+	results, err := FindServersInZones(ctx, api, []string{"tk1a", "is1a", "is1b"}, nil)
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+	require.Equal(t, &ZonedServer{Zone: "tk1a", Value: &Server{ID: 4}}, results[0])
+	require.Equal(t, "is1a", results[1].Zone)
+	require.Equal(t, types.ID(2), results[2].Value.ID)
+	require.Equal(t, "is1b", results[3].Zone)
+	require.Equal(t, 3, api.maxRun)
+
+	t.Run("parallelism", func(t *testing.T) {
+		api.maxRun = 0
+		_, err := FindServersInZones(ctx, api, []string{"tk1a", "is1a", "is1b"}, nil, &FindInZonesOptions{Parallelism: 1})
+		require.NoError(t, err)
+		require.Equal(t, 1, api.maxRun)
+	})
+
+	t.Run("errors are collected per zone", func(t *testing.T) {
+		api.errs = map[string]error{
+			"is1b": errors.New("is1b is unavailable"),
+			"tk1v": errors.New("tk1v is unavailable"),
+		}
+		defer func() { api.errs = nil }()
+
+		results, err := FindServersInZones(ctx, api, []string{"is1a", "is1b", "tk1a", "tk1v"}, nil)
+		require.Len(t, results, 3)
+		require.Equal(t, "is1a", results[0].Zone)
+		require.Equal(t, "tk1a", results[2].Zone)
+
+		merr, ok := err.(*multierror.Error)
+		require.True(t, ok)
+		require.Len(t, merr.Errors, 2)
+
+		var zoneErr *ZoneError
+		require.True(t, errors.As(merr.Errors[0], &zoneErr))
+		require.Equal(t, "is1b", zoneErr.Zone)
+		require.EqualError(t, merr.Errors[1], "zone tk1v: tk1v is unavailable")
+	})
+}
@@ -0,0 +1,65 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sacloud
+
+import (
+	"context"
+	"net/http"
+)
+
+// DoFunc APICaller.Doと同じシグニチャを持つfunc
+type DoFunc func(ctx context.Context, method, uri string, body interface{}) ([]byte, error)
+
+// Do APICallerインターフェースの実装
+func (f DoFunc) Do(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
+	return f(ctx, method, uri, body)
+}
+
+// Middleware Client.Doの前後に処理を挟むためのfunc
+//
+// nextを呼ばずにレスポンスを返すことでAPIコールを行わないようにすることも可能
+type Middleware func(next DoFunc) DoFunc
+
+// chainMiddlewares middlewaresでfを包んだDoFuncを返す
+//
+// middlewaresの先頭の要素が最も外側(最初に呼ばれる)となる
+func chainMiddlewares(f DoFunc, middlewares ...Middleware) DoFunc {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		f = middlewares[i](f)
+	}
+	return f
+}
+
+type requestHeaderContextKey struct{}
+
+// WithRequestHeader APIリクエスト時に追加するHTTPヘッダを設定したcontextを返す
+//
+// Middlewareからリクエストヘッダを追加したい場合などに利用する
+func WithRequestHeader(ctx context.Context, key, value string) context.Context {
+	header := http.Header{}
+	if current, ok := ctx.Value(requestHeaderContextKey{}).(http.Header); ok {
+		header = current.Clone()
+	}
+	header.Add(key, value)
+	return context.WithValue(ctx, requestHeaderContextKey{}, header)
+}
+
+// RequestHeaderFromContext contextに設定されたHTTPヘッダを返す
+func RequestHeaderFromContext(ctx context.Context) http.Header {
+	if header, ok := ctx.Value(requestHeaderContextKey{}).(http.Header); ok {
+		return header
+	}
+	return nil
+}
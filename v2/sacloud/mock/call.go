@@ -0,0 +1,230 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Call モックへの呼び出しの期待値
+//
+// 回数を指定しない場合は1回の呼び出しを期待する
+type Call struct {
+	t TestReporter
+
+	receiver   interface{}
+	method     string
+	methodType reflect.Type
+	args       []Matcher
+
+	minCalls int
+	maxCalls int
+	numCalls int
+
+	returns []interface{}
+	action  func(args []interface{}) []interface{}
+
+	prerequisites []*Call
+
+	mu sync.Mutex
+}
+
+// String 期待値の文字列表現
+func (c *Call) String() string {
+	var args []string
+	for _, arg := range c.args {
+		args = append(args, arg.String())
+	}
+	return fmt.Sprintf("%T.%s(%s)", c.receiver, c.method, strings.Join(args, ", "))
+}
+
+// Return 戻り値を指定する
+//
+// 戻り値の数と型がメソッドと一致しない場合はテストを失敗させる。
+// 戻り値を指定しない場合はゼロ値を返す
+func (c *Call) Return(rets ...interface{}) *Call {
+	c.t.Helper()
+
+	if len(rets) != c.methodType.NumOut() {
+		c.t.Fatalf("mock: wrong number of return values for %s: expected %d, got %d", c, c.methodType.NumOut(), len(rets))
+		return c
+	}
+	for i, ret := range rets {
+		want := c.methodType.Out(i)
+		if ret == nil {
+			switch want.Kind() {
+			case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+				continue
+			}
+			c.t.Fatalf("mock: wrong type of return value %d for %s: nil is not assignable to %s", i, c, want)
+			return c
+		}
+		if got := reflect.TypeOf(ret); !got.AssignableTo(want) {
+			c.t.Fatalf("mock: wrong type of return value %d for %s: %s is not assignable to %s", i, c, got, want)
+			return c
+		}
+	}
+
+	c.returns = rets
+	c.action = nil
+	return c
+}
+
+// DoAndReturn 呼び出し時に実行する関数を指定する
+//
+// fnはメソッドと同じシグネチャを持つ関数である必要があり、fnの戻り値がモックの戻り値となる
+func (c *Call) DoAndReturn(fn interface{}) *Call {
+	c.t.Helper()
+
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func || v.Type() != c.methodType {
+		c.t.Fatalf("mock: wrong function type for %s: expected %s, got %T", c, c.methodType, fn)
+		return c
+	}
+
+	c.returns = nil
+	c.action = func(args []interface{}) []interface{} {
+		in := make([]reflect.Value, len(args))
+		for i, arg := range args {
+			if arg == nil {
+				in[i] = reflect.Zero(c.methodType.In(i))
+				continue
+			}
+			in[i] = reflect.ValueOf(arg)
+		}
+		var rets []interface{}
+		for _, out := range v.Call(in) {
+			rets = append(rets, out.Interface())
+		}
+		return rets
+	}
+	return c
+}
+
+// Times 呼び出し回数を指定する
+func (c *Call) Times(n int) *Call {
+	c.minCalls, c.maxCalls = n, n
+	return c
+}
+
+// MinTimes 最小の呼び出し回数を指定する
+//
+// MaxTimesを指定していない場合、最大の呼び出し回数は無制限となる
+func (c *Call) MinTimes(n int) *Call {
+	c.minCalls = n
+	if c.maxCalls == 1 {
+		c.maxCalls = math.MaxInt32
+	}
+	return c
+}
+
+// MaxTimes 最大の呼び出し回数を指定する
+//
+// MinTimesを指定していない場合、最小の呼び出し回数は0回となる
+func (c *Call) MaxTimes(n int) *Call {
+	c.maxCalls = n
+	if c.minCalls == 1 {
+		c.minCalls = 0
+	}
+	return c
+}
+
+// AnyTimes 呼び出し回数を問わない(0回でもよい)
+func (c *Call) AnyTimes() *Call {
+	c.minCalls, c.maxCalls = 0, math.MaxInt32
+	return c
+}
+
+// After 指定の期待値が満たされた後に呼び出されることを期待する
+func (c *Call) After(prerequisite *Call) *Call {
+	c.prerequisites = append(c.prerequisites, prerequisite)
+	return c
+}
+
+// Count 期待値に一致した呼び出しの回数
+func (c *Call) Count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.numCalls
+}
+
+func (c *Call) matches(args []interface{}) error {
+	for i, m := range c.args {
+		if !m.Matches(args[i]) {
+			return fmt.Errorf("argument %d of %s does not match: %s", i, c, formatValue(args[i]))
+		}
+	}
+	return nil
+}
+
+func (c *Call) checkPrerequisites() error {
+	for _, p := range c.prerequisites {
+		if !p.satisfied() {
+			return fmt.Errorf("%s must be called after %s", c, p)
+		}
+	}
+	return nil
+}
+
+func (c *Call) satisfied() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.numCalls >= c.minCalls
+}
+
+func (c *Call) exhausted() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.numCalls >= c.maxCalls
+}
+
+func (c *Call) increment() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.numCalls++
+}
+
+func (c *Call) timesString() string {
+	switch {
+	case c.minCalls == c.maxCalls:
+		return fmt.Sprintf("%d times", c.minCalls)
+	case c.maxCalls == math.MaxInt32:
+		return fmt.Sprintf("at least %d times", c.minCalls)
+	default:
+		return fmt.Sprintf("%d to %d times", c.minCalls, c.maxCalls)
+	}
+}
+
+func (c *Call) results(args []interface{}) []interface{} {
+	if c.action != nil {
+		return c.action(args)
+	}
+	if c.returns != nil {
+		return c.returns
+	}
+	return zeroResults(c.methodType)
+}
+
+func zeroResults(methodType reflect.Type) []interface{} {
+	rets := make([]interface{}, methodType.NumOut())
+	for i := range rets {
+		rets[i] = reflect.Zero(methodType.Out(i)).Interface()
+	}
+	return rets
+}
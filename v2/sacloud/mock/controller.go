@@ -0,0 +1,186 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mock libsacloudの各APIインターフェースのモック
+//
+// Controllerで呼び出しの期待値(引数、戻り値、回数、順序)を定義し、
+// 期待値に一致しない呼び出しや呼び出されなかった期待値をテストの失敗として報告する。
+//
+//	ctrl := mock.NewController(t)
+//	serverAPI := mock.NewServerMock(ctrl)
+//
+//	read := serverAPI.EXPECT().Read(mock.Any(), "is1a", types.ID(1)).Return(&sacloud.Server{ID: 1}, nil)
+//	serverAPI.EXPECT().Boot(mock.Any(), "is1a", types.ID(1)).After(read)
+//
+//	// テスト対象の処理にserverAPIを渡す
+//
+// モックは対応するAPIの全メソッドを実装しているため、
+// helper/powerやhelper/query、helper/builder配下で定義されている、APIのメソッドの一部のみを持つインターフェースとしても利用できる。
+package mock
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// TestReporter テストの失敗を報告するためのインターフェース
+//
+// *testing.Tが実装している
+type TestReporter interface {
+	Errorf(format string, args ...interface{})
+	Fatalf(format string, args ...interface{})
+	Helper()
+}
+
+type cleanuper interface {
+	Cleanup(func())
+}
+
+// Controller モックへの呼び出しの期待値を管理する
+type Controller struct {
+	t TestReporter
+
+	mu       sync.Mutex
+	calls    []*Call
+	finished bool
+}
+
+// NewController Controllerを作成する
+//
+// tがCleanupを実装している場合(*testing.Tなど)はテスト終了時にFinishが呼ばれる
+func NewController(t TestReporter) *Controller {
+	ctrl := &Controller{t: t}
+	if c, ok := t.(cleanuper); ok {
+		c.Cleanup(ctrl.Finish)
+	}
+	return ctrl
+}
+
+// RecordCall 呼び出しの期待値を登録する
+//
+// 生成されたモックのEXPECT()から利用される。argsのうちMatcherでないものはEqとして扱う
+func (c *Controller) RecordCall(receiver interface{}, method string, args ...interface{}) *Call {
+	c.t.Helper()
+
+	m := reflect.ValueOf(receiver).MethodByName(method)
+	if !m.IsValid() {
+		c.t.Fatalf("mock: %T has no method %q", receiver, method)
+		return nil
+	}
+	methodType := m.Type()
+	if len(args) != methodType.NumIn() {
+		c.t.Fatalf("mock: %T.%s expects %d arguments, got %d", receiver, method, methodType.NumIn(), len(args))
+		return nil
+	}
+
+	call := &Call{
+		t:          c.t,
+		receiver:   receiver,
+		method:     method,
+		methodType: methodType,
+		minCalls:   1,
+		maxCalls:   1,
+	}
+	for _, arg := range args {
+		call.args = append(call.args, toMatcher(arg))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, call)
+	return call
+}
+
+// Call モックへの呼び出しを処理し、期待値に定義された戻り値を返す
+//
+// 生成されたモックのメソッドから利用される。
+// 一致する期待値がない場合はテストを失敗させる
+func (c *Controller) Call(receiver interface{}, method string, args ...interface{}) []interface{} {
+	c.t.Helper()
+
+	call, err := c.match(receiver, method, args)
+	if err != nil {
+		c.t.Fatalf("mock: unexpected call to %T.%s(%s): %s", receiver, method, formatArgs(args), err)
+		// Fatalfで処理が中断されないTestReporterの場合に備えゼロ値を返す
+		return zeroResults(reflect.ValueOf(receiver).MethodByName(method).Type())
+	}
+	return call.results(args)
+}
+
+func (c *Controller) match(receiver interface{}, method string, args []interface{}) (*Call, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var reasons []string
+	for _, call := range c.calls {
+		if call.receiver != receiver || call.method != method {
+			continue
+		}
+		if err := call.matches(args); err != nil {
+			reasons = append(reasons, err.Error())
+			continue
+		}
+		if call.exhausted() {
+			reasons = append(reasons, fmt.Sprintf("%s has already been called %d times", call, call.Count()))
+			continue
+		}
+		if err := call.checkPrerequisites(); err != nil {
+			reasons = append(reasons, err.Error())
+			continue
+		}
+		call.increment()
+		return call, nil
+	}
+	if len(reasons) == 0 {
+		return nil, fmt.Errorf("no expected calls")
+	}
+	return nil, fmt.Errorf("%s", strings.Join(reasons, "; "))
+}
+
+// Finish 全ての期待値が満たされているか検証する
+//
+// NewControllerにCleanupを実装したTestReporterを渡した場合は自動で呼ばれる
+func (c *Controller) Finish() {
+	c.t.Helper()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.finished {
+		return
+	}
+	c.finished = true
+
+	for _, call := range c.calls {
+		if !call.satisfied() {
+			c.t.Errorf("mock: missing call(s) to %s: expected %s, actual %d", call, call.timesString(), call.numCalls)
+		}
+	}
+}
+
+// InOrder 指定の順序で呼び出されることを期待する
+func InOrder(calls ...*Call) {
+	for i := 1; i < len(calls); i++ {
+		calls[i].After(calls[i-1])
+	}
+}
+
+func formatArgs(args []interface{}) string {
+	var s []string
+	for _, arg := range args {
+		s = append(s, formatValue(arg))
+	}
+	return strings.Join(s, ", ")
+}
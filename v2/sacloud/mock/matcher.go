@@ -0,0 +1,139 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Matcher モックの引数のマッチャー
+type Matcher interface {
+	// Matches 引数がマッチするか
+	Matches(x interface{}) bool
+	// String エラーメッセージなどで利用する文字列表現
+	String() string
+}
+
+// Any 任意の値にマッチするMatcherを返す
+func Any() Matcher {
+	return anyMatcher{}
+}
+
+// Eq reflect.DeepEqualで値が等しい場合にマッチするMatcherを返す
+func Eq(x interface{}) Matcher {
+	return eqMatcher{x: x}
+}
+
+// Nil nilにマッチするMatcherを返す
+//
+// 型付きのnil(nilポインタなど)にもマッチする
+func Nil() Matcher {
+	return nilMatcher{}
+}
+
+// NotNil nil以外にマッチするMatcherを返す
+func NotNil() Matcher {
+	return Not(Nil())
+}
+
+// Not 指定のMatcherにマッチしない場合にマッチするMatcherを返す
+//
+// MatcherでないxはEq(x)として扱う
+func Not(x interface{}) Matcher {
+	return notMatcher{m: toMatcher(x)}
+}
+
+// Func 任意の関数でマッチするMatcherを返す
+//
+// descはエラーメッセージで利用される
+//
+//	mock.Func("Name is example", func(x interface{}) bool {
+//		req, ok := x.(*sacloud.ServerUpdateRequest)
+//		return ok && req.Name == "example"
+//	})
+func Func(desc string, fn func(x interface{}) bool) Matcher {
+	return funcMatcher{desc: desc, fn: fn}
+}
+
+func toMatcher(x interface{}) Matcher {
+	if m, ok := x.(Matcher); ok {
+		return m
+	}
+	return Eq(x)
+}
+
+type anyMatcher struct{}
+
+func (anyMatcher) Matches(interface{}) bool { return true }
+func (anyMatcher) String() string           { return "is anything" }
+
+type eqMatcher struct {
+	x interface{}
+}
+
+func (m eqMatcher) Matches(x interface{}) bool {
+	if m.x == nil || x == nil {
+		return isNil(m.x) && isNil(x)
+	}
+	return reflect.DeepEqual(m.x, x)
+}
+
+func (m eqMatcher) String() string {
+	return fmt.Sprintf("is equal to %s", formatValue(m.x))
+}
+
+type nilMatcher struct{}
+
+func (nilMatcher) Matches(x interface{}) bool { return isNil(x) }
+func (nilMatcher) String() string             { return "is nil" }
+
+type notMatcher struct {
+	m Matcher
+}
+
+func (m notMatcher) Matches(x interface{}) bool { return !m.m.Matches(x) }
+func (m notMatcher) String() string             { return "not(" + m.m.String() + ")" }
+
+type funcMatcher struct {
+	desc string
+	fn   func(x interface{}) bool
+}
+
+func (m funcMatcher) Matches(x interface{}) bool { return m.fn(x) }
+func (m funcMatcher) String() string             { return m.desc }
+
+func isNil(x interface{}) bool {
+	if x == nil {
+		return true
+	}
+	v := reflect.ValueOf(x)
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		return v.IsNil()
+	}
+	return false
+}
+
+func formatValue(x interface{}) string {
+	if isNil(x) {
+		return fmt.Sprintf("%v", x)
+	}
+	v := reflect.ValueOf(x)
+	if v.Kind() == reflect.Ptr {
+		return fmt.Sprintf("&%+v", v.Elem().Interface())
+	}
+	return fmt.Sprintf("%+v", x)
+}
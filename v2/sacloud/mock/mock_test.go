@@ -0,0 +1,246 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	diskBuilder "github.com/sacloud/libsacloud/v2/helper/builder/disk"
+	serverBuilder "github.com/sacloud/libsacloud/v2/helper/builder/server"
+	"github.com/sacloud/libsacloud/v2/helper/power"
+	"github.com/sacloud/libsacloud/v2/helper/query"
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/mock"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+// 各helperで定義されているインターフェースをモックが満たしているか
+var (
+	_ power.ServerAPI        = (*mock.ServerMock)(nil)
+	_ power.LoadBalancerAPI  = (*mock.LoadBalancerMock)(nil)
+	_ power.DatabaseAPI      = (*mock.DatabaseMock)(nil)
+	_ power.VPCRouterAPI     = (*mock.VPCRouterMock)(nil)
+	_ power.NFSAPI           = (*mock.NFSMock)(nil)
+	_ power.MobileGatewayAPI = (*mock.MobileGatewayMock)(nil)
+
+	_ query.ArchiveFinder    = (*mock.ArchiveMock)(nil)
+	_ query.NoteFinder       = (*mock.NoteMock)(nil)
+	_ query.ServerPlanFinder = (*mock.ServerPlanMock)(nil)
+	_ query.ServerReader     = (*mock.ServerMock)(nil)
+	_ query.ArchiveReader    = (*mock.ArchiveMock)(nil)
+	_ query.DiskReader       = (*mock.DiskMock)(nil)
+
+	_ serverBuilder.DiskHandler         = (*mock.DiskMock)(nil)
+	_ serverBuilder.SwitchReader        = (*mock.SwitchMock)(nil)
+	_ serverBuilder.InterfaceHandler    = (*mock.InterfaceMock)(nil)
+	_ serverBuilder.PacketFilterReader  = (*mock.PacketFilterMock)(nil)
+	_ serverBuilder.CreateServerHandler = (*mock.ServerMock)(nil)
+
+	_ diskBuilder.ArchiveFinder     = (*mock.ArchiveMock)(nil)
+	_ diskBuilder.CreateDiskHandler = (*mock.DiskMock)(nil)
+	_ diskBuilder.PlanReader        = (*mock.DiskPlanMock)(nil)
+	_ diskBuilder.NoteHandler       = (*mock.NoteMock)(nil)
+	_ diskBuilder.SSHKeyHandler     = (*mock.SSHKeyMock)(nil)
+)
+
+type testReporter struct {
+	errors []string
+	fatals []string
+}
+
+func (r *testReporter) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *testReporter) Fatalf(format string, args ...interface{}) {
+	r.fatals = append(r.fatals, fmt.Sprintf(format, args...))
+}
+
+func (r *testReporter) Helper() {}
+
+func TestMock_returnValues(t *testing.T) {
+	ctx := context.Background()
+	ctrl := mock.NewController(t)
+	serverAPI := mock.NewServerMock(ctrl)
+
+	serverAPI.EXPECT().Read(mock.Any(), "is1a", types.ID(1)).Return(&sacloud.Server{ID: 1, Name: "example"}, nil)
+	serverAPI.EXPECT().Read(mock.Any(), "is1a", types.ID(2)).Return(nil, errors.New("not found"))
+	serverAPI.EXPECT().Boot(mock.Any(), mock.Any(), mock.Any())
+
+	server, err := serverAPI.Read(ctx, "is1a", 1)
+	require.NoError(t, err)
+	require.Equal(t, "example", server.Name)
+
+	server, err = serverAPI.Read(ctx, "is1a", 2)
+	require.EqualError(t, err, "not found")
+	require.Nil(t, server)
+
+	// Returnを指定しない場合はゼロ値
+	require.NoError(t, serverAPI.Boot(ctx, "is1a", 1))
+}
+
+func TestMock_doAndReturn(t *testing.T) {
+	ctrl := mock.NewController(t)
+	diskAPI := mock.NewDiskMock(ctrl)
+
+	diskAPI.EXPECT().Update(mock.Any(), "is1a", mock.Any(), mock.NotNil()).DoAndReturn(
+		func(ctx context.Context, zone string, id types.ID, param *sacloud.DiskUpdateRequest) (*sacloud.Disk, error) {
+			return &sacloud.Disk{ID: id, Name: param.Name}, nil
+		},
+	)
+
+	disk, err := diskAPI.Update(context.Background(), "is1a", 10, &sacloud.DiskUpdateRequest{Name: "updated"})
+	require.NoError(t, err)
+	require.Equal(t, &sacloud.Disk{ID: 10, Name: "updated"}, disk)
+}
+
+func TestMock_matchers(t *testing.T) {
+	ctx := context.Background()
+	ctrl := mock.NewController(t)
+	noteAPI := mock.NewNoteMock(ctrl)
+
+	named := mock.Func("name has prefix 'test'", func(x interface{}) bool {
+		req, ok := x.(*sacloud.NoteCreateRequest)
+		return ok && len(req.Name) >= 4 && req.Name[:4] == "test"
+	})
+	noteAPI.EXPECT().Create(mock.Any(), named).Return(&sacloud.Note{ID: 1}, nil)
+	noteAPI.EXPECT().Create(mock.Any(), mock.Not(named)).Return(&sacloud.Note{ID: 2}, nil)
+	noteAPI.EXPECT().Find(mock.Any(), mock.Nil()).Return(&sacloud.NoteFindResult{}, nil)
+
+	note, err := noteAPI.Create(ctx, &sacloud.NoteCreateRequest{Name: "other"})
+	require.NoError(t, err)
+	require.Equal(t, types.ID(2), note.ID)
+
+	note, err = noteAPI.Create(ctx, &sacloud.NoteCreateRequest{Name: "test-note"})
+	require.NoError(t, err)
+	require.Equal(t, types.ID(1), note.ID)
+
+	_, err = noteAPI.Find(ctx, nil)
+	require.NoError(t, err)
+}
+
+func TestMock_times(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("call counting", func(t *testing.T) {
+		ctrl := mock.NewController(t)
+		serverAPI := mock.NewServerMock(ctrl)
+
+		read := serverAPI.EXPECT().Read(mock.Any(), mock.Any(), mock.Any()).Return(&sacloud.Server{}, nil).Times(3)
+		serverAPI.EXPECT().Delete(mock.Any(), mock.Any(), mock.Any()).AnyTimes()
+
+		for i := 0; i < 3; i++ {
+			_, err := serverAPI.Read(ctx, "is1a", 1)
+			require.NoError(t, err)
+		}
+		require.Equal(t, 3, read.Count())
+	})
+
+	t.Run("too many calls", func(t *testing.T) {
+		reporter := &testReporter{}
+		ctrl := mock.NewController(reporter)
+		serverAPI := mock.NewServerMock(ctrl)
+
+		serverAPI.EXPECT().Boot(mock.Any(), mock.Any(), mock.Any())
+		require.NoError(t, serverAPI.Boot(ctx, "is1a", 1))
+		require.Empty(t, reporter.fatals)
+
+		serverAPI.Boot(ctx, "is1a", 1) // nolint
+		require.Len(t, reporter.fatals, 1)
+		require.Contains(t, reporter.fatals[0], "has already been called 1 times")
+	})
+
+	t.Run("missing calls", func(t *testing.T) {
+		reporter := &testReporter{}
+		ctrl := mock.NewController(reporter)
+		serverAPI := mock.NewServerMock(ctrl)
+
+		serverAPI.EXPECT().Boot(mock.Any(), mock.Any(), mock.Any()).MinTimes(2)
+		serverAPI.EXPECT().Shutdown(mock.Any(), mock.Any(), mock.Any(), mock.Any()).MaxTimes(1)
+		require.NoError(t, serverAPI.Boot(ctx, "is1a", 1))
+
+		ctrl.Finish()
+		require.Len(t, reporter.errors, 1)
+		require.Contains(t, reporter.errors[0], "expected at least 2 times, actual 1")
+	})
+}
+
+func TestMock_unexpectedCall(t *testing.T) {
+	reporter := &testReporter{}
+	ctrl := mock.NewController(reporter)
+	serverAPI := mock.NewServerMock(ctrl)
+
+	serverAPI.EXPECT().Read(mock.Any(), "is1a", types.ID(1))
+
+	serverAPI.Read(context.Background(), "is1a", 2) // nolint
+	require.Len(t, reporter.fatals, 1)
+	require.Contains(t, reporter.fatals[0], "argument 2 of")
+
+	serverAPI.Boot(context.Background(), "is1a", 1) // nolint
+	require.Len(t, reporter.fatals, 2)
+	require.Contains(t, reporter.fatals[1], "no expected calls")
+}
+
+func TestMock_inOrder(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("in order", func(t *testing.T) {
+		ctrl := mock.NewController(t)
+		serverAPI := mock.NewServerMock(ctrl)
+		diskAPI := mock.NewDiskMock(ctrl)
+
+		mock.InOrder(
+			serverAPI.EXPECT().Shutdown(mock.Any(), "is1a", types.ID(1), mock.Any()),
+			diskAPI.EXPECT().DisconnectFromServer(mock.Any(), "is1a", types.ID(2)),
+			serverAPI.EXPECT().Delete(mock.Any(), "is1a", types.ID(1)),
+		)
+
+		require.NoError(t, serverAPI.Shutdown(ctx, "is1a", 1, &sacloud.ShutdownOption{Force: true}))
+		require.NoError(t, diskAPI.DisconnectFromServer(ctx, "is1a", 2))
+		require.NoError(t, serverAPI.Delete(ctx, "is1a", 1))
+	})
+
+	t.Run("out of order", func(t *testing.T) {
+		reporter := &testReporter{}
+		ctrl := mock.NewController(reporter)
+		serverAPI := mock.NewServerMock(ctrl)
+
+		shutdown := serverAPI.EXPECT().Shutdown(mock.Any(), mock.Any(), mock.Any(), mock.Any())
+		serverAPI.EXPECT().Delete(mock.Any(), mock.Any(), mock.Any()).After(shutdown)
+
+		serverAPI.Delete(ctx, "is1a", 1) // nolint
+		require.Len(t, reporter.fatals, 1)
+		require.Contains(t, reporter.fatals[0], "must be called after")
+	})
+}
+
+func TestMock_invalidReturn(t *testing.T) {
+	reporter := &testReporter{}
+	ctrl := mock.NewController(reporter)
+	serverAPI := mock.NewServerMock(ctrl)
+
+	serverAPI.EXPECT().Read(mock.Any(), mock.Any(), mock.Any()).Return(&sacloud.Server{})
+	serverAPI.EXPECT().Read(mock.Any(), mock.Any(), mock.Any()).Return(&sacloud.Disk{}, nil)
+	serverAPI.EXPECT().Read(mock.Any(), mock.Any(), mock.Any()).DoAndReturn(func() {})
+
+	require.Len(t, reporter.fatals, 3)
+	require.Contains(t, reporter.fatals[0], "wrong number of return values")
+	require.Contains(t, reporter.fatals[1], "*sacloud.Disk is not assignable to *sacloud.Server")
+	require.Contains(t, reporter.fatals[2], "wrong function type")
+}
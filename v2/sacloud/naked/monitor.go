@@ -141,6 +141,63 @@ func (m *MonitorValues) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON アクティビティモニタ向けMarshalJSON実装
+//
+// APIからのレスポンスと同じく時刻をキーとしたオブジェクトを出力する
+func (m MonitorValues) MarshalJSON() ([]byte, error) {
+	values := make(rawMonitorValues)
+	at := func(t time.Time) *rawMonitorValue {
+		key := t.Format(time.RFC3339)
+		if _, ok := values[key]; !ok {
+			values[key] = &rawMonitorValue{}
+		}
+		return values[key]
+	}
+	f := func(v float64) *float64 { return &v }
+
+	for _, v := range m.CPU {
+		at(v.Time).CPUTime = f(v.CPUTime)
+	}
+	for _, v := range m.Disk {
+		raw := at(v.Time)
+		raw.Read, raw.Write = f(v.Read), f(v.Write)
+	}
+	for _, v := range m.Interface {
+		raw := at(v.Time)
+		raw.Receive, raw.Send = f(v.Receive), f(v.Send)
+	}
+	for _, v := range m.Router {
+		raw := at(v.Time)
+		raw.In, raw.Out = f(v.In), f(v.Out)
+	}
+	for _, v := range m.Database {
+		raw := at(v.Time)
+		raw.TotalMemorySize, raw.UsedMemorySize = f(v.TotalMemorySize), f(v.UsedMemorySize)
+		raw.TotalDisk1Size, raw.UsedDisk1Size = f(v.TotalDisk1Size), f(v.UsedDisk1Size)
+		raw.TotalDisk2Size, raw.UsedDisk2Size = f(v.TotalDisk2Size), f(v.UsedDisk2Size)
+		raw.BinlogUsedSizeKiB, raw.DelayTimeSec = f(v.BinlogUsedSizeKiB), f(v.DelayTimeSec)
+	}
+	for _, v := range m.FreeDiskSize {
+		at(v.Time).FreeDiskSize = f(v.FreeDiskSize)
+	}
+	for _, v := range m.ResponseTimeSec {
+		at(v.Time).ResponseTimeSec = f(v.ResponseTimeSec)
+	}
+	for _, v := range m.Link {
+		raw := at(v.Time)
+		raw.UplinkBPS, raw.DownlinkBPS = f(v.UplinkBPS), f(v.DownlinkBPS)
+	}
+	for _, v := range m.Connection {
+		raw := at(v.Time)
+		raw.ActiveConnections, raw.ConnectionsPerSec = f(v.ActiveConnections), f(v.ConnectionsPerSec)
+	}
+	for _, v := range m.LocalRouter {
+		raw := at(v.Time)
+		raw.ReceiveBytesPerSec, raw.SendBytesPerSec = f(v.ReceiveBytesPerSec), f(v.SendBytesPerSec)
+	}
+	return json.Marshal(values)
+}
+
 /************************************************
  * CPU-TIME
 ************************************************/
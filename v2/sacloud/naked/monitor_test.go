@@ -479,3 +479,24 @@ func TestMonitorValues_UnmarshalJSON(t *testing.T) {
 		require.Equal(t, tc.expect, dest)
 	}
 }
+
+func TestMonitorValues_MarshalJSON(t *testing.T) {
+	inputs := []string{
+		testMonitorValuesCPUTimeJSON,
+		testMonitorValuesDiskJSON,
+		testMonitorValuesInterfaceJSON,
+		testMonitorValuesLocalRouterJSON,
+	}
+
+	for _, input := range inputs {
+		values := MonitorValues{}
+		require.NoError(t, json.Unmarshal([]byte(input), &values))
+
+		data, err := json.Marshal(values)
+		require.NoError(t, err)
+
+		restored := MonitorValues{}
+		require.NoError(t, json.Unmarshal(data, &restored))
+		require.Equal(t, values, restored)
+	}
+}
@@ -149,6 +149,14 @@ type ConfigValue struct {
 	RetryWaitMin int
 	// RetryMax 423/503時のリトライ間隔(最大) 単位:秒
 	RetryWaitMax int
+	// RetryOnStillCreating 409+still_creating時にリトライするか
+	RetryOnStillCreating bool `json:",omitempty"`
+	// RetryOnTooManyRequests 429時にリトライするか
+	RetryOnTooManyRequests bool `json:",omitempty"`
+	// RetryOnConnectionError GETリクエストでの通信エラー時にリトライするか
+	RetryOnConnectionError bool `json:",omitempty"`
+	// RetryJitter リトライ間隔にゆらぎを加えるか
+	RetryJitter bool `json:",omitempty"`
 
 	// StatePollingTimeout StatePollWaiterでのタイムアウト 単位:秒
 	StatePollingTimeout int
@@ -0,0 +1,189 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sacloud
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ClientRegistry クライアントファクトリーやフック、ポーリング設定などをインスタンス単位で保持するレジストリ
+//
+// ClientRegistryHolderを実装したAPICaller(ClientやCallerWithRegistryなど)を用いてNewXxxOp(caller)を呼んだ場合、
+// パッケージレベルのファクトリー/フック(SetClientFactoryFuncやAddClientFacotyHookFuncで登録したもの)の代わりに
+// このレジストリに登録されたものが利用される。
+// これにより1プロセス内でフェイク/実API/トレースなどの設定が異なる複数のAPICallerを併用できる。
+//
+// レジストリにファクトリーが登録されていないリソースについては、libsacloud組み込みのファクトリー(実APIを呼び出すXxxOp)が利用される。
+// パッケージレベルのフックはレジストリ経由の場合は適用されない
+type ClientRegistry struct {
+	// StatePollingTimeout StatePollingWaiterでのデフォルトタイムアウト 0の場合はDefaultStatePollingTimeoutが利用される
+	StatePollingTimeout time.Duration
+	// StatePollingInterval StatePollingWaiterでのデフォルトポーリング間隔 0の場合はDefaultStatePollingIntervalが利用される
+	StatePollingInterval time.Duration
+	// DBStatusPollingInterval データベースアプライアンスのステータス取得ポーリング間隔 0の場合はDefaultDBStatusPollingIntervalが利用される
+	DBStatusPollingInterval time.Duration
+
+	mu        sync.RWMutex
+	factories map[string]func(APICaller) interface{}
+	hooks     map[string][]func(interface{}) interface{}
+}
+
+// NewClientRegistry 空のClientRegistryを返す
+func NewClientRegistry() *ClientRegistry {
+	return &ClientRegistry{}
+}
+
+// SetClientFactoryFunc リソースごとのクライアントファクトリーを登録する
+func (r *ClientRegistry) SetClientFactoryFunc(resourceName string, factoryFunc func(caller APICaller) interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.factories == nil {
+		r.factories = make(map[string]func(APICaller) interface{})
+	}
+	r.factories[resourceName] = factoryFunc
+}
+
+// AddClientFactoryHookFunc クライアントファクトリーのフックを登録する
+func (r *ClientRegistry) AddClientFactoryHookFunc(resourceName string, hookFunc func(interface{}) interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.hooks == nil {
+		r.hooks = make(map[string][]func(interface{}) interface{})
+	}
+	r.hooks[resourceName] = append(r.hooks[resourceName], hookFunc)
+}
+
+// GetClientFactoryFunc リソースごとのクライアントファクトリーを取得する
+//
+// レジストリにファクトリーが登録されていない場合はlibsacloud組み込みのファクトリーを返す。
+// どちらにも登録されていない場合はpanicする
+func (r *ClientRegistry) GetClientFactoryFunc(resourceName string) func(APICaller) interface{} {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	f, ok := r.factories[resourceName]
+	if !ok {
+		f, ok = builtinClientFactory[resourceName]
+	}
+	if !ok {
+		panic(resourceName + " is not found in clientFactory")
+	}
+	return applyClientFactoryHooks(f, r.hooks[resourceName])
+}
+
+func (r *ClientRegistry) statePollingTimeout() time.Duration {
+	if r != nil && r.StatePollingTimeout > 0 {
+		return r.StatePollingTimeout
+	}
+	return DefaultStatePollingTimeout
+}
+
+func (r *ClientRegistry) statePollingInterval() time.Duration {
+	if r != nil && r.StatePollingInterval > 0 {
+		return r.StatePollingInterval
+	}
+	return DefaultStatePollingInterval
+}
+
+func (r *ClientRegistry) dbStatusPollingInterval() time.Duration {
+	if r != nil && r.DBStatusPollingInterval > 0 {
+		return r.DBStatusPollingInterval
+	}
+	return DefaultDBStatusPollingInterval
+}
+
+// ClientRegistryHolder ClientRegistryを保持するAPICallerが実装するインターフェース
+type ClientRegistryHolder interface {
+	ClientRegistry() *ClientRegistry
+}
+
+// ClientRegistryFromCaller APICallerが保持するClientRegistryを返す
+func ClientRegistryFromCaller(caller APICaller) (*ClientRegistry, bool) {
+	if holder, ok := caller.(ClientRegistryHolder); ok {
+		if r := holder.ClientRegistry(); r != nil {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// ClientRegistryFromAPI APIクライアント(NewXxxOpで作成したXxxAPIなど)が保持するClientRegistryを返す
+//
+// ClientRegistryを保持するAPICallerを用いて作成したXxxOpや、レジストリに登録されたフェイクドライバ/トレーサーが該当する
+func ClientRegistryFromAPI(client interface{}) (*ClientRegistry, bool) {
+	if holder, ok := client.(ClientRegistryHolder); ok {
+		if r := holder.ClientRegistry(); r != nil {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// CallerWithRegistry 任意のAPICallerにClientRegistryを関連付けるためのAPICaller
+type CallerWithRegistry struct {
+	APICaller
+	Registry *ClientRegistry
+}
+
+// NewCallerWithRegistry APICallerにClientRegistryを関連付けたAPICallerを返す
+func NewCallerWithRegistry(caller APICaller, registry *ClientRegistry) *CallerWithRegistry {
+	return &CallerWithRegistry{APICaller: caller, Registry: registry}
+}
+
+// ClientRegistry ClientRegistryHolderの実装
+func (c *CallerWithRegistry) ClientRegistry() *ClientRegistry {
+	return c.Registry
+}
+
+type clientRegistryContextKey struct{}
+
+// WithClientRegistry 指定のClientRegistryを設定したcontextを返す
+//
+// このcontextを用いたStatePollingWaiterではClientRegistryのポーリング設定がデフォルト値として利用される
+func WithClientRegistry(ctx context.Context, registry *ClientRegistry) context.Context {
+	return context.WithValue(ctx, clientRegistryContextKey{}, registry)
+}
+
+// WithClientRegistryOf APIクライアントが保持するClientRegistryを設定したcontextを返す
+//
+// ctxに既にClientRegistryが設定されている場合、またはclientがClientRegistryを保持していない場合はctxをそのまま返す。
+// helper/waitなどでStatePollingWaiterにAPIクライアントのClientRegistryのポーリング設定を反映させるために利用する
+func WithClientRegistryOf(ctx context.Context, client interface{}) context.Context {
+	if _, ok := ClientRegistryFromContext(ctx); ok {
+		return ctx
+	}
+	if r, ok := ClientRegistryFromAPI(client); ok {
+		return WithClientRegistry(ctx, r)
+	}
+	return ctx
+}
+
+// ClientRegistryFromContext contextに設定されたClientRegistryを返す
+func ClientRegistryFromContext(ctx context.Context) (*ClientRegistry, bool) {
+	r, ok := ctx.Value(clientRegistryContextKey{}).(*ClientRegistry)
+	return r, ok && r != nil
+}
+
+// DBStatusPollingIntervalFromContext データベースアプライアンスのステータス取得ポーリング間隔を返す
+//
+// contextにClientRegistryが設定されている場合はその設定を、そうでない場合はDefaultDBStatusPollingIntervalを返す
+func DBStatusPollingIntervalFromContext(ctx context.Context) time.Duration {
+	r, _ := ClientRegistryFromContext(ctx)
+	return r.dbStatusPollingInterval()
+}
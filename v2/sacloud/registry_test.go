@@ -0,0 +1,108 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sacloud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type registryTestZoneAPI struct {
+	ZoneAPI
+	name string
+}
+
+func TestClientRegistry(t *testing.T) {
+	t.Run("without registry", func(t *testing.T) {
+		op := NewZoneOp(&Client{})
+		_, ok := op.(*ZoneOp)
+		require.True(t, ok)
+	})
+
+	t.Run("builtin factory is used when registry has no factory", func(t *testing.T) {
+		registry := NewClientRegistry()
+		op := NewZoneOp(&Client{Registry: registry})
+		_, ok := op.(*ZoneOp)
+		require.True(t, ok)
+	})
+
+	t.Run("factory and hooks are resolved from caller", func(t *testing.T) {
+		registry1 := NewClientRegistry()
+		registry1.SetClientFactoryFunc("Zone", func(caller APICaller) interface{} {
+			return &registryTestZoneAPI{name: "registry1"}
+		})
+		registry1.AddClientFactoryHookFunc("Zone", func(in interface{}) interface{} {
+			in.(*registryTestZoneAPI).name += "+hook"
+			return in
+		})
+
+		registry2 := NewClientRegistry()
+		registry2.SetClientFactoryFunc("Zone", func(caller APICaller) interface{} {
+			return &registryTestZoneAPI{name: "registry2"}
+		})
+
+		op1 := NewZoneOp(&Client{Registry: registry1})
+		require.Equal(t, "registry1+hook", op1.(*registryTestZoneAPI).name)
+
+		op2 := NewZoneOp(NewCallerWithRegistry(&Client{}, registry2))
+		require.Equal(t, "registry2", op2.(*registryTestZoneAPI).name)
+
+		// グローバルな設定は影響を受けない
+		_, ok := NewZoneOp(&Client{}).(*ZoneOp)
+		require.True(t, ok)
+	})
+
+	t.Run("polling defaults are resolved from context", func(t *testing.T) {
+		registry := &ClientRegistry{
+			StatePollingTimeout:     time.Minute,
+			StatePollingInterval:    time.Millisecond,
+			DBStatusPollingInterval: 2 * time.Millisecond,
+		}
+
+		w := &StatePollingWaiter{}
+		w.defaults(WithClientRegistry(context.Background(), registry))
+		require.Equal(t, time.Minute, w.Timeout)
+		require.Equal(t, time.Millisecond, w.PollingInterval)
+
+		w = &StatePollingWaiter{}
+		w.defaults(context.Background())
+		require.Equal(t, DefaultStatePollingTimeout, w.Timeout)
+		require.Equal(t, DefaultStatePollingInterval, w.PollingInterval)
+
+		require.Equal(t, 2*time.Millisecond, DBStatusPollingIntervalFromContext(WithClientRegistry(context.Background(), registry)))
+		require.Equal(t, DefaultDBStatusPollingInterval, DBStatusPollingIntervalFromContext(context.Background()))
+	})
+
+	t.Run("registry is resolved from the API client", func(t *testing.T) {
+		registry1 := &ClientRegistry{StatePollingInterval: time.Millisecond}
+		registry2 := &ClientRegistry{StatePollingInterval: 2 * time.Millisecond}
+
+		op := NewZoneOp(NewCallerWithRegistry(&Client{}, registry1))
+		w := &StatePollingWaiter{}
+		w.defaults(WithClientRegistryOf(context.Background(), op))
+		require.Equal(t, time.Millisecond, w.PollingInterval)
+
+		// contextに設定されたClientRegistryが優先される
+		w = &StatePollingWaiter{}
+		w.defaults(WithClientRegistryOf(WithClientRegistry(context.Background(), registry2), op))
+		require.Equal(t, 2*time.Millisecond, w.PollingInterval)
+
+		_, ok := ClientRegistryFromAPI(NewZoneOp(&Client{}))
+		require.False(t, ok)
+	})
+}
@@ -0,0 +1,188 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sacloud
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	sacloudhttp "github.com/sacloud/go-http"
+)
+
+// RetryPolicy APIコール時のリトライポリシー
+//
+// Client.RetryPolicy、またはWithRetryPolicyでcontextに設定することで有効になる。
+// 有効な場合、Clientのリトライ関連フィールド(RetryMax/RetryWaitMin/RetryWaitMax)の代わりにこちらの設定が利用される。
+//
+// リトライ間隔はRetryWaitMinからRetryWaitMaxまで指数的に増加する(Exponential Backoff)。
+// レスポンスにRetry-Afterヘッダが含まれる場合はその値を優先する
+type RetryPolicy struct {
+	// RetryMax リトライ回数 0の場合はデフォルト値、負の値の場合はリトライしない
+	RetryMax int
+	// RetryWaitMin リトライ待ち時間(最小) 0の場合はデフォルト値
+	RetryWaitMin time.Duration
+	// RetryWaitMax リトライ待ち時間(最大) 0の場合はデフォルト値
+	RetryWaitMax time.Duration
+	// JitterFactor リトライ待ち時間に加えるゆらぎの割合(0〜1)
+	JitterFactor float64
+
+	// RetryOnStillCreating 409+still_creatingの場合にリトライするか
+	RetryOnStillCreating bool
+	// RetryOnTooManyRequests 429の場合にリトライするか
+	RetryOnTooManyRequests bool
+	// RetryOnConnectionError GETリクエストでコネクションリセットなどの通信エラーが発生した場合にリトライするか
+	RetryOnConnectionError bool
+
+	// CheckRetryFunc リトライすべきか判定するためのfunc
+	//
+	// 未指定の場合はステータスコード423/503、およびRetryOnXXXで有効化された条件でリトライする
+	CheckRetryFunc func(ctx context.Context, method string, err error) bool
+
+	// OnRetry リトライ時に呼ばれるfunc
+	//
+	// ctxにはリトライ対象のAPIコールで指定されたcontextが渡される。
+	// トレースやメトリクスなどcontextに設定された情報をリトライイベントと関連付けるために利用できる
+	OnRetry func(ctx context.Context, event *RetryEvent)
+}
+
+// RetryEvent リトライ発生時のイベント
+type RetryEvent struct {
+	// Method リクエストメソッド
+	Method string
+	// URL リクエストURL
+	URL string
+	// Attempt リトライ回数(1始まり)
+	Attempt int
+	// Wait 次のリクエストまでの待ち時間
+	Wait time.Duration
+	// StatusCode レスポンスステータスコード 通信エラーの場合は0
+	StatusCode int
+	// Err リトライの原因となったエラー
+	Err error
+}
+
+type retryPolicyContextKey struct{}
+
+// WithRetryPolicy 指定のRetryPolicyを設定したcontextを返す
+//
+// このcontextを用いたAPIコールではClientに設定されたRetryPolicyの代わりに指定のRetryPolicyが利用される
+func WithRetryPolicy(ctx context.Context, policy *RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyContextKey{}, policy)
+}
+
+// RetryPolicyFromContext contextに設定されたRetryPolicyを返す
+func RetryPolicyFromContext(ctx context.Context) (*RetryPolicy, bool) {
+	policy, ok := ctx.Value(retryPolicyContextKey{}).(*RetryPolicy)
+	return policy, ok && policy != nil
+}
+
+func (p *RetryPolicy) retryMax() int {
+	if p.RetryMax == 0 {
+		return sacloudhttp.DefaultRetryMax
+	}
+	return p.RetryMax
+}
+
+func (p *RetryPolicy) retryWaitMin() time.Duration {
+	if p.RetryWaitMin == 0 {
+		return sacloudhttp.DefaultRetryWaitMin
+	}
+	return p.RetryWaitMin
+}
+
+func (p *RetryPolicy) retryWaitMax() time.Duration {
+	if p.RetryWaitMax == 0 {
+		return sacloudhttp.DefaultRetryWaitMax
+	}
+	return p.RetryWaitMax
+}
+
+func (p *RetryPolicy) shouldRetry(ctx context.Context, method string, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if p.CheckRetryFunc != nil {
+		return p.CheckRetryFunc(ctx, method, err)
+	}
+
+	if apiErr, ok := AsAPIError(err); ok {
+		switch apiErr.ResponseCode() {
+		case http.StatusLocked, http.StatusServiceUnavailable:
+			return true
+		case http.StatusTooManyRequests:
+			return p.RetryOnTooManyRequests
+		}
+		return p.RetryOnStillCreating && IsStillCreatingError(err)
+	}
+	return p.RetryOnConnectionError && method == http.MethodGet && isConnectionError(err)
+}
+
+// wait attempt回目(0始まり)のリトライまでの待ち時間を算出する
+func (p *RetryPolicy) wait(attempt int, header http.Header) time.Duration {
+	if wait, ok := retryAfter(header); ok {
+		return wait
+	}
+
+	min, max := p.retryWaitMin(), p.retryWaitMax()
+	wait := time.Duration(math.Pow(2, float64(attempt)) * float64(min))
+	if wait <= 0 || wait > max {
+		wait = max
+	}
+	if p.JitterFactor > 0 {
+		wait += time.Duration(rand.Float64() * p.JitterFactor * float64(wait)) // nolint:gosec
+	}
+	return wait
+}
+
+func retryAfter(header http.Header) (time.Duration, bool) {
+	if header == nil {
+		return 0, false
+	}
+	v := header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if sec, err := strconv.Atoi(v); err == nil && sec >= 0 {
+		return time.Duration(sec) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		wait := time.Until(t)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+func isConnectionError(err error) bool {
+	if errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
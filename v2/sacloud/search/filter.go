@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 )
 
@@ -59,6 +60,73 @@ func (f Filter) MarshalJSON() ([]byte, error) {
 	return json.Marshal(result)
 }
 
+// UnmarshalJSON 検索系APIコール時のGETパラメータからFilterを復元するためのjson.Unmarshaler実装
+//
+// MarshalJSONで出力した値を読み込んだ場合、OpEqualの条件は*EqualExpressionとして、
+// それ以外の演算子の条件はエスケープを解除した文字列として復元される
+func (f *Filter) UnmarshalJSON(data []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	filter := Filter{}
+	for k, v := range raw {
+		key := parseFilterKey(k)
+		switch key.Op {
+		case OpEqual:
+			filter[key] = parseEqualExpression(v)
+		default:
+			if s, ok := v.(string); ok {
+				filter[key] = unescapeFilterString(s)
+			} else {
+				filter[key] = v
+			}
+		}
+	}
+	*f = filter
+	return nil
+}
+
+func parseFilterKey(s string) FilterKey {
+	for _, op := range []ComparisonOperator{OpGreaterEqual, OpLessEqual, OpGreaterThan, OpLessThan} {
+		if strings.HasSuffix(s, string(op)) {
+			return KeyWithOp(strings.TrimSuffix(s, string(op)), op)
+		}
+	}
+	return Key(s)
+}
+
+func parseEqualExpression(v interface{}) *EqualExpression {
+	switch v := v.(type) {
+	case string:
+		// AND条件は%20で連結されている
+		return AndEqual(strings.Split(unescapeFilterString(v), " ")...)
+	case []interface{}:
+		var conditions []interface{}
+		for _, cond := range v {
+			switch cond := cond.(type) {
+			case string:
+				conditions = append(conditions, unescapeFilterString(cond))
+			case []interface{}:
+				// タグなどの配列値
+				var values []string
+				for _, c := range cond {
+					if s, ok := c.(string); ok {
+						values = append(values, unescapeFilterString(s))
+					}
+				}
+				conditions = append(conditions, values)
+			default:
+				conditions = append(conditions, cond)
+			}
+		}
+		return OrEqual(conditions...)
+	default:
+		return OrEqual(v)
+	}
+}
+
 func convertToValidFilterCondition(v interface{}) (string, error) {
 	switch v := v.(type) {
 	case time.Time:
@@ -87,3 +155,11 @@ func escapeFilterString(s string) string {
 	// このためurl.PathEscapeを利用する。
 	return url.PathEscape(s)
 }
+
+func unescapeFilterString(s string) string {
+	v, err := url.PathUnescape(s)
+	if err != nil {
+		return s
+	}
+	return v
+}
@@ -143,3 +143,33 @@ func TestFilter(t *testing.T) {
 		require.Equal(t, tc.expect, string(data))
 	}
 }
+
+func TestFilter_UnmarshalJSON(t *testing.T) {
+	filter := Filter{
+		Key("Name"):                                 AndEqual("test", "example"),
+		Key("Zone.Name"):                            OrEqual("is1a", "is1b"),
+		Key("Tags.Name"):                            TagsAndEqual("tag1", "tag2"),
+		Key("MACAddress"):                           ExactMatch("00:00:5E:00:53:00"),
+		KeyWithOp("CreatedAt", OpLessThan):          "2011-09-01T00:00:00+09:00",
+		KeyWithOp("ServerPlan.CPU", OpGreaterEqual): "2",
+	}
+
+	data, err := json.Marshal(filter)
+	require.NoError(t, err)
+
+	var restored Filter
+	require.NoError(t, json.Unmarshal(data, &restored))
+
+	require.Equal(t, Filter{
+		Key("Name"):                                 AndEqual("test", "example"),
+		Key("Zone.Name"):                            OrEqual("is1a", "is1b"),
+		Key("Tags.Name"):                            OrEqual([]string{"tag1", "tag2"}),
+		Key("MACAddress"):                           OrEqual("00:00:5E:00:53:00"),
+		KeyWithOp("CreatedAt", OpLessThan):          "2011-09-01T00:00:00+09:00",
+		KeyWithOp("ServerPlan.CPU", OpGreaterEqual): "2",
+	}, restored)
+
+	var keys SortKeys
+	require.NoError(t, json.Unmarshal([]byte(`["Name","-CreatedAt"]`), &keys))
+	require.Equal(t, SortKeys{SortKeyAsc("Name"), SortKeyDesc("CreatedAt")}, keys)
+}
@@ -14,7 +14,10 @@
 
 package search
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"strings"
+)
 
 // SortOrder ソート順
 type SortOrder int
@@ -59,3 +62,17 @@ func (k SortKey) MarshalJSON() ([]byte, error) {
 	}
 	return json.Marshal(s)
 }
+
+// UnmarshalJSON キーの文字列表現からSortKeyを復元する
+func (k *SortKey) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if strings.HasPrefix(s, "-") {
+		*k = SortKeyDesc(strings.TrimPrefix(s, "-"))
+	} else {
+		*k = SortKeyAsc(s)
+	}
+	return nil
+}
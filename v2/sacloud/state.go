@@ -42,6 +42,9 @@ var (
 	// DefaultStatePollingInterval StatePollWaiterでのデフォルトポーリング間隔
 	DefaultStatePollingInterval = 5 * time.Second
 
+	// DefaultStateMaxPollingInterval StatePollWaiterでポーリング間隔を伸ばす場合のデフォルトの上限
+	DefaultStateMaxPollingInterval = time.Minute
+
 	// DefaultDBStatusPollingInterval データベースアプライアンスのステータス取得ポーリング間隔
 	DefaultDBStatusPollingInterval = 30 * time.Second
 )
@@ -129,8 +132,25 @@ type StatePollingWaiter struct {
 	// PollingInterval ポーリング間隔
 	PollingInterval time.Duration
 
+	// PollingBackoffFactor ポーリング間隔の増加率
+	//
+	// 1より大きい値を指定した場合、状態(Availability/InstanceStatus/MigratedMB)に変化がない間は
+	// ポーリングごとに間隔をこの倍率で伸ばし、変化があった場合はPollingIntervalに戻す。
+	// 1以下の場合は常にPollingIntervalでポーリングする
+	PollingBackoffFactor float64
+	// MaxPollingInterval PollingBackoffFactorによって伸ばすポーリング間隔の上限 0の場合はDefaultStateMaxPollingInterval
+	MaxPollingInterval time.Duration
+
+	// EventHandler ポーリングごとに進捗を通知するためのハンドラ
+	EventHandler StateEventHandler
+
 	// RaiseErrorWithUnknownState State(AvailabilityとInstanceStatus)が予期しない値だった場合にエラーとするか
 	RaiseErrorWithUnknownState bool
+
+	// ClientRegistry TimeoutとPollingIntervalのデフォルト値を参照するClientRegistry
+	//
+	// 省略した場合はcontextに設定されたClientRegistry(WithClientRegistry)が参照される
+	ClientRegistry *ClientRegistry
 }
 
 func (w *StatePollingWaiter) validateFields() {
@@ -147,12 +167,16 @@ func (w *StatePollingWaiter) validateFields() {
 	}
 }
 
-func (w *StatePollingWaiter) defaults() {
+func (w *StatePollingWaiter) defaults(ctx context.Context) {
+	registry := w.ClientRegistry
+	if registry == nil {
+		registry, _ = ClientRegistryFromContext(ctx)
+	}
 	if w.Timeout == time.Duration(0) {
-		w.Timeout = DefaultStatePollingTimeout
+		w.Timeout = registry.statePollingTimeout()
 	}
 	if w.PollingInterval == time.Duration(0) {
-		w.PollingInterval = DefaultStatePollingInterval
+		w.PollingInterval = registry.statePollingInterval()
 	}
 }
 
@@ -166,6 +190,17 @@ func (w *StatePollingWaiter) SetPollingInterval(d time.Duration) {
 	w.PollingInterval = d
 }
 
+// SetPollingBackoff ポーリング間隔の増加率と上限を指定
+func (w *StatePollingWaiter) SetPollingBackoff(factor float64, max time.Duration) {
+	w.PollingBackoffFactor = factor
+	w.MaxPollingInterval = max
+}
+
+// SetEventHandler 進捗を通知するためのハンドラを指定
+func (w *StatePollingWaiter) SetEventHandler(handler StateEventHandler) {
+	w.EventHandler = handler
+}
+
 // WaitForState リソースが指定の状態になるまで待つ
 func (w *StatePollingWaiter) WaitForState(ctx context.Context) (interface{}, error) {
 	c, p, e := w.AsyncWaitForState(ctx)
@@ -186,45 +221,52 @@ func (w *StatePollingWaiter) WaitForState(ctx context.Context) (interface{}, err
 // AsyncWaitForState リソースが指定の状態になるまで待つ
 func (w *StatePollingWaiter) AsyncWaitForState(ctx context.Context) (compCh <-chan interface{}, progressCh <-chan interface{}, errorCh <-chan error) {
 	w.validateFields()
-	w.defaults()
+	w.defaults(ctx)
 
 	compChan := make(chan interface{})
 	progChan := make(chan interface{})
 	errChan := make(chan error)
 
-	ticker := time.NewTicker(w.PollingInterval)
+	interval := w.PollingInterval
+	timer := time.NewTimer(interval)
 
 	go func() {
 		ctx, cancel := context.WithTimeout(ctx, w.Timeout)
 		defer cancel()
 
-		defer ticker.Stop()
+		defer timer.Stop()
 
 		defer close(compChan)
 		defer close(progChan)
 		defer close(errChan)
 
+		tracker := newStateEventTracker()
 		notFoundCounter := w.NotFoundRetry
 		for {
 			select {
 			case <-ctx.Done():
+				w.notify(tracker.next(nil, false, ctx.Err()))
 				errChan <- ctx.Err()
 				return
-			case <-ticker.C:
+			case <-timer.C:
 				state, err := w.ReadFunc()
 
 				if err != nil {
 					if IsNotFoundError(err) {
 						notFoundCounter--
 						if notFoundCounter >= 0 {
+							timer.Reset(interval)
 							continue
 						}
 					}
+					w.notify(tracker.next(nil, false, err))
 					errChan <- err
 					return
 				}
 
 				exit, err := w.handleState(state)
+				event := tracker.next(state, exit, err)
+				w.notify(event)
 				if exit {
 					compChan <- state
 					return
@@ -238,6 +280,9 @@ func (w *StatePollingWaiter) AsyncWaitForState(ctx context.Context) (compCh <-ch
 				if state != nil {
 					progChan <- state
 				}
+
+				interval = w.nextPollingInterval(interval, event.Changed())
+				timer.Reset(interval)
 			}
 		}
 	}()
@@ -248,6 +293,31 @@ func (w *StatePollingWaiter) AsyncWaitForState(ctx context.Context) (compCh <-ch
 	return compCh, progressCh, errorCh
 }
 
+func (w *StatePollingWaiter) notify(event *StateEvent) {
+	if w.EventHandler != nil {
+		w.EventHandler(event)
+	}
+}
+
+func (w *StatePollingWaiter) nextPollingInterval(current time.Duration, changed bool) time.Duration {
+	if w.PollingBackoffFactor <= 1 || changed {
+		return w.PollingInterval
+	}
+	max := w.MaxPollingInterval
+	if max <= 0 {
+		max = DefaultStateMaxPollingInterval
+	}
+
+	next := time.Duration(float64(current) * w.PollingBackoffFactor)
+	if next > max {
+		next = max
+	}
+	if next < w.PollingInterval {
+		next = w.PollingInterval
+	}
+	return next
+}
+
 func (w *StatePollingWaiter) handleState(state interface{}) (bool, error) {
 	if w.StateCheckFunc != nil {
 		return w.StateCheckFunc(state)
@@ -0,0 +1,190 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sacloud
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sacloud/libsacloud/v2/sacloud/accessor"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+// StateEventWaiter 進捗の通知とポーリング間隔のバックオフに対応したStateWaiter
+//
+// StatePollingWaiterが実装している。WaiterForUpなどが返すStateWaiterは型アサーションで利用する
+//
+//	if w, ok := waiter.(sacloud.StateEventWaiter); ok {
+//		w.SetEventHandler(func(e *sacloud.StateEvent) {
+//			if p, ok := e.MigrationPercentage(); ok {
+//				fmt.Printf("copying: %.1f%%\n", p)
+//			}
+//		})
+//	}
+type StateEventWaiter interface {
+	StateWaiter
+	// SetEventHandler 進捗を通知するためのハンドラを指定
+	SetEventHandler(handler StateEventHandler)
+	// SetPollingBackoff ポーリング間隔の増加率と上限を指定
+	SetPollingBackoff(factor float64, max time.Duration)
+}
+
+// StateEventHandler StateEventを受け取るハンドラ
+//
+// ポーリングを行うgoroutineから同期的に呼び出されるため、時間のかかる処理は行わないこと
+type StateEventHandler func(event *StateEvent)
+
+// StateEvent StateWaiterでのポーリングごとの進捗
+type StateEvent struct {
+	// Attempt ポーリング回数(1から開始)
+	Attempt int
+	// Elapsed 待機開始からの経過時間
+	Elapsed time.Duration
+	// State ReadFuncで取得したリソース エラー発生時はnil
+	State interface{}
+
+	// PreviousAvailability 前回のポーリング時のAvailability
+	PreviousAvailability types.EAvailability
+	// Availability 現在のAvailability
+	Availability types.EAvailability
+	// PreviousInstanceStatus 前回のポーリング時のInstanceStatus
+	PreviousInstanceStatus types.EServerInstanceStatus
+	// InstanceStatus 現在のInstanceStatus
+	InstanceStatus types.EServerInstanceStatus
+	// PreviousMigratedMB 前回のポーリング時のMigratedMB
+	PreviousMigratedMB int
+	// MigratedMB 現在のMigratedMB(ディスク/アーカイブのみ)
+	MigratedMB int
+	// SizeMB サイズ(ディスク/アーカイブのみ)
+	SizeMB int
+
+	// Completed 待機が完了したか
+	Completed bool
+	// Err 待機を中断したエラー
+	Err error
+
+	migratable bool
+}
+
+// Changed 前回のポーリング時から状態(Availability/InstanceStatus/MigratedMB)が変化したか
+func (e *StateEvent) Changed() bool {
+	return e.Availability != e.PreviousAvailability ||
+		e.InstanceStatus != e.PreviousInstanceStatus ||
+		e.MigratedMB != e.PreviousMigratedMB
+}
+
+// MigrationPercentage ディスク/アーカイブのコピーの進捗率(0〜100)を返す
+//
+// 対象リソースがMigratedMBとSizeMBを持たない場合はfalseを返す
+func (e *StateEvent) MigrationPercentage() (float64, bool) {
+	if !e.migratable || e.SizeMB <= 0 {
+		return 0, false
+	}
+	if e.Availability.IsAvailable() {
+		return 100, true
+	}
+	p := float64(e.MigratedMB) * 100 / float64(e.SizeMB)
+	if p > 100 {
+		p = 100
+	}
+	return p, true
+}
+
+// stateEventTracker ポーリング結果から前回の状態を引き継いだStateEventを作成する
+type stateEventTracker struct {
+	started time.Time
+	attempt int
+	last    *StateEvent
+}
+
+func newStateEventTracker() *stateEventTracker {
+	return &stateEventTracker{started: time.Now()}
+}
+
+func (t *stateEventTracker) next(state interface{}, completed bool, err error) *StateEvent {
+	t.attempt++
+	event := &StateEvent{
+		Attempt:   t.attempt,
+		Elapsed:   time.Since(t.started),
+		State:     state,
+		Completed: completed,
+		Err:       err,
+	}
+	if t.last != nil {
+		event.PreviousAvailability = t.last.Availability
+		event.PreviousInstanceStatus = t.last.InstanceStatus
+		event.PreviousMigratedMB = t.last.MigratedMB
+		// エラー発生時などstateが取得できなかった場合は前回の値を引き継ぐ
+		event.Availability = t.last.Availability
+		event.InstanceStatus = t.last.InstanceStatus
+		event.MigratedMB = t.last.MigratedMB
+		event.SizeMB = t.last.SizeMB
+		event.migratable = t.last.migratable
+	}
+
+	if v, ok := state.(accessor.Availability); ok {
+		event.Availability = v.GetAvailability()
+	}
+	if v, ok := state.(accessor.InstanceStatus); ok {
+		event.InstanceStatus = v.GetInstanceStatus()
+	}
+	migrated, hasMigrated := state.(accessor.MigratedMB)
+	size, hasSize := state.(accessor.SizeMB)
+	if hasMigrated && hasSize {
+		event.MigratedMB = migrated.GetMigratedMB()
+		event.SizeMB = size.GetSizeMB()
+		event.migratable = true
+	}
+
+	if state != nil {
+		t.last = event
+	}
+	return event
+}
+
+// StateWaitResult WaitForStatesでの待機結果
+type StateWaitResult struct {
+	// Key WaitForStatesに渡したマップのキー
+	Key string
+	// State 待機完了時のリソース
+	State interface{}
+	// Err 待機中に発生したエラー
+	Err error
+}
+
+// WaitForStates 複数のStateWaiterで並列に待機し、待機が終了したものから順に結果を返す
+//
+// 返されるチャネルは全ての待機が終了した時点でクローズされる。
+// 1つのStateWaiterでエラーが発生しても他の待機は継続する
+func WaitForStates(ctx context.Context, waiters map[string]StateWaiter) <-chan *StateWaitResult {
+	results := make(chan *StateWaitResult, len(waiters))
+
+	var wg sync.WaitGroup
+	for key, waiter := range waiters {
+		wg.Add(1)
+		go func(key string, waiter StateWaiter) {
+			defer wg.Done()
+			state, err := waiter.WaitForState(ctx)
+			results <- &StateWaitResult{Key: key, State: state, Err: err}
+		}(key, waiter)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	return results
+}
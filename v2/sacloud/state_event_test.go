@@ -0,0 +1,164 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sacloud
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatePollingWaiter_nextPollingInterval(t *testing.T) {
+	waiter := &StatePollingWaiter{
+		PollingInterval:      time.Second,
+		PollingBackoffFactor: 2,
+		MaxPollingInterval:   5 * time.Second,
+	}
+
+	interval := waiter.PollingInterval
+	var intervals []time.Duration
+	for i := 0; i < 4; i++ {
+		interval = waiter.nextPollingInterval(interval, false)
+		intervals = append(intervals, interval)
+	}
+	require.Equal(t, []time.Duration{2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}, intervals)
+
+	// 状態が変化した場合はPollingIntervalに戻る
+	require.Equal(t, time.Second, waiter.nextPollingInterval(interval, true))
+
+	// 増加率が1以下の場合は固定間隔
+	waiter.PollingBackoffFactor = 0
+	require.Equal(t, time.Second, waiter.nextPollingInterval(4*time.Second, false))
+}
+
+func TestStatePollingWaiter_eventHandler(t *testing.T) {
+	states := []*Disk{
+		{Availability: types.Availabilities.Migrating, SizeMB: 1000, MigratedMB: 0},
+		{Availability: types.Availabilities.Migrating, SizeMB: 1000, MigratedMB: 0},
+		{Availability: types.Availabilities.Migrating, SizeMB: 1000, MigratedMB: 500},
+		{Availability: types.Availabilities.Available, SizeMB: 1000, MigratedMB: 1000},
+	}
+	var events []*StateEvent
+	var percentages []float64
+
+	calls := 0
+	waiter := &StatePollingWaiter{
+		ReadFunc: func() (interface{}, error) {
+			state := states[calls]
+			calls++
+			return state, nil
+		},
+		TargetAvailability:  []types.EAvailability{types.Availabilities.Available},
+		PendingAvailability: []types.EAvailability{types.Availabilities.Migrating},
+		Timeout:             time.Second,
+		PollingInterval:     time.Millisecond,
+	}
+	waiter.SetPollingBackoff(2, 4*time.Millisecond)
+	waiter.SetEventHandler(func(event *StateEvent) {
+		events = append(events, event)
+		p, ok := event.MigrationPercentage()
+		require.True(t, ok)
+		percentages = append(percentages, p)
+	})
+
+	state, err := waiter.WaitForState(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, states[3], state)
+
+	require.Len(t, events, 4)
+	require.Equal(t, []float64{0, 0, 50, 100}, percentages)
+
+	require.Equal(t, 1, events[0].Attempt)
+	require.Equal(t, types.EAvailability(""), events[0].PreviousAvailability)
+	require.True(t, events[0].Changed())
+	require.False(t, events[1].Changed())
+	require.Equal(t, 0, events[2].PreviousMigratedMB)
+	require.Equal(t, 500, events[2].MigratedMB)
+	require.Equal(t, types.Availabilities.Migrating, events[3].PreviousAvailability)
+	require.Equal(t, types.Availabilities.Available, events[3].Availability)
+	require.True(t, events[3].Completed)
+	require.False(t, events[2].Completed)
+}
+
+func TestStatePollingWaiter_eventHandlerWithError(t *testing.T) {
+	var events []*StateEvent
+	waiter := &StatePollingWaiter{
+		ReadFunc: func() (interface{}, error) {
+			return nil, errors.New("read failed")
+		},
+		TargetAvailability: []types.EAvailability{types.Availabilities.Available},
+		Timeout:            time.Second,
+		PollingInterval:    time.Millisecond,
+		EventHandler: func(event *StateEvent) {
+			events = append(events, event)
+		},
+	}
+
+	_, err := waiter.WaitForState(context.Background())
+	require.EqualError(t, err, "read failed")
+	require.Len(t, events, 1)
+	require.EqualError(t, events[0].Err, "read failed")
+	require.Nil(t, events[0].State)
+
+	_, ok := events[0].MigrationPercentage()
+	require.False(t, ok)
+}
+
+func TestWaitForStates(t *testing.T) {
+	newWaiter := func(delay time.Duration, err error) StateWaiter {
+		started := time.Now()
+		return &StatePollingWaiter{
+			ReadFunc: func() (interface{}, error) {
+				if err != nil {
+					return nil, err
+				}
+				state := &dummyInstanceStatus{availability: string(types.Availabilities.Migrating)}
+				if time.Since(started) >= delay {
+					state.availability = string(types.Availabilities.Available)
+				}
+				return state, nil
+			},
+			TargetAvailability:  []types.EAvailability{types.Availabilities.Available},
+			PendingAvailability: []types.EAvailability{types.Availabilities.Migrating},
+			Timeout:             time.Second,
+			PollingInterval:     time.Millisecond,
+		}
+	}
+
+	results := WaitForStates(context.Background(), map[string]StateWaiter{
+		"slow":   newWaiter(50*time.Millisecond, nil),
+		"fast":   newWaiter(0, nil),
+		"failed": newWaiter(0, errors.New("read failed")),
+	})
+
+	received := make(map[string]*StateWaitResult)
+	var order []string
+	for result := range results {
+		received[result.Key] = result
+		order = append(order, result.Key)
+	}
+
+	require.Len(t, received, 3)
+	require.Equal(t, "slow", order[2])
+	require.NoError(t, received["fast"].Err)
+	require.NoError(t, received["slow"].Err)
+	require.NotNil(t, received["slow"].State)
+	require.EqualError(t, received["failed"].Err, "read failed")
+	require.Nil(t, received["failed"].State)
+}
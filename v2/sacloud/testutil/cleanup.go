@@ -49,6 +49,8 @@ func CleanupResource(ctx context.Context, caller sacloud.APICaller, prefix strin
 	if !IsAccTest() {
 		return nil
 	}
+	// 停止待ちでcallerのClientRegistryのポーリング設定を利用する
+	ctx = sacloud.WithClientRegistryOf(ctx, caller)
 	if prefix == "" {
 		prefix = TestResourcePrefix
 	}
@@ -154,6 +156,8 @@ func CleanupTestResources(ctx context.Context, caller sacloud.APICaller) error {
 	if !IsAccTest() {
 		return nil
 	}
+	// 停止待ちでcallerのClientRegistryのポーリング設定を利用する
+	ctx = sacloud.WithClientRegistryOf(ctx, caller)
 	cleanupFindCondition = &sacloud.FindCondition{
 		Filter: search.Filter{
 			search.Key("Name"): search.PartialMatch(TestResourcePrefix),
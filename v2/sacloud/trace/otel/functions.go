@@ -18,6 +18,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"sync"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
 )
 
 var initOnce sync.Once
@@ -31,7 +33,12 @@ func Initialize(opts ...Option) {
 }
 
 func initialize(cnf *config) {
-	addClientFactoryHooks(cnf)
+	addClientFactoryHooks(sacloud.AddClientFacotyHookFunc, cnf)
+}
+
+// InitializeRegistry initialize tracer and add client factory hooks to the registry
+func InitializeRegistry(registry *sacloud.ClientRegistry, opts ...Option) {
+	addClientFactoryHooks(registry.AddClientFactoryHookFunc, newConfig(opts...))
 }
 
 func forceString(v interface{}) string {
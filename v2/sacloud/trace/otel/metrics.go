@@ -0,0 +1,220 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otel
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	sacloudhttp "github.com/sacloud/go-http"
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/unit"
+)
+
+// メトリクス名
+const (
+	// MetricAPICalls API呼び出し回数
+	MetricAPICalls = "libsacloud.api.calls"
+	// MetricAPIErrors API呼び出しでのエラー回数
+	MetricAPIErrors = "libsacloud.api.errors"
+	// MetricAPIDuration API呼び出しの所要時間(ミリ秒)
+	MetricAPIDuration = "libsacloud.api.duration"
+	// MetricAPIRetries API呼び出しでのリトライ回数
+	MetricAPIRetries = "libsacloud.api.retries"
+	// MetricAPIRateLimitWait レートリミットによる待ち時間(ミリ秒)
+	MetricAPIRateLimitWait = "libsacloud.api.rate_limit.wait"
+)
+
+// メトリクスのラベル
+const (
+	labelResource   = attribute.Key("libsacloud.api.resource")
+	labelOperation  = attribute.Key("libsacloud.api.operation")
+	labelZone       = attribute.Key("libsacloud.api.zone")
+	labelResult     = attribute.Key("libsacloud.api.result")
+	labelStatusCode = attribute.Key("libsacloud.api.status_code")
+	labelErrorCode  = attribute.Key("libsacloud.api.error_code")
+)
+
+var initMetricsOnce sync.Once
+
+// InitializeMetrics initialize meter and add client factory hooks
+func InitializeMetrics(opts ...Option) {
+	cnf := newConfig(opts...)
+	initMetricsOnce.Do(func() {
+		m := newMetrics(cnf)
+		defaultMetricsMu.Lock()
+		defaultMetrics = m
+		defaultMetricsMu.Unlock()
+
+		addMetricsClientFactoryHooks(sacloud.AddClientFacotyHookFunc, m)
+	})
+}
+
+// InitializeMetricsRegistry initialize meter and add client factory hooks to the registry
+//
+// レジストリ経由で作成したAPIクライアントでのリトライ回数やレートリミットによる待ち時間も、
+// このレジストリ用に初期化したメトリクスに記録される
+func InitializeMetricsRegistry(registry *sacloud.ClientRegistry, opts ...Option) {
+	addMetricsClientFactoryHooks(registry.AddClientFactoryHookFunc, newMetrics(newConfig(opts...)))
+}
+
+type metrics struct {
+	calls         metric.Int64Counter
+	errors        metric.Int64Counter
+	duration      metric.Float64Histogram
+	retries       metric.Int64Counter
+	rateLimitWait metric.Float64Histogram
+}
+
+var (
+	defaultMetrics   *metrics
+	defaultMetricsMu sync.Mutex
+)
+
+func newMetrics(cnf *config) *metrics {
+	meter := metric.Must(cnf.Meter)
+	m := &metrics{
+		calls: meter.NewInt64Counter(MetricAPICalls,
+			metric.WithDescription("number of API calls")),
+		errors: meter.NewInt64Counter(MetricAPIErrors,
+			metric.WithDescription("number of API call errors")),
+		duration: meter.NewFloat64Histogram(MetricAPIDuration,
+			metric.WithDescription("duration of API calls"), metric.WithUnit(unit.Milliseconds)),
+		retries: meter.NewInt64Counter(MetricAPIRetries,
+			metric.WithDescription("number of retried API requests")),
+		rateLimitWait: meter.NewFloat64Histogram(MetricAPIRateLimitWait,
+			metric.WithDescription("time spent waiting for the rate limiter"), metric.WithUnit(unit.Milliseconds)),
+	}
+	return m
+}
+
+func currentMetrics() *metrics {
+	defaultMetricsMu.Lock()
+	defer defaultMetricsMu.Unlock()
+	return defaultMetrics
+}
+
+type metricsContextKey struct{}
+
+// metricsContext API呼び出し中のcontextに設定される、記録先のメトリクスとラベル
+type metricsContext struct {
+	metrics *metrics
+	labels  []attribute.KeyValue
+}
+
+// withContext API呼び出し時のcontextに記録先のメトリクスとラベルを設定する
+//
+// APIクライアントの内部で発生したリトライやレートリミットによる待ち時間は、contextに設定されたメトリクスに記録される
+func (m *metrics) withContext(ctx context.Context, resource, operation, zone string) context.Context {
+	return context.WithValue(ctx, metricsContextKey{}, &metricsContext{
+		metrics: m,
+		labels: []attribute.KeyValue{
+			labelResource.String(resource),
+			labelOperation.String(operation),
+			labelZone.String(zone),
+		},
+	})
+}
+
+// metricsFromContext contextに設定された記録先のメトリクスとラベルを返す
+//
+// contextに設定されていない場合はInitializeMetricsで初期化されたメトリクスを返す
+func metricsFromContext(ctx context.Context) (*metrics, []attribute.KeyValue) {
+	if mc, ok := ctx.Value(metricsContextKey{}).(*metricsContext); ok {
+		return mc.metrics, mc.labels
+	}
+	return currentMetrics(), nil
+}
+
+func (m *metrics) record(ctx context.Context, startedAt time.Time, err error) {
+	_, labels := metricsFromContext(ctx)
+	elapsed := float64(time.Since(startedAt)) / float64(time.Millisecond)
+
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	m.calls.Add(ctx, 1, append(labels, labelResult.String(result))...)
+	m.duration.Record(ctx, elapsed, labels...)
+
+	if err != nil {
+		statusCode, errorCode := "", ""
+		if apiErr, ok := sacloud.AsAPIError(err); ok {
+			statusCode = strconv.Itoa(apiErr.ResponseCode())
+			errorCode = apiErr.Code()
+		}
+		m.errors.Add(ctx, 1, append(labels, labelStatusCode.String(statusCode), labelErrorCode.String(errorCode))...)
+	}
+}
+
+// RetryEventHandler リトライ回数をメトリクスとして記録するためのfunc
+//
+// sacloud.RetryPolicy.OnRetryに指定して利用する。
+// APIクライアントのメトリクス(InitializeMetricsRegistryでレジストリに登録したものを含む)に記録する。
+// メトリクスを記録するAPIクライアント以外からの呼び出しで、InitializeMetricsが呼ばれていない場合は何もしない
+func RetryEventHandler(ctx context.Context, event *sacloud.RetryEvent) {
+	m, labels := metricsFromContext(ctx)
+	if m == nil {
+		return
+	}
+	labels = append(labels, labelStatusCode.String(strconv.Itoa(event.StatusCode)))
+	m.retries.Add(ctx, 1, labels...)
+}
+
+type rateLimitStartedAtContextKey struct{}
+
+// NewRateLimitWaitRoundTripper レートリミットによる待ち時間をメトリクスとして記録するhttp.RoundTripperを返す
+//
+// rateLimitedの親となるhttp.RoundTripper(Transport)は待ち時間記録用のhttp.RoundTripperで置き換えられる。
+// 待ち時間はRetryEventHandlerと同様にAPIクライアントのメトリクスに記録される
+func NewRateLimitWaitRoundTripper(rateLimited *sacloudhttp.RateLimitRoundTripper) http.RoundTripper {
+	parent := rateLimited.Transport
+	if parent == nil {
+		parent = http.DefaultTransport
+	}
+	rateLimited.Transport = &rateLimitWaitRecorder{Transport: parent}
+	return &rateLimitWaitStarter{Transport: rateLimited}
+}
+
+// rateLimitWaitStarter レートリミット前の時刻をcontextに記録する
+type rateLimitWaitStarter struct {
+	Transport http.RoundTripper
+}
+
+func (r *rateLimitWaitStarter) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := context.WithValue(req.Context(), rateLimitStartedAtContextKey{}, time.Now())
+	return r.Transport.RoundTrip(req.WithContext(ctx))
+}
+
+// rateLimitWaitRecorder レートリミット通過後に待ち時間を記録する
+type rateLimitWaitRecorder struct {
+	Transport http.RoundTripper
+}
+
+func (r *rateLimitWaitRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if startedAt, ok := ctx.Value(rateLimitStartedAtContextKey{}).(time.Time); ok {
+		if m, labels := metricsFromContext(ctx); m != nil {
+			elapsed := float64(time.Since(startedAt)) / float64(time.Millisecond)
+			m.rateLimitWait.Record(ctx, elapsed, labels...)
+		}
+	}
+	return r.Transport.RoundTrip(req)
+}
@@ -0,0 +1,102 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/fake"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/metrictest"
+)
+
+func TestMetrics(t *testing.T) {
+	provider := metrictest.NewMeterProvider()
+
+	InitializeMetrics(WithMeterProvider(provider))
+	fake.SwitchFactoryFuncToFake()
+
+	caller := &sacloud.Client{}
+	serverOp := sacloud.NewServerOp(caller)
+	_, err := serverOp.Find(context.Background(), "is1a", nil)
+	require.NoError(t, err)
+	_, err = serverOp.Read(context.Background(), "is1a", 1)
+	require.Error(t, err)
+
+	ctx := currentMetrics().withContext(context.Background(), "ServerAPI", "Read", "is1a")
+	RetryEventHandler(ctx, &sacloud.RetryEvent{StatusCode: http.StatusLocked})
+
+	counts := map[string]int{}
+	for _, m := range metrictest.AsStructs(provider.MeasurementBatches) {
+		counts[m.Name]++
+		require.Equal(t, attribute.StringValue("ServerAPI"), m.Labels[labelResource])
+		require.Equal(t, attribute.StringValue("is1a"), m.Labels[labelZone])
+
+		switch m.Name {
+		case MetricAPIErrors:
+			require.Equal(t, attribute.StringValue("Read"), m.Labels[labelOperation])
+			require.Equal(t, attribute.StringValue("404"), m.Labels[labelStatusCode])
+		case MetricAPIRetries:
+			require.Equal(t, attribute.StringValue("423"), m.Labels[labelStatusCode])
+		}
+	}
+	require.Equal(t, map[string]int{
+		MetricAPICalls:    2,
+		MetricAPIDuration: 2,
+		MetricAPIErrors:   1,
+		MetricAPIRetries:  1,
+	}, counts)
+}
+
+// retryingServerOp Read時にリトライが発生するServerAPI
+type retryingServerOp struct {
+	sacloud.ServerAPI
+}
+
+func (o *retryingServerOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Server, error) {
+	RetryEventHandler(ctx, &sacloud.RetryEvent{StatusCode: http.StatusLocked})
+	return &sacloud.Server{ID: id}, nil
+}
+
+func TestMetrics_registry(t *testing.T) {
+	provider := metrictest.NewMeterProvider()
+
+	registry := sacloud.NewClientRegistry()
+	registry.SetClientFactoryFunc("Server", func(caller sacloud.APICaller) interface{} {
+		return &retryingServerOp{ServerAPI: fake.NewServerOp()}
+	})
+	InitializeMetricsRegistry(registry, WithMeterProvider(provider))
+
+	serverOp := sacloud.NewServerOp(sacloud.NewCallerWithRegistry(&sacloud.Client{}, registry))
+	_, err := serverOp.Read(context.Background(), "is1a", 1)
+	require.NoError(t, err)
+
+	// リトライ回数もレジストリ用のメトリクスに記録される
+	counts := map[string]int{}
+	for _, m := range metrictest.AsStructs(provider.MeasurementBatches) {
+		counts[m.Name]++
+		require.Equal(t, attribute.StringValue("Read"), m.Labels[labelOperation])
+	}
+	require.Equal(t, map[string]int{
+		MetricAPICalls:    1,
+		MetricAPIDuration: 1,
+		MetricAPIRetries:  1,
+	}, counts)
+}
@@ -17,6 +17,8 @@ package otel
 import (
 	"github.com/sacloud/libsacloud/v2"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -24,6 +26,9 @@ type config struct {
 	Tracer           trace.Tracer
 	TracerProvider   trace.TracerProvider
 	SpanStartOptions []trace.SpanStartOption
+
+	Meter         metric.Meter
+	MeterProvider metric.MeterProvider
 }
 
 type Option interface {
@@ -39,6 +44,7 @@ func (o OptionFunc) Apply(c *config) {
 func newConfig(opts ...Option) *config {
 	c := &config{
 		TracerProvider: otel.GetTracerProvider(),
+		MeterProvider:  global.GetMeterProvider(),
 	}
 	for _, opt := range opts {
 		opt.Apply(c)
@@ -48,6 +54,10 @@ func newConfig(opts ...Option) *config {
 		"github.com/sacloud/libsacloud",
 		trace.WithInstrumentationVersion(libsacloud.Version),
 	)
+	c.Meter = c.MeterProvider.Meter(
+		"github.com/sacloud/libsacloud",
+		metric.WithInstrumentationVersion(libsacloud.Version),
+	)
 	return c
 }
 
@@ -57,6 +67,12 @@ func WithTracerProvider(provider trace.TracerProvider) Option {
 	})
 }
 
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return OptionFunc(func(cfg *config) {
+		cfg.MeterProvider = provider
+	})
+}
+
 func WithSpanOptions(opts ...trace.SpanStartOption) Option {
 	return OptionFunc(func(c *config) {
 		c.SpanStartOptions = append(c.SpanStartOptions, opts...)
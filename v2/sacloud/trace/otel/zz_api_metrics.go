@@ -0,0 +1,5087 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// generated by 'github.com/sacloud/libsacloud/internal/tools/gen-otel-metrics'; DO NOT EDIT
+
+package otel
+
+import (
+	"context"
+	"time"
+
+	"github.com/sacloud/libsacloud/v2/sacloud"
+	"github.com/sacloud/libsacloud/v2/sacloud/types"
+)
+
+func addMetricsClientFactoryHooks(add func(resourceName string, hookFunc func(interface{}) interface{}), m *metrics) {
+	add("Archive", func(in interface{}) interface{} {
+		return newArchiveMetricsRecorder(in.(sacloud.ArchiveAPI), m)
+	})
+	add("AuthStatus", func(in interface{}) interface{} {
+		return newAuthStatusMetricsRecorder(in.(sacloud.AuthStatusAPI), m)
+	})
+	add("AutoBackup", func(in interface{}) interface{} {
+		return newAutoBackupMetricsRecorder(in.(sacloud.AutoBackupAPI), m)
+	})
+	add("Bill", func(in interface{}) interface{} {
+		return newBillMetricsRecorder(in.(sacloud.BillAPI), m)
+	})
+	add("Bridge", func(in interface{}) interface{} {
+		return newBridgeMetricsRecorder(in.(sacloud.BridgeAPI), m)
+	})
+	add("CDROM", func(in interface{}) interface{} {
+		return newCDROMMetricsRecorder(in.(sacloud.CDROMAPI), m)
+	})
+	add("CertificateAuthority", func(in interface{}) interface{} {
+		return newCertificateAuthorityMetricsRecorder(in.(sacloud.CertificateAuthorityAPI), m)
+	})
+	add("ContainerRegistry", func(in interface{}) interface{} {
+		return newContainerRegistryMetricsRecorder(in.(sacloud.ContainerRegistryAPI), m)
+	})
+	add("Coupon", func(in interface{}) interface{} {
+		return newCouponMetricsRecorder(in.(sacloud.CouponAPI), m)
+	})
+	add("Database", func(in interface{}) interface{} {
+		return newDatabaseMetricsRecorder(in.(sacloud.DatabaseAPI), m)
+	})
+	add("Disk", func(in interface{}) interface{} {
+		return newDiskMetricsRecorder(in.(sacloud.DiskAPI), m)
+	})
+	add("DiskPlan", func(in interface{}) interface{} {
+		return newDiskPlanMetricsRecorder(in.(sacloud.DiskPlanAPI), m)
+	})
+	add("DNS", func(in interface{}) interface{} {
+		return newDNSMetricsRecorder(in.(sacloud.DNSAPI), m)
+	})
+	add("EnhancedDB", func(in interface{}) interface{} {
+		return newEnhancedDBMetricsRecorder(in.(sacloud.EnhancedDBAPI), m)
+	})
+	add("ESME", func(in interface{}) interface{} {
+		return newESMEMetricsRecorder(in.(sacloud.ESMEAPI), m)
+	})
+	add("GSLB", func(in interface{}) interface{} {
+		return newGSLBMetricsRecorder(in.(sacloud.GSLBAPI), m)
+	})
+	add("Icon", func(in interface{}) interface{} {
+		return newIconMetricsRecorder(in.(sacloud.IconAPI), m)
+	})
+	add("Interface", func(in interface{}) interface{} {
+		return newInterfaceMetricsRecorder(in.(sacloud.InterfaceAPI), m)
+	})
+	add("Internet", func(in interface{}) interface{} {
+		return newInternetMetricsRecorder(in.(sacloud.InternetAPI), m)
+	})
+	add("InternetPlan", func(in interface{}) interface{} {
+		return newInternetPlanMetricsRecorder(in.(sacloud.InternetPlanAPI), m)
+	})
+	add("IPAddress", func(in interface{}) interface{} {
+		return newIPAddressMetricsRecorder(in.(sacloud.IPAddressAPI), m)
+	})
+	add("IPv6Net", func(in interface{}) interface{} {
+		return newIPv6NetMetricsRecorder(in.(sacloud.IPv6NetAPI), m)
+	})
+	add("IPv6Addr", func(in interface{}) interface{} {
+		return newIPv6AddrMetricsRecorder(in.(sacloud.IPv6AddrAPI), m)
+	})
+	add("License", func(in interface{}) interface{} {
+		return newLicenseMetricsRecorder(in.(sacloud.LicenseAPI), m)
+	})
+	add("LicenseInfo", func(in interface{}) interface{} {
+		return newLicenseInfoMetricsRecorder(in.(sacloud.LicenseInfoAPI), m)
+	})
+	add("LoadBalancer", func(in interface{}) interface{} {
+		return newLoadBalancerMetricsRecorder(in.(sacloud.LoadBalancerAPI), m)
+	})
+	add("LocalRouter", func(in interface{}) interface{} {
+		return newLocalRouterMetricsRecorder(in.(sacloud.LocalRouterAPI), m)
+	})
+	add("MobileGateway", func(in interface{}) interface{} {
+		return newMobileGatewayMetricsRecorder(in.(sacloud.MobileGatewayAPI), m)
+	})
+	add("NFS", func(in interface{}) interface{} {
+		return newNFSMetricsRecorder(in.(sacloud.NFSAPI), m)
+	})
+	add("Note", func(in interface{}) interface{} {
+		return newNoteMetricsRecorder(in.(sacloud.NoteAPI), m)
+	})
+	add("PacketFilter", func(in interface{}) interface{} {
+		return newPacketFilterMetricsRecorder(in.(sacloud.PacketFilterAPI), m)
+	})
+	add("PrivateHost", func(in interface{}) interface{} {
+		return newPrivateHostMetricsRecorder(in.(sacloud.PrivateHostAPI), m)
+	})
+	add("PrivateHostPlan", func(in interface{}) interface{} {
+		return newPrivateHostPlanMetricsRecorder(in.(sacloud.PrivateHostPlanAPI), m)
+	})
+	add("ProxyLB", func(in interface{}) interface{} {
+		return newProxyLBMetricsRecorder(in.(sacloud.ProxyLBAPI), m)
+	})
+	add("Region", func(in interface{}) interface{} {
+		return newRegionMetricsRecorder(in.(sacloud.RegionAPI), m)
+	})
+	add("Server", func(in interface{}) interface{} {
+		return newServerMetricsRecorder(in.(sacloud.ServerAPI), m)
+	})
+	add("ServerPlan", func(in interface{}) interface{} {
+		return newServerPlanMetricsRecorder(in.(sacloud.ServerPlanAPI), m)
+	})
+	add("ServiceClass", func(in interface{}) interface{} {
+		return newServiceClassMetricsRecorder(in.(sacloud.ServiceClassAPI), m)
+	})
+	add("SIM", func(in interface{}) interface{} {
+		return newSIMMetricsRecorder(in.(sacloud.SIMAPI), m)
+	})
+	add("SimpleMonitor", func(in interface{}) interface{} {
+		return newSimpleMonitorMetricsRecorder(in.(sacloud.SimpleMonitorAPI), m)
+	})
+	add("SSHKey", func(in interface{}) interface{} {
+		return newSSHKeyMetricsRecorder(in.(sacloud.SSHKeyAPI), m)
+	})
+	add("Subnet", func(in interface{}) interface{} {
+		return newSubnetMetricsRecorder(in.(sacloud.SubnetAPI), m)
+	})
+	add("Switch", func(in interface{}) interface{} {
+		return newSwitchMetricsRecorder(in.(sacloud.SwitchAPI), m)
+	})
+	add("VPCRouter", func(in interface{}) interface{} {
+		return newVPCRouterMetricsRecorder(in.(sacloud.VPCRouterAPI), m)
+	})
+	add("WebAccel", func(in interface{}) interface{} {
+		return newWebAccelMetricsRecorder(in.(sacloud.WebAccelAPI), m)
+	})
+	add("Zone", func(in interface{}) interface{} {
+		return newZoneMetricsRecorder(in.(sacloud.ZoneAPI), m)
+	})
+}
+
+/*************************************************
+* ArchiveMetricsRecorder
+*************************************************/
+
+// ArchiveMetricsRecorder is for record metrics of ArchiveOp operations
+type ArchiveMetricsRecorder struct {
+	Internal sacloud.ArchiveAPI
+	metrics  *metrics
+}
+
+// newArchiveMetricsRecorder creates new ArchiveMetricsRecorder instance
+func newArchiveMetricsRecorder(in sacloud.ArchiveAPI, m *metrics) sacloud.ArchiveAPI {
+	return &ArchiveMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ArchiveMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *ArchiveMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.ArchiveFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ArchiveAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *ArchiveMetricsRecorder) Create(ctx context.Context, zone string, param *sacloud.ArchiveCreateRequest) (*sacloud.Archive, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ArchiveAPI", "Create", zone)
+
+	resultArchive, err := t.Internal.Create(ctx, zone, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultArchive, err
+}
+
+// CreateBlank is API call with metrics
+func (t *ArchiveMetricsRecorder) CreateBlank(ctx context.Context, zone string, param *sacloud.ArchiveCreateBlankRequest) (*sacloud.Archive, *sacloud.FTPServer, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ArchiveAPI", "CreateBlank", zone)
+
+	resultArchive, resultFTPServer, err := t.Internal.CreateBlank(ctx, zone, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultArchive, resultFTPServer, err
+}
+
+// Read is API call with metrics
+func (t *ArchiveMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Archive, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ArchiveAPI", "Read", zone)
+
+	resultArchive, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultArchive, err
+}
+
+// Update is API call with metrics
+func (t *ArchiveMetricsRecorder) Update(ctx context.Context, zone string, id types.ID, param *sacloud.ArchiveUpdateRequest) (*sacloud.Archive, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ArchiveAPI", "Update", zone)
+
+	resultArchive, err := t.Internal.Update(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultArchive, err
+}
+
+// Delete is API call with metrics
+func (t *ArchiveMetricsRecorder) Delete(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ArchiveAPI", "Delete", zone)
+
+	err := t.Internal.Delete(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// OpenFTP is API call with metrics
+func (t *ArchiveMetricsRecorder) OpenFTP(ctx context.Context, zone string, id types.ID, openOption *sacloud.OpenFTPRequest) (*sacloud.FTPServer, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ArchiveAPI", "OpenFTP", zone)
+
+	resultFTPServer, err := t.Internal.OpenFTP(ctx, zone, id, openOption)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultFTPServer, err
+}
+
+// CloseFTP is API call with metrics
+func (t *ArchiveMetricsRecorder) CloseFTP(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ArchiveAPI", "CloseFTP", zone)
+
+	err := t.Internal.CloseFTP(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Share is API call with metrics
+func (t *ArchiveMetricsRecorder) Share(ctx context.Context, zone string, id types.ID) (*sacloud.ArchiveShareInfo, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ArchiveAPI", "Share", zone)
+
+	resultArchiveShareInfo, err := t.Internal.Share(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultArchiveShareInfo, err
+}
+
+// CreateFromShared is API call with metrics
+func (t *ArchiveMetricsRecorder) CreateFromShared(ctx context.Context, zone string, sourceArchiveID types.ID, destZoneID types.ID, param *sacloud.ArchiveCreateRequestFromShared) (*sacloud.Archive, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ArchiveAPI", "CreateFromShared", zone)
+
+	resultArchive, err := t.Internal.CreateFromShared(ctx, zone, sourceArchiveID, destZoneID, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultArchive, err
+}
+
+// Transfer is API call with metrics
+func (t *ArchiveMetricsRecorder) Transfer(ctx context.Context, zone string, sourceArchiveID types.ID, destZoneID types.ID, param *sacloud.ArchiveTransferRequest) (*sacloud.Archive, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ArchiveAPI", "Transfer", zone)
+
+	resultArchive, err := t.Internal.Transfer(ctx, zone, sourceArchiveID, destZoneID, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultArchive, err
+}
+
+/*************************************************
+* AuthStatusMetricsRecorder
+*************************************************/
+
+// AuthStatusMetricsRecorder is for record metrics of AuthStatusOp operations
+type AuthStatusMetricsRecorder struct {
+	Internal sacloud.AuthStatusAPI
+	metrics  *metrics
+}
+
+// newAuthStatusMetricsRecorder creates new AuthStatusMetricsRecorder instance
+func newAuthStatusMetricsRecorder(in sacloud.AuthStatusAPI, m *metrics) sacloud.AuthStatusAPI {
+	return &AuthStatusMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *AuthStatusMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Read is API call with metrics
+func (t *AuthStatusMetricsRecorder) Read(ctx context.Context) (*sacloud.AuthStatus, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "AuthStatusAPI", "Read", "")
+
+	resultAuthStatus, err := t.Internal.Read(ctx)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultAuthStatus, err
+}
+
+/*************************************************
+* AutoBackupMetricsRecorder
+*************************************************/
+
+// AutoBackupMetricsRecorder is for record metrics of AutoBackupOp operations
+type AutoBackupMetricsRecorder struct {
+	Internal sacloud.AutoBackupAPI
+	metrics  *metrics
+}
+
+// newAutoBackupMetricsRecorder creates new AutoBackupMetricsRecorder instance
+func newAutoBackupMetricsRecorder(in sacloud.AutoBackupAPI, m *metrics) sacloud.AutoBackupAPI {
+	return &AutoBackupMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *AutoBackupMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *AutoBackupMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.AutoBackupFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "AutoBackupAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *AutoBackupMetricsRecorder) Create(ctx context.Context, zone string, param *sacloud.AutoBackupCreateRequest) (*sacloud.AutoBackup, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "AutoBackupAPI", "Create", zone)
+
+	resultAutoBackup, err := t.Internal.Create(ctx, zone, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultAutoBackup, err
+}
+
+// Read is API call with metrics
+func (t *AutoBackupMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.AutoBackup, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "AutoBackupAPI", "Read", zone)
+
+	resultAutoBackup, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultAutoBackup, err
+}
+
+// Update is API call with metrics
+func (t *AutoBackupMetricsRecorder) Update(ctx context.Context, zone string, id types.ID, param *sacloud.AutoBackupUpdateRequest) (*sacloud.AutoBackup, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "AutoBackupAPI", "Update", zone)
+
+	resultAutoBackup, err := t.Internal.Update(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultAutoBackup, err
+}
+
+// UpdateSettings is API call with metrics
+func (t *AutoBackupMetricsRecorder) UpdateSettings(ctx context.Context, zone string, id types.ID, param *sacloud.AutoBackupUpdateSettingsRequest) (*sacloud.AutoBackup, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "AutoBackupAPI", "UpdateSettings", zone)
+
+	resultAutoBackup, err := t.Internal.UpdateSettings(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultAutoBackup, err
+}
+
+// Delete is API call with metrics
+func (t *AutoBackupMetricsRecorder) Delete(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "AutoBackupAPI", "Delete", zone)
+
+	err := t.Internal.Delete(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+/*************************************************
+* BillMetricsRecorder
+*************************************************/
+
+// BillMetricsRecorder is for record metrics of BillOp operations
+type BillMetricsRecorder struct {
+	Internal sacloud.BillAPI
+	metrics  *metrics
+}
+
+// newBillMetricsRecorder creates new BillMetricsRecorder instance
+func newBillMetricsRecorder(in sacloud.BillAPI, m *metrics) sacloud.BillAPI {
+	return &BillMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *BillMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// ByContract is API call with metrics
+func (t *BillMetricsRecorder) ByContract(ctx context.Context, accountID types.ID) (*sacloud.BillByContractResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "BillAPI", "ByContract", "")
+
+	result, err := t.Internal.ByContract(ctx, accountID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// ByContractYear is API call with metrics
+func (t *BillMetricsRecorder) ByContractYear(ctx context.Context, accountID types.ID, year int) (*sacloud.BillByContractYearResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "BillAPI", "ByContractYear", "")
+
+	result, err := t.Internal.ByContractYear(ctx, accountID, year)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// ByContractYearMonth is API call with metrics
+func (t *BillMetricsRecorder) ByContractYearMonth(ctx context.Context, accountID types.ID, year int, month int) (*sacloud.BillByContractYearMonthResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "BillAPI", "ByContractYearMonth", "")
+
+	result, err := t.Internal.ByContractYearMonth(ctx, accountID, year, month)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Read is API call with metrics
+func (t *BillMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.BillReadResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "BillAPI", "Read", "")
+
+	result, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Details is API call with metrics
+func (t *BillMetricsRecorder) Details(ctx context.Context, MemberCode string, id types.ID) (*sacloud.BillDetailsResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "BillAPI", "Details", "")
+
+	result, err := t.Internal.Details(ctx, MemberCode, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// DetailsCSV is API call with metrics
+func (t *BillMetricsRecorder) DetailsCSV(ctx context.Context, MemberCode string, id types.ID) (*sacloud.BillDetailCSV, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "BillAPI", "DetailsCSV", "")
+
+	resultBillDetailCSV, err := t.Internal.DetailsCSV(ctx, MemberCode, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultBillDetailCSV, err
+}
+
+/*************************************************
+* BridgeMetricsRecorder
+*************************************************/
+
+// BridgeMetricsRecorder is for record metrics of BridgeOp operations
+type BridgeMetricsRecorder struct {
+	Internal sacloud.BridgeAPI
+	metrics  *metrics
+}
+
+// newBridgeMetricsRecorder creates new BridgeMetricsRecorder instance
+func newBridgeMetricsRecorder(in sacloud.BridgeAPI, m *metrics) sacloud.BridgeAPI {
+	return &BridgeMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *BridgeMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *BridgeMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.BridgeFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "BridgeAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *BridgeMetricsRecorder) Create(ctx context.Context, zone string, param *sacloud.BridgeCreateRequest) (*sacloud.Bridge, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "BridgeAPI", "Create", zone)
+
+	resultBridge, err := t.Internal.Create(ctx, zone, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultBridge, err
+}
+
+// Read is API call with metrics
+func (t *BridgeMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Bridge, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "BridgeAPI", "Read", zone)
+
+	resultBridge, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultBridge, err
+}
+
+// Update is API call with metrics
+func (t *BridgeMetricsRecorder) Update(ctx context.Context, zone string, id types.ID, param *sacloud.BridgeUpdateRequest) (*sacloud.Bridge, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "BridgeAPI", "Update", zone)
+
+	resultBridge, err := t.Internal.Update(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultBridge, err
+}
+
+// Delete is API call with metrics
+func (t *BridgeMetricsRecorder) Delete(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "BridgeAPI", "Delete", zone)
+
+	err := t.Internal.Delete(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+/*************************************************
+* CDROMMetricsRecorder
+*************************************************/
+
+// CDROMMetricsRecorder is for record metrics of CDROMOp operations
+type CDROMMetricsRecorder struct {
+	Internal sacloud.CDROMAPI
+	metrics  *metrics
+}
+
+// newCDROMMetricsRecorder creates new CDROMMetricsRecorder instance
+func newCDROMMetricsRecorder(in sacloud.CDROMAPI, m *metrics) sacloud.CDROMAPI {
+	return &CDROMMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *CDROMMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *CDROMMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.CDROMFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CDROMAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *CDROMMetricsRecorder) Create(ctx context.Context, zone string, param *sacloud.CDROMCreateRequest) (*sacloud.CDROM, *sacloud.FTPServer, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CDROMAPI", "Create", zone)
+
+	resultCDROM, resultFTPServer, err := t.Internal.Create(ctx, zone, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCDROM, resultFTPServer, err
+}
+
+// Read is API call with metrics
+func (t *CDROMMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.CDROM, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CDROMAPI", "Read", zone)
+
+	resultCDROM, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCDROM, err
+}
+
+// Update is API call with metrics
+func (t *CDROMMetricsRecorder) Update(ctx context.Context, zone string, id types.ID, param *sacloud.CDROMUpdateRequest) (*sacloud.CDROM, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CDROMAPI", "Update", zone)
+
+	resultCDROM, err := t.Internal.Update(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCDROM, err
+}
+
+// Delete is API call with metrics
+func (t *CDROMMetricsRecorder) Delete(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CDROMAPI", "Delete", zone)
+
+	err := t.Internal.Delete(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// OpenFTP is API call with metrics
+func (t *CDROMMetricsRecorder) OpenFTP(ctx context.Context, zone string, id types.ID, openOption *sacloud.OpenFTPRequest) (*sacloud.FTPServer, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CDROMAPI", "OpenFTP", zone)
+
+	resultFTPServer, err := t.Internal.OpenFTP(ctx, zone, id, openOption)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultFTPServer, err
+}
+
+// CloseFTP is API call with metrics
+func (t *CDROMMetricsRecorder) CloseFTP(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CDROMAPI", "CloseFTP", zone)
+
+	err := t.Internal.CloseFTP(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+/*************************************************
+* CertificateAuthorityMetricsRecorder
+*************************************************/
+
+// CertificateAuthorityMetricsRecorder is for record metrics of CertificateAuthorityOp operations
+type CertificateAuthorityMetricsRecorder struct {
+	Internal sacloud.CertificateAuthorityAPI
+	metrics  *metrics
+}
+
+// newCertificateAuthorityMetricsRecorder creates new CertificateAuthorityMetricsRecorder instance
+func newCertificateAuthorityMetricsRecorder(in sacloud.CertificateAuthorityAPI, m *metrics) sacloud.CertificateAuthorityAPI {
+	return &CertificateAuthorityMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *CertificateAuthorityMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.CertificateAuthorityFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "Find", "")
+
+	result, err := t.Internal.Find(ctx, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) Create(ctx context.Context, param *sacloud.CertificateAuthorityCreateRequest) (*sacloud.CertificateAuthority, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "Create", "")
+
+	resultCertificateAuthority, err := t.Internal.Create(ctx, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCertificateAuthority, err
+}
+
+// Read is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.CertificateAuthority, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "Read", "")
+
+	resultCertificateAuthority, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCertificateAuthority, err
+}
+
+// Update is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) Update(ctx context.Context, id types.ID, param *sacloud.CertificateAuthorityUpdateRequest) (*sacloud.CertificateAuthority, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "Update", "")
+
+	resultCertificateAuthority, err := t.Internal.Update(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCertificateAuthority, err
+}
+
+// Delete is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) Delete(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "Delete", "")
+
+	err := t.Internal.Delete(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Detail is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) Detail(ctx context.Context, id types.ID) (*sacloud.CertificateAuthorityDetail, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "Detail", "")
+
+	resultCertificateAuthority, err := t.Internal.Detail(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCertificateAuthority, err
+}
+
+// AddClient is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) AddClient(ctx context.Context, id types.ID, param *sacloud.CertificateAuthorityAddClientParam) (*sacloud.CertificateAuthorityAddClientOrServerResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "AddClient", "")
+
+	resultCertificateAuthority, err := t.Internal.AddClient(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCertificateAuthority, err
+}
+
+// ListClients is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) ListClients(ctx context.Context, id types.ID) (*sacloud.CertificateAuthorityListClientsResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "ListClients", "")
+
+	result, err := t.Internal.ListClients(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// ReadClient is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) ReadClient(ctx context.Context, id types.ID, clientID string) (*sacloud.CertificateAuthorityClient, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "ReadClient", "")
+
+	resultCertificateAuthority, err := t.Internal.ReadClient(ctx, id, clientID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCertificateAuthority, err
+}
+
+// RevokeClient is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) RevokeClient(ctx context.Context, id types.ID, clientID string) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "RevokeClient", "")
+
+	err := t.Internal.RevokeClient(ctx, id, clientID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// HoldClient is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) HoldClient(ctx context.Context, id types.ID, clientID string) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "HoldClient", "")
+
+	err := t.Internal.HoldClient(ctx, id, clientID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// ResumeClient is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) ResumeClient(ctx context.Context, id types.ID, clientID string) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "ResumeClient", "")
+
+	err := t.Internal.ResumeClient(ctx, id, clientID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// DenyClient is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) DenyClient(ctx context.Context, id types.ID, clientID string) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "DenyClient", "")
+
+	err := t.Internal.DenyClient(ctx, id, clientID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// AddServer is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) AddServer(ctx context.Context, id types.ID, param *sacloud.CertificateAuthorityAddServerParam) (*sacloud.CertificateAuthorityAddClientOrServerResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "AddServer", "")
+
+	resultCertificateAuthority, err := t.Internal.AddServer(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCertificateAuthority, err
+}
+
+// ListServers is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) ListServers(ctx context.Context, id types.ID) (*sacloud.CertificateAuthorityListServersResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "ListServers", "")
+
+	result, err := t.Internal.ListServers(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// ReadServer is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) ReadServer(ctx context.Context, id types.ID, serverID string) (*sacloud.CertificateAuthorityServer, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "ReadServer", "")
+
+	resultCertificateAuthority, err := t.Internal.ReadServer(ctx, id, serverID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCertificateAuthority, err
+}
+
+// RevokeServer is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) RevokeServer(ctx context.Context, id types.ID, serverID string) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "RevokeServer", "")
+
+	err := t.Internal.RevokeServer(ctx, id, serverID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// HoldServer is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) HoldServer(ctx context.Context, id types.ID, serverID string) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "HoldServer", "")
+
+	err := t.Internal.HoldServer(ctx, id, serverID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// ResumeServer is API call with metrics
+func (t *CertificateAuthorityMetricsRecorder) ResumeServer(ctx context.Context, id types.ID, serverID string) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CertificateAuthorityAPI", "ResumeServer", "")
+
+	err := t.Internal.ResumeServer(ctx, id, serverID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+/*************************************************
+* ContainerRegistryMetricsRecorder
+*************************************************/
+
+// ContainerRegistryMetricsRecorder is for record metrics of ContainerRegistryOp operations
+type ContainerRegistryMetricsRecorder struct {
+	Internal sacloud.ContainerRegistryAPI
+	metrics  *metrics
+}
+
+// newContainerRegistryMetricsRecorder creates new ContainerRegistryMetricsRecorder instance
+func newContainerRegistryMetricsRecorder(in sacloud.ContainerRegistryAPI, m *metrics) sacloud.ContainerRegistryAPI {
+	return &ContainerRegistryMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ContainerRegistryMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *ContainerRegistryMetricsRecorder) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.ContainerRegistryFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ContainerRegistryAPI", "Find", "")
+
+	result, err := t.Internal.Find(ctx, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *ContainerRegistryMetricsRecorder) Create(ctx context.Context, param *sacloud.ContainerRegistryCreateRequest) (*sacloud.ContainerRegistry, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ContainerRegistryAPI", "Create", "")
+
+	resultContainerRegistry, err := t.Internal.Create(ctx, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultContainerRegistry, err
+}
+
+// Read is API call with metrics
+func (t *ContainerRegistryMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.ContainerRegistry, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ContainerRegistryAPI", "Read", "")
+
+	resultContainerRegistry, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultContainerRegistry, err
+}
+
+// Update is API call with metrics
+func (t *ContainerRegistryMetricsRecorder) Update(ctx context.Context, id types.ID, param *sacloud.ContainerRegistryUpdateRequest) (*sacloud.ContainerRegistry, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ContainerRegistryAPI", "Update", "")
+
+	resultContainerRegistry, err := t.Internal.Update(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultContainerRegistry, err
+}
+
+// UpdateSettings is API call with metrics
+func (t *ContainerRegistryMetricsRecorder) UpdateSettings(ctx context.Context, id types.ID, param *sacloud.ContainerRegistryUpdateSettingsRequest) (*sacloud.ContainerRegistry, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ContainerRegistryAPI", "UpdateSettings", "")
+
+	resultContainerRegistry, err := t.Internal.UpdateSettings(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultContainerRegistry, err
+}
+
+// Delete is API call with metrics
+func (t *ContainerRegistryMetricsRecorder) Delete(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ContainerRegistryAPI", "Delete", "")
+
+	err := t.Internal.Delete(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// ListUsers is API call with metrics
+func (t *ContainerRegistryMetricsRecorder) ListUsers(ctx context.Context, id types.ID) (*sacloud.ContainerRegistryUsers, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ContainerRegistryAPI", "ListUsers", "")
+
+	resultContainerRegistryUsers, err := t.Internal.ListUsers(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultContainerRegistryUsers, err
+}
+
+// AddUser is API call with metrics
+func (t *ContainerRegistryMetricsRecorder) AddUser(ctx context.Context, id types.ID, param *sacloud.ContainerRegistryUserCreateRequest) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ContainerRegistryAPI", "AddUser", "")
+
+	err := t.Internal.AddUser(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// UpdateUser is API call with metrics
+func (t *ContainerRegistryMetricsRecorder) UpdateUser(ctx context.Context, id types.ID, username string, param *sacloud.ContainerRegistryUserUpdateRequest) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ContainerRegistryAPI", "UpdateUser", "")
+
+	err := t.Internal.UpdateUser(ctx, id, username, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// DeleteUser is API call with metrics
+func (t *ContainerRegistryMetricsRecorder) DeleteUser(ctx context.Context, id types.ID, username string) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ContainerRegistryAPI", "DeleteUser", "")
+
+	err := t.Internal.DeleteUser(ctx, id, username)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+/*************************************************
+* CouponMetricsRecorder
+*************************************************/
+
+// CouponMetricsRecorder is for record metrics of CouponOp operations
+type CouponMetricsRecorder struct {
+	Internal sacloud.CouponAPI
+	metrics  *metrics
+}
+
+// newCouponMetricsRecorder creates new CouponMetricsRecorder instance
+func newCouponMetricsRecorder(in sacloud.CouponAPI, m *metrics) sacloud.CouponAPI {
+	return &CouponMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *CouponMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *CouponMetricsRecorder) Find(ctx context.Context, accountID types.ID) (*sacloud.CouponFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "CouponAPI", "Find", "")
+
+	result, err := t.Internal.Find(ctx, accountID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+/*************************************************
+* DatabaseMetricsRecorder
+*************************************************/
+
+// DatabaseMetricsRecorder is for record metrics of DatabaseOp operations
+type DatabaseMetricsRecorder struct {
+	Internal sacloud.DatabaseAPI
+	metrics  *metrics
+}
+
+// newDatabaseMetricsRecorder creates new DatabaseMetricsRecorder instance
+func newDatabaseMetricsRecorder(in sacloud.DatabaseAPI, m *metrics) sacloud.DatabaseAPI {
+	return &DatabaseMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *DatabaseMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *DatabaseMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.DatabaseFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DatabaseAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *DatabaseMetricsRecorder) Create(ctx context.Context, zone string, param *sacloud.DatabaseCreateRequest) (*sacloud.Database, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DatabaseAPI", "Create", zone)
+
+	resultDatabase, err := t.Internal.Create(ctx, zone, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDatabase, err
+}
+
+// Read is API call with metrics
+func (t *DatabaseMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Database, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DatabaseAPI", "Read", zone)
+
+	resultDatabase, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDatabase, err
+}
+
+// Update is API call with metrics
+func (t *DatabaseMetricsRecorder) Update(ctx context.Context, zone string, id types.ID, param *sacloud.DatabaseUpdateRequest) (*sacloud.Database, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DatabaseAPI", "Update", zone)
+
+	resultDatabase, err := t.Internal.Update(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDatabase, err
+}
+
+// UpdateSettings is API call with metrics
+func (t *DatabaseMetricsRecorder) UpdateSettings(ctx context.Context, zone string, id types.ID, param *sacloud.DatabaseUpdateSettingsRequest) (*sacloud.Database, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DatabaseAPI", "UpdateSettings", zone)
+
+	resultDatabase, err := t.Internal.UpdateSettings(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDatabase, err
+}
+
+// Delete is API call with metrics
+func (t *DatabaseMetricsRecorder) Delete(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DatabaseAPI", "Delete", zone)
+
+	err := t.Internal.Delete(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Config is API call with metrics
+func (t *DatabaseMetricsRecorder) Config(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DatabaseAPI", "Config", zone)
+
+	err := t.Internal.Config(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Boot is API call with metrics
+func (t *DatabaseMetricsRecorder) Boot(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DatabaseAPI", "Boot", zone)
+
+	err := t.Internal.Boot(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Shutdown is API call with metrics
+func (t *DatabaseMetricsRecorder) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DatabaseAPI", "Shutdown", zone)
+
+	err := t.Internal.Shutdown(ctx, zone, id, shutdownOption)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Reset is API call with metrics
+func (t *DatabaseMetricsRecorder) Reset(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DatabaseAPI", "Reset", zone)
+
+	err := t.Internal.Reset(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// MonitorCPU is API call with metrics
+func (t *DatabaseMetricsRecorder) MonitorCPU(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.CPUTimeActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DatabaseAPI", "MonitorCPU", zone)
+
+	resultCPUTimeActivity, err := t.Internal.MonitorCPU(ctx, zone, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCPUTimeActivity, err
+}
+
+// MonitorDisk is API call with metrics
+func (t *DatabaseMetricsRecorder) MonitorDisk(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.DiskActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DatabaseAPI", "MonitorDisk", zone)
+
+	resultDiskActivity, err := t.Internal.MonitorDisk(ctx, zone, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDiskActivity, err
+}
+
+// MonitorInterface is API call with metrics
+func (t *DatabaseMetricsRecorder) MonitorInterface(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DatabaseAPI", "MonitorInterface", zone)
+
+	resultInterfaceActivity, err := t.Internal.MonitorInterface(ctx, zone, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultInterfaceActivity, err
+}
+
+// MonitorDatabase is API call with metrics
+func (t *DatabaseMetricsRecorder) MonitorDatabase(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.DatabaseActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DatabaseAPI", "MonitorDatabase", zone)
+
+	resultDatabaseActivity, err := t.Internal.MonitorDatabase(ctx, zone, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDatabaseActivity, err
+}
+
+// Status is API call with metrics
+func (t *DatabaseMetricsRecorder) Status(ctx context.Context, zone string, id types.ID) (*sacloud.DatabaseStatus, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DatabaseAPI", "Status", zone)
+
+	resultDatabaseStatus, err := t.Internal.Status(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDatabaseStatus, err
+}
+
+// GetParameter is API call with metrics
+func (t *DatabaseMetricsRecorder) GetParameter(ctx context.Context, zone string, id types.ID) (*sacloud.DatabaseParameter, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DatabaseAPI", "GetParameter", zone)
+
+	resultDatabaseParameter, err := t.Internal.GetParameter(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDatabaseParameter, err
+}
+
+// SetParameter is API call with metrics
+func (t *DatabaseMetricsRecorder) SetParameter(ctx context.Context, zone string, id types.ID, param map[string]interface{}) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DatabaseAPI", "SetParameter", zone)
+
+	err := t.Internal.SetParameter(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+/*************************************************
+* DiskMetricsRecorder
+*************************************************/
+
+// DiskMetricsRecorder is for record metrics of DiskOp operations
+type DiskMetricsRecorder struct {
+	Internal sacloud.DiskAPI
+	metrics  *metrics
+}
+
+// newDiskMetricsRecorder creates new DiskMetricsRecorder instance
+func newDiskMetricsRecorder(in sacloud.DiskAPI, m *metrics) sacloud.DiskAPI {
+	return &DiskMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *DiskMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *DiskMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.DiskFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DiskAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *DiskMetricsRecorder) Create(ctx context.Context, zone string, createParam *sacloud.DiskCreateRequest, distantFrom []types.ID) (*sacloud.Disk, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DiskAPI", "Create", zone)
+
+	resultDisk, err := t.Internal.Create(ctx, zone, createParam, distantFrom)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDisk, err
+}
+
+// Config is API call with metrics
+func (t *DiskMetricsRecorder) Config(ctx context.Context, zone string, id types.ID, edit *sacloud.DiskEditRequest) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DiskAPI", "Config", zone)
+
+	err := t.Internal.Config(ctx, zone, id, edit)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// CreateWithConfig is API call with metrics
+func (t *DiskMetricsRecorder) CreateWithConfig(ctx context.Context, zone string, createParam *sacloud.DiskCreateRequest, editParam *sacloud.DiskEditRequest, bootAtAvailable bool, distantFrom []types.ID) (*sacloud.Disk, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DiskAPI", "CreateWithConfig", zone)
+
+	resultDisk, err := t.Internal.CreateWithConfig(ctx, zone, createParam, editParam, bootAtAvailable, distantFrom)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDisk, err
+}
+
+// ResizePartition is API call with metrics
+func (t *DiskMetricsRecorder) ResizePartition(ctx context.Context, zone string, id types.ID, param *sacloud.DiskResizePartitionRequest) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DiskAPI", "ResizePartition", zone)
+
+	err := t.Internal.ResizePartition(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// ConnectToServer is API call with metrics
+func (t *DiskMetricsRecorder) ConnectToServer(ctx context.Context, zone string, id types.ID, serverID types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DiskAPI", "ConnectToServer", zone)
+
+	err := t.Internal.ConnectToServer(ctx, zone, id, serverID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// DisconnectFromServer is API call with metrics
+func (t *DiskMetricsRecorder) DisconnectFromServer(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DiskAPI", "DisconnectFromServer", zone)
+
+	err := t.Internal.DisconnectFromServer(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Read is API call with metrics
+func (t *DiskMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Disk, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DiskAPI", "Read", zone)
+
+	resultDisk, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDisk, err
+}
+
+// Update is API call with metrics
+func (t *DiskMetricsRecorder) Update(ctx context.Context, zone string, id types.ID, param *sacloud.DiskUpdateRequest) (*sacloud.Disk, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DiskAPI", "Update", zone)
+
+	resultDisk, err := t.Internal.Update(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDisk, err
+}
+
+// Delete is API call with metrics
+func (t *DiskMetricsRecorder) Delete(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DiskAPI", "Delete", zone)
+
+	err := t.Internal.Delete(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Monitor is API call with metrics
+func (t *DiskMetricsRecorder) Monitor(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.DiskActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DiskAPI", "Monitor", zone)
+
+	resultDiskActivity, err := t.Internal.Monitor(ctx, zone, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDiskActivity, err
+}
+
+// MonitorDisk is API call with metrics
+func (t *DiskMetricsRecorder) MonitorDisk(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.DiskActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DiskAPI", "MonitorDisk", zone)
+
+	resultDiskActivity, err := t.Internal.MonitorDisk(ctx, zone, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDiskActivity, err
+}
+
+/*************************************************
+* DiskPlanMetricsRecorder
+*************************************************/
+
+// DiskPlanMetricsRecorder is for record metrics of DiskPlanOp operations
+type DiskPlanMetricsRecorder struct {
+	Internal sacloud.DiskPlanAPI
+	metrics  *metrics
+}
+
+// newDiskPlanMetricsRecorder creates new DiskPlanMetricsRecorder instance
+func newDiskPlanMetricsRecorder(in sacloud.DiskPlanAPI, m *metrics) sacloud.DiskPlanAPI {
+	return &DiskPlanMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *DiskPlanMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *DiskPlanMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.DiskPlanFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DiskPlanAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Read is API call with metrics
+func (t *DiskPlanMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.DiskPlan, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DiskPlanAPI", "Read", zone)
+
+	resultDiskPlan, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDiskPlan, err
+}
+
+/*************************************************
+* DNSMetricsRecorder
+*************************************************/
+
+// DNSMetricsRecorder is for record metrics of DNSOp operations
+type DNSMetricsRecorder struct {
+	Internal sacloud.DNSAPI
+	metrics  *metrics
+}
+
+// newDNSMetricsRecorder creates new DNSMetricsRecorder instance
+func newDNSMetricsRecorder(in sacloud.DNSAPI, m *metrics) sacloud.DNSAPI {
+	return &DNSMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *DNSMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *DNSMetricsRecorder) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.DNSFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DNSAPI", "Find", "")
+
+	result, err := t.Internal.Find(ctx, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *DNSMetricsRecorder) Create(ctx context.Context, param *sacloud.DNSCreateRequest) (*sacloud.DNS, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DNSAPI", "Create", "")
+
+	resultDNS, err := t.Internal.Create(ctx, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDNS, err
+}
+
+// Read is API call with metrics
+func (t *DNSMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.DNS, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DNSAPI", "Read", "")
+
+	resultDNS, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDNS, err
+}
+
+// Update is API call with metrics
+func (t *DNSMetricsRecorder) Update(ctx context.Context, id types.ID, param *sacloud.DNSUpdateRequest) (*sacloud.DNS, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DNSAPI", "Update", "")
+
+	resultDNS, err := t.Internal.Update(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDNS, err
+}
+
+// UpdateSettings is API call with metrics
+func (t *DNSMetricsRecorder) UpdateSettings(ctx context.Context, id types.ID, param *sacloud.DNSUpdateSettingsRequest) (*sacloud.DNS, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DNSAPI", "UpdateSettings", "")
+
+	resultDNS, err := t.Internal.UpdateSettings(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultDNS, err
+}
+
+// Delete is API call with metrics
+func (t *DNSMetricsRecorder) Delete(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "DNSAPI", "Delete", "")
+
+	err := t.Internal.Delete(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+/*************************************************
+* EnhancedDBMetricsRecorder
+*************************************************/
+
+// EnhancedDBMetricsRecorder is for record metrics of EnhancedDBOp operations
+type EnhancedDBMetricsRecorder struct {
+	Internal sacloud.EnhancedDBAPI
+	metrics  *metrics
+}
+
+// newEnhancedDBMetricsRecorder creates new EnhancedDBMetricsRecorder instance
+func newEnhancedDBMetricsRecorder(in sacloud.EnhancedDBAPI, m *metrics) sacloud.EnhancedDBAPI {
+	return &EnhancedDBMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *EnhancedDBMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *EnhancedDBMetricsRecorder) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.EnhancedDBFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "EnhancedDBAPI", "Find", "")
+
+	result, err := t.Internal.Find(ctx, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *EnhancedDBMetricsRecorder) Create(ctx context.Context, param *sacloud.EnhancedDBCreateRequest) (*sacloud.EnhancedDB, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "EnhancedDBAPI", "Create", "")
+
+	resultEnhancedDB, err := t.Internal.Create(ctx, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultEnhancedDB, err
+}
+
+// Read is API call with metrics
+func (t *EnhancedDBMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.EnhancedDB, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "EnhancedDBAPI", "Read", "")
+
+	resultEnhancedDB, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultEnhancedDB, err
+}
+
+// Update is API call with metrics
+func (t *EnhancedDBMetricsRecorder) Update(ctx context.Context, id types.ID, param *sacloud.EnhancedDBUpdateRequest) (*sacloud.EnhancedDB, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "EnhancedDBAPI", "Update", "")
+
+	resultEnhancedDB, err := t.Internal.Update(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultEnhancedDB, err
+}
+
+// Delete is API call with metrics
+func (t *EnhancedDBMetricsRecorder) Delete(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "EnhancedDBAPI", "Delete", "")
+
+	err := t.Internal.Delete(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// SetPassword is API call with metrics
+func (t *EnhancedDBMetricsRecorder) SetPassword(ctx context.Context, id types.ID, param *sacloud.EnhancedDBSetPasswordRequest) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "EnhancedDBAPI", "SetPassword", "")
+
+	err := t.Internal.SetPassword(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+/*************************************************
+* ESMEMetricsRecorder
+*************************************************/
+
+// ESMEMetricsRecorder is for record metrics of ESMEOp operations
+type ESMEMetricsRecorder struct {
+	Internal sacloud.ESMEAPI
+	metrics  *metrics
+}
+
+// newESMEMetricsRecorder creates new ESMEMetricsRecorder instance
+func newESMEMetricsRecorder(in sacloud.ESMEAPI, m *metrics) sacloud.ESMEAPI {
+	return &ESMEMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ESMEMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *ESMEMetricsRecorder) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.ESMEFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ESMEAPI", "Find", "")
+
+	result, err := t.Internal.Find(ctx, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *ESMEMetricsRecorder) Create(ctx context.Context, param *sacloud.ESMECreateRequest) (*sacloud.ESME, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ESMEAPI", "Create", "")
+
+	resultESME, err := t.Internal.Create(ctx, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultESME, err
+}
+
+// Read is API call with metrics
+func (t *ESMEMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.ESME, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ESMEAPI", "Read", "")
+
+	resultESME, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultESME, err
+}
+
+// Update is API call with metrics
+func (t *ESMEMetricsRecorder) Update(ctx context.Context, id types.ID, param *sacloud.ESMEUpdateRequest) (*sacloud.ESME, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ESMEAPI", "Update", "")
+
+	resultESME, err := t.Internal.Update(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultESME, err
+}
+
+// Delete is API call with metrics
+func (t *ESMEMetricsRecorder) Delete(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ESMEAPI", "Delete", "")
+
+	err := t.Internal.Delete(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// SendMessageWithGeneratedOTP is API call with metrics
+func (t *ESMEMetricsRecorder) SendMessageWithGeneratedOTP(ctx context.Context, id types.ID, param *sacloud.ESMESendMessageWithGeneratedOTPRequest) (*sacloud.ESMESendMessageResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ESMEAPI", "SendMessageWithGeneratedOTP", "")
+
+	resultESMESendMessageResult, err := t.Internal.SendMessageWithGeneratedOTP(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultESMESendMessageResult, err
+}
+
+// SendMessageWithInputtedOTP is API call with metrics
+func (t *ESMEMetricsRecorder) SendMessageWithInputtedOTP(ctx context.Context, id types.ID, param *sacloud.ESMESendMessageWithInputtedOTPRequest) (*sacloud.ESMESendMessageResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ESMEAPI", "SendMessageWithInputtedOTP", "")
+
+	resultESMESendMessageResult, err := t.Internal.SendMessageWithInputtedOTP(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultESMESendMessageResult, err
+}
+
+// Logs is API call with metrics
+func (t *ESMEMetricsRecorder) Logs(ctx context.Context, id types.ID) ([]*sacloud.ESMELogs, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ESMEAPI", "Logs", "")
+
+	resultLogs, err := t.Internal.Logs(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultLogs, err
+}
+
+/*************************************************
+* GSLBMetricsRecorder
+*************************************************/
+
+// GSLBMetricsRecorder is for record metrics of GSLBOp operations
+type GSLBMetricsRecorder struct {
+	Internal sacloud.GSLBAPI
+	metrics  *metrics
+}
+
+// newGSLBMetricsRecorder creates new GSLBMetricsRecorder instance
+func newGSLBMetricsRecorder(in sacloud.GSLBAPI, m *metrics) sacloud.GSLBAPI {
+	return &GSLBMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *GSLBMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *GSLBMetricsRecorder) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.GSLBFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "GSLBAPI", "Find", "")
+
+	result, err := t.Internal.Find(ctx, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *GSLBMetricsRecorder) Create(ctx context.Context, param *sacloud.GSLBCreateRequest) (*sacloud.GSLB, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "GSLBAPI", "Create", "")
+
+	resultGSLB, err := t.Internal.Create(ctx, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultGSLB, err
+}
+
+// Read is API call with metrics
+func (t *GSLBMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.GSLB, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "GSLBAPI", "Read", "")
+
+	resultGSLB, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultGSLB, err
+}
+
+// Update is API call with metrics
+func (t *GSLBMetricsRecorder) Update(ctx context.Context, id types.ID, param *sacloud.GSLBUpdateRequest) (*sacloud.GSLB, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "GSLBAPI", "Update", "")
+
+	resultGSLB, err := t.Internal.Update(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultGSLB, err
+}
+
+// UpdateSettings is API call with metrics
+func (t *GSLBMetricsRecorder) UpdateSettings(ctx context.Context, id types.ID, param *sacloud.GSLBUpdateSettingsRequest) (*sacloud.GSLB, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "GSLBAPI", "UpdateSettings", "")
+
+	resultGSLB, err := t.Internal.UpdateSettings(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultGSLB, err
+}
+
+// Delete is API call with metrics
+func (t *GSLBMetricsRecorder) Delete(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "GSLBAPI", "Delete", "")
+
+	err := t.Internal.Delete(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+/*************************************************
+* IconMetricsRecorder
+*************************************************/
+
+// IconMetricsRecorder is for record metrics of IconOp operations
+type IconMetricsRecorder struct {
+	Internal sacloud.IconAPI
+	metrics  *metrics
+}
+
+// newIconMetricsRecorder creates new IconMetricsRecorder instance
+func newIconMetricsRecorder(in sacloud.IconAPI, m *metrics) sacloud.IconAPI {
+	return &IconMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *IconMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *IconMetricsRecorder) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.IconFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "IconAPI", "Find", "")
+
+	result, err := t.Internal.Find(ctx, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *IconMetricsRecorder) Create(ctx context.Context, param *sacloud.IconCreateRequest) (*sacloud.Icon, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "IconAPI", "Create", "")
+
+	resultIcon, err := t.Internal.Create(ctx, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultIcon, err
+}
+
+// Read is API call with metrics
+func (t *IconMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.Icon, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "IconAPI", "Read", "")
+
+	resultIcon, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultIcon, err
+}
+
+// Update is API call with metrics
+func (t *IconMetricsRecorder) Update(ctx context.Context, id types.ID, param *sacloud.IconUpdateRequest) (*sacloud.Icon, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "IconAPI", "Update", "")
+
+	resultIcon, err := t.Internal.Update(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultIcon, err
+}
+
+// Delete is API call with metrics
+func (t *IconMetricsRecorder) Delete(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "IconAPI", "Delete", "")
+
+	err := t.Internal.Delete(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+/*************************************************
+* InterfaceMetricsRecorder
+*************************************************/
+
+// InterfaceMetricsRecorder is for record metrics of InterfaceOp operations
+type InterfaceMetricsRecorder struct {
+	Internal sacloud.InterfaceAPI
+	metrics  *metrics
+}
+
+// newInterfaceMetricsRecorder creates new InterfaceMetricsRecorder instance
+func newInterfaceMetricsRecorder(in sacloud.InterfaceAPI, m *metrics) sacloud.InterfaceAPI {
+	return &InterfaceMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *InterfaceMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *InterfaceMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.InterfaceFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InterfaceAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *InterfaceMetricsRecorder) Create(ctx context.Context, zone string, param *sacloud.InterfaceCreateRequest) (*sacloud.Interface, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InterfaceAPI", "Create", zone)
+
+	resultInterface, err := t.Internal.Create(ctx, zone, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultInterface, err
+}
+
+// Read is API call with metrics
+func (t *InterfaceMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Interface, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InterfaceAPI", "Read", zone)
+
+	resultInterface, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultInterface, err
+}
+
+// Update is API call with metrics
+func (t *InterfaceMetricsRecorder) Update(ctx context.Context, zone string, id types.ID, param *sacloud.InterfaceUpdateRequest) (*sacloud.Interface, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InterfaceAPI", "Update", zone)
+
+	resultInterface, err := t.Internal.Update(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultInterface, err
+}
+
+// Delete is API call with metrics
+func (t *InterfaceMetricsRecorder) Delete(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InterfaceAPI", "Delete", zone)
+
+	err := t.Internal.Delete(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Monitor is API call with metrics
+func (t *InterfaceMetricsRecorder) Monitor(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InterfaceAPI", "Monitor", zone)
+
+	resultInterfaceActivity, err := t.Internal.Monitor(ctx, zone, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultInterfaceActivity, err
+}
+
+// ConnectToSharedSegment is API call with metrics
+func (t *InterfaceMetricsRecorder) ConnectToSharedSegment(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InterfaceAPI", "ConnectToSharedSegment", zone)
+
+	err := t.Internal.ConnectToSharedSegment(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// ConnectToSwitch is API call with metrics
+func (t *InterfaceMetricsRecorder) ConnectToSwitch(ctx context.Context, zone string, id types.ID, switchID types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InterfaceAPI", "ConnectToSwitch", zone)
+
+	err := t.Internal.ConnectToSwitch(ctx, zone, id, switchID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// DisconnectFromSwitch is API call with metrics
+func (t *InterfaceMetricsRecorder) DisconnectFromSwitch(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InterfaceAPI", "DisconnectFromSwitch", zone)
+
+	err := t.Internal.DisconnectFromSwitch(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// ConnectToPacketFilter is API call with metrics
+func (t *InterfaceMetricsRecorder) ConnectToPacketFilter(ctx context.Context, zone string, id types.ID, packetFilterID types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InterfaceAPI", "ConnectToPacketFilter", zone)
+
+	err := t.Internal.ConnectToPacketFilter(ctx, zone, id, packetFilterID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// DisconnectFromPacketFilter is API call with metrics
+func (t *InterfaceMetricsRecorder) DisconnectFromPacketFilter(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InterfaceAPI", "DisconnectFromPacketFilter", zone)
+
+	err := t.Internal.DisconnectFromPacketFilter(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+/*************************************************
+* InternetMetricsRecorder
+*************************************************/
+
+// InternetMetricsRecorder is for record metrics of InternetOp operations
+type InternetMetricsRecorder struct {
+	Internal sacloud.InternetAPI
+	metrics  *metrics
+}
+
+// newInternetMetricsRecorder creates new InternetMetricsRecorder instance
+func newInternetMetricsRecorder(in sacloud.InternetAPI, m *metrics) sacloud.InternetAPI {
+	return &InternetMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *InternetMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *InternetMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.InternetFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InternetAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *InternetMetricsRecorder) Create(ctx context.Context, zone string, param *sacloud.InternetCreateRequest) (*sacloud.Internet, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InternetAPI", "Create", zone)
+
+	resultInternet, err := t.Internal.Create(ctx, zone, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultInternet, err
+}
+
+// Read is API call with metrics
+func (t *InternetMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Internet, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InternetAPI", "Read", zone)
+
+	resultInternet, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultInternet, err
+}
+
+// Update is API call with metrics
+func (t *InternetMetricsRecorder) Update(ctx context.Context, zone string, id types.ID, param *sacloud.InternetUpdateRequest) (*sacloud.Internet, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InternetAPI", "Update", zone)
+
+	resultInternet, err := t.Internal.Update(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultInternet, err
+}
+
+// Delete is API call with metrics
+func (t *InternetMetricsRecorder) Delete(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InternetAPI", "Delete", zone)
+
+	err := t.Internal.Delete(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// UpdateBandWidth is API call with metrics
+func (t *InternetMetricsRecorder) UpdateBandWidth(ctx context.Context, zone string, id types.ID, param *sacloud.InternetUpdateBandWidthRequest) (*sacloud.Internet, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InternetAPI", "UpdateBandWidth", zone)
+
+	resultInternet, err := t.Internal.UpdateBandWidth(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultInternet, err
+}
+
+// AddSubnet is API call with metrics
+func (t *InternetMetricsRecorder) AddSubnet(ctx context.Context, zone string, id types.ID, param *sacloud.InternetAddSubnetRequest) (*sacloud.InternetSubnetOperationResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InternetAPI", "AddSubnet", zone)
+
+	resultSubnet, err := t.Internal.AddSubnet(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSubnet, err
+}
+
+// UpdateSubnet is API call with metrics
+func (t *InternetMetricsRecorder) UpdateSubnet(ctx context.Context, zone string, id types.ID, subnetID types.ID, param *sacloud.InternetUpdateSubnetRequest) (*sacloud.InternetSubnetOperationResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InternetAPI", "UpdateSubnet", zone)
+
+	resultSubnet, err := t.Internal.UpdateSubnet(ctx, zone, id, subnetID, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSubnet, err
+}
+
+// DeleteSubnet is API call with metrics
+func (t *InternetMetricsRecorder) DeleteSubnet(ctx context.Context, zone string, id types.ID, subnetID types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InternetAPI", "DeleteSubnet", zone)
+
+	err := t.Internal.DeleteSubnet(ctx, zone, id, subnetID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Monitor is API call with metrics
+func (t *InternetMetricsRecorder) Monitor(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.RouterActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InternetAPI", "Monitor", zone)
+
+	resultRouterActivity, err := t.Internal.Monitor(ctx, zone, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultRouterActivity, err
+}
+
+// MonitorRouter is API call with metrics
+func (t *InternetMetricsRecorder) MonitorRouter(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.RouterActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InternetAPI", "MonitorRouter", zone)
+
+	resultRouterActivity, err := t.Internal.MonitorRouter(ctx, zone, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultRouterActivity, err
+}
+
+// EnableIPv6 is API call with metrics
+func (t *InternetMetricsRecorder) EnableIPv6(ctx context.Context, zone string, id types.ID) (*sacloud.IPv6NetInfo, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InternetAPI", "EnableIPv6", zone)
+
+	resultIPv6Net, err := t.Internal.EnableIPv6(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultIPv6Net, err
+}
+
+// DisableIPv6 is API call with metrics
+func (t *InternetMetricsRecorder) DisableIPv6(ctx context.Context, zone string, id types.ID, ipv6netID types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InternetAPI", "DisableIPv6", zone)
+
+	err := t.Internal.DisableIPv6(ctx, zone, id, ipv6netID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+/*************************************************
+* InternetPlanMetricsRecorder
+*************************************************/
+
+// InternetPlanMetricsRecorder is for record metrics of InternetPlanOp operations
+type InternetPlanMetricsRecorder struct {
+	Internal sacloud.InternetPlanAPI
+	metrics  *metrics
+}
+
+// newInternetPlanMetricsRecorder creates new InternetPlanMetricsRecorder instance
+func newInternetPlanMetricsRecorder(in sacloud.InternetPlanAPI, m *metrics) sacloud.InternetPlanAPI {
+	return &InternetPlanMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *InternetPlanMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *InternetPlanMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.InternetPlanFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InternetPlanAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Read is API call with metrics
+func (t *InternetPlanMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.InternetPlan, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "InternetPlanAPI", "Read", zone)
+
+	resultInternetPlan, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultInternetPlan, err
+}
+
+/*************************************************
+* IPAddressMetricsRecorder
+*************************************************/
+
+// IPAddressMetricsRecorder is for record metrics of IPAddressOp operations
+type IPAddressMetricsRecorder struct {
+	Internal sacloud.IPAddressAPI
+	metrics  *metrics
+}
+
+// newIPAddressMetricsRecorder creates new IPAddressMetricsRecorder instance
+func newIPAddressMetricsRecorder(in sacloud.IPAddressAPI, m *metrics) sacloud.IPAddressAPI {
+	return &IPAddressMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *IPAddressMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// List is API call with metrics
+func (t *IPAddressMetricsRecorder) List(ctx context.Context, zone string) (*sacloud.IPAddressListResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "IPAddressAPI", "List", zone)
+
+	result, err := t.Internal.List(ctx, zone)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Read is API call with metrics
+func (t *IPAddressMetricsRecorder) Read(ctx context.Context, zone string, ipAddress string) (*sacloud.IPAddress, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "IPAddressAPI", "Read", zone)
+
+	resultIPAddress, err := t.Internal.Read(ctx, zone, ipAddress)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultIPAddress, err
+}
+
+// UpdateHostName is API call with metrics
+func (t *IPAddressMetricsRecorder) UpdateHostName(ctx context.Context, zone string, ipAddress string, hostName string) (*sacloud.IPAddress, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "IPAddressAPI", "UpdateHostName", zone)
+
+	resultIPAddress, err := t.Internal.UpdateHostName(ctx, zone, ipAddress, hostName)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultIPAddress, err
+}
+
+/*************************************************
+* IPv6NetMetricsRecorder
+*************************************************/
+
+// IPv6NetMetricsRecorder is for record metrics of IPv6NetOp operations
+type IPv6NetMetricsRecorder struct {
+	Internal sacloud.IPv6NetAPI
+	metrics  *metrics
+}
+
+// newIPv6NetMetricsRecorder creates new IPv6NetMetricsRecorder instance
+func newIPv6NetMetricsRecorder(in sacloud.IPv6NetAPI, m *metrics) sacloud.IPv6NetAPI {
+	return &IPv6NetMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *IPv6NetMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// List is API call with metrics
+func (t *IPv6NetMetricsRecorder) List(ctx context.Context, zone string) (*sacloud.IPv6NetListResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "IPv6NetAPI", "List", zone)
+
+	result, err := t.Internal.List(ctx, zone)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Find is API call with metrics
+func (t *IPv6NetMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.IPv6NetFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "IPv6NetAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Read is API call with metrics
+func (t *IPv6NetMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.IPv6Net, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "IPv6NetAPI", "Read", zone)
+
+	resultIPv6Net, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultIPv6Net, err
+}
+
+/*************************************************
+* IPv6AddrMetricsRecorder
+*************************************************/
+
+// IPv6AddrMetricsRecorder is for record metrics of IPv6AddrOp operations
+type IPv6AddrMetricsRecorder struct {
+	Internal sacloud.IPv6AddrAPI
+	metrics  *metrics
+}
+
+// newIPv6AddrMetricsRecorder creates new IPv6AddrMetricsRecorder instance
+func newIPv6AddrMetricsRecorder(in sacloud.IPv6AddrAPI, m *metrics) sacloud.IPv6AddrAPI {
+	return &IPv6AddrMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *IPv6AddrMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *IPv6AddrMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.IPv6AddrFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "IPv6AddrAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *IPv6AddrMetricsRecorder) Create(ctx context.Context, zone string, param *sacloud.IPv6AddrCreateRequest) (*sacloud.IPv6Addr, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "IPv6AddrAPI", "Create", zone)
+
+	resultIPv6Addr, err := t.Internal.Create(ctx, zone, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultIPv6Addr, err
+}
+
+// Read is API call with metrics
+func (t *IPv6AddrMetricsRecorder) Read(ctx context.Context, zone string, ipv6addr string) (*sacloud.IPv6Addr, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "IPv6AddrAPI", "Read", zone)
+
+	resultIPv6Addr, err := t.Internal.Read(ctx, zone, ipv6addr)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultIPv6Addr, err
+}
+
+// Update is API call with metrics
+func (t *IPv6AddrMetricsRecorder) Update(ctx context.Context, zone string, ipv6addr string, param *sacloud.IPv6AddrUpdateRequest) (*sacloud.IPv6Addr, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "IPv6AddrAPI", "Update", zone)
+
+	resultIPv6Addr, err := t.Internal.Update(ctx, zone, ipv6addr, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultIPv6Addr, err
+}
+
+// Delete is API call with metrics
+func (t *IPv6AddrMetricsRecorder) Delete(ctx context.Context, zone string, ipv6addr string) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "IPv6AddrAPI", "Delete", zone)
+
+	err := t.Internal.Delete(ctx, zone, ipv6addr)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+/*************************************************
+* LicenseMetricsRecorder
+*************************************************/
+
+// LicenseMetricsRecorder is for record metrics of LicenseOp operations
+type LicenseMetricsRecorder struct {
+	Internal sacloud.LicenseAPI
+	metrics  *metrics
+}
+
+// newLicenseMetricsRecorder creates new LicenseMetricsRecorder instance
+func newLicenseMetricsRecorder(in sacloud.LicenseAPI, m *metrics) sacloud.LicenseAPI {
+	return &LicenseMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *LicenseMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *LicenseMetricsRecorder) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.LicenseFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LicenseAPI", "Find", "")
+
+	result, err := t.Internal.Find(ctx, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *LicenseMetricsRecorder) Create(ctx context.Context, param *sacloud.LicenseCreateRequest) (*sacloud.License, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LicenseAPI", "Create", "")
+
+	resultLicense, err := t.Internal.Create(ctx, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultLicense, err
+}
+
+// Read is API call with metrics
+func (t *LicenseMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.License, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LicenseAPI", "Read", "")
+
+	resultLicense, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultLicense, err
+}
+
+// Update is API call with metrics
+func (t *LicenseMetricsRecorder) Update(ctx context.Context, id types.ID, param *sacloud.LicenseUpdateRequest) (*sacloud.License, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LicenseAPI", "Update", "")
+
+	resultLicense, err := t.Internal.Update(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultLicense, err
+}
+
+// Delete is API call with metrics
+func (t *LicenseMetricsRecorder) Delete(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LicenseAPI", "Delete", "")
+
+	err := t.Internal.Delete(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+/*************************************************
+* LicenseInfoMetricsRecorder
+*************************************************/
+
+// LicenseInfoMetricsRecorder is for record metrics of LicenseInfoOp operations
+type LicenseInfoMetricsRecorder struct {
+	Internal sacloud.LicenseInfoAPI
+	metrics  *metrics
+}
+
+// newLicenseInfoMetricsRecorder creates new LicenseInfoMetricsRecorder instance
+func newLicenseInfoMetricsRecorder(in sacloud.LicenseInfoAPI, m *metrics) sacloud.LicenseInfoAPI {
+	return &LicenseInfoMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *LicenseInfoMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *LicenseInfoMetricsRecorder) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.LicenseInfoFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LicenseInfoAPI", "Find", "")
+
+	result, err := t.Internal.Find(ctx, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Read is API call with metrics
+func (t *LicenseInfoMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.LicenseInfo, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LicenseInfoAPI", "Read", "")
+
+	resultLicenseInfo, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultLicenseInfo, err
+}
+
+/*************************************************
+* LoadBalancerMetricsRecorder
+*************************************************/
+
+// LoadBalancerMetricsRecorder is for record metrics of LoadBalancerOp operations
+type LoadBalancerMetricsRecorder struct {
+	Internal sacloud.LoadBalancerAPI
+	metrics  *metrics
+}
+
+// newLoadBalancerMetricsRecorder creates new LoadBalancerMetricsRecorder instance
+func newLoadBalancerMetricsRecorder(in sacloud.LoadBalancerAPI, m *metrics) sacloud.LoadBalancerAPI {
+	return &LoadBalancerMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *LoadBalancerMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *LoadBalancerMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.LoadBalancerFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LoadBalancerAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *LoadBalancerMetricsRecorder) Create(ctx context.Context, zone string, param *sacloud.LoadBalancerCreateRequest) (*sacloud.LoadBalancer, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LoadBalancerAPI", "Create", zone)
+
+	resultLoadBalancer, err := t.Internal.Create(ctx, zone, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultLoadBalancer, err
+}
+
+// Read is API call with metrics
+func (t *LoadBalancerMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.LoadBalancer, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LoadBalancerAPI", "Read", zone)
+
+	resultLoadBalancer, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultLoadBalancer, err
+}
+
+// Update is API call with metrics
+func (t *LoadBalancerMetricsRecorder) Update(ctx context.Context, zone string, id types.ID, param *sacloud.LoadBalancerUpdateRequest) (*sacloud.LoadBalancer, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LoadBalancerAPI", "Update", zone)
+
+	resultLoadBalancer, err := t.Internal.Update(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultLoadBalancer, err
+}
+
+// UpdateSettings is API call with metrics
+func (t *LoadBalancerMetricsRecorder) UpdateSettings(ctx context.Context, zone string, id types.ID, param *sacloud.LoadBalancerUpdateSettingsRequest) (*sacloud.LoadBalancer, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LoadBalancerAPI", "UpdateSettings", zone)
+
+	resultLoadBalancer, err := t.Internal.UpdateSettings(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultLoadBalancer, err
+}
+
+// Delete is API call with metrics
+func (t *LoadBalancerMetricsRecorder) Delete(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LoadBalancerAPI", "Delete", zone)
+
+	err := t.Internal.Delete(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Config is API call with metrics
+func (t *LoadBalancerMetricsRecorder) Config(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LoadBalancerAPI", "Config", zone)
+
+	err := t.Internal.Config(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Boot is API call with metrics
+func (t *LoadBalancerMetricsRecorder) Boot(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LoadBalancerAPI", "Boot", zone)
+
+	err := t.Internal.Boot(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Shutdown is API call with metrics
+func (t *LoadBalancerMetricsRecorder) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LoadBalancerAPI", "Shutdown", zone)
+
+	err := t.Internal.Shutdown(ctx, zone, id, shutdownOption)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Reset is API call with metrics
+func (t *LoadBalancerMetricsRecorder) Reset(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LoadBalancerAPI", "Reset", zone)
+
+	err := t.Internal.Reset(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// MonitorCPU is API call with metrics
+func (t *LoadBalancerMetricsRecorder) MonitorCPU(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.CPUTimeActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LoadBalancerAPI", "MonitorCPU", zone)
+
+	resultCPUTimeActivity, err := t.Internal.MonitorCPU(ctx, zone, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCPUTimeActivity, err
+}
+
+// MonitorInterface is API call with metrics
+func (t *LoadBalancerMetricsRecorder) MonitorInterface(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LoadBalancerAPI", "MonitorInterface", zone)
+
+	resultInterfaceActivity, err := t.Internal.MonitorInterface(ctx, zone, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultInterfaceActivity, err
+}
+
+// Status is API call with metrics
+func (t *LoadBalancerMetricsRecorder) Status(ctx context.Context, zone string, id types.ID) (*sacloud.LoadBalancerStatusResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LoadBalancerAPI", "Status", zone)
+
+	result, err := t.Internal.Status(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+/*************************************************
+* LocalRouterMetricsRecorder
+*************************************************/
+
+// LocalRouterMetricsRecorder is for record metrics of LocalRouterOp operations
+type LocalRouterMetricsRecorder struct {
+	Internal sacloud.LocalRouterAPI
+	metrics  *metrics
+}
+
+// newLocalRouterMetricsRecorder creates new LocalRouterMetricsRecorder instance
+func newLocalRouterMetricsRecorder(in sacloud.LocalRouterAPI, m *metrics) sacloud.LocalRouterAPI {
+	return &LocalRouterMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *LocalRouterMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *LocalRouterMetricsRecorder) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.LocalRouterFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LocalRouterAPI", "Find", "")
+
+	result, err := t.Internal.Find(ctx, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *LocalRouterMetricsRecorder) Create(ctx context.Context, param *sacloud.LocalRouterCreateRequest) (*sacloud.LocalRouter, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LocalRouterAPI", "Create", "")
+
+	resultLocalRouter, err := t.Internal.Create(ctx, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultLocalRouter, err
+}
+
+// Read is API call with metrics
+func (t *LocalRouterMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.LocalRouter, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LocalRouterAPI", "Read", "")
+
+	resultLocalRouter, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultLocalRouter, err
+}
+
+// Update is API call with metrics
+func (t *LocalRouterMetricsRecorder) Update(ctx context.Context, id types.ID, param *sacloud.LocalRouterUpdateRequest) (*sacloud.LocalRouter, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LocalRouterAPI", "Update", "")
+
+	resultLocalRouter, err := t.Internal.Update(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultLocalRouter, err
+}
+
+// UpdateSettings is API call with metrics
+func (t *LocalRouterMetricsRecorder) UpdateSettings(ctx context.Context, id types.ID, param *sacloud.LocalRouterUpdateSettingsRequest) (*sacloud.LocalRouter, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LocalRouterAPI", "UpdateSettings", "")
+
+	resultLocalRouter, err := t.Internal.UpdateSettings(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultLocalRouter, err
+}
+
+// Delete is API call with metrics
+func (t *LocalRouterMetricsRecorder) Delete(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LocalRouterAPI", "Delete", "")
+
+	err := t.Internal.Delete(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// HealthStatus is API call with metrics
+func (t *LocalRouterMetricsRecorder) HealthStatus(ctx context.Context, id types.ID) (*sacloud.LocalRouterHealth, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LocalRouterAPI", "HealthStatus", "")
+
+	resultLocalRouterHealth, err := t.Internal.HealthStatus(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultLocalRouterHealth, err
+}
+
+// MonitorLocalRouter is API call with metrics
+func (t *LocalRouterMetricsRecorder) MonitorLocalRouter(ctx context.Context, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.LocalRouterActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "LocalRouterAPI", "MonitorLocalRouter", "")
+
+	resultLocalRouterActivity, err := t.Internal.MonitorLocalRouter(ctx, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultLocalRouterActivity, err
+}
+
+/*************************************************
+* MobileGatewayMetricsRecorder
+*************************************************/
+
+// MobileGatewayMetricsRecorder is for record metrics of MobileGatewayOp operations
+type MobileGatewayMetricsRecorder struct {
+	Internal sacloud.MobileGatewayAPI
+	metrics  *metrics
+}
+
+// newMobileGatewayMetricsRecorder creates new MobileGatewayMetricsRecorder instance
+func newMobileGatewayMetricsRecorder(in sacloud.MobileGatewayAPI, m *metrics) sacloud.MobileGatewayAPI {
+	return &MobileGatewayMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *MobileGatewayMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *MobileGatewayMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.MobileGatewayFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *MobileGatewayMetricsRecorder) Create(ctx context.Context, zone string, param *sacloud.MobileGatewayCreateRequest) (*sacloud.MobileGateway, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "Create", zone)
+
+	resultMobileGateway, err := t.Internal.Create(ctx, zone, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultMobileGateway, err
+}
+
+// Read is API call with metrics
+func (t *MobileGatewayMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGateway, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "Read", zone)
+
+	resultMobileGateway, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultMobileGateway, err
+}
+
+// Update is API call with metrics
+func (t *MobileGatewayMetricsRecorder) Update(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayUpdateRequest) (*sacloud.MobileGateway, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "Update", zone)
+
+	resultMobileGateway, err := t.Internal.Update(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultMobileGateway, err
+}
+
+// UpdateSettings is API call with metrics
+func (t *MobileGatewayMetricsRecorder) UpdateSettings(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayUpdateSettingsRequest) (*sacloud.MobileGateway, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "UpdateSettings", zone)
+
+	resultMobileGateway, err := t.Internal.UpdateSettings(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultMobileGateway, err
+}
+
+// Delete is API call with metrics
+func (t *MobileGatewayMetricsRecorder) Delete(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "Delete", zone)
+
+	err := t.Internal.Delete(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Config is API call with metrics
+func (t *MobileGatewayMetricsRecorder) Config(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "Config", zone)
+
+	err := t.Internal.Config(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Boot is API call with metrics
+func (t *MobileGatewayMetricsRecorder) Boot(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "Boot", zone)
+
+	err := t.Internal.Boot(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Shutdown is API call with metrics
+func (t *MobileGatewayMetricsRecorder) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "Shutdown", zone)
+
+	err := t.Internal.Shutdown(ctx, zone, id, shutdownOption)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Reset is API call with metrics
+func (t *MobileGatewayMetricsRecorder) Reset(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "Reset", zone)
+
+	err := t.Internal.Reset(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// ConnectToSwitch is API call with metrics
+func (t *MobileGatewayMetricsRecorder) ConnectToSwitch(ctx context.Context, zone string, id types.ID, switchID types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "ConnectToSwitch", zone)
+
+	err := t.Internal.ConnectToSwitch(ctx, zone, id, switchID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// DisconnectFromSwitch is API call with metrics
+func (t *MobileGatewayMetricsRecorder) DisconnectFromSwitch(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "DisconnectFromSwitch", zone)
+
+	err := t.Internal.DisconnectFromSwitch(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// GetDNS is API call with metrics
+func (t *MobileGatewayMetricsRecorder) GetDNS(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGatewayDNSSetting, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "GetDNS", zone)
+
+	resultSIMGroup, err := t.Internal.GetDNS(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSIMGroup, err
+}
+
+// SetDNS is API call with metrics
+func (t *MobileGatewayMetricsRecorder) SetDNS(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayDNSSetting) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "SetDNS", zone)
+
+	err := t.Internal.SetDNS(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// GetSIMRoutes is API call with metrics
+func (t *MobileGatewayMetricsRecorder) GetSIMRoutes(ctx context.Context, zone string, id types.ID) (sacloud.MobileGatewaySIMRoutes, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "GetSIMRoutes", zone)
+
+	resultSIMRoutes, err := t.Internal.GetSIMRoutes(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSIMRoutes, err
+}
+
+// SetSIMRoutes is API call with metrics
+func (t *MobileGatewayMetricsRecorder) SetSIMRoutes(ctx context.Context, zone string, id types.ID, param []*sacloud.MobileGatewaySIMRouteParam) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "SetSIMRoutes", zone)
+
+	err := t.Internal.SetSIMRoutes(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// ListSIM is API call with metrics
+func (t *MobileGatewayMetricsRecorder) ListSIM(ctx context.Context, zone string, id types.ID) (sacloud.MobileGatewaySIMs, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "ListSIM", zone)
+
+	resultSIM, err := t.Internal.ListSIM(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSIM, err
+}
+
+// AddSIM is API call with metrics
+func (t *MobileGatewayMetricsRecorder) AddSIM(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayAddSIMRequest) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "AddSIM", zone)
+
+	err := t.Internal.AddSIM(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// DeleteSIM is API call with metrics
+func (t *MobileGatewayMetricsRecorder) DeleteSIM(ctx context.Context, zone string, id types.ID, simID types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "DeleteSIM", zone)
+
+	err := t.Internal.DeleteSIM(ctx, zone, id, simID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Logs is API call with metrics
+func (t *MobileGatewayMetricsRecorder) Logs(ctx context.Context, zone string, id types.ID) ([]*sacloud.MobileGatewaySIMLogs, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "Logs", zone)
+
+	resultLogs, err := t.Internal.Logs(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultLogs, err
+}
+
+// GetTrafficConfig is API call with metrics
+func (t *MobileGatewayMetricsRecorder) GetTrafficConfig(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGatewayTrafficControl, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "GetTrafficConfig", zone)
+
+	resultTrafficMonitoring, err := t.Internal.GetTrafficConfig(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultTrafficMonitoring, err
+}
+
+// SetTrafficConfig is API call with metrics
+func (t *MobileGatewayMetricsRecorder) SetTrafficConfig(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayTrafficControl) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "SetTrafficConfig", zone)
+
+	err := t.Internal.SetTrafficConfig(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// DeleteTrafficConfig is API call with metrics
+func (t *MobileGatewayMetricsRecorder) DeleteTrafficConfig(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "DeleteTrafficConfig", zone)
+
+	err := t.Internal.DeleteTrafficConfig(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// TrafficStatus is API call with metrics
+func (t *MobileGatewayMetricsRecorder) TrafficStatus(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGatewayTrafficStatus, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "TrafficStatus", zone)
+
+	resultTrafficStatus, err := t.Internal.TrafficStatus(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultTrafficStatus, err
+}
+
+// MonitorInterface is API call with metrics
+func (t *MobileGatewayMetricsRecorder) MonitorInterface(ctx context.Context, zone string, id types.ID, index int, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "MobileGatewayAPI", "MonitorInterface", zone)
+
+	resultInterfaceActivity, err := t.Internal.MonitorInterface(ctx, zone, id, index, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultInterfaceActivity, err
+}
+
+/*************************************************
+* NFSMetricsRecorder
+*************************************************/
+
+// NFSMetricsRecorder is for record metrics of NFSOp operations
+type NFSMetricsRecorder struct {
+	Internal sacloud.NFSAPI
+	metrics  *metrics
+}
+
+// newNFSMetricsRecorder creates new NFSMetricsRecorder instance
+func newNFSMetricsRecorder(in sacloud.NFSAPI, m *metrics) sacloud.NFSAPI {
+	return &NFSMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *NFSMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *NFSMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.NFSFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "NFSAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *NFSMetricsRecorder) Create(ctx context.Context, zone string, param *sacloud.NFSCreateRequest) (*sacloud.NFS, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "NFSAPI", "Create", zone)
+
+	resultNFS, err := t.Internal.Create(ctx, zone, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultNFS, err
+}
+
+// Read is API call with metrics
+func (t *NFSMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.NFS, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "NFSAPI", "Read", zone)
+
+	resultNFS, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultNFS, err
+}
+
+// Update is API call with metrics
+func (t *NFSMetricsRecorder) Update(ctx context.Context, zone string, id types.ID, param *sacloud.NFSUpdateRequest) (*sacloud.NFS, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "NFSAPI", "Update", zone)
+
+	resultNFS, err := t.Internal.Update(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultNFS, err
+}
+
+// Delete is API call with metrics
+func (t *NFSMetricsRecorder) Delete(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "NFSAPI", "Delete", zone)
+
+	err := t.Internal.Delete(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Boot is API call with metrics
+func (t *NFSMetricsRecorder) Boot(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "NFSAPI", "Boot", zone)
+
+	err := t.Internal.Boot(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Shutdown is API call with metrics
+func (t *NFSMetricsRecorder) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "NFSAPI", "Shutdown", zone)
+
+	err := t.Internal.Shutdown(ctx, zone, id, shutdownOption)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Reset is API call with metrics
+func (t *NFSMetricsRecorder) Reset(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "NFSAPI", "Reset", zone)
+
+	err := t.Internal.Reset(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// MonitorCPU is API call with metrics
+func (t *NFSMetricsRecorder) MonitorCPU(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.CPUTimeActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "NFSAPI", "MonitorCPU", zone)
+
+	resultCPUTimeActivity, err := t.Internal.MonitorCPU(ctx, zone, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCPUTimeActivity, err
+}
+
+// MonitorFreeDiskSize is API call with metrics
+func (t *NFSMetricsRecorder) MonitorFreeDiskSize(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.FreeDiskSizeActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "NFSAPI", "MonitorFreeDiskSize", zone)
+
+	resultFreeDiskSizeActivity, err := t.Internal.MonitorFreeDiskSize(ctx, zone, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultFreeDiskSizeActivity, err
+}
+
+// MonitorInterface is API call with metrics
+func (t *NFSMetricsRecorder) MonitorInterface(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "NFSAPI", "MonitorInterface", zone)
+
+	resultInterfaceActivity, err := t.Internal.MonitorInterface(ctx, zone, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultInterfaceActivity, err
+}
+
+/*************************************************
+* NoteMetricsRecorder
+*************************************************/
+
+// NoteMetricsRecorder is for record metrics of NoteOp operations
+type NoteMetricsRecorder struct {
+	Internal sacloud.NoteAPI
+	metrics  *metrics
+}
+
+// newNoteMetricsRecorder creates new NoteMetricsRecorder instance
+func newNoteMetricsRecorder(in sacloud.NoteAPI, m *metrics) sacloud.NoteAPI {
+	return &NoteMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *NoteMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *NoteMetricsRecorder) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.NoteFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "NoteAPI", "Find", "")
+
+	result, err := t.Internal.Find(ctx, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *NoteMetricsRecorder) Create(ctx context.Context, param *sacloud.NoteCreateRequest) (*sacloud.Note, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "NoteAPI", "Create", "")
+
+	resultNote, err := t.Internal.Create(ctx, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultNote, err
+}
+
+// Read is API call with metrics
+func (t *NoteMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.Note, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "NoteAPI", "Read", "")
+
+	resultNote, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultNote, err
+}
+
+// Update is API call with metrics
+func (t *NoteMetricsRecorder) Update(ctx context.Context, id types.ID, param *sacloud.NoteUpdateRequest) (*sacloud.Note, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "NoteAPI", "Update", "")
+
+	resultNote, err := t.Internal.Update(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultNote, err
+}
+
+// Delete is API call with metrics
+func (t *NoteMetricsRecorder) Delete(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "NoteAPI", "Delete", "")
+
+	err := t.Internal.Delete(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+/*************************************************
+* PacketFilterMetricsRecorder
+*************************************************/
+
+// PacketFilterMetricsRecorder is for record metrics of PacketFilterOp operations
+type PacketFilterMetricsRecorder struct {
+	Internal sacloud.PacketFilterAPI
+	metrics  *metrics
+}
+
+// newPacketFilterMetricsRecorder creates new PacketFilterMetricsRecorder instance
+func newPacketFilterMetricsRecorder(in sacloud.PacketFilterAPI, m *metrics) sacloud.PacketFilterAPI {
+	return &PacketFilterMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *PacketFilterMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *PacketFilterMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.PacketFilterFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "PacketFilterAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *PacketFilterMetricsRecorder) Create(ctx context.Context, zone string, param *sacloud.PacketFilterCreateRequest) (*sacloud.PacketFilter, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "PacketFilterAPI", "Create", zone)
+
+	resultPacketFilter, err := t.Internal.Create(ctx, zone, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultPacketFilter, err
+}
+
+// Read is API call with metrics
+func (t *PacketFilterMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.PacketFilter, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "PacketFilterAPI", "Read", zone)
+
+	resultPacketFilter, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultPacketFilter, err
+}
+
+// Update is API call with metrics
+func (t *PacketFilterMetricsRecorder) Update(ctx context.Context, zone string, id types.ID, updateParam *sacloud.PacketFilterUpdateRequest, originalExpressionHash string) (*sacloud.PacketFilter, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "PacketFilterAPI", "Update", zone)
+
+	resultPacketFilter, err := t.Internal.Update(ctx, zone, id, updateParam, originalExpressionHash)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultPacketFilter, err
+}
+
+// Delete is API call with metrics
+func (t *PacketFilterMetricsRecorder) Delete(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "PacketFilterAPI", "Delete", zone)
+
+	err := t.Internal.Delete(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+/*************************************************
+* PrivateHostMetricsRecorder
+*************************************************/
+
+// PrivateHostMetricsRecorder is for record metrics of PrivateHostOp operations
+type PrivateHostMetricsRecorder struct {
+	Internal sacloud.PrivateHostAPI
+	metrics  *metrics
+}
+
+// newPrivateHostMetricsRecorder creates new PrivateHostMetricsRecorder instance
+func newPrivateHostMetricsRecorder(in sacloud.PrivateHostAPI, m *metrics) sacloud.PrivateHostAPI {
+	return &PrivateHostMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *PrivateHostMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *PrivateHostMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.PrivateHostFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "PrivateHostAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *PrivateHostMetricsRecorder) Create(ctx context.Context, zone string, param *sacloud.PrivateHostCreateRequest) (*sacloud.PrivateHost, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "PrivateHostAPI", "Create", zone)
+
+	resultPrivateHost, err := t.Internal.Create(ctx, zone, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultPrivateHost, err
+}
+
+// Read is API call with metrics
+func (t *PrivateHostMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.PrivateHost, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "PrivateHostAPI", "Read", zone)
+
+	resultPrivateHost, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultPrivateHost, err
+}
+
+// Update is API call with metrics
+func (t *PrivateHostMetricsRecorder) Update(ctx context.Context, zone string, id types.ID, param *sacloud.PrivateHostUpdateRequest) (*sacloud.PrivateHost, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "PrivateHostAPI", "Update", zone)
+
+	resultPrivateHost, err := t.Internal.Update(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultPrivateHost, err
+}
+
+// Delete is API call with metrics
+func (t *PrivateHostMetricsRecorder) Delete(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "PrivateHostAPI", "Delete", zone)
+
+	err := t.Internal.Delete(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+/*************************************************
+* PrivateHostPlanMetricsRecorder
+*************************************************/
+
+// PrivateHostPlanMetricsRecorder is for record metrics of PrivateHostPlanOp operations
+type PrivateHostPlanMetricsRecorder struct {
+	Internal sacloud.PrivateHostPlanAPI
+	metrics  *metrics
+}
+
+// newPrivateHostPlanMetricsRecorder creates new PrivateHostPlanMetricsRecorder instance
+func newPrivateHostPlanMetricsRecorder(in sacloud.PrivateHostPlanAPI, m *metrics) sacloud.PrivateHostPlanAPI {
+	return &PrivateHostPlanMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *PrivateHostPlanMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *PrivateHostPlanMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.PrivateHostPlanFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "PrivateHostPlanAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Read is API call with metrics
+func (t *PrivateHostPlanMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.PrivateHostPlan, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "PrivateHostPlanAPI", "Read", zone)
+
+	resultPrivateHostPlan, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultPrivateHostPlan, err
+}
+
+/*************************************************
+* ProxyLBMetricsRecorder
+*************************************************/
+
+// ProxyLBMetricsRecorder is for record metrics of ProxyLBOp operations
+type ProxyLBMetricsRecorder struct {
+	Internal sacloud.ProxyLBAPI
+	metrics  *metrics
+}
+
+// newProxyLBMetricsRecorder creates new ProxyLBMetricsRecorder instance
+func newProxyLBMetricsRecorder(in sacloud.ProxyLBAPI, m *metrics) sacloud.ProxyLBAPI {
+	return &ProxyLBMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ProxyLBMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *ProxyLBMetricsRecorder) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.ProxyLBFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ProxyLBAPI", "Find", "")
+
+	result, err := t.Internal.Find(ctx, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *ProxyLBMetricsRecorder) Create(ctx context.Context, param *sacloud.ProxyLBCreateRequest) (*sacloud.ProxyLB, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ProxyLBAPI", "Create", "")
+
+	resultProxyLB, err := t.Internal.Create(ctx, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultProxyLB, err
+}
+
+// Read is API call with metrics
+func (t *ProxyLBMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.ProxyLB, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ProxyLBAPI", "Read", "")
+
+	resultProxyLB, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultProxyLB, err
+}
+
+// Update is API call with metrics
+func (t *ProxyLBMetricsRecorder) Update(ctx context.Context, id types.ID, param *sacloud.ProxyLBUpdateRequest) (*sacloud.ProxyLB, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ProxyLBAPI", "Update", "")
+
+	resultProxyLB, err := t.Internal.Update(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultProxyLB, err
+}
+
+// UpdateSettings is API call with metrics
+func (t *ProxyLBMetricsRecorder) UpdateSettings(ctx context.Context, id types.ID, param *sacloud.ProxyLBUpdateSettingsRequest) (*sacloud.ProxyLB, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ProxyLBAPI", "UpdateSettings", "")
+
+	resultProxyLB, err := t.Internal.UpdateSettings(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultProxyLB, err
+}
+
+// Delete is API call with metrics
+func (t *ProxyLBMetricsRecorder) Delete(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ProxyLBAPI", "Delete", "")
+
+	err := t.Internal.Delete(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// ChangePlan is API call with metrics
+func (t *ProxyLBMetricsRecorder) ChangePlan(ctx context.Context, id types.ID, param *sacloud.ProxyLBChangePlanRequest) (*sacloud.ProxyLB, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ProxyLBAPI", "ChangePlan", "")
+
+	resultProxyLB, err := t.Internal.ChangePlan(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultProxyLB, err
+}
+
+// GetCertificates is API call with metrics
+func (t *ProxyLBMetricsRecorder) GetCertificates(ctx context.Context, id types.ID) (*sacloud.ProxyLBCertificates, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ProxyLBAPI", "GetCertificates", "")
+
+	resultProxyLBCertificates, err := t.Internal.GetCertificates(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultProxyLBCertificates, err
+}
+
+// SetCertificates is API call with metrics
+func (t *ProxyLBMetricsRecorder) SetCertificates(ctx context.Context, id types.ID, param *sacloud.ProxyLBSetCertificatesRequest) (*sacloud.ProxyLBCertificates, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ProxyLBAPI", "SetCertificates", "")
+
+	resultProxyLBCertificates, err := t.Internal.SetCertificates(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultProxyLBCertificates, err
+}
+
+// DeleteCertificates is API call with metrics
+func (t *ProxyLBMetricsRecorder) DeleteCertificates(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ProxyLBAPI", "DeleteCertificates", "")
+
+	err := t.Internal.DeleteCertificates(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// RenewLetsEncryptCert is API call with metrics
+func (t *ProxyLBMetricsRecorder) RenewLetsEncryptCert(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ProxyLBAPI", "RenewLetsEncryptCert", "")
+
+	err := t.Internal.RenewLetsEncryptCert(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// HealthStatus is API call with metrics
+func (t *ProxyLBMetricsRecorder) HealthStatus(ctx context.Context, id types.ID) (*sacloud.ProxyLBHealth, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ProxyLBAPI", "HealthStatus", "")
+
+	resultProxyLBHealth, err := t.Internal.HealthStatus(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultProxyLBHealth, err
+}
+
+// MonitorConnection is API call with metrics
+func (t *ProxyLBMetricsRecorder) MonitorConnection(ctx context.Context, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.ConnectionActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ProxyLBAPI", "MonitorConnection", "")
+
+	resultConnectionActivity, err := t.Internal.MonitorConnection(ctx, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultConnectionActivity, err
+}
+
+/*************************************************
+* RegionMetricsRecorder
+*************************************************/
+
+// RegionMetricsRecorder is for record metrics of RegionOp operations
+type RegionMetricsRecorder struct {
+	Internal sacloud.RegionAPI
+	metrics  *metrics
+}
+
+// newRegionMetricsRecorder creates new RegionMetricsRecorder instance
+func newRegionMetricsRecorder(in sacloud.RegionAPI, m *metrics) sacloud.RegionAPI {
+	return &RegionMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *RegionMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *RegionMetricsRecorder) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.RegionFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "RegionAPI", "Find", "")
+
+	result, err := t.Internal.Find(ctx, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Read is API call with metrics
+func (t *RegionMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.Region, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "RegionAPI", "Read", "")
+
+	resultRegion, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultRegion, err
+}
+
+/*************************************************
+* ServerMetricsRecorder
+*************************************************/
+
+// ServerMetricsRecorder is for record metrics of ServerOp operations
+type ServerMetricsRecorder struct {
+	Internal sacloud.ServerAPI
+	metrics  *metrics
+}
+
+// newServerMetricsRecorder creates new ServerMetricsRecorder instance
+func newServerMetricsRecorder(in sacloud.ServerAPI, m *metrics) sacloud.ServerAPI {
+	return &ServerMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ServerMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *ServerMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.ServerFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *ServerMetricsRecorder) Create(ctx context.Context, zone string, param *sacloud.ServerCreateRequest) (*sacloud.Server, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerAPI", "Create", zone)
+
+	resultServer, err := t.Internal.Create(ctx, zone, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultServer, err
+}
+
+// Read is API call with metrics
+func (t *ServerMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Server, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerAPI", "Read", zone)
+
+	resultServer, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultServer, err
+}
+
+// Update is API call with metrics
+func (t *ServerMetricsRecorder) Update(ctx context.Context, zone string, id types.ID, param *sacloud.ServerUpdateRequest) (*sacloud.Server, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerAPI", "Update", zone)
+
+	resultServer, err := t.Internal.Update(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultServer, err
+}
+
+// Delete is API call with metrics
+func (t *ServerMetricsRecorder) Delete(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerAPI", "Delete", zone)
+
+	err := t.Internal.Delete(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// DeleteWithDisks is API call with metrics
+func (t *ServerMetricsRecorder) DeleteWithDisks(ctx context.Context, zone string, id types.ID, disks *sacloud.ServerDeleteWithDisksRequest) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerAPI", "DeleteWithDisks", zone)
+
+	err := t.Internal.DeleteWithDisks(ctx, zone, id, disks)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// ChangePlan is API call with metrics
+func (t *ServerMetricsRecorder) ChangePlan(ctx context.Context, zone string, id types.ID, plan *sacloud.ServerChangePlanRequest) (*sacloud.Server, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerAPI", "ChangePlan", zone)
+
+	resultServer, err := t.Internal.ChangePlan(ctx, zone, id, plan)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultServer, err
+}
+
+// InsertCDROM is API call with metrics
+func (t *ServerMetricsRecorder) InsertCDROM(ctx context.Context, zone string, id types.ID, insertParam *sacloud.InsertCDROMRequest) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerAPI", "InsertCDROM", zone)
+
+	err := t.Internal.InsertCDROM(ctx, zone, id, insertParam)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// EjectCDROM is API call with metrics
+func (t *ServerMetricsRecorder) EjectCDROM(ctx context.Context, zone string, id types.ID, ejectParam *sacloud.EjectCDROMRequest) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerAPI", "EjectCDROM", zone)
+
+	err := t.Internal.EjectCDROM(ctx, zone, id, ejectParam)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Boot is API call with metrics
+func (t *ServerMetricsRecorder) Boot(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerAPI", "Boot", zone)
+
+	err := t.Internal.Boot(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Shutdown is API call with metrics
+func (t *ServerMetricsRecorder) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerAPI", "Shutdown", zone)
+
+	err := t.Internal.Shutdown(ctx, zone, id, shutdownOption)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Reset is API call with metrics
+func (t *ServerMetricsRecorder) Reset(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerAPI", "Reset", zone)
+
+	err := t.Internal.Reset(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// BootWithVariables is API call with metrics
+func (t *ServerMetricsRecorder) BootWithVariables(ctx context.Context, zone string, id types.ID, param *sacloud.ServerBootVariables) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerAPI", "BootWithVariables", zone)
+
+	err := t.Internal.BootWithVariables(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// SendKey is API call with metrics
+func (t *ServerMetricsRecorder) SendKey(ctx context.Context, zone string, id types.ID, keyboardParam *sacloud.SendKeyRequest) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerAPI", "SendKey", zone)
+
+	err := t.Internal.SendKey(ctx, zone, id, keyboardParam)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// SendNMI is API call with metrics
+func (t *ServerMetricsRecorder) SendNMI(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerAPI", "SendNMI", zone)
+
+	err := t.Internal.SendNMI(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// GetVNCProxy is API call with metrics
+func (t *ServerMetricsRecorder) GetVNCProxy(ctx context.Context, zone string, id types.ID) (*sacloud.VNCProxyInfo, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerAPI", "GetVNCProxy", zone)
+
+	resultVNCProxyInfo, err := t.Internal.GetVNCProxy(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultVNCProxyInfo, err
+}
+
+// Monitor is API call with metrics
+func (t *ServerMetricsRecorder) Monitor(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.CPUTimeActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerAPI", "Monitor", zone)
+
+	resultCPUTimeActivity, err := t.Internal.Monitor(ctx, zone, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCPUTimeActivity, err
+}
+
+// MonitorCPU is API call with metrics
+func (t *ServerMetricsRecorder) MonitorCPU(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.CPUTimeActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerAPI", "MonitorCPU", zone)
+
+	resultCPUTimeActivity, err := t.Internal.MonitorCPU(ctx, zone, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCPUTimeActivity, err
+}
+
+/*************************************************
+* ServerPlanMetricsRecorder
+*************************************************/
+
+// ServerPlanMetricsRecorder is for record metrics of ServerPlanOp operations
+type ServerPlanMetricsRecorder struct {
+	Internal sacloud.ServerPlanAPI
+	metrics  *metrics
+}
+
+// newServerPlanMetricsRecorder creates new ServerPlanMetricsRecorder instance
+func newServerPlanMetricsRecorder(in sacloud.ServerPlanAPI, m *metrics) sacloud.ServerPlanAPI {
+	return &ServerPlanMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ServerPlanMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *ServerPlanMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.ServerPlanFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerPlanAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Read is API call with metrics
+func (t *ServerPlanMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.ServerPlan, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServerPlanAPI", "Read", zone)
+
+	resultServerPlan, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultServerPlan, err
+}
+
+/*************************************************
+* ServiceClassMetricsRecorder
+*************************************************/
+
+// ServiceClassMetricsRecorder is for record metrics of ServiceClassOp operations
+type ServiceClassMetricsRecorder struct {
+	Internal sacloud.ServiceClassAPI
+	metrics  *metrics
+}
+
+// newServiceClassMetricsRecorder creates new ServiceClassMetricsRecorder instance
+func newServiceClassMetricsRecorder(in sacloud.ServiceClassAPI, m *metrics) sacloud.ServiceClassAPI {
+	return &ServiceClassMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ServiceClassMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *ServiceClassMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.ServiceClassFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ServiceClassAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+/*************************************************
+* SIMMetricsRecorder
+*************************************************/
+
+// SIMMetricsRecorder is for record metrics of SIMOp operations
+type SIMMetricsRecorder struct {
+	Internal sacloud.SIMAPI
+	metrics  *metrics
+}
+
+// newSIMMetricsRecorder creates new SIMMetricsRecorder instance
+func newSIMMetricsRecorder(in sacloud.SIMAPI, m *metrics) sacloud.SIMAPI {
+	return &SIMMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *SIMMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *SIMMetricsRecorder) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.SIMFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SIMAPI", "Find", "")
+
+	result, err := t.Internal.Find(ctx, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *SIMMetricsRecorder) Create(ctx context.Context, param *sacloud.SIMCreateRequest) (*sacloud.SIM, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SIMAPI", "Create", "")
+
+	resultSIM, err := t.Internal.Create(ctx, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSIM, err
+}
+
+// Read is API call with metrics
+func (t *SIMMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.SIM, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SIMAPI", "Read", "")
+
+	resultSIM, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSIM, err
+}
+
+// Update is API call with metrics
+func (t *SIMMetricsRecorder) Update(ctx context.Context, id types.ID, param *sacloud.SIMUpdateRequest) (*sacloud.SIM, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SIMAPI", "Update", "")
+
+	resultSIM, err := t.Internal.Update(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSIM, err
+}
+
+// Delete is API call with metrics
+func (t *SIMMetricsRecorder) Delete(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SIMAPI", "Delete", "")
+
+	err := t.Internal.Delete(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Activate is API call with metrics
+func (t *SIMMetricsRecorder) Activate(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SIMAPI", "Activate", "")
+
+	err := t.Internal.Activate(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Deactivate is API call with metrics
+func (t *SIMMetricsRecorder) Deactivate(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SIMAPI", "Deactivate", "")
+
+	err := t.Internal.Deactivate(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// AssignIP is API call with metrics
+func (t *SIMMetricsRecorder) AssignIP(ctx context.Context, id types.ID, param *sacloud.SIMAssignIPRequest) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SIMAPI", "AssignIP", "")
+
+	err := t.Internal.AssignIP(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// ClearIP is API call with metrics
+func (t *SIMMetricsRecorder) ClearIP(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SIMAPI", "ClearIP", "")
+
+	err := t.Internal.ClearIP(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// IMEILock is API call with metrics
+func (t *SIMMetricsRecorder) IMEILock(ctx context.Context, id types.ID, param *sacloud.SIMIMEILockRequest) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SIMAPI", "IMEILock", "")
+
+	err := t.Internal.IMEILock(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// IMEIUnlock is API call with metrics
+func (t *SIMMetricsRecorder) IMEIUnlock(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SIMAPI", "IMEIUnlock", "")
+
+	err := t.Internal.IMEIUnlock(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Logs is API call with metrics
+func (t *SIMMetricsRecorder) Logs(ctx context.Context, id types.ID) (*sacloud.SIMLogsResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SIMAPI", "Logs", "")
+
+	result, err := t.Internal.Logs(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// GetNetworkOperator is API call with metrics
+func (t *SIMMetricsRecorder) GetNetworkOperator(ctx context.Context, id types.ID) ([]*sacloud.SIMNetworkOperatorConfig, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SIMAPI", "GetNetworkOperator", "")
+
+	resultConfigs, err := t.Internal.GetNetworkOperator(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultConfigs, err
+}
+
+// SetNetworkOperator is API call with metrics
+func (t *SIMMetricsRecorder) SetNetworkOperator(ctx context.Context, id types.ID, configs []*sacloud.SIMNetworkOperatorConfig) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SIMAPI", "SetNetworkOperator", "")
+
+	err := t.Internal.SetNetworkOperator(ctx, id, configs)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// MonitorSIM is API call with metrics
+func (t *SIMMetricsRecorder) MonitorSIM(ctx context.Context, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.LinkActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SIMAPI", "MonitorSIM", "")
+
+	resultLinkActivity, err := t.Internal.MonitorSIM(ctx, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultLinkActivity, err
+}
+
+// Status is API call with metrics
+func (t *SIMMetricsRecorder) Status(ctx context.Context, id types.ID) (*sacloud.SIMInfo, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SIMAPI", "Status", "")
+
+	resultSIM, err := t.Internal.Status(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSIM, err
+}
+
+/*************************************************
+* SimpleMonitorMetricsRecorder
+*************************************************/
+
+// SimpleMonitorMetricsRecorder is for record metrics of SimpleMonitorOp operations
+type SimpleMonitorMetricsRecorder struct {
+	Internal sacloud.SimpleMonitorAPI
+	metrics  *metrics
+}
+
+// newSimpleMonitorMetricsRecorder creates new SimpleMonitorMetricsRecorder instance
+func newSimpleMonitorMetricsRecorder(in sacloud.SimpleMonitorAPI, m *metrics) sacloud.SimpleMonitorAPI {
+	return &SimpleMonitorMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *SimpleMonitorMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *SimpleMonitorMetricsRecorder) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.SimpleMonitorFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SimpleMonitorAPI", "Find", "")
+
+	result, err := t.Internal.Find(ctx, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *SimpleMonitorMetricsRecorder) Create(ctx context.Context, param *sacloud.SimpleMonitorCreateRequest) (*sacloud.SimpleMonitor, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SimpleMonitorAPI", "Create", "")
+
+	resultSimpleMonitor, err := t.Internal.Create(ctx, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSimpleMonitor, err
+}
+
+// Read is API call with metrics
+func (t *SimpleMonitorMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.SimpleMonitor, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SimpleMonitorAPI", "Read", "")
+
+	resultSimpleMonitor, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSimpleMonitor, err
+}
+
+// Update is API call with metrics
+func (t *SimpleMonitorMetricsRecorder) Update(ctx context.Context, id types.ID, param *sacloud.SimpleMonitorUpdateRequest) (*sacloud.SimpleMonitor, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SimpleMonitorAPI", "Update", "")
+
+	resultSimpleMonitor, err := t.Internal.Update(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSimpleMonitor, err
+}
+
+// UpdateSettings is API call with metrics
+func (t *SimpleMonitorMetricsRecorder) UpdateSettings(ctx context.Context, id types.ID, param *sacloud.SimpleMonitorUpdateSettingsRequest) (*sacloud.SimpleMonitor, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SimpleMonitorAPI", "UpdateSettings", "")
+
+	resultSimpleMonitor, err := t.Internal.UpdateSettings(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSimpleMonitor, err
+}
+
+// Delete is API call with metrics
+func (t *SimpleMonitorMetricsRecorder) Delete(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SimpleMonitorAPI", "Delete", "")
+
+	err := t.Internal.Delete(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// MonitorResponseTime is API call with metrics
+func (t *SimpleMonitorMetricsRecorder) MonitorResponseTime(ctx context.Context, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.ResponseTimeSecActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SimpleMonitorAPI", "MonitorResponseTime", "")
+
+	resultResponseTimeSecActivity, err := t.Internal.MonitorResponseTime(ctx, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultResponseTimeSecActivity, err
+}
+
+// HealthStatus is API call with metrics
+func (t *SimpleMonitorMetricsRecorder) HealthStatus(ctx context.Context, id types.ID) (*sacloud.SimpleMonitorHealthStatus, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SimpleMonitorAPI", "HealthStatus", "")
+
+	resultSimpleMonitorHealthStatus, err := t.Internal.HealthStatus(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSimpleMonitorHealthStatus, err
+}
+
+/*************************************************
+* SSHKeyMetricsRecorder
+*************************************************/
+
+// SSHKeyMetricsRecorder is for record metrics of SSHKeyOp operations
+type SSHKeyMetricsRecorder struct {
+	Internal sacloud.SSHKeyAPI
+	metrics  *metrics
+}
+
+// newSSHKeyMetricsRecorder creates new SSHKeyMetricsRecorder instance
+func newSSHKeyMetricsRecorder(in sacloud.SSHKeyAPI, m *metrics) sacloud.SSHKeyAPI {
+	return &SSHKeyMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *SSHKeyMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *SSHKeyMetricsRecorder) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.SSHKeyFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SSHKeyAPI", "Find", "")
+
+	result, err := t.Internal.Find(ctx, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *SSHKeyMetricsRecorder) Create(ctx context.Context, param *sacloud.SSHKeyCreateRequest) (*sacloud.SSHKey, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SSHKeyAPI", "Create", "")
+
+	resultSSHKey, err := t.Internal.Create(ctx, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSSHKey, err
+}
+
+// Generate is API call with metrics
+func (t *SSHKeyMetricsRecorder) Generate(ctx context.Context, param *sacloud.SSHKeyGenerateRequest) (*sacloud.SSHKeyGenerated, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SSHKeyAPI", "Generate", "")
+
+	resultSSHKeyGenerated, err := t.Internal.Generate(ctx, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSSHKeyGenerated, err
+}
+
+// Read is API call with metrics
+func (t *SSHKeyMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.SSHKey, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SSHKeyAPI", "Read", "")
+
+	resultSSHKey, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSSHKey, err
+}
+
+// Update is API call with metrics
+func (t *SSHKeyMetricsRecorder) Update(ctx context.Context, id types.ID, param *sacloud.SSHKeyUpdateRequest) (*sacloud.SSHKey, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SSHKeyAPI", "Update", "")
+
+	resultSSHKey, err := t.Internal.Update(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSSHKey, err
+}
+
+// Delete is API call with metrics
+func (t *SSHKeyMetricsRecorder) Delete(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SSHKeyAPI", "Delete", "")
+
+	err := t.Internal.Delete(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+/*************************************************
+* SubnetMetricsRecorder
+*************************************************/
+
+// SubnetMetricsRecorder is for record metrics of SubnetOp operations
+type SubnetMetricsRecorder struct {
+	Internal sacloud.SubnetAPI
+	metrics  *metrics
+}
+
+// newSubnetMetricsRecorder creates new SubnetMetricsRecorder instance
+func newSubnetMetricsRecorder(in sacloud.SubnetAPI, m *metrics) sacloud.SubnetAPI {
+	return &SubnetMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *SubnetMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *SubnetMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.SubnetFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SubnetAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Read is API call with metrics
+func (t *SubnetMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Subnet, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SubnetAPI", "Read", zone)
+
+	resultSubnet, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSubnet, err
+}
+
+/*************************************************
+* SwitchMetricsRecorder
+*************************************************/
+
+// SwitchMetricsRecorder is for record metrics of SwitchOp operations
+type SwitchMetricsRecorder struct {
+	Internal sacloud.SwitchAPI
+	metrics  *metrics
+}
+
+// newSwitchMetricsRecorder creates new SwitchMetricsRecorder instance
+func newSwitchMetricsRecorder(in sacloud.SwitchAPI, m *metrics) sacloud.SwitchAPI {
+	return &SwitchMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *SwitchMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *SwitchMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.SwitchFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SwitchAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *SwitchMetricsRecorder) Create(ctx context.Context, zone string, param *sacloud.SwitchCreateRequest) (*sacloud.Switch, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SwitchAPI", "Create", zone)
+
+	resultSwitch, err := t.Internal.Create(ctx, zone, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSwitch, err
+}
+
+// Read is API call with metrics
+func (t *SwitchMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Switch, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SwitchAPI", "Read", zone)
+
+	resultSwitch, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSwitch, err
+}
+
+// Update is API call with metrics
+func (t *SwitchMetricsRecorder) Update(ctx context.Context, zone string, id types.ID, param *sacloud.SwitchUpdateRequest) (*sacloud.Switch, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SwitchAPI", "Update", zone)
+
+	resultSwitch, err := t.Internal.Update(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultSwitch, err
+}
+
+// Delete is API call with metrics
+func (t *SwitchMetricsRecorder) Delete(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SwitchAPI", "Delete", zone)
+
+	err := t.Internal.Delete(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// ConnectToBridge is API call with metrics
+func (t *SwitchMetricsRecorder) ConnectToBridge(ctx context.Context, zone string, id types.ID, bridgeID types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SwitchAPI", "ConnectToBridge", zone)
+
+	err := t.Internal.ConnectToBridge(ctx, zone, id, bridgeID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// DisconnectFromBridge is API call with metrics
+func (t *SwitchMetricsRecorder) DisconnectFromBridge(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SwitchAPI", "DisconnectFromBridge", zone)
+
+	err := t.Internal.DisconnectFromBridge(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// GetServers is API call with metrics
+func (t *SwitchMetricsRecorder) GetServers(ctx context.Context, zone string, id types.ID) (*sacloud.SwitchGetServersResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "SwitchAPI", "GetServers", zone)
+
+	result, err := t.Internal.GetServers(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+/*************************************************
+* VPCRouterMetricsRecorder
+*************************************************/
+
+// VPCRouterMetricsRecorder is for record metrics of VPCRouterOp operations
+type VPCRouterMetricsRecorder struct {
+	Internal sacloud.VPCRouterAPI
+	metrics  *metrics
+}
+
+// newVPCRouterMetricsRecorder creates new VPCRouterMetricsRecorder instance
+func newVPCRouterMetricsRecorder(in sacloud.VPCRouterAPI, m *metrics) sacloud.VPCRouterAPI {
+	return &VPCRouterMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *VPCRouterMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *VPCRouterMetricsRecorder) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.VPCRouterFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "VPCRouterAPI", "Find", zone)
+
+	result, err := t.Internal.Find(ctx, zone, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Create is API call with metrics
+func (t *VPCRouterMetricsRecorder) Create(ctx context.Context, zone string, param *sacloud.VPCRouterCreateRequest) (*sacloud.VPCRouter, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "VPCRouterAPI", "Create", zone)
+
+	resultVPCRouter, err := t.Internal.Create(ctx, zone, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultVPCRouter, err
+}
+
+// Read is API call with metrics
+func (t *VPCRouterMetricsRecorder) Read(ctx context.Context, zone string, id types.ID) (*sacloud.VPCRouter, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "VPCRouterAPI", "Read", zone)
+
+	resultVPCRouter, err := t.Internal.Read(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultVPCRouter, err
+}
+
+// Update is API call with metrics
+func (t *VPCRouterMetricsRecorder) Update(ctx context.Context, zone string, id types.ID, param *sacloud.VPCRouterUpdateRequest) (*sacloud.VPCRouter, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "VPCRouterAPI", "Update", zone)
+
+	resultVPCRouter, err := t.Internal.Update(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultVPCRouter, err
+}
+
+// UpdateSettings is API call with metrics
+func (t *VPCRouterMetricsRecorder) UpdateSettings(ctx context.Context, zone string, id types.ID, param *sacloud.VPCRouterUpdateSettingsRequest) (*sacloud.VPCRouter, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "VPCRouterAPI", "UpdateSettings", zone)
+
+	resultVPCRouter, err := t.Internal.UpdateSettings(ctx, zone, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultVPCRouter, err
+}
+
+// Delete is API call with metrics
+func (t *VPCRouterMetricsRecorder) Delete(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "VPCRouterAPI", "Delete", zone)
+
+	err := t.Internal.Delete(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Config is API call with metrics
+func (t *VPCRouterMetricsRecorder) Config(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "VPCRouterAPI", "Config", zone)
+
+	err := t.Internal.Config(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Boot is API call with metrics
+func (t *VPCRouterMetricsRecorder) Boot(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "VPCRouterAPI", "Boot", zone)
+
+	err := t.Internal.Boot(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Shutdown is API call with metrics
+func (t *VPCRouterMetricsRecorder) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "VPCRouterAPI", "Shutdown", zone)
+
+	err := t.Internal.Shutdown(ctx, zone, id, shutdownOption)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// Reset is API call with metrics
+func (t *VPCRouterMetricsRecorder) Reset(ctx context.Context, zone string, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "VPCRouterAPI", "Reset", zone)
+
+	err := t.Internal.Reset(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// ConnectToSwitch is API call with metrics
+func (t *VPCRouterMetricsRecorder) ConnectToSwitch(ctx context.Context, zone string, id types.ID, nicIndex int, switchID types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "VPCRouterAPI", "ConnectToSwitch", zone)
+
+	err := t.Internal.ConnectToSwitch(ctx, zone, id, nicIndex, switchID)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// DisconnectFromSwitch is API call with metrics
+func (t *VPCRouterMetricsRecorder) DisconnectFromSwitch(ctx context.Context, zone string, id types.ID, nicIndex int) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "VPCRouterAPI", "DisconnectFromSwitch", zone)
+
+	err := t.Internal.DisconnectFromSwitch(ctx, zone, id, nicIndex)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// MonitorCPU is API call with metrics
+func (t *VPCRouterMetricsRecorder) MonitorCPU(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.CPUTimeActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "VPCRouterAPI", "MonitorCPU", zone)
+
+	resultCPUTimeActivity, err := t.Internal.MonitorCPU(ctx, zone, id, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCPUTimeActivity, err
+}
+
+// MonitorInterface is API call with metrics
+func (t *VPCRouterMetricsRecorder) MonitorInterface(ctx context.Context, zone string, id types.ID, index int, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "VPCRouterAPI", "MonitorInterface", zone)
+
+	resultInterfaceActivity, err := t.Internal.MonitorInterface(ctx, zone, id, index, condition)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultInterfaceActivity, err
+}
+
+// Status is API call with metrics
+func (t *VPCRouterMetricsRecorder) Status(ctx context.Context, zone string, id types.ID) (*sacloud.VPCRouterStatus, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "VPCRouterAPI", "Status", zone)
+
+	resultVPCRouterStatus, err := t.Internal.Status(ctx, zone, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultVPCRouterStatus, err
+}
+
+/*************************************************
+* WebAccelMetricsRecorder
+*************************************************/
+
+// WebAccelMetricsRecorder is for record metrics of WebAccelOp operations
+type WebAccelMetricsRecorder struct {
+	Internal sacloud.WebAccelAPI
+	metrics  *metrics
+}
+
+// newWebAccelMetricsRecorder creates new WebAccelMetricsRecorder instance
+func newWebAccelMetricsRecorder(in sacloud.WebAccelAPI, m *metrics) sacloud.WebAccelAPI {
+	return &WebAccelMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *WebAccelMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// List is API call with metrics
+func (t *WebAccelMetricsRecorder) List(ctx context.Context) (*sacloud.WebAccelListResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "WebAccelAPI", "List", "")
+
+	result, err := t.Internal.List(ctx)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Read is API call with metrics
+func (t *WebAccelMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.WebAccel, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "WebAccelAPI", "Read", "")
+
+	resultWebAccel, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultWebAccel, err
+}
+
+// ReadCertificate is API call with metrics
+func (t *WebAccelMetricsRecorder) ReadCertificate(ctx context.Context, id types.ID) (*sacloud.WebAccelCerts, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "WebAccelAPI", "ReadCertificate", "")
+
+	resultCertificate, err := t.Internal.ReadCertificate(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCertificate, err
+}
+
+// CreateCertificate is API call with metrics
+func (t *WebAccelMetricsRecorder) CreateCertificate(ctx context.Context, id types.ID, param *sacloud.WebAccelCertRequest) (*sacloud.WebAccelCerts, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "WebAccelAPI", "CreateCertificate", "")
+
+	resultCertificate, err := t.Internal.CreateCertificate(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCertificate, err
+}
+
+// UpdateCertificate is API call with metrics
+func (t *WebAccelMetricsRecorder) UpdateCertificate(ctx context.Context, id types.ID, param *sacloud.WebAccelCertRequest) (*sacloud.WebAccelCerts, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "WebAccelAPI", "UpdateCertificate", "")
+
+	resultCertificate, err := t.Internal.UpdateCertificate(ctx, id, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultCertificate, err
+}
+
+// DeleteCertificate is API call with metrics
+func (t *WebAccelMetricsRecorder) DeleteCertificate(ctx context.Context, id types.ID) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "WebAccelAPI", "DeleteCertificate", "")
+
+	err := t.Internal.DeleteCertificate(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// DeleteAllCache is API call with metrics
+func (t *WebAccelMetricsRecorder) DeleteAllCache(ctx context.Context, param *sacloud.WebAccelDeleteAllCacheRequest) error {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "WebAccelAPI", "DeleteAllCache", "")
+
+	err := t.Internal.DeleteAllCache(ctx, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return err
+}
+
+// DeleteCache is API call with metrics
+func (t *WebAccelMetricsRecorder) DeleteCache(ctx context.Context, param *sacloud.WebAccelDeleteCacheRequest) ([]*sacloud.WebAccelDeleteCacheResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "WebAccelAPI", "DeleteCache", "")
+
+	resultResults, err := t.Internal.DeleteCache(ctx, param)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultResults, err
+}
+
+/*************************************************
+* ZoneMetricsRecorder
+*************************************************/
+
+// ZoneMetricsRecorder is for record metrics of ZoneOp operations
+type ZoneMetricsRecorder struct {
+	Internal sacloud.ZoneAPI
+	metrics  *metrics
+}
+
+// newZoneMetricsRecorder creates new ZoneMetricsRecorder instance
+func newZoneMetricsRecorder(in sacloud.ZoneAPI, m *metrics) sacloud.ZoneAPI {
+	return &ZoneMetricsRecorder{
+		Internal: in,
+		metrics:  m,
+	}
+}
+
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ZoneMetricsRecorder) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
+// Find is API call with metrics
+func (t *ZoneMetricsRecorder) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.ZoneFindResult, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ZoneAPI", "Find", "")
+
+	result, err := t.Internal.Find(ctx, conditions)
+
+	t.metrics.record(ctx, startedAt, err)
+	return result, err
+}
+
+// Read is API call with metrics
+func (t *ZoneMetricsRecorder) Read(ctx context.Context, id types.ID) (*sacloud.Zone, error) {
+	startedAt := time.Now()
+	ctx = t.metrics.withContext(ctx, "ZoneAPI", "Read", "")
+
+	resultZone, err := t.Internal.Read(ctx, id)
+
+	t.metrics.record(ctx, startedAt, err)
+	return resultZone, err
+}
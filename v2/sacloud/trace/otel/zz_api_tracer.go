@@ -28,143 +28,143 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
-func addClientFactoryHooks(cnf *config) {
-	sacloud.AddClientFacotyHookFunc("Archive", func(in interface{}) interface{} {
+func addClientFactoryHooks(add func(resourceName string, hookFunc func(interface{}) interface{}), cnf *config) {
+	add("Archive", func(in interface{}) interface{} {
 		return newArchiveTracer(in.(sacloud.ArchiveAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("AuthStatus", func(in interface{}) interface{} {
+	add("AuthStatus", func(in interface{}) interface{} {
 		return newAuthStatusTracer(in.(sacloud.AuthStatusAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("AutoBackup", func(in interface{}) interface{} {
+	add("AutoBackup", func(in interface{}) interface{} {
 		return newAutoBackupTracer(in.(sacloud.AutoBackupAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("Bill", func(in interface{}) interface{} {
+	add("Bill", func(in interface{}) interface{} {
 		return newBillTracer(in.(sacloud.BillAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("Bridge", func(in interface{}) interface{} {
+	add("Bridge", func(in interface{}) interface{} {
 		return newBridgeTracer(in.(sacloud.BridgeAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("CDROM", func(in interface{}) interface{} {
+	add("CDROM", func(in interface{}) interface{} {
 		return newCDROMTracer(in.(sacloud.CDROMAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("CertificateAuthority", func(in interface{}) interface{} {
+	add("CertificateAuthority", func(in interface{}) interface{} {
 		return newCertificateAuthorityTracer(in.(sacloud.CertificateAuthorityAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("ContainerRegistry", func(in interface{}) interface{} {
+	add("ContainerRegistry", func(in interface{}) interface{} {
 		return newContainerRegistryTracer(in.(sacloud.ContainerRegistryAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("Coupon", func(in interface{}) interface{} {
+	add("Coupon", func(in interface{}) interface{} {
 		return newCouponTracer(in.(sacloud.CouponAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("Database", func(in interface{}) interface{} {
+	add("Database", func(in interface{}) interface{} {
 		return newDatabaseTracer(in.(sacloud.DatabaseAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("Disk", func(in interface{}) interface{} {
+	add("Disk", func(in interface{}) interface{} {
 		return newDiskTracer(in.(sacloud.DiskAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("DiskPlan", func(in interface{}) interface{} {
+	add("DiskPlan", func(in interface{}) interface{} {
 		return newDiskPlanTracer(in.(sacloud.DiskPlanAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("DNS", func(in interface{}) interface{} {
+	add("DNS", func(in interface{}) interface{} {
 		return newDNSTracer(in.(sacloud.DNSAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("EnhancedDB", func(in interface{}) interface{} {
+	add("EnhancedDB", func(in interface{}) interface{} {
 		return newEnhancedDBTracer(in.(sacloud.EnhancedDBAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("ESME", func(in interface{}) interface{} {
+	add("ESME", func(in interface{}) interface{} {
 		return newESMETracer(in.(sacloud.ESMEAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("GSLB", func(in interface{}) interface{} {
+	add("GSLB", func(in interface{}) interface{} {
 		return newGSLBTracer(in.(sacloud.GSLBAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("Icon", func(in interface{}) interface{} {
+	add("Icon", func(in interface{}) interface{} {
 		return newIconTracer(in.(sacloud.IconAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("Interface", func(in interface{}) interface{} {
+	add("Interface", func(in interface{}) interface{} {
 		return newInterfaceTracer(in.(sacloud.InterfaceAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("Internet", func(in interface{}) interface{} {
+	add("Internet", func(in interface{}) interface{} {
 		return newInternetTracer(in.(sacloud.InternetAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("InternetPlan", func(in interface{}) interface{} {
+	add("InternetPlan", func(in interface{}) interface{} {
 		return newInternetPlanTracer(in.(sacloud.InternetPlanAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("IPAddress", func(in interface{}) interface{} {
+	add("IPAddress", func(in interface{}) interface{} {
 		return newIPAddressTracer(in.(sacloud.IPAddressAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("IPv6Net", func(in interface{}) interface{} {
+	add("IPv6Net", func(in interface{}) interface{} {
 		return newIPv6NetTracer(in.(sacloud.IPv6NetAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("IPv6Addr", func(in interface{}) interface{} {
+	add("IPv6Addr", func(in interface{}) interface{} {
 		return newIPv6AddrTracer(in.(sacloud.IPv6AddrAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("License", func(in interface{}) interface{} {
+	add("License", func(in interface{}) interface{} {
 		return newLicenseTracer(in.(sacloud.LicenseAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("LicenseInfo", func(in interface{}) interface{} {
+	add("LicenseInfo", func(in interface{}) interface{} {
 		return newLicenseInfoTracer(in.(sacloud.LicenseInfoAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("LoadBalancer", func(in interface{}) interface{} {
+	add("LoadBalancer", func(in interface{}) interface{} {
 		return newLoadBalancerTracer(in.(sacloud.LoadBalancerAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("LocalRouter", func(in interface{}) interface{} {
+	add("LocalRouter", func(in interface{}) interface{} {
 		return newLocalRouterTracer(in.(sacloud.LocalRouterAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("MobileGateway", func(in interface{}) interface{} {
+	add("MobileGateway", func(in interface{}) interface{} {
 		return newMobileGatewayTracer(in.(sacloud.MobileGatewayAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("NFS", func(in interface{}) interface{} {
+	add("NFS", func(in interface{}) interface{} {
 		return newNFSTracer(in.(sacloud.NFSAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("Note", func(in interface{}) interface{} {
+	add("Note", func(in interface{}) interface{} {
 		return newNoteTracer(in.(sacloud.NoteAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("PacketFilter", func(in interface{}) interface{} {
+	add("PacketFilter", func(in interface{}) interface{} {
 		return newPacketFilterTracer(in.(sacloud.PacketFilterAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("PrivateHost", func(in interface{}) interface{} {
+	add("PrivateHost", func(in interface{}) interface{} {
 		return newPrivateHostTracer(in.(sacloud.PrivateHostAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("PrivateHostPlan", func(in interface{}) interface{} {
+	add("PrivateHostPlan", func(in interface{}) interface{} {
 		return newPrivateHostPlanTracer(in.(sacloud.PrivateHostPlanAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("ProxyLB", func(in interface{}) interface{} {
+	add("ProxyLB", func(in interface{}) interface{} {
 		return newProxyLBTracer(in.(sacloud.ProxyLBAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("Region", func(in interface{}) interface{} {
+	add("Region", func(in interface{}) interface{} {
 		return newRegionTracer(in.(sacloud.RegionAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("Server", func(in interface{}) interface{} {
+	add("Server", func(in interface{}) interface{} {
 		return newServerTracer(in.(sacloud.ServerAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("ServerPlan", func(in interface{}) interface{} {
+	add("ServerPlan", func(in interface{}) interface{} {
 		return newServerPlanTracer(in.(sacloud.ServerPlanAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("ServiceClass", func(in interface{}) interface{} {
+	add("ServiceClass", func(in interface{}) interface{} {
 		return newServiceClassTracer(in.(sacloud.ServiceClassAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("SIM", func(in interface{}) interface{} {
+	add("SIM", func(in interface{}) interface{} {
 		return newSIMTracer(in.(sacloud.SIMAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("SimpleMonitor", func(in interface{}) interface{} {
+	add("SimpleMonitor", func(in interface{}) interface{} {
 		return newSimpleMonitorTracer(in.(sacloud.SimpleMonitorAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("SSHKey", func(in interface{}) interface{} {
+	add("SSHKey", func(in interface{}) interface{} {
 		return newSSHKeyTracer(in.(sacloud.SSHKeyAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("Subnet", func(in interface{}) interface{} {
+	add("Subnet", func(in interface{}) interface{} {
 		return newSubnetTracer(in.(sacloud.SubnetAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("Switch", func(in interface{}) interface{} {
+	add("Switch", func(in interface{}) interface{} {
 		return newSwitchTracer(in.(sacloud.SwitchAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("VPCRouter", func(in interface{}) interface{} {
+	add("VPCRouter", func(in interface{}) interface{} {
 		return newVPCRouterTracer(in.(sacloud.VPCRouterAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("WebAccel", func(in interface{}) interface{} {
+	add("WebAccel", func(in interface{}) interface{} {
 		return newWebAccelTracer(in.(sacloud.WebAccelAPI), cnf)
 	})
-	sacloud.AddClientFacotyHookFunc("Zone", func(in interface{}) interface{} {
+	add("Zone", func(in interface{}) interface{} {
 		return newZoneTracer(in.(sacloud.ZoneAPI), cnf)
 	})
 }
@@ -187,6 +187,12 @@ func newArchiveTracer(in sacloud.ArchiveAPI, cnf *config) sacloud.ArchiveAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ArchiveTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *ArchiveTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.ArchiveFindResult, error) {
 	var span trace.Span
@@ -496,6 +502,12 @@ func newAuthStatusTracer(in sacloud.AuthStatusAPI, cnf *config) sacloud.AuthStat
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *AuthStatusTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Read is API call with trace log
 func (t *AuthStatusTracer) Read(ctx context.Context) (*sacloud.AuthStatus, error) {
 	var span trace.Span
@@ -537,6 +549,12 @@ func newAutoBackupTracer(in sacloud.AutoBackupAPI, cnf *config) sacloud.AutoBack
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *AutoBackupTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *AutoBackupTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.AutoBackupFindResult, error) {
 	var span trace.Span
@@ -712,6 +730,12 @@ func newBillTracer(in sacloud.BillAPI, cnf *config) sacloud.BillAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *BillTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // ByContract is API call with trace log
 func (t *BillTracer) ByContract(ctx context.Context, accountID types.ID) (*sacloud.BillByContractResult, error) {
 	var span trace.Span
@@ -885,6 +909,12 @@ func newBridgeTracer(in sacloud.BridgeAPI, cnf *config) sacloud.BridgeAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *BridgeTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *BridgeTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.BridgeFindResult, error) {
 	var span trace.Span
@@ -1033,6 +1063,12 @@ func newCDROMTracer(in sacloud.CDROMAPI, cnf *config) sacloud.CDROMAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *CDROMTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *CDROMTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.CDROMFindResult, error) {
 	var span trace.Span
@@ -1234,6 +1270,12 @@ func newCertificateAuthorityTracer(in sacloud.CertificateAuthorityAPI, cnf *conf
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *CertificateAuthorityTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *CertificateAuthorityTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.CertificateAuthorityFindResult, error) {
 	var span trace.Span
@@ -1731,6 +1773,12 @@ func newContainerRegistryTracer(in sacloud.ContainerRegistryAPI, cnf *config) sa
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ContainerRegistryTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *ContainerRegistryTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.ContainerRegistryFindResult, error) {
 	var span trace.Span
@@ -2001,6 +2049,12 @@ func newCouponTracer(in sacloud.CouponAPI, cnf *config) sacloud.CouponAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *CouponTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *CouponTracer) Find(ctx context.Context, accountID types.ID) (*sacloud.CouponFindResult, error) {
 	var span trace.Span
@@ -2044,6 +2098,12 @@ func newDatabaseTracer(in sacloud.DatabaseAPI, cnf *config) sacloud.DatabaseAPI
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *DatabaseTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *DatabaseTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.DatabaseFindResult, error) {
 	var span trace.Span
@@ -2506,6 +2566,12 @@ func newDiskTracer(in sacloud.DiskAPI, cnf *config) sacloud.DiskAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *DiskTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *DiskTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.DiskFindResult, error) {
 	var span trace.Span
@@ -2841,6 +2907,12 @@ func newDiskPlanTracer(in sacloud.DiskPlanAPI, cnf *config) sacloud.DiskPlanAPI
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *DiskPlanTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *DiskPlanTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.DiskPlanFindResult, error) {
 	var span trace.Span
@@ -2911,6 +2983,12 @@ func newDNSTracer(in sacloud.DNSAPI, cnf *config) sacloud.DNSAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *DNSTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *DNSTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.DNSFindResult, error) {
 	var span trace.Span
@@ -3080,6 +3158,12 @@ func newEnhancedDBTracer(in sacloud.EnhancedDBAPI, cnf *config) sacloud.Enhanced
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *EnhancedDBTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *EnhancedDBTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.EnhancedDBFindResult, error) {
 	var span trace.Span
@@ -3248,6 +3332,12 @@ func newESMETracer(in sacloud.ESMEAPI, cnf *config) sacloud.ESMEAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ESMETracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *ESMETracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.ESMEFindResult, error) {
 	var span trace.Span
@@ -3468,6 +3558,12 @@ func newGSLBTracer(in sacloud.GSLBAPI, cnf *config) sacloud.GSLBAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *GSLBTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *GSLBTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.GSLBFindResult, error) {
 	var span trace.Span
@@ -3637,6 +3733,12 @@ func newIconTracer(in sacloud.IconAPI, cnf *config) sacloud.IconAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *IconTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *IconTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.IconFindResult, error) {
 	var span trace.Span
@@ -3780,6 +3882,12 @@ func newInterfaceTracer(in sacloud.InterfaceAPI, cnf *config) sacloud.InterfaceA
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *InterfaceTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *InterfaceTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.InterfaceFindResult, error) {
 	var span trace.Span
@@ -4082,6 +4190,12 @@ func newInternetTracer(in sacloud.InternetAPI, cnf *config) sacloud.InternetAPI
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *InternetTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *InternetTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.InternetFindResult, error) {
 	var span trace.Span
@@ -4444,6 +4558,12 @@ func newInternetPlanTracer(in sacloud.InternetPlanAPI, cnf *config) sacloud.Inte
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *InternetPlanTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *InternetPlanTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.InternetPlanFindResult, error) {
 	var span trace.Span
@@ -4514,6 +4634,12 @@ func newIPAddressTracer(in sacloud.IPAddressAPI, cnf *config) sacloud.IPAddressA
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *IPAddressTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // List is API call with trace log
 func (t *IPAddressTracer) List(ctx context.Context, zone string) (*sacloud.IPAddressListResult, error) {
 	var span trace.Span
@@ -4610,6 +4736,12 @@ func newIPv6NetTracer(in sacloud.IPv6NetAPI, cnf *config) sacloud.IPv6NetAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *IPv6NetTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // List is API call with trace log
 func (t *IPv6NetTracer) List(ctx context.Context, zone string) (*sacloud.IPv6NetListResult, error) {
 	var span trace.Span
@@ -4705,6 +4837,12 @@ func newIPv6AddrTracer(in sacloud.IPv6AddrAPI, cnf *config) sacloud.IPv6AddrAPI
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *IPv6AddrTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *IPv6AddrTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.IPv6AddrFindResult, error) {
 	var span trace.Span
@@ -4853,6 +4991,12 @@ func newLicenseTracer(in sacloud.LicenseAPI, cnf *config) sacloud.LicenseAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *LicenseTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *LicenseTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.LicenseFindResult, error) {
 	var span trace.Span
@@ -4996,6 +5140,12 @@ func newLicenseInfoTracer(in sacloud.LicenseInfoAPI, cnf *config) sacloud.Licens
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *LicenseInfoTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *LicenseInfoTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.LicenseInfoFindResult, error) {
 	var span trace.Span
@@ -5064,6 +5214,12 @@ func newLoadBalancerTracer(in sacloud.LoadBalancerAPI, cnf *config) sacloud.Load
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *LoadBalancerTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *LoadBalancerTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.LoadBalancerFindResult, error) {
 	var span trace.Span
@@ -5420,6 +5576,12 @@ func newLocalRouterTracer(in sacloud.LocalRouterAPI, cnf *config) sacloud.LocalR
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *LocalRouterTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *LocalRouterTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.LocalRouterFindResult, error) {
 	var span trace.Span
@@ -5640,6 +5802,12 @@ func newMobileGatewayTracer(in sacloud.MobileGatewayAPI, cnf *config) sacloud.Mo
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *MobileGatewayTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *MobileGatewayTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.MobileGatewayFindResult, error) {
 	var span trace.Span
@@ -6306,6 +6474,12 @@ func newNFSTracer(in sacloud.NFSAPI, cnf *config) sacloud.NFSAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *NFSTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *NFSTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.NFSFindResult, error) {
 	var span trace.Span
@@ -6611,6 +6785,12 @@ func newNoteTracer(in sacloud.NoteAPI, cnf *config) sacloud.NoteAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *NoteTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *NoteTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.NoteFindResult, error) {
 	var span trace.Span
@@ -6754,6 +6934,12 @@ func newPacketFilterTracer(in sacloud.PacketFilterAPI, cnf *config) sacloud.Pack
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *PacketFilterTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *PacketFilterTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.PacketFilterFindResult, error) {
 	var span trace.Span
@@ -6903,6 +7089,12 @@ func newPrivateHostTracer(in sacloud.PrivateHostAPI, cnf *config) sacloud.Privat
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *PrivateHostTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *PrivateHostTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.PrivateHostFindResult, error) {
 	var span trace.Span
@@ -7051,6 +7243,12 @@ func newPrivateHostPlanTracer(in sacloud.PrivateHostPlanAPI, cnf *config) saclou
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *PrivateHostPlanTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *PrivateHostPlanTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.PrivateHostPlanFindResult, error) {
 	var span trace.Span
@@ -7121,6 +7319,12 @@ func newProxyLBTracer(in sacloud.ProxyLBAPI, cnf *config) sacloud.ProxyLBAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ProxyLBTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *ProxyLBTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.ProxyLBFindResult, error) {
 	var span trace.Span
@@ -7466,6 +7670,12 @@ func newRegionTracer(in sacloud.RegionAPI, cnf *config) sacloud.RegionAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *RegionTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *RegionTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.RegionFindResult, error) {
 	var span trace.Span
@@ -7534,6 +7744,12 @@ func newServerTracer(in sacloud.ServerAPI, cnf *config) sacloud.ServerAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ServerTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *ServerTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.ServerFindResult, error) {
 	var span trace.Span
@@ -8020,6 +8236,12 @@ func newServerPlanTracer(in sacloud.ServerPlanAPI, cnf *config) sacloud.ServerPl
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ServerPlanTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *ServerPlanTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.ServerPlanFindResult, error) {
 	var span trace.Span
@@ -8090,6 +8312,12 @@ func newServiceClassTracer(in sacloud.ServiceClassAPI, cnf *config) sacloud.Serv
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ServiceClassTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *ServiceClassTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.ServiceClassFindResult, error) {
 	var span trace.Span
@@ -8134,6 +8362,12 @@ func newSIMTracer(in sacloud.SIMAPI, cnf *config) sacloud.SIMAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *SIMTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *SIMTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.SIMFindResult, error) {
 	var span trace.Span
@@ -8549,6 +8783,12 @@ func newSimpleMonitorTracer(in sacloud.SimpleMonitorAPI, cnf *config) sacloud.Si
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *SimpleMonitorTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *SimpleMonitorTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.SimpleMonitorFindResult, error) {
 	var span trace.Span
@@ -8769,6 +9009,12 @@ func newSSHKeyTracer(in sacloud.SSHKeyAPI, cnf *config) sacloud.SSHKeyAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *SSHKeyTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *SSHKeyTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.SSHKeyFindResult, error) {
 	var span trace.Span
@@ -8937,6 +9183,12 @@ func newSubnetTracer(in sacloud.SubnetAPI, cnf *config) sacloud.SubnetAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *SubnetTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *SubnetTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.SubnetFindResult, error) {
 	var span trace.Span
@@ -9007,6 +9259,12 @@ func newSwitchTracer(in sacloud.SwitchAPI, cnf *config) sacloud.SwitchAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *SwitchTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *SwitchTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.SwitchFindResult, error) {
 	var span trace.Span
@@ -9232,6 +9490,12 @@ func newVPCRouterTracer(in sacloud.VPCRouterAPI, cnf *config) sacloud.VPCRouterA
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *VPCRouterTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *VPCRouterTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.VPCRouterFindResult, error) {
 	var span trace.Span
@@ -9642,6 +9906,12 @@ func newWebAccelTracer(in sacloud.WebAccelAPI, cnf *config) sacloud.WebAccelAPI
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *WebAccelTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // List is API call with trace log
 func (t *WebAccelTracer) List(ctx context.Context) (*sacloud.WebAccelListResult, error) {
 	var span trace.Span
@@ -9858,6 +10128,12 @@ func newZoneTracer(in sacloud.ZoneAPI, cnf *config) sacloud.ZoneAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ZoneTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *ZoneTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.ZoneFindResult, error) {
 	var span trace.Span
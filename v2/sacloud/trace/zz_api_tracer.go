@@ -31,147 +31,152 @@ var initOnce sync.Once
 // AddClientFactoryHooks add client factory hooks
 func AddClientFactoryHooks() {
 	initOnce.Do(func() {
-		addClientFactoryHooks()
+		addClientFactoryHooks(sacloud.AddClientFacotyHookFunc)
 	})
 }
 
-func addClientFactoryHooks() {
-	sacloud.AddClientFacotyHookFunc("Archive", func(in interface{}) interface{} {
+// AddClientFactoryHooksToRegistry add client factory hooks to the registry
+func AddClientFactoryHooksToRegistry(registry *sacloud.ClientRegistry) {
+	addClientFactoryHooks(registry.AddClientFactoryHookFunc)
+}
+
+func addClientFactoryHooks(add func(resourceName string, hookFunc func(interface{}) interface{})) {
+	add("Archive", func(in interface{}) interface{} {
 		return NewArchiveTracer(in.(sacloud.ArchiveAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("AuthStatus", func(in interface{}) interface{} {
+	add("AuthStatus", func(in interface{}) interface{} {
 		return NewAuthStatusTracer(in.(sacloud.AuthStatusAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("AutoBackup", func(in interface{}) interface{} {
+	add("AutoBackup", func(in interface{}) interface{} {
 		return NewAutoBackupTracer(in.(sacloud.AutoBackupAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("Bill", func(in interface{}) interface{} {
+	add("Bill", func(in interface{}) interface{} {
 		return NewBillTracer(in.(sacloud.BillAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("Bridge", func(in interface{}) interface{} {
+	add("Bridge", func(in interface{}) interface{} {
 		return NewBridgeTracer(in.(sacloud.BridgeAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("CDROM", func(in interface{}) interface{} {
+	add("CDROM", func(in interface{}) interface{} {
 		return NewCDROMTracer(in.(sacloud.CDROMAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("CertificateAuthority", func(in interface{}) interface{} {
+	add("CertificateAuthority", func(in interface{}) interface{} {
 		return NewCertificateAuthorityTracer(in.(sacloud.CertificateAuthorityAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("ContainerRegistry", func(in interface{}) interface{} {
+	add("ContainerRegistry", func(in interface{}) interface{} {
 		return NewContainerRegistryTracer(in.(sacloud.ContainerRegistryAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("Coupon", func(in interface{}) interface{} {
+	add("Coupon", func(in interface{}) interface{} {
 		return NewCouponTracer(in.(sacloud.CouponAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("Database", func(in interface{}) interface{} {
+	add("Database", func(in interface{}) interface{} {
 		return NewDatabaseTracer(in.(sacloud.DatabaseAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("Disk", func(in interface{}) interface{} {
+	add("Disk", func(in interface{}) interface{} {
 		return NewDiskTracer(in.(sacloud.DiskAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("DiskPlan", func(in interface{}) interface{} {
+	add("DiskPlan", func(in interface{}) interface{} {
 		return NewDiskPlanTracer(in.(sacloud.DiskPlanAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("DNS", func(in interface{}) interface{} {
+	add("DNS", func(in interface{}) interface{} {
 		return NewDNSTracer(in.(sacloud.DNSAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("EnhancedDB", func(in interface{}) interface{} {
+	add("EnhancedDB", func(in interface{}) interface{} {
 		return NewEnhancedDBTracer(in.(sacloud.EnhancedDBAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("ESME", func(in interface{}) interface{} {
+	add("ESME", func(in interface{}) interface{} {
 		return NewESMETracer(in.(sacloud.ESMEAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("GSLB", func(in interface{}) interface{} {
+	add("GSLB", func(in interface{}) interface{} {
 		return NewGSLBTracer(in.(sacloud.GSLBAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("Icon", func(in interface{}) interface{} {
+	add("Icon", func(in interface{}) interface{} {
 		return NewIconTracer(in.(sacloud.IconAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("Interface", func(in interface{}) interface{} {
+	add("Interface", func(in interface{}) interface{} {
 		return NewInterfaceTracer(in.(sacloud.InterfaceAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("Internet", func(in interface{}) interface{} {
+	add("Internet", func(in interface{}) interface{} {
 		return NewInternetTracer(in.(sacloud.InternetAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("InternetPlan", func(in interface{}) interface{} {
+	add("InternetPlan", func(in interface{}) interface{} {
 		return NewInternetPlanTracer(in.(sacloud.InternetPlanAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("IPAddress", func(in interface{}) interface{} {
+	add("IPAddress", func(in interface{}) interface{} {
 		return NewIPAddressTracer(in.(sacloud.IPAddressAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("IPv6Net", func(in interface{}) interface{} {
+	add("IPv6Net", func(in interface{}) interface{} {
 		return NewIPv6NetTracer(in.(sacloud.IPv6NetAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("IPv6Addr", func(in interface{}) interface{} {
+	add("IPv6Addr", func(in interface{}) interface{} {
 		return NewIPv6AddrTracer(in.(sacloud.IPv6AddrAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("License", func(in interface{}) interface{} {
+	add("License", func(in interface{}) interface{} {
 		return NewLicenseTracer(in.(sacloud.LicenseAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("LicenseInfo", func(in interface{}) interface{} {
+	add("LicenseInfo", func(in interface{}) interface{} {
 		return NewLicenseInfoTracer(in.(sacloud.LicenseInfoAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("LoadBalancer", func(in interface{}) interface{} {
+	add("LoadBalancer", func(in interface{}) interface{} {
 		return NewLoadBalancerTracer(in.(sacloud.LoadBalancerAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("LocalRouter", func(in interface{}) interface{} {
+	add("LocalRouter", func(in interface{}) interface{} {
 		return NewLocalRouterTracer(in.(sacloud.LocalRouterAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("MobileGateway", func(in interface{}) interface{} {
+	add("MobileGateway", func(in interface{}) interface{} {
 		return NewMobileGatewayTracer(in.(sacloud.MobileGatewayAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("NFS", func(in interface{}) interface{} {
+	add("NFS", func(in interface{}) interface{} {
 		return NewNFSTracer(in.(sacloud.NFSAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("Note", func(in interface{}) interface{} {
+	add("Note", func(in interface{}) interface{} {
 		return NewNoteTracer(in.(sacloud.NoteAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("PacketFilter", func(in interface{}) interface{} {
+	add("PacketFilter", func(in interface{}) interface{} {
 		return NewPacketFilterTracer(in.(sacloud.PacketFilterAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("PrivateHost", func(in interface{}) interface{} {
+	add("PrivateHost", func(in interface{}) interface{} {
 		return NewPrivateHostTracer(in.(sacloud.PrivateHostAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("PrivateHostPlan", func(in interface{}) interface{} {
+	add("PrivateHostPlan", func(in interface{}) interface{} {
 		return NewPrivateHostPlanTracer(in.(sacloud.PrivateHostPlanAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("ProxyLB", func(in interface{}) interface{} {
+	add("ProxyLB", func(in interface{}) interface{} {
 		return NewProxyLBTracer(in.(sacloud.ProxyLBAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("Region", func(in interface{}) interface{} {
+	add("Region", func(in interface{}) interface{} {
 		return NewRegionTracer(in.(sacloud.RegionAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("Server", func(in interface{}) interface{} {
+	add("Server", func(in interface{}) interface{} {
 		return NewServerTracer(in.(sacloud.ServerAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("ServerPlan", func(in interface{}) interface{} {
+	add("ServerPlan", func(in interface{}) interface{} {
 		return NewServerPlanTracer(in.(sacloud.ServerPlanAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("ServiceClass", func(in interface{}) interface{} {
+	add("ServiceClass", func(in interface{}) interface{} {
 		return NewServiceClassTracer(in.(sacloud.ServiceClassAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("SIM", func(in interface{}) interface{} {
+	add("SIM", func(in interface{}) interface{} {
 		return NewSIMTracer(in.(sacloud.SIMAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("SimpleMonitor", func(in interface{}) interface{} {
+	add("SimpleMonitor", func(in interface{}) interface{} {
 		return NewSimpleMonitorTracer(in.(sacloud.SimpleMonitorAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("SSHKey", func(in interface{}) interface{} {
+	add("SSHKey", func(in interface{}) interface{} {
 		return NewSSHKeyTracer(in.(sacloud.SSHKeyAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("Subnet", func(in interface{}) interface{} {
+	add("Subnet", func(in interface{}) interface{} {
 		return NewSubnetTracer(in.(sacloud.SubnetAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("Switch", func(in interface{}) interface{} {
+	add("Switch", func(in interface{}) interface{} {
 		return NewSwitchTracer(in.(sacloud.SwitchAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("VPCRouter", func(in interface{}) interface{} {
+	add("VPCRouter", func(in interface{}) interface{} {
 		return NewVPCRouterTracer(in.(sacloud.VPCRouterAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("WebAccel", func(in interface{}) interface{} {
+	add("WebAccel", func(in interface{}) interface{} {
 		return NewWebAccelTracer(in.(sacloud.WebAccelAPI))
 	})
-	sacloud.AddClientFacotyHookFunc("Zone", func(in interface{}) interface{} {
+	add("Zone", func(in interface{}) interface{} {
 		return NewZoneTracer(in.(sacloud.ZoneAPI))
 	})
 }
@@ -192,6 +197,12 @@ func NewArchiveTracer(in sacloud.ArchiveAPI) sacloud.ArchiveAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ArchiveTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *ArchiveTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.ArchiveFindResult, error) {
 	log.Println("[TRACE] ArchiveAPI.Find start")
@@ -581,6 +592,12 @@ func NewAuthStatusTracer(in sacloud.AuthStatusAPI) sacloud.AuthStatusAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *AuthStatusTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Read is API call with trace log
 func (t *AuthStatusTracer) Read(ctx context.Context) (*sacloud.AuthStatus, error) {
 	log.Println("[TRACE] AuthStatusAPI.Read start")
@@ -625,6 +642,12 @@ func NewAutoBackupTracer(in sacloud.AutoBackupAPI) sacloud.AutoBackupAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *AutoBackupTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *AutoBackupTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.AutoBackupFindResult, error) {
 	log.Println("[TRACE] AutoBackupAPI.Find start")
@@ -841,6 +864,12 @@ func NewBillTracer(in sacloud.BillAPI) sacloud.BillAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *BillTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // ByContract is API call with trace log
 func (t *BillTracer) ByContract(ctx context.Context, accountID types.ID) (*sacloud.BillByContractResult, error) {
 	log.Println("[TRACE] BillAPI.ByContract start")
@@ -1053,6 +1082,12 @@ func NewBridgeTracer(in sacloud.BridgeAPI) sacloud.BridgeAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *BridgeTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *BridgeTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.BridgeFindResult, error) {
 	log.Println("[TRACE] BridgeAPI.Find start")
@@ -1234,6 +1269,12 @@ func NewCDROMTracer(in sacloud.CDROMAPI) sacloud.CDROMAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *CDROMTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *CDROMTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.CDROMFindResult, error) {
 	log.Println("[TRACE] CDROMAPI.Find start")
@@ -1483,6 +1524,12 @@ func NewCertificateAuthorityTracer(in sacloud.CertificateAuthorityAPI) sacloud.C
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *CertificateAuthorityTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *CertificateAuthorityTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.CertificateAuthorityFindResult, error) {
 	log.Println("[TRACE] CertificateAuthorityAPI.Find start")
@@ -2096,6 +2143,12 @@ func NewContainerRegistryTracer(in sacloud.ContainerRegistryAPI) sacloud.Contain
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ContainerRegistryTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *ContainerRegistryTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.ContainerRegistryFindResult, error) {
 	log.Println("[TRACE] ContainerRegistryAPI.Find start")
@@ -2426,6 +2479,12 @@ func NewCouponTracer(in sacloud.CouponAPI) sacloud.CouponAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *CouponTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *CouponTracer) Find(ctx context.Context, accountID types.ID) (*sacloud.CouponFindResult, error) {
 	log.Println("[TRACE] CouponAPI.Find start")
@@ -2473,6 +2532,12 @@ func NewDatabaseTracer(in sacloud.DatabaseAPI) sacloud.DatabaseAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *DatabaseTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *DatabaseTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.DatabaseFindResult, error) {
 	log.Println("[TRACE] DatabaseAPI.Find start")
@@ -3054,6 +3119,12 @@ func NewDiskTracer(in sacloud.DiskAPI) sacloud.DiskAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *DiskTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *DiskTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.DiskFindResult, error) {
 	log.Println("[TRACE] DiskAPI.Find start")
@@ -3476,6 +3547,12 @@ func NewDiskPlanTracer(in sacloud.DiskPlanAPI) sacloud.DiskPlanAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *DiskPlanTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *DiskPlanTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.DiskPlanFindResult, error) {
 	log.Println("[TRACE] DiskPlanAPI.Find start")
@@ -3558,6 +3635,12 @@ func NewDNSTracer(in sacloud.DNSAPI) sacloud.DNSAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *DNSTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *DNSTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.DNSFindResult, error) {
 	log.Println("[TRACE] DNSAPI.Find start")
@@ -3762,6 +3845,12 @@ func NewEnhancedDBTracer(in sacloud.EnhancedDBAPI) sacloud.EnhancedDBAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *EnhancedDBTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *EnhancedDBTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.EnhancedDBFindResult, error) {
 	log.Println("[TRACE] EnhancedDBAPI.Find start")
@@ -3964,6 +4053,12 @@ func NewESMETracer(in sacloud.ESMEAPI) sacloud.ESMEAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ESMETracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *ESMETracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.ESMEFindResult, error) {
 	log.Println("[TRACE] ESMEAPI.Find start")
@@ -4232,6 +4327,12 @@ func NewGSLBTracer(in sacloud.GSLBAPI) sacloud.GSLBAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *GSLBTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *GSLBTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.GSLBFindResult, error) {
 	log.Println("[TRACE] GSLBAPI.Find start")
@@ -4436,6 +4537,12 @@ func NewIconTracer(in sacloud.IconAPI) sacloud.IconAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *IconTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *IconTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.IconFindResult, error) {
 	log.Println("[TRACE] IconAPI.Find start")
@@ -4607,6 +4714,12 @@ func NewInterfaceTracer(in sacloud.InterfaceAPI) sacloud.InterfaceAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *InterfaceTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *InterfaceTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.InterfaceFindResult, error) {
 	log.Println("[TRACE] InterfaceAPI.Find start")
@@ -4982,6 +5095,12 @@ func NewInternetTracer(in sacloud.InternetAPI) sacloud.InternetAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *InternetTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *InternetTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.InternetFindResult, error) {
 	log.Println("[TRACE] InternetAPI.Find start")
@@ -5439,6 +5558,12 @@ func NewInternetPlanTracer(in sacloud.InternetPlanAPI) sacloud.InternetPlanAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *InternetPlanTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *InternetPlanTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.InternetPlanFindResult, error) {
 	log.Println("[TRACE] InternetPlanAPI.Find start")
@@ -5521,6 +5646,12 @@ func NewIPAddressTracer(in sacloud.IPAddressAPI) sacloud.IPAddressAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *IPAddressTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // List is API call with trace log
 func (t *IPAddressTracer) List(ctx context.Context, zone string) (*sacloud.IPAddressListResult, error) {
 	log.Println("[TRACE] IPAddressAPI.List start")
@@ -5636,6 +5767,12 @@ func NewIPv6NetTracer(in sacloud.IPv6NetAPI) sacloud.IPv6NetAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *IPv6NetTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // List is API call with trace log
 func (t *IPv6NetTracer) List(ctx context.Context, zone string) (*sacloud.IPv6NetListResult, error) {
 	log.Println("[TRACE] IPv6NetAPI.List start")
@@ -5749,6 +5886,12 @@ func NewIPv6AddrTracer(in sacloud.IPv6AddrAPI) sacloud.IPv6AddrAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *IPv6AddrTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *IPv6AddrTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.IPv6AddrFindResult, error) {
 	log.Println("[TRACE] IPv6AddrAPI.Find start")
@@ -5930,6 +6073,12 @@ func NewLicenseTracer(in sacloud.LicenseAPI) sacloud.LicenseAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *LicenseTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *LicenseTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.LicenseFindResult, error) {
 	log.Println("[TRACE] LicenseAPI.Find start")
@@ -6101,6 +6250,12 @@ func NewLicenseInfoTracer(in sacloud.LicenseInfoAPI) sacloud.LicenseInfoAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *LicenseInfoTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *LicenseInfoTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.LicenseInfoFindResult, error) {
 	log.Println("[TRACE] LicenseInfoAPI.Find start")
@@ -6179,6 +6334,12 @@ func NewLoadBalancerTracer(in sacloud.LoadBalancerAPI) sacloud.LoadBalancerAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *LoadBalancerTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *LoadBalancerTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.LoadBalancerFindResult, error) {
 	log.Println("[TRACE] LoadBalancerAPI.Find start")
@@ -6624,6 +6785,12 @@ func NewLocalRouterTracer(in sacloud.LocalRouterAPI) sacloud.LocalRouterAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *LocalRouterTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *LocalRouterTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.LocalRouterFindResult, error) {
 	log.Println("[TRACE] LocalRouterAPI.Find start")
@@ -6892,6 +7059,12 @@ func NewMobileGatewayTracer(in sacloud.MobileGatewayAPI) sacloud.MobileGatewayAP
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *MobileGatewayTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *MobileGatewayTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.MobileGatewayFindResult, error) {
 	log.Println("[TRACE] MobileGatewayAPI.Find start")
@@ -7729,6 +7902,12 @@ func NewNFSTracer(in sacloud.NFSAPI) sacloud.NFSAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *NFSTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *NFSTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.NFSFindResult, error) {
 	log.Println("[TRACE] NFSAPI.Find start")
@@ -8110,6 +8289,12 @@ func NewNoteTracer(in sacloud.NoteAPI) sacloud.NoteAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *NoteTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *NoteTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.NoteFindResult, error) {
 	log.Println("[TRACE] NoteAPI.Find start")
@@ -8281,6 +8466,12 @@ func NewPacketFilterTracer(in sacloud.PacketFilterAPI) sacloud.PacketFilterAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *PacketFilterTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *PacketFilterTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.PacketFilterFindResult, error) {
 	log.Println("[TRACE] PacketFilterAPI.Find start")
@@ -8464,6 +8655,12 @@ func NewPrivateHostTracer(in sacloud.PrivateHostAPI) sacloud.PrivateHostAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *PrivateHostTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *PrivateHostTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.PrivateHostFindResult, error) {
 	log.Println("[TRACE] PrivateHostAPI.Find start")
@@ -8645,6 +8842,12 @@ func NewPrivateHostPlanTracer(in sacloud.PrivateHostPlanAPI) sacloud.PrivateHost
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *PrivateHostPlanTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *PrivateHostPlanTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.PrivateHostPlanFindResult, error) {
 	log.Println("[TRACE] PrivateHostPlanAPI.Find start")
@@ -8727,6 +8930,12 @@ func NewProxyLBTracer(in sacloud.ProxyLBAPI) sacloud.ProxyLBAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ProxyLBTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *ProxyLBTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.ProxyLBFindResult, error) {
 	log.Println("[TRACE] ProxyLBAPI.Find start")
@@ -9150,6 +9359,12 @@ func NewRegionTracer(in sacloud.RegionAPI) sacloud.RegionAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *RegionTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *RegionTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.RegionFindResult, error) {
 	log.Println("[TRACE] RegionAPI.Find start")
@@ -9228,6 +9443,12 @@ func NewServerTracer(in sacloud.ServerAPI) sacloud.ServerAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ServerTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *ServerTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.ServerFindResult, error) {
 	log.Println("[TRACE] ServerAPI.Find start")
@@ -9838,6 +10059,12 @@ func NewServerPlanTracer(in sacloud.ServerPlanAPI) sacloud.ServerPlanAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ServerPlanTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *ServerPlanTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.ServerPlanFindResult, error) {
 	log.Println("[TRACE] ServerPlanAPI.Find start")
@@ -9920,6 +10147,12 @@ func NewServiceClassTracer(in sacloud.ServiceClassAPI) sacloud.ServiceClassAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ServiceClassTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *ServiceClassTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.ServiceClassFindResult, error) {
 	log.Println("[TRACE] ServiceClassAPI.Find start")
@@ -9969,6 +10202,12 @@ func NewSIMTracer(in sacloud.SIMAPI) sacloud.SIMAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *SIMTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *SIMTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.SIMFindResult, error) {
 	log.Println("[TRACE] SIMAPI.Find start")
@@ -10475,6 +10714,12 @@ func NewSimpleMonitorTracer(in sacloud.SimpleMonitorAPI) sacloud.SimpleMonitorAP
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *SimpleMonitorTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *SimpleMonitorTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.SimpleMonitorFindResult, error) {
 	log.Println("[TRACE] SimpleMonitorAPI.Find start")
@@ -10743,6 +10988,12 @@ func NewSSHKeyTracer(in sacloud.SSHKeyAPI) sacloud.SSHKeyAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *SSHKeyTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *SSHKeyTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.SSHKeyFindResult, error) {
 	log.Println("[TRACE] SSHKeyAPI.Find start")
@@ -10945,6 +11196,12 @@ func NewSubnetTracer(in sacloud.SubnetAPI) sacloud.SubnetAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *SubnetTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *SubnetTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.SubnetFindResult, error) {
 	log.Println("[TRACE] SubnetAPI.Find start")
@@ -11027,6 +11284,12 @@ func NewSwitchTracer(in sacloud.SwitchAPI) sacloud.SwitchAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *SwitchTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *SwitchTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.SwitchFindResult, error) {
 	log.Println("[TRACE] SwitchAPI.Find start")
@@ -11305,6 +11568,12 @@ func NewVPCRouterTracer(in sacloud.VPCRouterAPI) sacloud.VPCRouterAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *VPCRouterTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *VPCRouterTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) (*sacloud.VPCRouterFindResult, error) {
 	log.Println("[TRACE] VPCRouterAPI.Find start")
@@ -11820,6 +12089,12 @@ func NewWebAccelTracer(in sacloud.WebAccelAPI) sacloud.WebAccelAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *WebAccelTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // List is API call with trace log
 func (t *WebAccelTracer) List(ctx context.Context) (*sacloud.WebAccelListResult, error) {
 	log.Println("[TRACE] WebAccelAPI.List start")
@@ -12081,6 +12356,12 @@ func NewZoneTracer(in sacloud.ZoneAPI) sacloud.ZoneAPI {
 	}
 }
 
+// ClientRegistry returns ClientRegistry held by the internal client
+func (t *ZoneTracer) ClientRegistry() *sacloud.ClientRegistry {
+	r, _ := sacloud.ClientRegistryFromAPI(t.Internal)
+	return r
+}
+
 // Find is API call with trace log
 func (t *ZoneTracer) Find(ctx context.Context, conditions *sacloud.FindCondition) (*sacloud.ZoneFindResult, error) {
 	log.Println("[TRACE] ZoneAPI.Find start")
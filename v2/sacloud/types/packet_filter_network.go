@@ -14,7 +14,13 @@
 
 package types
 
-import "fmt"
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
 
 // PacketFilterNetwork パケットフィルタのルールでの送信元ネットワーク(アドレス/範囲)
 //
@@ -47,3 +53,55 @@ func (p *PacketFilterNetwork) String() string {
 func (p *PacketFilterNetwork) Equal(p2 *PacketFilterNetwork) bool {
 	return p.String() == p2.String()
 }
+
+// Range 送信元ネットワークが表すアドレスの範囲(IPv4)を返す
+//
+// 空の場合は全てのアドレス(0.0.0.0〜255.255.255.255)を返す。
+// A.A.A.A/M.M.M.M形式の場合、M.M.M.Mがネットマスクであればネットワークアドレスとして、
+// それ以外の場合はA.A.A.AからM.M.M.Mまでのアドレス範囲として扱う
+func (p *PacketFilterNetwork) Range() (from, to net.IP, err error) {
+	value := p.String()
+	if value == "" {
+		return net.IPv4zero.To4(), net.IPv4bcast.To4(), nil
+	}
+
+	addr, suffix := value, ""
+	if i := strings.Index(value, "/"); i >= 0 {
+		addr, suffix = value[:i], value[i+1:]
+	}
+	ip := net.ParseIP(addr).To4()
+	if ip == nil {
+		return nil, nil, fmt.Errorf("invalid source network: %q", value)
+	}
+	if suffix == "" {
+		return ip, ip, nil
+	}
+
+	var mask net.IPMask
+	if maskLen, err := strconv.Atoi(suffix); err == nil {
+		if maskLen < 1 || maskLen > 32 {
+			return nil, nil, fmt.Errorf("invalid source network: %q", value)
+		}
+		mask = net.CIDRMask(maskLen, 32)
+	} else {
+		end := net.ParseIP(suffix).To4()
+		if end == nil {
+			return nil, nil, fmt.Errorf("invalid source network: %q", value)
+		}
+		if ones, bits := net.IPMask(end).Size(); bits == 0 || ones == 0 {
+			// ネットマスクでない場合はアドレス範囲
+			if bytes.Compare(ip, end) > 0 {
+				return nil, nil, fmt.Errorf("invalid source network: %q", value)
+			}
+			return ip, end, nil
+		}
+		mask = net.IPMask(end)
+	}
+
+	from = ip.Mask(mask)
+	to = make(net.IP, net.IPv4len)
+	for i := range from {
+		to[i] = from[i] | ^mask[i]
+	}
+	return from, to, nil
+}
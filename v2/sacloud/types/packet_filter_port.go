@@ -14,7 +14,11 @@
 
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 // PacketFilterPort パケットフィルターのルールでのポート型
 //
@@ -45,3 +49,22 @@ func (p *PacketFilterPort) String() string {
 func (p *PacketFilterPort) Equal(p2 *PacketFilterPort) bool {
 	return p.String() == p2.String()
 }
+
+// Range ポートの範囲を返す 空の場合は全てのポート(0〜65535)を返す
+func (p *PacketFilterPort) Range() (from, to int, err error) {
+	value := p.String()
+	if value == "" {
+		return 0, 65535, nil
+	}
+
+	strFrom, strTo := value, value
+	if i := strings.Index(value, "-"); i >= 0 {
+		strFrom, strTo = value[:i], value[i+1:]
+	}
+	from, err1 := strconv.Atoi(strFrom)
+	to, err2 := strconv.Atoi(strTo)
+	if err1 != nil || err2 != nil || from < 0 || to > 65535 || from > to {
+		return 0, 0, fmt.Errorf("invalid port: %q", value)
+	}
+	return from, to, nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2016-2022 The Libsacloud Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPacketFilterNetwork_Range(t *testing.T) {
+	cases := []struct {
+		input string
+		from  string
+		to    string
+		err   bool
+	}{
+		{input: "", from: "0.0.0.0", to: "255.255.255.255"},
+		{input: "192.0.2.1", from: "192.0.2.1", to: "192.0.2.1"},
+		{input: "192.0.2.1/24", from: "192.0.2.0", to: "192.0.2.255"},
+		{input: "192.0.2.0/255.255.255.128", from: "192.0.2.0", to: "192.0.2.127"},
+		{input: "192.0.2.10/192.0.2.20", from: "192.0.2.10", to: "192.0.2.20"},
+		{input: "192.0.2.20/192.0.2.10", err: true},
+		{input: "192.0.2.1/33", err: true},
+		{input: "2001:db8::1", err: true},
+		{input: "example", err: true},
+	}
+	for _, tc := range cases {
+		network := PacketFilterNetwork(tc.input)
+		from, to, err := network.Range()
+		if tc.err {
+			require.Error(t, err, tc.input)
+			continue
+		}
+		require.NoError(t, err, tc.input)
+		require.Equal(t, tc.from, from.String(), tc.input)
+		require.Equal(t, tc.to, to.String(), tc.input)
+	}
+}
+
+func TestPacketFilterPort_Range(t *testing.T) {
+	cases := []struct {
+		input string
+		from  int
+		to    int
+		err   bool
+	}{
+		{input: "", from: 0, to: 65535},
+		{input: "80", from: 80, to: 80},
+		{input: "1024-65535", from: 1024, to: 65535},
+		{input: "443-80", err: true},
+		{input: "65536", err: true},
+		{input: "http", err: true},
+	}
+	for _, tc := range cases {
+		port := PacketFilterPort(tc.input)
+		from, to, err := port.Range()
+		if tc.err {
+			require.Error(t, err, tc.input)
+			continue
+		}
+		require.NoError(t, err, tc.input)
+		require.Equal(t, tc.from, from, tc.input)
+		require.Equal(t, tc.to, to, tc.input)
+	}
+}
+
+func TestVPCFirewallNetwork_Range(t *testing.T) {
+	network := VPCFirewallNetwork("192.0.2.1/28")
+	from, to, err := network.Range()
+	require.NoError(t, err)
+	require.Equal(t, "192.0.2.0", from.String())
+	require.Equal(t, "192.0.2.15", to.String())
+
+	network = VPCFirewallNetwork("192.0.2.0/255.255.255.0")
+	_, _, err = network.Range()
+	require.Error(t, err)
+}
+
+func TestVPCFirewallPort_Ranges(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected [][2]int
+		err      bool
+	}{
+		{input: "", expected: [][2]int{{1, 65535}}},
+		{input: "22", expected: [][2]int{{22, 22}}},
+		{input: "80,443,8000-8080", expected: [][2]int{{80, 80}, {443, 443}, {8000, 8080}}},
+		{input: "0", err: true},
+		{input: "443-80", err: true},
+		{input: "80,http", err: true},
+	}
+	for _, tc := range cases {
+		port := VPCFirewallPort(tc.input)
+		ranges, err := port.Ranges()
+		if tc.err {
+			require.Error(t, err, tc.input)
+			continue
+		}
+		require.NoError(t, err, tc.input)
+		require.Equal(t, tc.expected, ranges, tc.input)
+	}
+}
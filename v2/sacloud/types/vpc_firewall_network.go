@@ -14,7 +14,12 @@
 
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
 
 // VPCFirewallNetwork VPCルータのファイアウォールルールでの送信元ネットワーク(アドレス/範囲)
 //
@@ -40,3 +45,19 @@ func (p *VPCFirewallNetwork) String() string {
 func (p *VPCFirewallNetwork) Equal(p2 *PacketFilterNetwork) bool {
 	return p.String() == p2.String()
 }
+
+// Range ネットワークが表すアドレスの範囲(IPv4)を返す 空の場合は全てのアドレスを返す
+func (p *VPCFirewallNetwork) Range() (from, to net.IP, err error) {
+	value := p.String()
+	if i := strings.Index(value, "/"); i >= 0 {
+		if _, err := strconv.Atoi(value[i+1:]); err != nil {
+			return nil, nil, fmt.Errorf("invalid network: %q", value)
+		}
+	}
+	network := PacketFilterNetwork(value)
+	from, to, err = network.Range()
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid network: %q", value)
+	}
+	return from, to, nil
+}
@@ -17,6 +17,7 @@ package types
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -60,3 +61,26 @@ func (p *VPCFirewallPort) String() string {
 func (p *VPCFirewallPort) Equal(p2 *PacketFilterPort) bool {
 	return p.String() == p2.String()
 }
+
+// Ranges ポート範囲(開始と終了のポート番号の組)のリストを返す 空の場合は全てのポート(1〜65535)を返す
+func (p *VPCFirewallPort) Ranges() ([][2]int, error) {
+	value := p.String()
+	if value == "" {
+		return [][2]int{{1, 65535}}, nil
+	}
+
+	var ranges [][2]int
+	for _, v := range strings.Split(value, ",") {
+		strFrom, strTo := v, v
+		if i := strings.Index(v, "-"); i >= 0 {
+			strFrom, strTo = v[:i], v[i+1:]
+		}
+		from, err1 := strconv.Atoi(strings.TrimSpace(strFrom))
+		to, err2 := strconv.Atoi(strings.TrimSpace(strTo))
+		if err1 != nil || err2 != nil || from < 1 || to > 65535 || from > to {
+			return nil, fmt.Errorf("invalid port: %q", value)
+		}
+		ranges = append(ranges, [2]int{from, to})
+	}
+	return ranges, nil
+}
@@ -27,7 +27,7 @@ var apiLocker = mutexkv.NewMutexKV()
 
 func init() {
 
-	SetClientFactoryFunc("Archive", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("Archive", func(caller APICaller) interface{} {
 		return &ArchiveOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -35,7 +35,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("AuthStatus", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("AuthStatus", func(caller APICaller) interface{} {
 		return &AuthStatusOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -43,7 +43,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("AutoBackup", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("AutoBackup", func(caller APICaller) interface{} {
 		return &AutoBackupOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -51,7 +51,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("Bill", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("Bill", func(caller APICaller) interface{} {
 		return &BillOp{
 			Client:     caller,
 			PathSuffix: "api/system/1.0",
@@ -59,7 +59,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("Bridge", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("Bridge", func(caller APICaller) interface{} {
 		return &BridgeOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -67,7 +67,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("CDROM", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("CDROM", func(caller APICaller) interface{} {
 		return &CDROMOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -75,7 +75,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("CertificateAuthority", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("CertificateAuthority", func(caller APICaller) interface{} {
 		return &CertificateAuthorityOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -83,7 +83,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("ContainerRegistry", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("ContainerRegistry", func(caller APICaller) interface{} {
 		return &ContainerRegistryOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -91,7 +91,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("Coupon", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("Coupon", func(caller APICaller) interface{} {
 		return &CouponOp{
 			Client:     caller,
 			PathSuffix: "api/system/1.0",
@@ -99,7 +99,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("Database", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("Database", func(caller APICaller) interface{} {
 		return &DatabaseOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -107,7 +107,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("Disk", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("Disk", func(caller APICaller) interface{} {
 		return &DiskOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -115,7 +115,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("DiskPlan", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("DiskPlan", func(caller APICaller) interface{} {
 		return &DiskPlanOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -123,7 +123,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("DNS", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("DNS", func(caller APICaller) interface{} {
 		return &DNSOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -131,7 +131,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("EnhancedDB", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("EnhancedDB", func(caller APICaller) interface{} {
 		return &EnhancedDBOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -139,7 +139,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("ESME", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("ESME", func(caller APICaller) interface{} {
 		return &ESMEOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -147,7 +147,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("GSLB", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("GSLB", func(caller APICaller) interface{} {
 		return &GSLBOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -155,7 +155,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("Icon", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("Icon", func(caller APICaller) interface{} {
 		return &IconOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -163,7 +163,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("Interface", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("Interface", func(caller APICaller) interface{} {
 		return &InterfaceOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -171,7 +171,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("Internet", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("Internet", func(caller APICaller) interface{} {
 		return &InternetOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -179,7 +179,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("InternetPlan", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("InternetPlan", func(caller APICaller) interface{} {
 		return &InternetPlanOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -187,7 +187,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("IPAddress", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("IPAddress", func(caller APICaller) interface{} {
 		return &IPAddressOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -195,7 +195,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("IPv6Net", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("IPv6Net", func(caller APICaller) interface{} {
 		return &IPv6NetOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -203,7 +203,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("IPv6Addr", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("IPv6Addr", func(caller APICaller) interface{} {
 		return &IPv6AddrOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -211,7 +211,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("License", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("License", func(caller APICaller) interface{} {
 		return &LicenseOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -219,7 +219,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("LicenseInfo", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("LicenseInfo", func(caller APICaller) interface{} {
 		return &LicenseInfoOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -227,7 +227,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("LoadBalancer", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("LoadBalancer", func(caller APICaller) interface{} {
 		return &LoadBalancerOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -235,7 +235,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("LocalRouter", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("LocalRouter", func(caller APICaller) interface{} {
 		return &LocalRouterOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -243,7 +243,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("MobileGateway", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("MobileGateway", func(caller APICaller) interface{} {
 		return &MobileGatewayOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -251,7 +251,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("NFS", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("NFS", func(caller APICaller) interface{} {
 		return &NFSOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -259,7 +259,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("Note", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("Note", func(caller APICaller) interface{} {
 		return &NoteOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -267,7 +267,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("PacketFilter", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("PacketFilter", func(caller APICaller) interface{} {
 		return &PacketFilterOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -275,7 +275,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("PrivateHost", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("PrivateHost", func(caller APICaller) interface{} {
 		return &PrivateHostOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -283,7 +283,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("PrivateHostPlan", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("PrivateHostPlan", func(caller APICaller) interface{} {
 		return &PrivateHostPlanOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -291,7 +291,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("ProxyLB", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("ProxyLB", func(caller APICaller) interface{} {
 		return &ProxyLBOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -299,7 +299,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("Region", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("Region", func(caller APICaller) interface{} {
 		return &RegionOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -307,7 +307,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("Server", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("Server", func(caller APICaller) interface{} {
 		return &ServerOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -315,7 +315,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("ServerPlan", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("ServerPlan", func(caller APICaller) interface{} {
 		return &ServerPlanOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -323,7 +323,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("ServiceClass", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("ServiceClass", func(caller APICaller) interface{} {
 		return &ServiceClassOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -331,7 +331,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("SIM", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("SIM", func(caller APICaller) interface{} {
 		return &SIMOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -339,7 +339,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("SimpleMonitor", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("SimpleMonitor", func(caller APICaller) interface{} {
 		return &SimpleMonitorOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -347,7 +347,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("SSHKey", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("SSHKey", func(caller APICaller) interface{} {
 		return &SSHKeyOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -355,7 +355,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("Subnet", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("Subnet", func(caller APICaller) interface{} {
 		return &SubnetOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -363,7 +363,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("Switch", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("Switch", func(caller APICaller) interface{} {
 		return &SwitchOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -371,7 +371,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("VPCRouter", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("VPCRouter", func(caller APICaller) interface{} {
 		return &VPCRouterOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -379,7 +379,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("WebAccel", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("WebAccel", func(caller APICaller) interface{} {
 		return &WebAccelOp{
 			Client:     caller,
 			PathSuffix: "api/webaccel/1.0",
@@ -387,7 +387,7 @@ func init() {
 		}
 	})
 
-	SetClientFactoryFunc("Zone", func(caller APICaller) interface{} {
+	registerBuiltinClientFactoryFunc("Zone", func(caller APICaller) interface{} {
 		return &ZoneOp{
 			Client:     caller,
 			PathSuffix: "api/cloud/1.1",
@@ -412,7 +412,13 @@ type ArchiveOp struct {
 
 // NewArchiveOp creates new ArchiveOp instance
 func NewArchiveOp(caller APICaller) ArchiveAPI {
-	return GetClientFactoryFunc("Archive")(caller).(ArchiveAPI)
+	return getClientFactoryFuncFor(caller, "Archive")(caller).(ArchiveAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *ArchiveOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -823,7 +829,13 @@ type AuthStatusOp struct {
 
 // NewAuthStatusOp creates new AuthStatusOp instance
 func NewAuthStatusOp(caller APICaller) AuthStatusAPI {
-	return GetClientFactoryFunc("AuthStatus")(caller).(AuthStatusAPI)
+	return getClientFactoryFuncFor(caller, "AuthStatus")(caller).(AuthStatusAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *AuthStatusOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Read is API call
@@ -873,7 +885,13 @@ type AutoBackupOp struct {
 
 // NewAutoBackupOp creates new AutoBackupOp instance
 func NewAutoBackupOp(caller APICaller) AutoBackupAPI {
-	return GetClientFactoryFunc("AutoBackup")(caller).(AutoBackupAPI)
+	return getClientFactoryFuncFor(caller, "AutoBackup")(caller).(AutoBackupAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *AutoBackupOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -1103,7 +1121,13 @@ type BillOp struct {
 
 // NewBillOp creates new BillOp instance
 func NewBillOp(caller APICaller) BillAPI {
-	return GetClientFactoryFunc("Bill")(caller).(BillAPI)
+	return getClientFactoryFuncFor(caller, "Bill")(caller).(BillAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *BillOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // ByContract is API call
@@ -1319,7 +1343,13 @@ type BridgeOp struct {
 
 // NewBridgeOp creates new BridgeOp instance
 func NewBridgeOp(caller APICaller) BridgeAPI {
-	return GetClientFactoryFunc("Bridge")(caller).(BridgeAPI)
+	return getClientFactoryFuncFor(caller, "Bridge")(caller).(BridgeAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *BridgeOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -1511,7 +1541,13 @@ type CDROMOp struct {
 
 // NewCDROMOp creates new CDROMOp instance
 func NewCDROMOp(caller APICaller) CDROMAPI {
-	return GetClientFactoryFunc("CDROM")(caller).(CDROMAPI)
+	return getClientFactoryFuncFor(caller, "CDROM")(caller).(CDROMAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *CDROMOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -1770,7 +1806,13 @@ type CertificateAuthorityOp struct {
 
 // NewCertificateAuthorityOp creates new CertificateAuthorityOp instance
 func NewCertificateAuthorityOp(caller APICaller) CertificateAuthorityAPI {
-	return GetClientFactoryFunc("CertificateAuthority")(caller).(CertificateAuthorityAPI)
+	return getClientFactoryFuncFor(caller, "CertificateAuthority")(caller).(CertificateAuthorityAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *CertificateAuthorityOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -2410,7 +2452,13 @@ type ContainerRegistryOp struct {
 
 // NewContainerRegistryOp creates new ContainerRegistryOp instance
 func NewContainerRegistryOp(caller APICaller) ContainerRegistryAPI {
-	return GetClientFactoryFunc("ContainerRegistry")(caller).(ContainerRegistryAPI)
+	return getClientFactoryFuncFor(caller, "ContainerRegistry")(caller).(ContainerRegistryAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *ContainerRegistryOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -2773,7 +2821,13 @@ type CouponOp struct {
 
 // NewCouponOp creates new CouponOp instance
 func NewCouponOp(caller APICaller) CouponAPI {
-	return GetClientFactoryFunc("Coupon")(caller).(CouponAPI)
+	return getClientFactoryFuncFor(caller, "Coupon")(caller).(CouponAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *CouponOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -2824,7 +2878,13 @@ type DatabaseOp struct {
 
 // NewDatabaseOp creates new DatabaseOp instance
 func NewDatabaseOp(caller APICaller) DatabaseAPI {
-	return GetClientFactoryFunc("Database")(caller).(DatabaseAPI)
+	return getClientFactoryFuncFor(caller, "Database")(caller).(DatabaseAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *DatabaseOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -3445,7 +3505,13 @@ type DiskOp struct {
 
 // NewDiskOp creates new DiskOp instance
 func NewDiskOp(caller APICaller) DiskAPI {
-	return GetClientFactoryFunc("Disk")(caller).(DiskAPI)
+	return getClientFactoryFuncFor(caller, "Disk")(caller).(DiskAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *DiskOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -3883,7 +3949,13 @@ type DiskPlanOp struct {
 
 // NewDiskPlanOp creates new DiskPlanOp instance
 func NewDiskPlanOp(caller APICaller) DiskPlanAPI {
-	return GetClientFactoryFunc("DiskPlan")(caller).(DiskPlanAPI)
+	return getClientFactoryFuncFor(caller, "DiskPlan")(caller).(DiskPlanAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *DiskPlanOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -3971,7 +4043,13 @@ type DNSOp struct {
 
 // NewDNSOp creates new DNSOp instance
 func NewDNSOp(caller APICaller) DNSAPI {
-	return GetClientFactoryFunc("DNS")(caller).(DNSAPI)
+	return getClientFactoryFuncFor(caller, "DNS")(caller).(DNSAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *DNSOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -4201,7 +4279,13 @@ type EnhancedDBOp struct {
 
 // NewEnhancedDBOp creates new EnhancedDBOp instance
 func NewEnhancedDBOp(caller APICaller) EnhancedDBAPI {
-	return GetClientFactoryFunc("EnhancedDB")(caller).(EnhancedDBAPI)
+	return getClientFactoryFuncFor(caller, "EnhancedDB")(caller).(EnhancedDBAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *EnhancedDBOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -4428,7 +4512,13 @@ type ESMEOp struct {
 
 // NewESMEOp creates new ESMEOp instance
 func NewESMEOp(caller APICaller) ESMEAPI {
-	return GetClientFactoryFunc("ESME")(caller).(ESMEAPI)
+	return getClientFactoryFuncFor(caller, "ESME")(caller).(ESMEAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *ESMEOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -4728,7 +4818,13 @@ type GSLBOp struct {
 
 // NewGSLBOp creates new GSLBOp instance
 func NewGSLBOp(caller APICaller) GSLBAPI {
-	return GetClientFactoryFunc("GSLB")(caller).(GSLBAPI)
+	return getClientFactoryFuncFor(caller, "GSLB")(caller).(GSLBAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *GSLBOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -4958,7 +5054,13 @@ type IconOp struct {
 
 // NewIconOp creates new IconOp instance
 func NewIconOp(caller APICaller) IconAPI {
-	return GetClientFactoryFunc("Icon")(caller).(IconAPI)
+	return getClientFactoryFuncFor(caller, "Icon")(caller).(IconAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *IconOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -5150,7 +5252,13 @@ type InterfaceOp struct {
 
 // NewInterfaceOp creates new InterfaceOp instance
 func NewInterfaceOp(caller APICaller) InterfaceAPI {
-	return GetClientFactoryFunc("Interface")(caller).(InterfaceAPI)
+	return getClientFactoryFuncFor(caller, "Interface")(caller).(InterfaceAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *InterfaceOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -5527,7 +5635,13 @@ type InternetOp struct {
 
 // NewInternetOp creates new InternetOp instance
 func NewInternetOp(caller APICaller) InternetAPI {
-	return GetClientFactoryFunc("Internet")(caller).(InternetAPI)
+	return getClientFactoryFuncFor(caller, "Internet")(caller).(InternetAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *InternetOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -6002,7 +6116,13 @@ type InternetPlanOp struct {
 
 // NewInternetPlanOp creates new InternetPlanOp instance
 func NewInternetPlanOp(caller APICaller) InternetPlanAPI {
-	return GetClientFactoryFunc("InternetPlan")(caller).(InternetPlanAPI)
+	return getClientFactoryFuncFor(caller, "InternetPlan")(caller).(InternetPlanAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *InternetPlanOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -6090,7 +6210,13 @@ type IPAddressOp struct {
 
 // NewIPAddressOp creates new IPAddressOp instance
 func NewIPAddressOp(caller APICaller) IPAddressAPI {
-	return GetClientFactoryFunc("IPAddress")(caller).(IPAddressAPI)
+	return getClientFactoryFuncFor(caller, "IPAddress")(caller).(IPAddressAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *IPAddressOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // List is API call
@@ -6210,7 +6336,13 @@ type IPv6NetOp struct {
 
 // NewIPv6NetOp creates new IPv6NetOp instance
 func NewIPv6NetOp(caller APICaller) IPv6NetAPI {
-	return GetClientFactoryFunc("IPv6Net")(caller).(IPv6NetAPI)
+	return getClientFactoryFuncFor(caller, "IPv6Net")(caller).(IPv6NetAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *IPv6NetOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // List is API call
@@ -6329,7 +6461,13 @@ type IPv6AddrOp struct {
 
 // NewIPv6AddrOp creates new IPv6AddrOp instance
 func NewIPv6AddrOp(caller APICaller) IPv6AddrAPI {
-	return GetClientFactoryFunc("IPv6Addr")(caller).(IPv6AddrAPI)
+	return getClientFactoryFuncFor(caller, "IPv6Addr")(caller).(IPv6AddrAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *IPv6AddrOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -6521,7 +6659,13 @@ type LicenseOp struct {
 
 // NewLicenseOp creates new LicenseOp instance
 func NewLicenseOp(caller APICaller) LicenseAPI {
-	return GetClientFactoryFunc("License")(caller).(LicenseAPI)
+	return getClientFactoryFuncFor(caller, "License")(caller).(LicenseAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *LicenseOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -6713,7 +6857,13 @@ type LicenseInfoOp struct {
 
 // NewLicenseInfoOp creates new LicenseInfoOp instance
 func NewLicenseInfoOp(caller APICaller) LicenseInfoAPI {
-	return GetClientFactoryFunc("LicenseInfo")(caller).(LicenseInfoAPI)
+	return getClientFactoryFuncFor(caller, "LicenseInfo")(caller).(LicenseInfoAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *LicenseInfoOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -6801,7 +6951,13 @@ type LoadBalancerOp struct {
 
 // NewLoadBalancerOp creates new LoadBalancerOp instance
 func NewLoadBalancerOp(caller APICaller) LoadBalancerAPI {
-	return GetClientFactoryFunc("LoadBalancer")(caller).(LoadBalancerAPI)
+	return getClientFactoryFuncFor(caller, "LoadBalancer")(caller).(LoadBalancerAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *LoadBalancerOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -7279,7 +7435,13 @@ type LocalRouterOp struct {
 
 // NewLocalRouterOp creates new LocalRouterOp instance
 func NewLocalRouterOp(caller APICaller) LocalRouterAPI {
-	return GetClientFactoryFunc("LocalRouter")(caller).(LocalRouterAPI)
+	return getClientFactoryFuncFor(caller, "LocalRouter")(caller).(LocalRouterAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *LocalRouterOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -7579,7 +7741,13 @@ type MobileGatewayOp struct {
 
 // NewMobileGatewayOp creates new MobileGatewayOp instance
 func NewMobileGatewayOp(caller APICaller) MobileGatewayAPI {
-	return GetClientFactoryFunc("MobileGateway")(caller).(MobileGatewayAPI)
+	return getClientFactoryFuncFor(caller, "MobileGateway")(caller).(MobileGatewayAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *MobileGatewayOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -8438,7 +8606,13 @@ type NFSOp struct {
 
 // NewNFSOp creates new NFSOp instance
 func NewNFSOp(caller APICaller) NFSAPI {
-	return GetClientFactoryFunc("NFS")(caller).(NFSAPI)
+	return getClientFactoryFuncFor(caller, "NFS")(caller).(NFSAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *NFSOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -8855,7 +9029,13 @@ type NoteOp struct {
 
 // NewNoteOp creates new NoteOp instance
 func NewNoteOp(caller APICaller) NoteAPI {
-	return GetClientFactoryFunc("Note")(caller).(NoteAPI)
+	return getClientFactoryFuncFor(caller, "Note")(caller).(NoteAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *NoteOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -9047,7 +9227,13 @@ type PacketFilterOp struct {
 
 // NewPacketFilterOp creates new PacketFilterOp instance
 func NewPacketFilterOp(caller APICaller) PacketFilterAPI {
-	return GetClientFactoryFunc("PacketFilter")(caller).(PacketFilterAPI)
+	return getClientFactoryFuncFor(caller, "PacketFilter")(caller).(PacketFilterAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *PacketFilterOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -9240,7 +9426,13 @@ type PrivateHostOp struct {
 
 // NewPrivateHostOp creates new PrivateHostOp instance
 func NewPrivateHostOp(caller APICaller) PrivateHostAPI {
-	return GetClientFactoryFunc("PrivateHost")(caller).(PrivateHostAPI)
+	return getClientFactoryFuncFor(caller, "PrivateHost")(caller).(PrivateHostAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *PrivateHostOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -9432,7 +9624,13 @@ type PrivateHostPlanOp struct {
 
 // NewPrivateHostPlanOp creates new PrivateHostPlanOp instance
 func NewPrivateHostPlanOp(caller APICaller) PrivateHostPlanAPI {
-	return GetClientFactoryFunc("PrivateHostPlan")(caller).(PrivateHostPlanAPI)
+	return getClientFactoryFuncFor(caller, "PrivateHostPlan")(caller).(PrivateHostPlanAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *PrivateHostPlanOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -9520,7 +9718,13 @@ type ProxyLBOp struct {
 
 // NewProxyLBOp creates new ProxyLBOp instance
 func NewProxyLBOp(caller APICaller) ProxyLBAPI {
-	return GetClientFactoryFunc("ProxyLB")(caller).(ProxyLBAPI)
+	return getClientFactoryFuncFor(caller, "ProxyLB")(caller).(ProxyLBAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *ProxyLBOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -9986,7 +10190,13 @@ type RegionOp struct {
 
 // NewRegionOp creates new RegionOp instance
 func NewRegionOp(caller APICaller) RegionAPI {
-	return GetClientFactoryFunc("Region")(caller).(RegionAPI)
+	return getClientFactoryFuncFor(caller, "Region")(caller).(RegionAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *RegionOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -10074,7 +10284,13 @@ type ServerOp struct {
 
 // NewServerOp creates new ServerOp instance
 func NewServerOp(caller APICaller) ServerAPI {
-	return GetClientFactoryFunc("Server")(caller).(ServerAPI)
+	return getClientFactoryFuncFor(caller, "Server")(caller).(ServerAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *ServerOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -10733,7 +10949,13 @@ type ServerPlanOp struct {
 
 // NewServerPlanOp creates new ServerPlanOp instance
 func NewServerPlanOp(caller APICaller) ServerPlanAPI {
-	return GetClientFactoryFunc("ServerPlan")(caller).(ServerPlanAPI)
+	return getClientFactoryFuncFor(caller, "ServerPlan")(caller).(ServerPlanAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *ServerPlanOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -10821,7 +11043,13 @@ type ServiceClassOp struct {
 
 // NewServiceClassOp creates new ServiceClassOp instance
 func NewServiceClassOp(caller APICaller) ServiceClassAPI {
-	return GetClientFactoryFunc("ServiceClass")(caller).(ServiceClassAPI)
+	return getClientFactoryFuncFor(caller, "ServiceClass")(caller).(ServiceClassAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *ServiceClassOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -10877,7 +11105,13 @@ type SIMOp struct {
 
 // NewSIMOp creates new SIMOp instance
 func NewSIMOp(caller APICaller) SIMAPI {
-	return GetClientFactoryFunc("SIM")(caller).(SIMAPI)
+	return getClientFactoryFuncFor(caller, "SIM")(caller).(SIMAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *SIMOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -11424,7 +11658,13 @@ type SimpleMonitorOp struct {
 
 // NewSimpleMonitorOp creates new SimpleMonitorOp instance
 func NewSimpleMonitorOp(caller APICaller) SimpleMonitorAPI {
-	return GetClientFactoryFunc("SimpleMonitor")(caller).(SimpleMonitorAPI)
+	return getClientFactoryFuncFor(caller, "SimpleMonitor")(caller).(SimpleMonitorAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *SimpleMonitorOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -11724,7 +11964,13 @@ type SSHKeyOp struct {
 
 // NewSSHKeyOp creates new SSHKeyOp instance
 func NewSSHKeyOp(caller APICaller) SSHKeyAPI {
-	return GetClientFactoryFunc("SSHKey")(caller).(SSHKeyAPI)
+	return getClientFactoryFuncFor(caller, "SSHKey")(caller).(SSHKeyAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *SSHKeyOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -11953,7 +12199,13 @@ type SubnetOp struct {
 
 // NewSubnetOp creates new SubnetOp instance
 func NewSubnetOp(caller APICaller) SubnetAPI {
-	return GetClientFactoryFunc("Subnet")(caller).(SubnetAPI)
+	return getClientFactoryFuncFor(caller, "Subnet")(caller).(SubnetAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *SubnetOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -12041,7 +12293,13 @@ type SwitchOp struct {
 
 // NewSwitchOp creates new SwitchOp instance
 func NewSwitchOp(caller APICaller) SwitchAPI {
-	return GetClientFactoryFunc("Switch")(caller).(SwitchAPI)
+	return getClientFactoryFuncFor(caller, "Switch")(caller).(SwitchAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *SwitchOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -12324,7 +12582,13 @@ type VPCRouterOp struct {
 
 // NewVPCRouterOp creates new VPCRouterOp instance
 func NewVPCRouterOp(caller APICaller) VPCRouterAPI {
-	return GetClientFactoryFunc("VPCRouter")(caller).(VPCRouterAPI)
+	return getClientFactoryFuncFor(caller, "VPCRouter")(caller).(VPCRouterAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *VPCRouterOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -12864,7 +13128,13 @@ type WebAccelOp struct {
 
 // NewWebAccelOp creates new WebAccelOp instance
 func NewWebAccelOp(caller APICaller) WebAccelAPI {
-	return GetClientFactoryFunc("WebAccel")(caller).(WebAccelAPI)
+	return getClientFactoryFuncFor(caller, "WebAccel")(caller).(WebAccelAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *WebAccelOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // List is API call
@@ -13154,7 +13424,13 @@ type ZoneOp struct {
 
 // NewZoneOp creates new ZoneOp instance
 func NewZoneOp(caller APICaller) ZoneAPI {
-	return GetClientFactoryFunc("Zone")(caller).(ZoneAPI)
+	return getClientFactoryFuncFor(caller, "Zone")(caller).(ZoneAPI)
+}
+
+// ClientRegistry returns ClientRegistry held by the APICaller
+func (o *ZoneOp) ClientRegistry() *ClientRegistry {
+	r, _ := ClientRegistryFromCaller(o.Client)
+	return r
 }
 
 // Find is API call
@@ -8059,3 +8059,1997 @@ func (o *ZoneOp) transformReadResults(data []byte) (*zoneReadResult, error) {
 	}
 	return results, nil
 }
+
+var apiEnvelopeTransformers = map[string]*apiEnvelopeTransformer{
+	"Archive.Find": {
+		newRequest: func() interface{} { return &archiveFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&ArchiveOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &archiveFindResponseEnvelope{} },
+	},
+	"Archive.Create": {
+		newRequest: func() interface{} { return &archiveCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*ArchiveCreateRequest)
+			return (&ArchiveOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &archiveCreateResponseEnvelope{} },
+	},
+	"Archive.CreateBlank": {
+		newRequest: func() interface{} { return &archiveCreateBlankRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*ArchiveCreateBlankRequest)
+			return (&ArchiveOp{}).transformCreateBlankArgs(arg0)
+		},
+		newResponse: func() interface{} { return &archiveCreateBlankResponseEnvelope{} },
+	},
+	"Archive.Read": {
+		newResponse: func() interface{} { return &archiveReadResponseEnvelope{} },
+	},
+	"Archive.Update": {
+		newRequest: func() interface{} { return &archiveUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ArchiveUpdateRequest)
+			return (&ArchiveOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &archiveUpdateResponseEnvelope{} },
+	},
+	"Archive.OpenFTP": {
+		newRequest: func() interface{} { return &archiveOpenFTPRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*OpenFTPRequest)
+			return (&ArchiveOp{}).transformOpenFTPArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &archiveOpenFTPResponseEnvelope{} },
+	},
+	"Archive.Share": {
+		newRequest: func() interface{} { return &archiveShareRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			return (&ArchiveOp{}).transformShareArgs(arg0)
+		},
+		newResponse: func() interface{} { return &archiveShareResponseEnvelope{} },
+	},
+	"Archive.CreateFromShared": {
+		newRequest: func() interface{} { return &archiveCreateFromSharedRequestEnvelope{} },
+		numArgs:    3,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(types.ID)
+			arg2, _ := args[2].(*ArchiveCreateRequestFromShared)
+			return (&ArchiveOp{}).transformCreateFromSharedArgs(arg0, arg1, arg2)
+		},
+		newResponse: func() interface{} { return &archiveCreateFromSharedResponseEnvelope{} },
+	},
+	"Archive.Transfer": {
+		newRequest: func() interface{} { return &archiveTransferRequestEnvelope{} },
+		numArgs:    3,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(types.ID)
+			arg2, _ := args[2].(*ArchiveTransferRequest)
+			return (&ArchiveOp{}).transformTransferArgs(arg0, arg1, arg2)
+		},
+		newResponse: func() interface{} { return &archiveTransferResponseEnvelope{} },
+	},
+	"AuthStatus.Read": {
+		newResponse: func() interface{} { return &authStatusReadResponseEnvelope{} },
+	},
+	"AutoBackup.Find": {
+		newRequest: func() interface{} { return &autoBackupFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&AutoBackupOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &autoBackupFindResponseEnvelope{} },
+	},
+	"AutoBackup.Create": {
+		newRequest: func() interface{} { return &autoBackupCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*AutoBackupCreateRequest)
+			return (&AutoBackupOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &autoBackupCreateResponseEnvelope{} },
+	},
+	"AutoBackup.Read": {
+		newResponse: func() interface{} { return &autoBackupReadResponseEnvelope{} },
+	},
+	"AutoBackup.Update": {
+		newRequest: func() interface{} { return &autoBackupUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*AutoBackupUpdateRequest)
+			return (&AutoBackupOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &autoBackupUpdateResponseEnvelope{} },
+	},
+	"AutoBackup.UpdateSettings": {
+		newRequest: func() interface{} { return &autoBackupUpdateSettingsRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*AutoBackupUpdateSettingsRequest)
+			return (&AutoBackupOp{}).transformUpdateSettingsArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &autoBackupUpdateSettingsResponseEnvelope{} },
+	},
+	"Bill.ByContract": {
+		newResponse: func() interface{} { return &billByContractResponseEnvelope{} },
+	},
+	"Bill.ByContractYear": {
+		newResponse: func() interface{} { return &billByContractYearResponseEnvelope{} },
+	},
+	"Bill.ByContractYearMonth": {
+		newResponse: func() interface{} { return &billByContractYearMonthResponseEnvelope{} },
+	},
+	"Bill.Read": {
+		newResponse: func() interface{} { return &billReadResponseEnvelope{} },
+	},
+	"Bill.Details": {
+		newResponse: func() interface{} { return &billDetailsResponseEnvelope{} },
+	},
+	"Bill.DetailsCSV": {
+		newResponse: func() interface{} { return &billDetailsCSVResponseEnvelope{} },
+	},
+	"Bridge.Find": {
+		newRequest: func() interface{} { return &bridgeFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&BridgeOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &bridgeFindResponseEnvelope{} },
+	},
+	"Bridge.Create": {
+		newRequest: func() interface{} { return &bridgeCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*BridgeCreateRequest)
+			return (&BridgeOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &bridgeCreateResponseEnvelope{} },
+	},
+	"Bridge.Read": {
+		newResponse: func() interface{} { return &bridgeReadResponseEnvelope{} },
+	},
+	"Bridge.Update": {
+		newRequest: func() interface{} { return &bridgeUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*BridgeUpdateRequest)
+			return (&BridgeOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &bridgeUpdateResponseEnvelope{} },
+	},
+	"CDROM.Find": {
+		newRequest: func() interface{} { return &cDROMFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&CDROMOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &cDROMFindResponseEnvelope{} },
+	},
+	"CDROM.Create": {
+		newRequest: func() interface{} { return &cDROMCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*CDROMCreateRequest)
+			return (&CDROMOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &cDROMCreateResponseEnvelope{} },
+	},
+	"CDROM.Read": {
+		newResponse: func() interface{} { return &cDROMReadResponseEnvelope{} },
+	},
+	"CDROM.Update": {
+		newRequest: func() interface{} { return &cDROMUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*CDROMUpdateRequest)
+			return (&CDROMOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &cDROMUpdateResponseEnvelope{} },
+	},
+	"CDROM.OpenFTP": {
+		newRequest: func() interface{} { return &cDROMOpenFTPRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*OpenFTPRequest)
+			return (&CDROMOp{}).transformOpenFTPArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &cDROMOpenFTPResponseEnvelope{} },
+	},
+	"CertificateAuthority.Find": {
+		newRequest: func() interface{} { return &certificateAuthorityFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&CertificateAuthorityOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &certificateAuthorityFindResponseEnvelope{} },
+	},
+	"CertificateAuthority.Create": {
+		newRequest: func() interface{} { return &certificateAuthorityCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*CertificateAuthorityCreateRequest)
+			return (&CertificateAuthorityOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &certificateAuthorityCreateResponseEnvelope{} },
+	},
+	"CertificateAuthority.Read": {
+		newResponse: func() interface{} { return &certificateAuthorityReadResponseEnvelope{} },
+	},
+	"CertificateAuthority.Update": {
+		newRequest: func() interface{} { return &certificateAuthorityUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*CertificateAuthorityUpdateRequest)
+			return (&CertificateAuthorityOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &certificateAuthorityUpdateResponseEnvelope{} },
+	},
+	"CertificateAuthority.Detail": {
+		newResponse: func() interface{} { return &certificateAuthorityDetailResponseEnvelope{} },
+	},
+	"CertificateAuthority.AddClient": {
+		newRequest: func() interface{} { return &certificateAuthorityAddClientRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*CertificateAuthorityAddClientParam)
+			return (&CertificateAuthorityOp{}).transformAddClientArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &certificateAuthorityAddClientResponseEnvelope{} },
+	},
+	"CertificateAuthority.ListClients": {
+		newResponse: func() interface{} { return &certificateAuthorityListClientsResponseEnvelope{} },
+	},
+	"CertificateAuthority.ReadClient": {
+		newResponse: func() interface{} { return &certificateAuthorityReadClientResponseEnvelope{} },
+	},
+	"CertificateAuthority.AddServer": {
+		newRequest: func() interface{} { return &certificateAuthorityAddServerRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*CertificateAuthorityAddServerParam)
+			return (&CertificateAuthorityOp{}).transformAddServerArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &certificateAuthorityAddServerResponseEnvelope{} },
+	},
+	"CertificateAuthority.ListServers": {
+		newResponse: func() interface{} { return &certificateAuthorityListServersResponseEnvelope{} },
+	},
+	"CertificateAuthority.ReadServer": {
+		newResponse: func() interface{} { return &certificateAuthorityReadServerResponseEnvelope{} },
+	},
+	"ContainerRegistry.Find": {
+		newRequest: func() interface{} { return &containerRegistryFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&ContainerRegistryOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &containerRegistryFindResponseEnvelope{} },
+	},
+	"ContainerRegistry.Create": {
+		newRequest: func() interface{} { return &containerRegistryCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*ContainerRegistryCreateRequest)
+			return (&ContainerRegistryOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &containerRegistryCreateResponseEnvelope{} },
+	},
+	"ContainerRegistry.Read": {
+		newResponse: func() interface{} { return &containerRegistryReadResponseEnvelope{} },
+	},
+	"ContainerRegistry.Update": {
+		newRequest: func() interface{} { return &containerRegistryUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ContainerRegistryUpdateRequest)
+			return (&ContainerRegistryOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &containerRegistryUpdateResponseEnvelope{} },
+	},
+	"ContainerRegistry.UpdateSettings": {
+		newRequest: func() interface{} { return &containerRegistryUpdateSettingsRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ContainerRegistryUpdateSettingsRequest)
+			return (&ContainerRegistryOp{}).transformUpdateSettingsArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &containerRegistryUpdateSettingsResponseEnvelope{} },
+	},
+	"ContainerRegistry.ListUsers": {
+		newResponse: func() interface{} { return &containerRegistryListUsersResponseEnvelope{} },
+	},
+	"ContainerRegistry.AddUser": {
+		newRequest: func() interface{} { return &containerRegistryAddUserRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ContainerRegistryUserCreateRequest)
+			return (&ContainerRegistryOp{}).transformAddUserArgs(arg0, arg1)
+		},
+	},
+	"ContainerRegistry.UpdateUser": {
+		newRequest: func() interface{} { return &containerRegistryUpdateUserRequestEnvelope{} },
+		numArgs:    3,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(string)
+			arg2, _ := args[2].(*ContainerRegistryUserUpdateRequest)
+			return (&ContainerRegistryOp{}).transformUpdateUserArgs(arg0, arg1, arg2)
+		},
+	},
+	"Coupon.Find": {
+		newResponse: func() interface{} { return &couponFindResponseEnvelope{} },
+	},
+	"Database.Find": {
+		newRequest: func() interface{} { return &databaseFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&DatabaseOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &databaseFindResponseEnvelope{} },
+	},
+	"Database.Create": {
+		newRequest: func() interface{} { return &databaseCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*DatabaseCreateRequest)
+			return (&DatabaseOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &databaseCreateResponseEnvelope{} },
+	},
+	"Database.Read": {
+		newResponse: func() interface{} { return &databaseReadResponseEnvelope{} },
+	},
+	"Database.Update": {
+		newRequest: func() interface{} { return &databaseUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*DatabaseUpdateRequest)
+			return (&DatabaseOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &databaseUpdateResponseEnvelope{} },
+	},
+	"Database.UpdateSettings": {
+		newRequest: func() interface{} { return &databaseUpdateSettingsRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*DatabaseUpdateSettingsRequest)
+			return (&DatabaseOp{}).transformUpdateSettingsArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &databaseUpdateSettingsResponseEnvelope{} },
+	},
+	"Database.Shutdown": {
+		newRequest: func() interface{} { return &databaseShutdownRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ShutdownOption)
+			return (&DatabaseOp{}).transformShutdownArgs(arg0, arg1)
+		},
+	},
+	"Database.MonitorCPU": {
+		newRequest: func() interface{} { return &databaseMonitorCPURequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&DatabaseOp{}).transformMonitorCPUArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &databaseMonitorCPUResponseEnvelope{} },
+	},
+	"Database.MonitorDisk": {
+		newRequest: func() interface{} { return &databaseMonitorDiskRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&DatabaseOp{}).transformMonitorDiskArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &databaseMonitorDiskResponseEnvelope{} },
+	},
+	"Database.MonitorInterface": {
+		newRequest: func() interface{} { return &databaseMonitorInterfaceRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&DatabaseOp{}).transformMonitorInterfaceArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &databaseMonitorInterfaceResponseEnvelope{} },
+	},
+	"Database.MonitorDatabase": {
+		newRequest: func() interface{} { return &databaseMonitorDatabaseRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&DatabaseOp{}).transformMonitorDatabaseArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &databaseMonitorDatabaseResponseEnvelope{} },
+	},
+	"Database.Status": {
+		newResponse: func() interface{} { return &databaseStatusResponseEnvelope{} },
+	},
+	"Database.GetParameter": {
+		newResponse: func() interface{} { return &databaseGetParameterResponseEnvelope{} },
+	},
+	"Database.SetParameter": {
+		newRequest: func() interface{} { return &databaseSetParameterRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(map[string]interface{})
+			return (&DatabaseOp{}).transformSetParameterArgs(arg0, arg1)
+		},
+	},
+	"Disk.Find": {
+		newRequest: func() interface{} { return &diskFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&DiskOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &diskFindResponseEnvelope{} },
+	},
+	"Disk.Create": {
+		newRequest: func() interface{} { return &diskCreateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*DiskCreateRequest)
+			arg1, _ := args[1].([]types.ID)
+			return (&DiskOp{}).transformCreateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &diskCreateResponseEnvelope{} },
+	},
+	"Disk.Config": {
+		newRequest: func() interface{} { return &diskConfigRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*DiskEditRequest)
+			return (&DiskOp{}).transformConfigArgs(arg0, arg1)
+		},
+	},
+	"Disk.CreateWithConfig": {
+		newRequest: func() interface{} { return &diskCreateWithConfigRequestEnvelope{} },
+		numArgs:    4,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*DiskCreateRequest)
+			arg1, _ := args[1].(*DiskEditRequest)
+			arg2, _ := args[2].(bool)
+			arg3, _ := args[3].([]types.ID)
+			return (&DiskOp{}).transformCreateWithConfigArgs(arg0, arg1, arg2, arg3)
+		},
+		newResponse: func() interface{} { return &diskCreateWithConfigResponseEnvelope{} },
+	},
+	"Disk.ResizePartition": {
+		newRequest: func() interface{} { return &diskResizePartitionRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*DiskResizePartitionRequest)
+			return (&DiskOp{}).transformResizePartitionArgs(arg0, arg1)
+		},
+	},
+	"Disk.Read": {
+		newResponse: func() interface{} { return &diskReadResponseEnvelope{} },
+	},
+	"Disk.Update": {
+		newRequest: func() interface{} { return &diskUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*DiskUpdateRequest)
+			return (&DiskOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &diskUpdateResponseEnvelope{} },
+	},
+	"Disk.Monitor": {
+		newRequest: func() interface{} { return &diskMonitorRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&DiskOp{}).transformMonitorArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &diskMonitorResponseEnvelope{} },
+	},
+	"Disk.MonitorDisk": {
+		newRequest: func() interface{} { return &diskMonitorDiskRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&DiskOp{}).transformMonitorDiskArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &diskMonitorDiskResponseEnvelope{} },
+	},
+	"DiskPlan.Find": {
+		newRequest: func() interface{} { return &diskPlanFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&DiskPlanOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &diskPlanFindResponseEnvelope{} },
+	},
+	"DiskPlan.Read": {
+		newResponse: func() interface{} { return &diskPlanReadResponseEnvelope{} },
+	},
+	"DNS.Find": {
+		newRequest: func() interface{} { return &dNSFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&DNSOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &dNSFindResponseEnvelope{} },
+	},
+	"DNS.Create": {
+		newRequest: func() interface{} { return &dNSCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*DNSCreateRequest)
+			return (&DNSOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &dNSCreateResponseEnvelope{} },
+	},
+	"DNS.Read": {
+		newResponse: func() interface{} { return &dNSReadResponseEnvelope{} },
+	},
+	"DNS.Update": {
+		newRequest: func() interface{} { return &dNSUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*DNSUpdateRequest)
+			return (&DNSOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &dNSUpdateResponseEnvelope{} },
+	},
+	"DNS.UpdateSettings": {
+		newRequest: func() interface{} { return &dNSUpdateSettingsRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*DNSUpdateSettingsRequest)
+			return (&DNSOp{}).transformUpdateSettingsArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &dNSUpdateSettingsResponseEnvelope{} },
+	},
+	"EnhancedDB.Find": {
+		newRequest: func() interface{} { return &enhancedDBFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&EnhancedDBOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &enhancedDBFindResponseEnvelope{} },
+	},
+	"EnhancedDB.Create": {
+		newRequest: func() interface{} { return &enhancedDBCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*EnhancedDBCreateRequest)
+			return (&EnhancedDBOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &enhancedDBCreateResponseEnvelope{} },
+	},
+	"EnhancedDB.Read": {
+		newResponse: func() interface{} { return &enhancedDBReadResponseEnvelope{} },
+	},
+	"EnhancedDB.Update": {
+		newRequest: func() interface{} { return &enhancedDBUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*EnhancedDBUpdateRequest)
+			return (&EnhancedDBOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &enhancedDBUpdateResponseEnvelope{} },
+	},
+	"EnhancedDB.SetPassword": {
+		newRequest: func() interface{} { return &enhancedDBSetPasswordRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*EnhancedDBSetPasswordRequest)
+			return (&EnhancedDBOp{}).transformSetPasswordArgs(arg0, arg1)
+		},
+	},
+	"ESME.Find": {
+		newRequest: func() interface{} { return &eSMEFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&ESMEOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &eSMEFindResponseEnvelope{} },
+	},
+	"ESME.Create": {
+		newRequest: func() interface{} { return &eSMECreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*ESMECreateRequest)
+			return (&ESMEOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &eSMECreateResponseEnvelope{} },
+	},
+	"ESME.Read": {
+		newResponse: func() interface{} { return &eSMEReadResponseEnvelope{} },
+	},
+	"ESME.Update": {
+		newRequest: func() interface{} { return &eSMEUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ESMEUpdateRequest)
+			return (&ESMEOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &eSMEUpdateResponseEnvelope{} },
+	},
+	"ESME.SendMessageWithGeneratedOTP": {
+		newRequest: func() interface{} { return &eSMESendMessageWithGeneratedOTPRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ESMESendMessageWithGeneratedOTPRequest)
+			return (&ESMEOp{}).transformSendMessageWithGeneratedOTPArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &eSMESendMessageWithGeneratedOTPResponseEnvelope{} },
+	},
+	"ESME.SendMessageWithInputtedOTP": {
+		newRequest: func() interface{} { return &eSMESendMessageWithInputtedOTPRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ESMESendMessageWithInputtedOTPRequest)
+			return (&ESMEOp{}).transformSendMessageWithInputtedOTPArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &eSMESendMessageWithInputtedOTPResponseEnvelope{} },
+	},
+	"ESME.Logs": {
+		newResponse: func() interface{} { return &eSMELogsResponseEnvelope{} },
+	},
+	"GSLB.Find": {
+		newRequest: func() interface{} { return &gSLBFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&GSLBOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &gSLBFindResponseEnvelope{} },
+	},
+	"GSLB.Create": {
+		newRequest: func() interface{} { return &gSLBCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*GSLBCreateRequest)
+			return (&GSLBOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &gSLBCreateResponseEnvelope{} },
+	},
+	"GSLB.Read": {
+		newResponse: func() interface{} { return &gSLBReadResponseEnvelope{} },
+	},
+	"GSLB.Update": {
+		newRequest: func() interface{} { return &gSLBUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*GSLBUpdateRequest)
+			return (&GSLBOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &gSLBUpdateResponseEnvelope{} },
+	},
+	"GSLB.UpdateSettings": {
+		newRequest: func() interface{} { return &gSLBUpdateSettingsRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*GSLBUpdateSettingsRequest)
+			return (&GSLBOp{}).transformUpdateSettingsArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &gSLBUpdateSettingsResponseEnvelope{} },
+	},
+	"Icon.Find": {
+		newRequest: func() interface{} { return &iconFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&IconOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &iconFindResponseEnvelope{} },
+	},
+	"Icon.Create": {
+		newRequest: func() interface{} { return &iconCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*IconCreateRequest)
+			return (&IconOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &iconCreateResponseEnvelope{} },
+	},
+	"Icon.Read": {
+		newResponse: func() interface{} { return &iconReadResponseEnvelope{} },
+	},
+	"Icon.Update": {
+		newRequest: func() interface{} { return &iconUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*IconUpdateRequest)
+			return (&IconOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &iconUpdateResponseEnvelope{} },
+	},
+	"Interface.Find": {
+		newRequest: func() interface{} { return &interfaceFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&InterfaceOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &interfaceFindResponseEnvelope{} },
+	},
+	"Interface.Create": {
+		newRequest: func() interface{} { return &interfaceCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*InterfaceCreateRequest)
+			return (&InterfaceOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &interfaceCreateResponseEnvelope{} },
+	},
+	"Interface.Read": {
+		newResponse: func() interface{} { return &interfaceReadResponseEnvelope{} },
+	},
+	"Interface.Update": {
+		newRequest: func() interface{} { return &interfaceUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*InterfaceUpdateRequest)
+			return (&InterfaceOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &interfaceUpdateResponseEnvelope{} },
+	},
+	"Interface.Monitor": {
+		newRequest: func() interface{} { return &interfaceMonitorRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&InterfaceOp{}).transformMonitorArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &interfaceMonitorResponseEnvelope{} },
+	},
+	"Internet.Find": {
+		newRequest: func() interface{} { return &internetFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&InternetOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &internetFindResponseEnvelope{} },
+	},
+	"Internet.Create": {
+		newRequest: func() interface{} { return &internetCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*InternetCreateRequest)
+			return (&InternetOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &internetCreateResponseEnvelope{} },
+	},
+	"Internet.Read": {
+		newResponse: func() interface{} { return &internetReadResponseEnvelope{} },
+	},
+	"Internet.Update": {
+		newRequest: func() interface{} { return &internetUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*InternetUpdateRequest)
+			return (&InternetOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &internetUpdateResponseEnvelope{} },
+	},
+	"Internet.UpdateBandWidth": {
+		newRequest: func() interface{} { return &internetUpdateBandWidthRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*InternetUpdateBandWidthRequest)
+			return (&InternetOp{}).transformUpdateBandWidthArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &internetUpdateBandWidthResponseEnvelope{} },
+	},
+	"Internet.AddSubnet": {
+		newRequest: func() interface{} { return &internetAddSubnetRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*InternetAddSubnetRequest)
+			return (&InternetOp{}).transformAddSubnetArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &internetAddSubnetResponseEnvelope{} },
+	},
+	"Internet.UpdateSubnet": {
+		newRequest: func() interface{} { return &internetUpdateSubnetRequestEnvelope{} },
+		numArgs:    3,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(types.ID)
+			arg2, _ := args[2].(*InternetUpdateSubnetRequest)
+			return (&InternetOp{}).transformUpdateSubnetArgs(arg0, arg1, arg2)
+		},
+		newResponse: func() interface{} { return &internetUpdateSubnetResponseEnvelope{} },
+	},
+	"Internet.Monitor": {
+		newRequest: func() interface{} { return &internetMonitorRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&InternetOp{}).transformMonitorArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &internetMonitorResponseEnvelope{} },
+	},
+	"Internet.MonitorRouter": {
+		newRequest: func() interface{} { return &internetMonitorRouterRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&InternetOp{}).transformMonitorRouterArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &internetMonitorRouterResponseEnvelope{} },
+	},
+	"Internet.EnableIPv6": {
+		newResponse: func() interface{} { return &internetEnableIPv6ResponseEnvelope{} },
+	},
+	"InternetPlan.Find": {
+		newRequest: func() interface{} { return &internetPlanFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&InternetPlanOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &internetPlanFindResponseEnvelope{} },
+	},
+	"InternetPlan.Read": {
+		newResponse: func() interface{} { return &internetPlanReadResponseEnvelope{} },
+	},
+	"IPAddress.List": {
+		newResponse: func() interface{} { return &iPAddressListResponseEnvelope{} },
+	},
+	"IPAddress.Read": {
+		newResponse: func() interface{} { return &iPAddressReadResponseEnvelope{} },
+	},
+	"IPAddress.UpdateHostName": {
+		newRequest: func() interface{} { return &iPAddressUpdateHostNameRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(string)
+			arg1, _ := args[1].(string)
+			return (&IPAddressOp{}).transformUpdateHostNameArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &iPAddressUpdateHostNameResponseEnvelope{} },
+	},
+	"IPv6Net.List": {
+		newResponse: func() interface{} { return &iPv6NetListResponseEnvelope{} },
+	},
+	"IPv6Net.Find": {
+		newRequest: func() interface{} { return &iPv6NetFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&IPv6NetOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &iPv6NetFindResponseEnvelope{} },
+	},
+	"IPv6Net.Read": {
+		newResponse: func() interface{} { return &iPv6NetReadResponseEnvelope{} },
+	},
+	"IPv6Addr.Find": {
+		newRequest: func() interface{} { return &iPv6AddrFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&IPv6AddrOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &iPv6AddrFindResponseEnvelope{} },
+	},
+	"IPv6Addr.Create": {
+		newRequest: func() interface{} { return &iPv6AddrCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*IPv6AddrCreateRequest)
+			return (&IPv6AddrOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &iPv6AddrCreateResponseEnvelope{} },
+	},
+	"IPv6Addr.Read": {
+		newResponse: func() interface{} { return &iPv6AddrReadResponseEnvelope{} },
+	},
+	"IPv6Addr.Update": {
+		newRequest: func() interface{} { return &iPv6AddrUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(string)
+			arg1, _ := args[1].(*IPv6AddrUpdateRequest)
+			return (&IPv6AddrOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &iPv6AddrUpdateResponseEnvelope{} },
+	},
+	"License.Find": {
+		newRequest: func() interface{} { return &licenseFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&LicenseOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &licenseFindResponseEnvelope{} },
+	},
+	"License.Create": {
+		newRequest: func() interface{} { return &licenseCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*LicenseCreateRequest)
+			return (&LicenseOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &licenseCreateResponseEnvelope{} },
+	},
+	"License.Read": {
+		newResponse: func() interface{} { return &licenseReadResponseEnvelope{} },
+	},
+	"License.Update": {
+		newRequest: func() interface{} { return &licenseUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*LicenseUpdateRequest)
+			return (&LicenseOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &licenseUpdateResponseEnvelope{} },
+	},
+	"LicenseInfo.Find": {
+		newRequest: func() interface{} { return &licenseInfoFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&LicenseInfoOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &licenseInfoFindResponseEnvelope{} },
+	},
+	"LicenseInfo.Read": {
+		newResponse: func() interface{} { return &licenseInfoReadResponseEnvelope{} },
+	},
+	"LoadBalancer.Find": {
+		newRequest: func() interface{} { return &loadBalancerFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&LoadBalancerOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &loadBalancerFindResponseEnvelope{} },
+	},
+	"LoadBalancer.Create": {
+		newRequest: func() interface{} { return &loadBalancerCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*LoadBalancerCreateRequest)
+			return (&LoadBalancerOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &loadBalancerCreateResponseEnvelope{} },
+	},
+	"LoadBalancer.Read": {
+		newResponse: func() interface{} { return &loadBalancerReadResponseEnvelope{} },
+	},
+	"LoadBalancer.Update": {
+		newRequest: func() interface{} { return &loadBalancerUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*LoadBalancerUpdateRequest)
+			return (&LoadBalancerOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &loadBalancerUpdateResponseEnvelope{} },
+	},
+	"LoadBalancer.UpdateSettings": {
+		newRequest: func() interface{} { return &loadBalancerUpdateSettingsRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*LoadBalancerUpdateSettingsRequest)
+			return (&LoadBalancerOp{}).transformUpdateSettingsArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &loadBalancerUpdateSettingsResponseEnvelope{} },
+	},
+	"LoadBalancer.Shutdown": {
+		newRequest: func() interface{} { return &loadBalancerShutdownRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ShutdownOption)
+			return (&LoadBalancerOp{}).transformShutdownArgs(arg0, arg1)
+		},
+	},
+	"LoadBalancer.MonitorCPU": {
+		newRequest: func() interface{} { return &loadBalancerMonitorCPURequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&LoadBalancerOp{}).transformMonitorCPUArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &loadBalancerMonitorCPUResponseEnvelope{} },
+	},
+	"LoadBalancer.MonitorInterface": {
+		newRequest: func() interface{} { return &loadBalancerMonitorInterfaceRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&LoadBalancerOp{}).transformMonitorInterfaceArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &loadBalancerMonitorInterfaceResponseEnvelope{} },
+	},
+	"LoadBalancer.Status": {
+		newResponse: func() interface{} { return &loadBalancerStatusResponseEnvelope{} },
+	},
+	"LocalRouter.Find": {
+		newRequest: func() interface{} { return &localRouterFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&LocalRouterOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &localRouterFindResponseEnvelope{} },
+	},
+	"LocalRouter.Create": {
+		newRequest: func() interface{} { return &localRouterCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*LocalRouterCreateRequest)
+			return (&LocalRouterOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &localRouterCreateResponseEnvelope{} },
+	},
+	"LocalRouter.Read": {
+		newResponse: func() interface{} { return &localRouterReadResponseEnvelope{} },
+	},
+	"LocalRouter.Update": {
+		newRequest: func() interface{} { return &localRouterUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*LocalRouterUpdateRequest)
+			return (&LocalRouterOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &localRouterUpdateResponseEnvelope{} },
+	},
+	"LocalRouter.UpdateSettings": {
+		newRequest: func() interface{} { return &localRouterUpdateSettingsRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*LocalRouterUpdateSettingsRequest)
+			return (&LocalRouterOp{}).transformUpdateSettingsArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &localRouterUpdateSettingsResponseEnvelope{} },
+	},
+	"LocalRouter.HealthStatus": {
+		newResponse: func() interface{} { return &localRouterHealthStatusResponseEnvelope{} },
+	},
+	"LocalRouter.MonitorLocalRouter": {
+		newRequest: func() interface{} { return &localRouterMonitorLocalRouterRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&LocalRouterOp{}).transformMonitorLocalRouterArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &localRouterMonitorLocalRouterResponseEnvelope{} },
+	},
+	"MobileGateway.Find": {
+		newRequest: func() interface{} { return &mobileGatewayFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&MobileGatewayOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &mobileGatewayFindResponseEnvelope{} },
+	},
+	"MobileGateway.Create": {
+		newRequest: func() interface{} { return &mobileGatewayCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*MobileGatewayCreateRequest)
+			return (&MobileGatewayOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &mobileGatewayCreateResponseEnvelope{} },
+	},
+	"MobileGateway.Read": {
+		newResponse: func() interface{} { return &mobileGatewayReadResponseEnvelope{} },
+	},
+	"MobileGateway.Update": {
+		newRequest: func() interface{} { return &mobileGatewayUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MobileGatewayUpdateRequest)
+			return (&MobileGatewayOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &mobileGatewayUpdateResponseEnvelope{} },
+	},
+	"MobileGateway.UpdateSettings": {
+		newRequest: func() interface{} { return &mobileGatewayUpdateSettingsRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MobileGatewayUpdateSettingsRequest)
+			return (&MobileGatewayOp{}).transformUpdateSettingsArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &mobileGatewayUpdateSettingsResponseEnvelope{} },
+	},
+	"MobileGateway.Shutdown": {
+		newRequest: func() interface{} { return &mobileGatewayShutdownRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ShutdownOption)
+			return (&MobileGatewayOp{}).transformShutdownArgs(arg0, arg1)
+		},
+	},
+	"MobileGateway.GetDNS": {
+		newResponse: func() interface{} { return &mobileGatewayGetDNSResponseEnvelope{} },
+	},
+	"MobileGateway.SetDNS": {
+		newRequest: func() interface{} { return &mobileGatewaySetDNSRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MobileGatewayDNSSetting)
+			return (&MobileGatewayOp{}).transformSetDNSArgs(arg0, arg1)
+		},
+	},
+	"MobileGateway.GetSIMRoutes": {
+		newResponse: func() interface{} { return &mobileGatewayGetSIMRoutesResponseEnvelope{} },
+	},
+	"MobileGateway.SetSIMRoutes": {
+		newRequest: func() interface{} { return &mobileGatewaySetSIMRoutesRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].([]*MobileGatewaySIMRouteParam)
+			return (&MobileGatewayOp{}).transformSetSIMRoutesArgs(arg0, arg1)
+		},
+	},
+	"MobileGateway.ListSIM": {
+		newResponse: func() interface{} { return &mobileGatewayListSIMResponseEnvelope{} },
+	},
+	"MobileGateway.AddSIM": {
+		newRequest: func() interface{} { return &mobileGatewayAddSIMRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MobileGatewayAddSIMRequest)
+			return (&MobileGatewayOp{}).transformAddSIMArgs(arg0, arg1)
+		},
+	},
+	"MobileGateway.Logs": {
+		newResponse: func() interface{} { return &mobileGatewayLogsResponseEnvelope{} },
+	},
+	"MobileGateway.GetTrafficConfig": {
+		newResponse: func() interface{} { return &mobileGatewayGetTrafficConfigResponseEnvelope{} },
+	},
+	"MobileGateway.SetTrafficConfig": {
+		newRequest: func() interface{} { return &mobileGatewaySetTrafficConfigRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MobileGatewayTrafficControl)
+			return (&MobileGatewayOp{}).transformSetTrafficConfigArgs(arg0, arg1)
+		},
+	},
+	"MobileGateway.TrafficStatus": {
+		newResponse: func() interface{} { return &mobileGatewayTrafficStatusResponseEnvelope{} },
+	},
+	"MobileGateway.MonitorInterface": {
+		newRequest: func() interface{} { return &mobileGatewayMonitorInterfaceRequestEnvelope{} },
+		numArgs:    3,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(int)
+			arg2, _ := args[2].(*MonitorCondition)
+			return (&MobileGatewayOp{}).transformMonitorInterfaceArgs(arg0, arg1, arg2)
+		},
+		newResponse: func() interface{} { return &mobileGatewayMonitorInterfaceResponseEnvelope{} },
+	},
+	"NFS.Find": {
+		newRequest: func() interface{} { return &nFSFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&NFSOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &nFSFindResponseEnvelope{} },
+	},
+	"NFS.Create": {
+		newRequest: func() interface{} { return &nFSCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*NFSCreateRequest)
+			return (&NFSOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &nFSCreateResponseEnvelope{} },
+	},
+	"NFS.Read": {
+		newResponse: func() interface{} { return &nFSReadResponseEnvelope{} },
+	},
+	"NFS.Update": {
+		newRequest: func() interface{} { return &nFSUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*NFSUpdateRequest)
+			return (&NFSOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &nFSUpdateResponseEnvelope{} },
+	},
+	"NFS.Shutdown": {
+		newRequest: func() interface{} { return &nFSShutdownRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ShutdownOption)
+			return (&NFSOp{}).transformShutdownArgs(arg0, arg1)
+		},
+	},
+	"NFS.MonitorCPU": {
+		newRequest: func() interface{} { return &nFSMonitorCPURequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&NFSOp{}).transformMonitorCPUArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &nFSMonitorCPUResponseEnvelope{} },
+	},
+	"NFS.MonitorFreeDiskSize": {
+		newRequest: func() interface{} { return &nFSMonitorFreeDiskSizeRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&NFSOp{}).transformMonitorFreeDiskSizeArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &nFSMonitorFreeDiskSizeResponseEnvelope{} },
+	},
+	"NFS.MonitorInterface": {
+		newRequest: func() interface{} { return &nFSMonitorInterfaceRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&NFSOp{}).transformMonitorInterfaceArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &nFSMonitorInterfaceResponseEnvelope{} },
+	},
+	"Note.Find": {
+		newRequest: func() interface{} { return &noteFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&NoteOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &noteFindResponseEnvelope{} },
+	},
+	"Note.Create": {
+		newRequest: func() interface{} { return &noteCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*NoteCreateRequest)
+			return (&NoteOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &noteCreateResponseEnvelope{} },
+	},
+	"Note.Read": {
+		newResponse: func() interface{} { return &noteReadResponseEnvelope{} },
+	},
+	"Note.Update": {
+		newRequest: func() interface{} { return &noteUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*NoteUpdateRequest)
+			return (&NoteOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &noteUpdateResponseEnvelope{} },
+	},
+	"PacketFilter.Find": {
+		newRequest: func() interface{} { return &packetFilterFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&PacketFilterOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &packetFilterFindResponseEnvelope{} },
+	},
+	"PacketFilter.Create": {
+		newRequest: func() interface{} { return &packetFilterCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*PacketFilterCreateRequest)
+			return (&PacketFilterOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &packetFilterCreateResponseEnvelope{} },
+	},
+	"PacketFilter.Read": {
+		newResponse: func() interface{} { return &packetFilterReadResponseEnvelope{} },
+	},
+	"PacketFilter.Update": {
+		newRequest: func() interface{} { return &packetFilterUpdateRequestEnvelope{} },
+		numArgs:    3,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*PacketFilterUpdateRequest)
+			arg2, _ := args[2].(string)
+			return (&PacketFilterOp{}).transformUpdateArgs(arg0, arg1, arg2)
+		},
+		newResponse: func() interface{} { return &packetFilterUpdateResponseEnvelope{} },
+	},
+	"PrivateHost.Find": {
+		newRequest: func() interface{} { return &privateHostFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&PrivateHostOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &privateHostFindResponseEnvelope{} },
+	},
+	"PrivateHost.Create": {
+		newRequest: func() interface{} { return &privateHostCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*PrivateHostCreateRequest)
+			return (&PrivateHostOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &privateHostCreateResponseEnvelope{} },
+	},
+	"PrivateHost.Read": {
+		newResponse: func() interface{} { return &privateHostReadResponseEnvelope{} },
+	},
+	"PrivateHost.Update": {
+		newRequest: func() interface{} { return &privateHostUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*PrivateHostUpdateRequest)
+			return (&PrivateHostOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &privateHostUpdateResponseEnvelope{} },
+	},
+	"PrivateHostPlan.Find": {
+		newRequest: func() interface{} { return &privateHostPlanFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&PrivateHostPlanOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &privateHostPlanFindResponseEnvelope{} },
+	},
+	"PrivateHostPlan.Read": {
+		newResponse: func() interface{} { return &privateHostPlanReadResponseEnvelope{} },
+	},
+	"ProxyLB.Find": {
+		newRequest: func() interface{} { return &proxyLBFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&ProxyLBOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &proxyLBFindResponseEnvelope{} },
+	},
+	"ProxyLB.Create": {
+		newRequest: func() interface{} { return &proxyLBCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*ProxyLBCreateRequest)
+			return (&ProxyLBOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &proxyLBCreateResponseEnvelope{} },
+	},
+	"ProxyLB.Read": {
+		newResponse: func() interface{} { return &proxyLBReadResponseEnvelope{} },
+	},
+	"ProxyLB.Update": {
+		newRequest: func() interface{} { return &proxyLBUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ProxyLBUpdateRequest)
+			return (&ProxyLBOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &proxyLBUpdateResponseEnvelope{} },
+	},
+	"ProxyLB.UpdateSettings": {
+		newRequest: func() interface{} { return &proxyLBUpdateSettingsRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ProxyLBUpdateSettingsRequest)
+			return (&ProxyLBOp{}).transformUpdateSettingsArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &proxyLBUpdateSettingsResponseEnvelope{} },
+	},
+	"ProxyLB.ChangePlan": {
+		newRequest: func() interface{} { return &proxyLBChangePlanRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ProxyLBChangePlanRequest)
+			return (&ProxyLBOp{}).transformChangePlanArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &proxyLBChangePlanResponseEnvelope{} },
+	},
+	"ProxyLB.GetCertificates": {
+		newResponse: func() interface{} { return &proxyLBGetCertificatesResponseEnvelope{} },
+	},
+	"ProxyLB.SetCertificates": {
+		newRequest: func() interface{} { return &proxyLBSetCertificatesRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ProxyLBSetCertificatesRequest)
+			return (&ProxyLBOp{}).transformSetCertificatesArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &proxyLBSetCertificatesResponseEnvelope{} },
+	},
+	"ProxyLB.HealthStatus": {
+		newResponse: func() interface{} { return &proxyLBHealthStatusResponseEnvelope{} },
+	},
+	"ProxyLB.MonitorConnection": {
+		newRequest: func() interface{} { return &proxyLBMonitorConnectionRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&ProxyLBOp{}).transformMonitorConnectionArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &proxyLBMonitorConnectionResponseEnvelope{} },
+	},
+	"Region.Find": {
+		newRequest: func() interface{} { return &regionFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&RegionOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &regionFindResponseEnvelope{} },
+	},
+	"Region.Read": {
+		newResponse: func() interface{} { return &regionReadResponseEnvelope{} },
+	},
+	"Server.Find": {
+		newRequest: func() interface{} { return &serverFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&ServerOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &serverFindResponseEnvelope{} },
+	},
+	"Server.Create": {
+		newRequest: func() interface{} { return &serverCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*ServerCreateRequest)
+			return (&ServerOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &serverCreateResponseEnvelope{} },
+	},
+	"Server.Read": {
+		newResponse: func() interface{} { return &serverReadResponseEnvelope{} },
+	},
+	"Server.Update": {
+		newRequest: func() interface{} { return &serverUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ServerUpdateRequest)
+			return (&ServerOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &serverUpdateResponseEnvelope{} },
+	},
+	"Server.DeleteWithDisks": {
+		newRequest: func() interface{} { return &serverDeleteWithDisksRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ServerDeleteWithDisksRequest)
+			return (&ServerOp{}).transformDeleteWithDisksArgs(arg0, arg1)
+		},
+	},
+	"Server.ChangePlan": {
+		newRequest: func() interface{} { return &serverChangePlanRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ServerChangePlanRequest)
+			return (&ServerOp{}).transformChangePlanArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &serverChangePlanResponseEnvelope{} },
+	},
+	"Server.InsertCDROM": {
+		newRequest: func() interface{} { return &serverInsertCDROMRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*InsertCDROMRequest)
+			return (&ServerOp{}).transformInsertCDROMArgs(arg0, arg1)
+		},
+	},
+	"Server.EjectCDROM": {
+		newRequest: func() interface{} { return &serverEjectCDROMRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*EjectCDROMRequest)
+			return (&ServerOp{}).transformEjectCDROMArgs(arg0, arg1)
+		},
+	},
+	"Server.Shutdown": {
+		newRequest: func() interface{} { return &serverShutdownRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ShutdownOption)
+			return (&ServerOp{}).transformShutdownArgs(arg0, arg1)
+		},
+	},
+	"Server.BootWithVariables": {
+		newRequest: func() interface{} { return &serverBootWithVariablesRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ServerBootVariables)
+			return (&ServerOp{}).transformBootWithVariablesArgs(arg0, arg1)
+		},
+	},
+	"Server.SendKey": {
+		newRequest: func() interface{} { return &serverSendKeyRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*SendKeyRequest)
+			return (&ServerOp{}).transformSendKeyArgs(arg0, arg1)
+		},
+	},
+	"Server.GetVNCProxy": {
+		newResponse: func() interface{} { return &serverGetVNCProxyResponseEnvelope{} },
+	},
+	"Server.Monitor": {
+		newRequest: func() interface{} { return &serverMonitorRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&ServerOp{}).transformMonitorArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &serverMonitorResponseEnvelope{} },
+	},
+	"Server.MonitorCPU": {
+		newRequest: func() interface{} { return &serverMonitorCPURequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&ServerOp{}).transformMonitorCPUArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &serverMonitorCPUResponseEnvelope{} },
+	},
+	"ServerPlan.Find": {
+		newRequest: func() interface{} { return &serverPlanFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&ServerPlanOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &serverPlanFindResponseEnvelope{} },
+	},
+	"ServerPlan.Read": {
+		newResponse: func() interface{} { return &serverPlanReadResponseEnvelope{} },
+	},
+	"ServiceClass.Find": {
+		newRequest: func() interface{} { return &serviceClassFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&ServiceClassOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &serviceClassFindResponseEnvelope{} },
+	},
+	"SIM.Find": {
+		newRequest: func() interface{} { return &sIMFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&SIMOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &sIMFindResponseEnvelope{} },
+	},
+	"SIM.Create": {
+		newRequest: func() interface{} { return &sIMCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*SIMCreateRequest)
+			return (&SIMOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &sIMCreateResponseEnvelope{} },
+	},
+	"SIM.Read": {
+		newResponse: func() interface{} { return &sIMReadResponseEnvelope{} },
+	},
+	"SIM.Update": {
+		newRequest: func() interface{} { return &sIMUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*SIMUpdateRequest)
+			return (&SIMOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &sIMUpdateResponseEnvelope{} },
+	},
+	"SIM.AssignIP": {
+		newRequest: func() interface{} { return &sIMAssignIPRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*SIMAssignIPRequest)
+			return (&SIMOp{}).transformAssignIPArgs(arg0, arg1)
+		},
+	},
+	"SIM.IMEILock": {
+		newRequest: func() interface{} { return &sIMIMEILockRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*SIMIMEILockRequest)
+			return (&SIMOp{}).transformIMEILockArgs(arg0, arg1)
+		},
+	},
+	"SIM.Logs": {
+		newResponse: func() interface{} { return &sIMLogsResponseEnvelope{} },
+	},
+	"SIM.GetNetworkOperator": {
+		newResponse: func() interface{} { return &sIMGetNetworkOperatorResponseEnvelope{} },
+	},
+	"SIM.SetNetworkOperator": {
+		newRequest: func() interface{} { return &sIMSetNetworkOperatorRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].([]*SIMNetworkOperatorConfig)
+			return (&SIMOp{}).transformSetNetworkOperatorArgs(arg0, arg1)
+		},
+	},
+	"SIM.MonitorSIM": {
+		newRequest: func() interface{} { return &sIMMonitorSIMRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&SIMOp{}).transformMonitorSIMArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &sIMMonitorSIMResponseEnvelope{} },
+	},
+	"SIM.Status": {
+		newResponse: func() interface{} { return &sIMStatusResponseEnvelope{} },
+	},
+	"SimpleMonitor.Find": {
+		newRequest: func() interface{} { return &simpleMonitorFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&SimpleMonitorOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &simpleMonitorFindResponseEnvelope{} },
+	},
+	"SimpleMonitor.Create": {
+		newRequest: func() interface{} { return &simpleMonitorCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*SimpleMonitorCreateRequest)
+			return (&SimpleMonitorOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &simpleMonitorCreateResponseEnvelope{} },
+	},
+	"SimpleMonitor.Read": {
+		newResponse: func() interface{} { return &simpleMonitorReadResponseEnvelope{} },
+	},
+	"SimpleMonitor.Update": {
+		newRequest: func() interface{} { return &simpleMonitorUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*SimpleMonitorUpdateRequest)
+			return (&SimpleMonitorOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &simpleMonitorUpdateResponseEnvelope{} },
+	},
+	"SimpleMonitor.UpdateSettings": {
+		newRequest: func() interface{} { return &simpleMonitorUpdateSettingsRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*SimpleMonitorUpdateSettingsRequest)
+			return (&SimpleMonitorOp{}).transformUpdateSettingsArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &simpleMonitorUpdateSettingsResponseEnvelope{} },
+	},
+	"SimpleMonitor.MonitorResponseTime": {
+		newRequest: func() interface{} { return &simpleMonitorMonitorResponseTimeRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&SimpleMonitorOp{}).transformMonitorResponseTimeArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &simpleMonitorMonitorResponseTimeResponseEnvelope{} },
+	},
+	"SimpleMonitor.HealthStatus": {
+		newResponse: func() interface{} { return &simpleMonitorHealthStatusResponseEnvelope{} },
+	},
+	"SSHKey.Find": {
+		newRequest: func() interface{} { return &sSHKeyFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&SSHKeyOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &sSHKeyFindResponseEnvelope{} },
+	},
+	"SSHKey.Create": {
+		newRequest: func() interface{} { return &sSHKeyCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*SSHKeyCreateRequest)
+			return (&SSHKeyOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &sSHKeyCreateResponseEnvelope{} },
+	},
+	"SSHKey.Generate": {
+		newRequest: func() interface{} { return &sSHKeyGenerateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*SSHKeyGenerateRequest)
+			return (&SSHKeyOp{}).transformGenerateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &sSHKeyGenerateResponseEnvelope{} },
+	},
+	"SSHKey.Read": {
+		newResponse: func() interface{} { return &sSHKeyReadResponseEnvelope{} },
+	},
+	"SSHKey.Update": {
+		newRequest: func() interface{} { return &sSHKeyUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*SSHKeyUpdateRequest)
+			return (&SSHKeyOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &sSHKeyUpdateResponseEnvelope{} },
+	},
+	"Subnet.Find": {
+		newRequest: func() interface{} { return &subnetFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&SubnetOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &subnetFindResponseEnvelope{} },
+	},
+	"Subnet.Read": {
+		newResponse: func() interface{} { return &subnetReadResponseEnvelope{} },
+	},
+	"Switch.Find": {
+		newRequest: func() interface{} { return &switchFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&SwitchOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &switchFindResponseEnvelope{} },
+	},
+	"Switch.Create": {
+		newRequest: func() interface{} { return &switchCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*SwitchCreateRequest)
+			return (&SwitchOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &switchCreateResponseEnvelope{} },
+	},
+	"Switch.Read": {
+		newResponse: func() interface{} { return &switchReadResponseEnvelope{} },
+	},
+	"Switch.Update": {
+		newRequest: func() interface{} { return &switchUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*SwitchUpdateRequest)
+			return (&SwitchOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &switchUpdateResponseEnvelope{} },
+	},
+	"Switch.GetServers": {
+		newResponse: func() interface{} { return &switchGetServersResponseEnvelope{} },
+	},
+	"VPCRouter.Find": {
+		newRequest: func() interface{} { return &vPCRouterFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&VPCRouterOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &vPCRouterFindResponseEnvelope{} },
+	},
+	"VPCRouter.Create": {
+		newRequest: func() interface{} { return &vPCRouterCreateRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*VPCRouterCreateRequest)
+			return (&VPCRouterOp{}).transformCreateArgs(arg0)
+		},
+		newResponse: func() interface{} { return &vPCRouterCreateResponseEnvelope{} },
+	},
+	"VPCRouter.Read": {
+		newResponse: func() interface{} { return &vPCRouterReadResponseEnvelope{} },
+	},
+	"VPCRouter.Update": {
+		newRequest: func() interface{} { return &vPCRouterUpdateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*VPCRouterUpdateRequest)
+			return (&VPCRouterOp{}).transformUpdateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &vPCRouterUpdateResponseEnvelope{} },
+	},
+	"VPCRouter.UpdateSettings": {
+		newRequest: func() interface{} { return &vPCRouterUpdateSettingsRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*VPCRouterUpdateSettingsRequest)
+			return (&VPCRouterOp{}).transformUpdateSettingsArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &vPCRouterUpdateSettingsResponseEnvelope{} },
+	},
+	"VPCRouter.Shutdown": {
+		newRequest: func() interface{} { return &vPCRouterShutdownRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*ShutdownOption)
+			return (&VPCRouterOp{}).transformShutdownArgs(arg0, arg1)
+		},
+	},
+	"VPCRouter.MonitorCPU": {
+		newRequest: func() interface{} { return &vPCRouterMonitorCPURequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*MonitorCondition)
+			return (&VPCRouterOp{}).transformMonitorCPUArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &vPCRouterMonitorCPUResponseEnvelope{} },
+	},
+	"VPCRouter.MonitorInterface": {
+		newRequest: func() interface{} { return &vPCRouterMonitorInterfaceRequestEnvelope{} },
+		numArgs:    3,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(int)
+			arg2, _ := args[2].(*MonitorCondition)
+			return (&VPCRouterOp{}).transformMonitorInterfaceArgs(arg0, arg1, arg2)
+		},
+		newResponse: func() interface{} { return &vPCRouterMonitorInterfaceResponseEnvelope{} },
+	},
+	"VPCRouter.Status": {
+		newResponse: func() interface{} { return &vPCRouterStatusResponseEnvelope{} },
+	},
+	"WebAccel.List": {
+		newResponse: func() interface{} { return &webAccelListResponseEnvelope{} },
+	},
+	"WebAccel.Read": {
+		newResponse: func() interface{} { return &webAccelReadResponseEnvelope{} },
+	},
+	"WebAccel.ReadCertificate": {
+		newResponse: func() interface{} { return &webAccelReadCertificateResponseEnvelope{} },
+	},
+	"WebAccel.CreateCertificate": {
+		newRequest: func() interface{} { return &webAccelCreateCertificateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*WebAccelCertRequest)
+			return (&WebAccelOp{}).transformCreateCertificateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &webAccelCreateCertificateResponseEnvelope{} },
+	},
+	"WebAccel.UpdateCertificate": {
+		newRequest: func() interface{} { return &webAccelUpdateCertificateRequestEnvelope{} },
+		numArgs:    2,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(types.ID)
+			arg1, _ := args[1].(*WebAccelCertRequest)
+			return (&WebAccelOp{}).transformUpdateCertificateArgs(arg0, arg1)
+		},
+		newResponse: func() interface{} { return &webAccelUpdateCertificateResponseEnvelope{} },
+	},
+	"WebAccel.DeleteAllCache": {
+		newRequest: func() interface{} { return &webAccelDeleteAllCacheRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*WebAccelDeleteAllCacheRequest)
+			return (&WebAccelOp{}).transformDeleteAllCacheArgs(arg0)
+		},
+	},
+	"WebAccel.DeleteCache": {
+		newRequest: func() interface{} { return &webAccelDeleteCacheRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*WebAccelDeleteCacheRequest)
+			return (&WebAccelOp{}).transformDeleteCacheArgs(arg0)
+		},
+		newResponse: func() interface{} { return &webAccelDeleteCacheResponseEnvelope{} },
+	},
+	"Zone.Find": {
+		newRequest: func() interface{} { return &zoneFindRequestEnvelope{} },
+		numArgs:    1,
+		transformArgs: func(args []interface{}) (interface{}, error) {
+			arg0, _ := args[0].(*FindCondition)
+			return (&ZoneOp{}).transformFindArgs(arg0)
+		},
+		newResponse: func() interface{} { return &zoneFindResponseEnvelope{} },
+	},
+	"Zone.Read": {
+		newResponse: func() interface{} { return &zoneReadResponseEnvelope{} },
+	},
+}